@@ -2,17 +2,27 @@ package main
 
 import (
 	"context"
+	"crypto/rand"
+	"expvar"
 	"log"
 	"net/http"
 	"os"
 	"os/signal"
+	"sync"
 	"syscall"
 	"time"
 
 	"github.com/jphacks/os_2502/back/api/config"
 	"github.com/jphacks/os_2502/back/api/internal"
+	"github.com/jphacks/os_2502/back/api/internal/auth"
+	"github.com/jphacks/os_2502/back/api/internal/blobstore"
 	"github.com/jphacks/os_2502/back/api/internal/db"
+	"github.com/jphacks/os_2502/back/api/internal/domain/device_token"
 	"github.com/jphacks/os_2502/back/api/internal/infrastructure/repository"
+	"github.com/jphacks/os_2502/back/api/internal/notification"
+	"github.com/jphacks/os_2502/back/api/internal/realtime"
+	"github.com/jphacks/os_2502/back/api/internal/resample"
+	"github.com/jphacks/os_2502/back/api/internal/usecase"
 	"github.com/jphacks/os_2502/back/api/internal/worker"
 )
 
@@ -34,19 +44,149 @@ func main() {
 	}
 	defer database.Close()
 
+	// 組み込みテンプレート（resources/templates.json）をテーブルに取り込む
+	templateRepo := repository.NewCollageTemplateRepositorySQLBoiler(database)
+	if n, err := usecase.NewTemplateImporter(templateRepo, usecase.BuiltinTemplatesPath).Import(context.Background()); err != nil {
+		log.Printf("⚠️ Failed to import built-in templates: %v", err)
+	} else {
+		log.Printf("🖼️ Imported %d built-in templates", n)
+	}
+
+	// グループのイベント配信ハブ（APIとワーカーで共有）
+	hub := realtime.NewHub()
+
+	// Firebase ID トークンの検証
+	if cfg.Auth.FirebaseProjectID == "" {
+		log.Fatalf("FIREBASE_PROJECT_ID が設定されていません")
+	}
+	jwksURL := cfg.Auth.JWKSURL
+	if jwksURL == "" {
+		jwksURL = auth.FirebaseJWKSURL
+	}
+	verifier := auth.NewVerifier(cfg.Auth.FirebaseProjectID, auth.NewJWKS(jwksURL, nil))
+
+	// プッシュ通知（APIとワーカーで共有）
+	deviceTokenRepo := repository.NewDeviceTokenRepositorySQLBoiler(database)
+	notifier := newNotifier(cfg.Notification, deviceTokenRepo)
+
+	// 毎日のコラージュの日付の区切り（APIとワーカーで共有）
+	dailyLocation, err := time.LoadLocation(cfg.Daily.Timezone)
+	if err != nil {
+		log.Printf("⚠️ Unknown DAILY_COLLAGE_TIMEZONE %q, falling back to local time: %v", cfg.Daily.Timezone, err)
+		dailyLocation = time.Local
+	}
+
+	// 写真とコラージュ画像の保存先（APIとワーカーで共有）
+	store := newStore(cfg.Storage)
+
 	// ルーターの初期化と設定
-	router := internal.NewRouter(database)
+	router := internal.NewRouter(database, hub, verifier, notifier, cfg.Capture.Window, dailyLocation, store)
 	handler := router.SetupRoutes()
 
 	// コラージュ生成ワーカーを起動
 	groupRepo := repository.NewGroupRepositorySQLBoiler(database)
 	groupMemberRepo := repository.NewGroupMemberRepositorySQLBoiler(database)
-	collageGenerator := worker.NewCollageGenerator(groupRepo, groupMemberRepo, 10*time.Second)
+	uploadImageRepo := repository.NewUploadImageRepositorySQLBoiler(database)
+	templatePartRepo := repository.NewTemplatePartRepository(database)
+	collageResultRepo := repository.NewCollageResultRepositorySQLBoiler(database)
+	sessionRoundRepo := repository.NewSessionRoundRepositorySQLBoiler(database)
+	resumableUploadRepo := repository.NewResumableUploadRepositorySQLBoiler(database)
+	resampleKernel, err := resample.ParseKernel(cfg.Collage.ResampleKernel)
+	if err != nil {
+		log.Printf("⚠️ %v, falling back to %s", err, resample.Bilinear.Name)
+		resampleKernel = resample.Bilinear
+	}
+	missingPhotos, err := worker.ParseMissingPhotoPolicy(cfg.Capture.MissingPhotos)
+	if err != nil {
+		log.Printf("⚠️ %v, falling back to %s", err, worker.MissingPhotosPlaceholder)
+		missingPhotos = worker.MissingPhotosPlaceholder
+	}
+	collageGenerator := worker.NewCollageGenerator(groupRepo, groupMemberRepo, uploadImageRepo, templateRepo, templatePartRepo, collageResultRepo, sessionRoundRepo, resumableUploadRepo, store, hub, notifier, missingPhotos, resampleKernel)
+	collageJobRepo := repository.NewCollageJobRepositorySQLBoiler(database)
+	jobRunner := worker.NewCollageJobRunner(collageJobRepo, collageGenerator.Generate, worker.CollageJobRunnerConfig{
+		Workers:     cfg.Collage.Workers,
+		Lease:       cfg.Collage.JobLease,
+		BackoffBase: cfg.Collage.JobBackoffBase,
+		BackoffMax:  cfg.Collage.JobBackoffMax,
+	})
 
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
-	go collageGenerator.Start(ctx)
+	// 撮影セッションの進行と、グループの期限切れやトークンの後片付けをする定期ジョブ
+	scheduledJobs := append([]worker.ScheduledJob{
+		worker.CaptureSessionJob(groupRepo, collageJobRepo, hub, cfg.Capture.ResolveInterval),
+	}, worker.LifecycleJobs(
+		groupRepo,
+		repository.NewFriendRepositorySQLBoiler(database),
+		deviceTokenRepo,
+		hub,
+		worker.LifecycleConfig{
+			GroupExpiryInterval:   cfg.Lifecycle.GroupExpiryInterval,
+			StuckSessionInterval:  cfg.Lifecycle.StuckSessionInterval,
+			StuckSessionTimeout:   cfg.Lifecycle.StuckSessionTimeout,
+			FriendRequestInterval: cfg.Lifecycle.FriendRequestInterval,
+			DeviceTokenInterval:   cfg.Lifecycle.DeviceTokenInterval,
+			DeviceTokenIdleDays:   cfg.Lifecycle.DeviceTokenIdleDays,
+		},
+	)...)
+	// 永続グループの毎日のコラージュ
+	dailyCollageScheduler := worker.NewDailyCollageScheduler(
+		groupRepo,
+		groupMemberRepo,
+		repository.NewDailyCollageRepositorySQLBoiler(database),
+		repository.NewGroupPartAssignmentRepository(database),
+		templateRepo,
+		templatePartRepo,
+		uploadImageRepo,
+		collageResultRepo,
+		store,
+		hub,
+		notifier,
+		resampleKernel,
+		worker.DailyCollageConfig{
+			Location:       dailyLocation,
+			StartHour:      cfg.Daily.StartHour,
+			EndHour:        cfg.Daily.EndHour,
+			PlanInterval:   cfg.Daily.PlanInterval,
+			NotifyInterval: cfg.Daily.NotifyInterval,
+			RenderInterval: cfg.Daily.RenderInterval,
+		},
+	)
+	scheduledJobs = append(scheduledJobs, dailyCollageScheduler.Jobs()...)
+	// 確定されなかった直接アップロードと、完了しなかった再開可能なアップロードの後片付け
+	scheduledJobs = append(scheduledJobs,
+		worker.UploadSlotCleanupJob(repository.NewUploadSlotRepositorySQLBoiler(database), store, cfg.Lifecycle.UploadSlotInterval),
+		worker.ResumableUploadCleanupJob(resumableUploadRepo, store, cfg.Lifecycle.ResumableUploadInterval),
+	)
+	scheduler := worker.NewScheduler(nil, scheduledJobs...)
+	expvar.Publish("scheduler", expvar.Func(func() interface{} { return scheduler.Metrics() }))
+
+	var workers sync.WaitGroup
+	workers.Add(2)
+	go func() {
+		defer workers.Done()
+		jobRunner.Start(ctx)
+	}()
+	go func() {
+		defer workers.Done()
+		scheduler.Start(ctx)
+	}()
+	workerDone := make(chan struct{})
+	go func() {
+		workers.Wait()
+		close(workerDone)
+	}()
+
+	// メトリクスはAPIとは別のアドレスで公開する（認証の外に出さない）
+	if cfg.Server.MetricsAddr != "" {
+		go func() {
+			log.Printf("Starting metrics server on %s", cfg.Server.MetricsAddr)
+			if err := http.ListenAndServe(cfg.Server.MetricsAddr, expvar.Handler()); err != nil {
+				log.Printf("⚠️ Metrics server stopped: %v", err)
+			}
+		}()
+	}
 
 	// サーバーを起動
 	go func() {
@@ -67,5 +207,98 @@ func main() {
 	log.Println("サーバーをシャットダウン中...")
 	cancel() // ワーカーを停止
 
+	// 実行中のコラージュ生成と定期ジョブを待つ（終わらなければリースが切れた後に他のインスタンスが再実行する）
+	select {
+	case <-workerDone:
+	case <-time.After(30 * time.Second):
+		log.Println("⚠️ Timed out waiting for workers to finish")
+	}
+
 	log.Println("シャットダウン完了")
 }
+
+// newNotifier 設定されているプロバイダーだけで通知を送る。どちらも未設定なら送らない
+func newNotifier(cfg config.NotificationConfig, tokens device_token.Repository) notification.Notifier {
+	var providers []notification.Provider
+
+	if cfg.APNsKeyPath != "" {
+		pemData, err := os.ReadFile(cfg.APNsKeyPath)
+		if err != nil {
+			log.Fatalf("APNs キーの読み込みに失敗: %v", err)
+		}
+		key, err := notification.ParseAPNsKey(pemData)
+		if err != nil {
+			log.Fatalf("APNs キーの解析に失敗: %v", err)
+		}
+		providers = append(providers, notification.NewAPNs(notification.APNsConfig{
+			KeyID:      cfg.APNsKeyID,
+			TeamID:     cfg.APNsTeamID,
+			BundleID:   cfg.APNsBundleID,
+			Key:        key,
+			Production: cfg.APNsProduction,
+		}, nil))
+	}
+
+	if cfg.FCMCredentialsPath != "" {
+		creds, err := os.ReadFile(cfg.FCMCredentialsPath)
+		if err != nil {
+			log.Fatalf("FCM の認証情報の読み込みに失敗: %v", err)
+		}
+		sa, err := notification.NewServiceAccount(creds, nil)
+		if err != nil {
+			log.Fatalf("FCM の認証情報の解析に失敗: %v", err)
+		}
+		projectID := cfg.FCMProjectID
+		if projectID == "" {
+			projectID = sa.ProjectID()
+		}
+		providers = append(providers, notification.NewFCM(projectID, sa, nil))
+	}
+
+	if len(providers) == 0 {
+		log.Println("⚠️ Push notifications are disabled: neither APNS_KEY_PATH nor FCM_CREDENTIALS_PATH is set")
+		return notification.NopNotifier{}
+	}
+	return notification.NewDispatcher(tokens, providers...)
+}
+
+// newStore STORAGE_BACKEND に応じた保存先を作成する
+func newStore(cfg config.StorageConfig) blobstore.Store {
+	switch cfg.Backend {
+	case "s3":
+		store, err := blobstore.NewS3Store(blobstore.S3Config{
+			Endpoint:        cfg.Endpoint,
+			PublicEndpoint:  cfg.PublicEndpoint,
+			Region:          cfg.Region,
+			Bucket:          cfg.Bucket,
+			AccessKeyID:     cfg.AccessKeyID,
+			SecretAccessKey: cfg.SecretAccessKey,
+			ForcePathStyle:  cfg.ForcePathStyle,
+		}, nil)
+		if err != nil {
+			log.Fatalf("S3 の設定が不正です: %v", err)
+		}
+		log.Printf("🗄️ Storing files in S3: %s", store)
+		return store
+
+	case "local", "":
+		key := []byte(cfg.SigningKey)
+		if len(key) == 0 {
+			// レプリカごと・起動ごとに鍵が変わると、他のプロセスが発行した署名付きURLが使えなくなる
+			if !cfg.IsLocalDevelopment() {
+				log.Fatalf("STORAGE_SIGNING_KEY を設定してください（STORAGE_PUBLIC_URL=%s）", cfg.PublicURL)
+			}
+			log.Println("⚠️ STORAGE_SIGNING_KEY is not set, using a random key: signed URLs will not survive a restart and will not work across replicas")
+			key = make([]byte, 32)
+			if _, err := rand.Read(key); err != nil {
+				log.Fatalf("署名鍵の生成に失敗: %v", err)
+			}
+		}
+		log.Printf("🗄️ Storing files in %s", cfg.LocalDir)
+		return blobstore.NewLocalStore(cfg.LocalDir, cfg.PublicURL, key)
+
+	default:
+		log.Fatalf("不明な STORAGE_BACKEND です: %s", cfg.Backend)
+		return nil
+	}
+}
@@ -3,14 +3,23 @@ package config
 import (
 	"bufio"
 	"log"
+	"net/url"
 	"os"
 	"strconv"
 	"strings"
+	"time"
 )
 
 type Config struct {
-	Database DatabaseConfig
-	Server   ServerConfig
+	Database     DatabaseConfig
+	Server       ServerConfig
+	Collage      CollageConfig
+	Auth         AuthConfig
+	Notification NotificationConfig
+	Lifecycle    LifecycleConfig
+	Capture      CaptureConfig
+	Daily        DailyConfig
+	Storage      StorageConfig
 }
 
 type DatabaseConfig struct {
@@ -23,6 +32,113 @@ type DatabaseConfig struct {
 
 type ServerConfig struct {
 	Port int
+	// MetricsAddr ワーカーのメトリクス (expvar) を公開するアドレス（空の場合は公開しない）
+	MetricsAddr string
+}
+
+type CollageConfig struct {
+	// ResampleKernel 写真をフレームに合わせる際のリサンプリングカーネル (bilinear / catmullrom / lanczos)
+	// デフォルトの bilinear が最も軽い。catmullrom / lanczos はシャープになるが、写真1枚あたりの CPU 時間が
+	// 1.4〜2倍ほどかかる（1コアの場合。数値は internal/resample の BenchmarkResize を参照）
+	ResampleKernel string
+	// Workers 1インスタンスで同時に実行するコラージュ生成ジョブ数
+	Workers int
+	// JobLease 1回の実行でジョブを確保しておく時間
+	JobLease time.Duration
+	// JobBackoffBase / JobBackoffMax 失敗したジョブを再試行するまでの待ち時間（倍々で増え、上限で頭打ち）
+	JobBackoffBase time.Duration
+	JobBackoffMax  time.Duration
+}
+
+type AuthConfig struct {
+	// FirebaseProjectID ID トークンの iss / aud に入る Firebase プロジェクトID
+	FirebaseProjectID string
+	// JWKSURL ID トークンの署名鍵を取得する JWKS のURL（空の場合は Firebase の公開URL）
+	JWKSURL string
+}
+
+type NotificationConfig struct {
+	// APNsKeyPath Apple Developer で発行した .p8 キーのパス（空の場合は iOS へ送らない）
+	APNsKeyPath string
+	APNsKeyID   string
+	APNsTeamID  string
+	// APNsBundleID 通知の apns-topic になるアプリのバンドルID
+	APNsBundleID string
+	// APNsProduction false の場合は sandbox の APNs に送る
+	APNsProduction bool
+	// FCMCredentialsPath サービスアカウントのJSONのパス（空の場合は Android へ送らない）
+	FCMCredentialsPath string
+	// FCMProjectID 空の場合はサービスアカウントの project_id を使う
+	FCMProjectID string
+}
+
+// CaptureConfig 撮影セッションの設定
+type CaptureConfig struct {
+	// Window 撮影時刻から写真を受け付ける時間
+	Window time.Duration
+	// MissingPhotos 締め切りまでに写真が揃わなかったときの扱い (placeholder / fail)
+	MissingPhotos string
+	// ResolveInterval セッションを撮影中に進めたり締め切りを確認したりする間隔
+	ResolveInterval time.Duration
+}
+
+// DailyConfig 永続グループの毎日のコラージュの設定
+type DailyConfig struct {
+	// Timezone 撮影時刻と日付の区切りを決めるタイムゾーン (IANA 名)
+	Timezone string
+	// StartHour / EndHour この時間帯 [StartHour, EndHour) の中からランダムに撮影時刻を選ぶ
+	StartHour int
+	EndHour   int
+	// PlanInterval / NotifyInterval / RenderInterval 予定の作成・撮影の通知・コラージュ生成をする間隔
+	PlanInterval   time.Duration
+	NotifyInterval time.Duration
+	RenderInterval time.Duration
+}
+
+// StorageConfig 写真とコラージュ画像の保存先
+type StorageConfig struct {
+	// Backend local（ローカルディスク）または s3（S3 互換のオブジェクトストレージ）
+	Backend string
+	// LocalDir local の保存先のディレクトリ
+	LocalDir string
+	// PublicURL local の署名付きURLのベース（/api/blobs を公開しているURL）
+	PublicURL string
+	// SigningKey local の署名付きURLの署名鍵。全てのレプリカで同じ値にする。
+	// 空の場合は PublicURL がローカルホストのとき（ローカル開発）だけ起動ごとにランダムな鍵を使い、それ以外では起動しない
+	SigningKey string
+	// S3 の接続先。PublicEndpoint は署名付きURLに使う（空なら Endpoint）
+	Bucket          string
+	Endpoint        string
+	PublicEndpoint  string
+	Region          string
+	AccessKeyID     string
+	SecretAccessKey string
+	ForcePathStyle  bool
+}
+
+// IsLocalDevelopment PublicURL がローカルホストを指しているか
+func (c StorageConfig) IsLocalDevelopment() bool {
+	u, err := url.Parse(c.PublicURL)
+	if err != nil {
+		return false
+	}
+	switch u.Hostname() {
+	case "localhost", "127.0.0.1", "::1":
+		return true
+	}
+	return false
+}
+
+// LifecycleConfig グループやトークンの後片付けをする定期ジョブの設定
+type LifecycleConfig struct {
+	GroupExpiryInterval     time.Duration
+	StuckSessionInterval    time.Duration
+	StuckSessionTimeout     time.Duration
+	FriendRequestInterval   time.Duration
+	DeviceTokenInterval     time.Duration
+	DeviceTokenIdleDays     int
+	UploadSlotInterval      time.Duration
+	ResumableUploadInterval time.Duration
 }
 
 func Load() *Config {
@@ -34,6 +150,25 @@ func Load() *Config {
 
 	dbPort, _ := strconv.Atoi(getEnvOrDefault("DB_PORT", port))
 	serverPort, _ := strconv.Atoi(getEnvOrDefault("SERVER_PORT", "8080"))
+	collageWorkers, _ := strconv.Atoi(getEnvOrDefault("COLLAGE_WORKERS", "2"))
+	jobLease, _ := time.ParseDuration(getEnvOrDefault("COLLAGE_JOB_LEASE", "2m"))
+	jobBackoffBase, _ := time.ParseDuration(getEnvOrDefault("COLLAGE_JOB_BACKOFF_BASE", "5s"))
+	jobBackoffMax, _ := time.ParseDuration(getEnvOrDefault("COLLAGE_JOB_BACKOFF_MAX", "5m"))
+	groupExpiryInterval, _ := time.ParseDuration(getEnvOrDefault("GROUP_EXPIRY_INTERVAL", "1m"))
+	stuckSessionInterval, _ := time.ParseDuration(getEnvOrDefault("STUCK_SESSION_INTERVAL", "1m"))
+	stuckSessionTimeout, _ := time.ParseDuration(getEnvOrDefault("STUCK_SESSION_TIMEOUT", "15m"))
+	friendRequestInterval, _ := time.ParseDuration(getEnvOrDefault("FRIEND_REQUEST_PURGE_INTERVAL", "1h"))
+	deviceTokenInterval, _ := time.ParseDuration(getEnvOrDefault("DEVICE_TOKEN_SWEEP_INTERVAL", "24h"))
+	deviceTokenIdleDays, _ := strconv.Atoi(getEnvOrDefault("DEVICE_TOKEN_IDLE_DAYS", "90"))
+	uploadSlotInterval, _ := time.ParseDuration(getEnvOrDefault("UPLOAD_SLOT_PURGE_INTERVAL", "10m"))
+	resumableUploadInterval, _ := time.ParseDuration(getEnvOrDefault("RESUMABLE_UPLOAD_PURGE_INTERVAL", "10m"))
+	captureWindow, _ := time.ParseDuration(getEnvOrDefault("CAPTURE_WINDOW", "60s"))
+	captureResolveInterval, _ := time.ParseDuration(getEnvOrDefault("CAPTURE_RESOLVE_INTERVAL", "5s"))
+	dailyStartHour, _ := strconv.Atoi(getEnvOrDefault("DAILY_COLLAGE_START_HOUR", "9"))
+	dailyEndHour, _ := strconv.Atoi(getEnvOrDefault("DAILY_COLLAGE_END_HOUR", "21"))
+	dailyPlanInterval, _ := time.ParseDuration(getEnvOrDefault("DAILY_COLLAGE_PLAN_INTERVAL", "10m"))
+	dailyNotifyInterval, _ := time.ParseDuration(getEnvOrDefault("DAILY_COLLAGE_NOTIFY_INTERVAL", "30s"))
+	dailyRenderInterval, _ := time.ParseDuration(getEnvOrDefault("DAILY_COLLAGE_RENDER_INTERVAL", "1m"))
 
 	return &Config{
 		Database: DatabaseConfig{
@@ -44,7 +179,64 @@ func Load() *Config {
 			Password: getEnvOrDefault("MYSQL_PASSWORD", ""),
 		},
 		Server: ServerConfig{
-			Port: serverPort,
+			Port:        serverPort,
+			MetricsAddr: getEnvOrDefault("METRICS_ADDR", ""),
+		},
+		Collage: CollageConfig{
+			ResampleKernel: getEnvOrDefault("COLLAGE_RESAMPLE_KERNEL", "bilinear"),
+			Workers:        collageWorkers,
+			JobLease:       jobLease,
+			JobBackoffBase: jobBackoffBase,
+			JobBackoffMax:  jobBackoffMax,
+		},
+		Auth: AuthConfig{
+			FirebaseProjectID: getEnvOrDefault("FIREBASE_PROJECT_ID", ""),
+			JWKSURL:           getEnvOrDefault("FIREBASE_JWKS_URL", ""),
+		},
+		Notification: NotificationConfig{
+			APNsKeyPath:        getEnvOrDefault("APNS_KEY_PATH", ""),
+			APNsKeyID:          getEnvOrDefault("APNS_KEY_ID", ""),
+			APNsTeamID:         getEnvOrDefault("APNS_TEAM_ID", ""),
+			APNsBundleID:       getEnvOrDefault("APNS_BUNDLE_ID", ""),
+			APNsProduction:     getEnvOrDefault("APNS_PRODUCTION", "false") == "true",
+			FCMCredentialsPath: getEnvOrDefault("FCM_CREDENTIALS_PATH", ""),
+			FCMProjectID:       getEnvOrDefault("FCM_PROJECT_ID", ""),
+		},
+		Lifecycle: LifecycleConfig{
+			GroupExpiryInterval:     groupExpiryInterval,
+			StuckSessionInterval:    stuckSessionInterval,
+			StuckSessionTimeout:     stuckSessionTimeout,
+			FriendRequestInterval:   friendRequestInterval,
+			DeviceTokenInterval:     deviceTokenInterval,
+			DeviceTokenIdleDays:     deviceTokenIdleDays,
+			UploadSlotInterval:      uploadSlotInterval,
+			ResumableUploadInterval: resumableUploadInterval,
+		},
+		Capture: CaptureConfig{
+			Window:          captureWindow,
+			MissingPhotos:   getEnvOrDefault("CAPTURE_MISSING_PHOTOS", "placeholder"),
+			ResolveInterval: captureResolveInterval,
+		},
+		Daily: DailyConfig{
+			Timezone:       getEnvOrDefault("DAILY_COLLAGE_TIMEZONE", "Asia/Tokyo"),
+			StartHour:      dailyStartHour,
+			EndHour:        dailyEndHour,
+			PlanInterval:   dailyPlanInterval,
+			NotifyInterval: dailyNotifyInterval,
+			RenderInterval: dailyRenderInterval,
+		},
+		Storage: StorageConfig{
+			Backend:         getEnvOrDefault("STORAGE_BACKEND", "local"),
+			LocalDir:        getEnvOrDefault("STORAGE_LOCAL_DIR", "/uploads"),
+			PublicURL:       getEnvOrDefault("STORAGE_PUBLIC_URL", "http://localhost:8080/api/blobs"),
+			SigningKey:      getEnvOrDefault("STORAGE_SIGNING_KEY", ""),
+			Bucket:          getEnvOrDefault("BUCKET_NAME", ""),
+			Endpoint:        getEnvOrDefault("S3_ENDPOINT", ""),
+			PublicEndpoint:  getEnvOrDefault("S3_PUBLIC_ENDPOINT", ""),
+			Region:          getEnvOrDefault("AWS_REGION", "us-east-1"),
+			AccessKeyID:     getEnvOrDefault("AWS_ACCESS_KEY_ID", ""),
+			SecretAccessKey: getEnvOrDefault("AWS_SECRET_ACCESS_KEY", ""),
+			ForcePathStyle:  getEnvOrDefault("AWS_S3_FORCE_PATH_STYLE", "false") == "true",
 		},
 	}
 }
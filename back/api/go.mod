@@ -14,6 +14,7 @@ require (
 	github.com/gorilla/websocket v1.5.3
 	github.com/kat-co/vala v0.0.0-20170210184112-42e1d8b61f12
 	github.com/spf13/viper v1.21.0
+	golang.org/x/image v0.25.0
 )
 
 require (
@@ -0,0 +1,36 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/jphacks/os_2502/back/api/internal/domain/user"
+)
+
+type contextKey int
+
+const (
+	tokenKey contextKey = iota
+	userKey
+)
+
+// WithToken 検証済みのトークンをコンテキストに入れる
+func WithToken(ctx context.Context, token *Token) context.Context {
+	return context.WithValue(ctx, tokenKey, token)
+}
+
+// TokenFromContext 検証済みのトークンを取り出す
+func TokenFromContext(ctx context.Context) (*Token, bool) {
+	token, ok := ctx.Value(tokenKey).(*Token)
+	return token, ok && token != nil
+}
+
+// WithUser トークンから特定したユーザーをコンテキストに入れる
+func WithUser(ctx context.Context, u *user.User) context.Context {
+	return context.WithValue(ctx, userKey, u)
+}
+
+// UserFromContext トークンから特定したユーザーを取り出す。未登録のユーザーの場合は false
+func UserFromContext(ctx context.Context) (*user.User, bool) {
+	u, ok := ctx.Value(userKey).(*user.User)
+	return u, ok && u != nil
+}
@@ -0,0 +1,150 @@
+// Package auth は Firebase ID トークンを検証し、リクエストの呼び出し元を特定する。
+//
+// トークンは RS256 の JWT で、KeySource から得た公開鍵で署名を検証した後に
+// iss / aud / exp / iat / auth_time / sub を Firebase の仕様どおりに確認する。
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrInvalidToken 形式や署名、クレームが不正なトークン
+	ErrInvalidToken = errors.New("auth: invalid id token")
+	// ErrTokenExpired 有効期限が切れたトークン
+	ErrTokenExpired = errors.New("auth: id token expired")
+	// ErrUnknownKey kid に対応する公開鍵が無い
+	ErrUnknownKey = errors.New("auth: unknown signing key")
+)
+
+// clockSkew 端末とサーバーの時計のずれとして許容する幅
+const clockSkew = time.Minute
+
+// Token 検証済みの Firebase ID トークン
+type Token struct {
+	UID       string
+	Email     string
+	IssuedAt  time.Time
+	ExpiresAt time.Time
+}
+
+// Verifier Firebase ID トークンの検証
+type Verifier struct {
+	projectID string
+	keys      KeySource
+	now       func() time.Time
+}
+
+// NewVerifier projectID の Firebase プロジェクトが発行したトークンを keys で検証する Verifier を作成
+func NewVerifier(projectID string, keys KeySource) *Verifier {
+	return &Verifier{
+		projectID: projectID,
+		keys:      keys,
+		now:       time.Now,
+	}
+}
+
+type header struct {
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+}
+
+type claims struct {
+	Issuer   string  `json:"iss"`
+	Audience string  `json:"aud"`
+	Subject  string  `json:"sub"`
+	IssuedAt float64 `json:"iat"`
+	Expires  float64 `json:"exp"`
+	AuthTime float64 `json:"auth_time"`
+	Email    string  `json:"email"`
+}
+
+// Verify トークンを検証して中身を返す
+func (v *Verifier) Verify(ctx context.Context, raw string) (*Token, error) {
+	parts := strings.Split(raw, ".")
+	if len(parts) != 3 {
+		return nil, ErrInvalidToken
+	}
+
+	var h header
+	if err := decodeSegment(parts[0], &h); err != nil {
+		return nil, ErrInvalidToken
+	}
+	// alg を固定し、none や HS256 へのすり替えを受け付けない
+	if h.Alg != "RS256" || h.Kid == "" {
+		return nil, ErrInvalidToken
+	}
+
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+
+	key, err := v.keys.PublicKey(ctx, h.Kid)
+	if err != nil {
+		return nil, err
+	}
+
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	if err := rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], sig); err != nil {
+		return nil, ErrInvalidToken
+	}
+
+	var c claims
+	if err := decodeSegment(parts[1], &c); err != nil {
+		return nil, ErrInvalidToken
+	}
+
+	return v.validate(&c)
+}
+
+// validate Firebase が定めるクレームの条件を確認
+func (v *Verifier) validate(c *claims) (*Token, error) {
+	now := v.now()
+
+	if c.Issuer != "https://securetoken.google.com/"+v.projectID || c.Audience != v.projectID {
+		return nil, ErrInvalidToken
+	}
+	if c.Subject == "" || len(c.Subject) > 128 {
+		return nil, ErrInvalidToken
+	}
+
+	issuedAt := unixTime(c.IssuedAt)
+	expiresAt := unixTime(c.Expires)
+	if c.IssuedAt <= 0 || issuedAt.After(now.Add(clockSkew)) {
+		return nil, ErrInvalidToken
+	}
+	if c.AuthTime <= 0 || unixTime(c.AuthTime).After(now.Add(clockSkew)) {
+		return nil, ErrInvalidToken
+	}
+	if c.Expires <= 0 || !now.Before(expiresAt.Add(clockSkew)) {
+		return nil, ErrTokenExpired
+	}
+
+	return &Token{
+		UID:       c.Subject,
+		Email:     c.Email,
+		IssuedAt:  issuedAt,
+		ExpiresAt: expiresAt,
+	}, nil
+}
+
+func decodeSegment(seg string, v interface{}) error {
+	b, err := base64.RawURLEncoding.DecodeString(seg)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, v)
+}
+
+func unixTime(sec float64) time.Time {
+	return time.Unix(int64(sec), 0)
+}
@@ -0,0 +1,133 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+const testProject = "test-project"
+
+var testKey = func() *rsa.PrivateKey {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		panic(err)
+	}
+	return key
+}()
+
+func sign(t *testing.T, key *rsa.PrivateKey, hdr, body map[string]interface{}) string {
+	t.Helper()
+	h, _ := json.Marshal(hdr)
+	b, _ := json.Marshal(body)
+	signing := base64.RawURLEncoding.EncodeToString(h) + "." + base64.RawURLEncoding.EncodeToString(b)
+	digest := sha256.Sum256([]byte(signing))
+	sig, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signing + "." + base64.RawURLEncoding.EncodeToString(sig)
+}
+
+func validClaims(now time.Time) map[string]interface{} {
+	return map[string]interface{}{
+		"iss":       "https://securetoken.google.com/" + testProject,
+		"aud":       testProject,
+		"sub":       "firebase-uid-1",
+		"iat":       now.Add(-time.Minute).Unix(),
+		"exp":       now.Add(time.Hour).Unix(),
+		"auth_time": now.Add(-time.Hour).Unix(),
+		"email":     "user@example.com",
+	}
+}
+
+func TestVerify(t *testing.T) {
+	now := time.Now()
+	v := NewVerifier(testProject, StaticKeys{"k1": &testKey.PublicKey})
+	otherKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	with := func(key string, value interface{}) map[string]interface{} {
+		c := validClaims(now)
+		c[key] = value
+		return c
+	}
+	rs256 := map[string]interface{}{"alg": "RS256", "kid": "k1"}
+
+	tests := []struct {
+		name    string
+		token   string
+		wantErr error
+	}{
+		{name: "valid", token: sign(t, testKey, rs256, validClaims(now))},
+		{name: "wrong audience", token: sign(t, testKey, rs256, with("aud", "other")), wantErr: ErrInvalidToken},
+		{name: "wrong issuer", token: sign(t, testKey, rs256, with("iss", "https://securetoken.google.com/other")), wantErr: ErrInvalidToken},
+		{name: "expired", token: sign(t, testKey, rs256, with("exp", now.Add(-2*time.Minute).Unix())), wantErr: ErrTokenExpired},
+		{name: "issued in the future", token: sign(t, testKey, rs256, with("iat", now.Add(time.Hour).Unix())), wantErr: ErrInvalidToken},
+		{name: "empty subject", token: sign(t, testKey, rs256, with("sub", "")), wantErr: ErrInvalidToken},
+		{name: "unknown kid", token: sign(t, testKey, map[string]interface{}{"alg": "RS256", "kid": "nope"}, validClaims(now)), wantErr: ErrUnknownKey},
+		{name: "alg none", token: sign(t, testKey, map[string]interface{}{"alg": "none", "kid": "k1"}, validClaims(now)), wantErr: ErrInvalidToken},
+		{name: "signed by another key", token: sign(t, otherKey, rs256, validClaims(now)), wantErr: ErrInvalidToken},
+		{name: "malformed", token: "not.a.jwt", wantErr: ErrInvalidToken},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token, err := v.Verify(context.Background(), tt.token)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Verify() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil && (token.UID != "firebase-uid-1" || token.Email != "user@example.com") {
+				t.Errorf("Verify() = %+v", token)
+			}
+		})
+	}
+}
+
+func TestJWKSCachesKeys(t *testing.T) {
+	var fetches int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&fetches, 1)
+		w.Header().Set("Cache-Control", "public, max-age=3600, must-revalidate")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"keys": []map[string]string{{
+				"kty": "RSA",
+				"kid": "k1",
+				"n":   base64.RawURLEncoding.EncodeToString(testKey.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(testKey.E)).Bytes()),
+			}},
+		})
+	}))
+	defer srv.Close()
+
+	v := NewVerifier(testProject, NewJWKS(srv.URL, srv.Client()))
+	token := sign(t, testKey, map[string]interface{}{"alg": "RS256", "kid": "k1"}, validClaims(time.Now()))
+
+	for i := 0; i < 3; i++ {
+		if _, err := v.Verify(context.Background(), token); err != nil {
+			t.Fatalf("Verify() error = %v", err)
+		}
+	}
+	// 未知の kid は直前に取得したばかりなので再取得しない
+	unknown := sign(t, testKey, map[string]interface{}{"alg": "RS256", "kid": "k2"}, validClaims(time.Now()))
+	if _, err := v.Verify(context.Background(), unknown); !errors.Is(err, ErrUnknownKey) {
+		t.Fatalf("Verify() error = %v, want %v", err, ErrUnknownKey)
+	}
+
+	if n := atomic.LoadInt32(&fetches); n != 1 {
+		t.Errorf("JWKS fetched %d times, want 1", n)
+	}
+}
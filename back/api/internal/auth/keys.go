@@ -0,0 +1,179 @@
+package auth
+
+import (
+	"context"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// FirebaseJWKSURL Firebase ID トークンの署名鍵を公開している JWKS
+const FirebaseJWKSURL = "https://www.googleapis.com/service_accounts/v1/jwk/securetoken@system.gserviceaccount.com"
+
+const (
+	// defaultKeyTTL Cache-Control が無い場合に鍵をキャッシュする期間
+	defaultKeyTTL = time.Hour
+	// minRefreshInterval 未知の kid による再取得の最小間隔。不正なトークンで JWKS を叩かれ続けないようにする
+	minRefreshInterval = time.Minute
+	// retryInterval 取得に失敗した後、再取得を試みるまでの間隔
+	retryInterval = 10 * time.Second
+)
+
+// KeySource kid に対応する署名検証用の公開鍵を返す
+type KeySource interface {
+	PublicKey(ctx context.Context, kid string) (*rsa.PublicKey, error)
+}
+
+// StaticKeys 固定の鍵セット（テストやローカル環境用）
+type StaticKeys map[string]*rsa.PublicKey
+
+// PublicKey kid に対応する鍵を返す
+func (s StaticKeys) PublicKey(_ context.Context, kid string) (*rsa.PublicKey, error) {
+	key, ok := s[kid]
+	if !ok {
+		return nil, ErrUnknownKey
+	}
+	return key, nil
+}
+
+// JWKS HTTP で取得した JWKS をキャッシュする KeySource
+type JWKS struct {
+	url    string
+	client *http.Client
+
+	mu        sync.Mutex
+	keys      map[string]*rsa.PublicKey
+	expiresAt time.Time
+	fetchedAt time.Time
+	lastErr   error
+}
+
+// NewJWKS url の JWKS を使う KeySource を作成
+func NewJWKS(url string, client *http.Client) *JWKS {
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+	return &JWKS{url: url, client: client}
+}
+
+// PublicKey kid に対応する鍵を返す。キャッシュが切れているか kid が未知の場合は取得し直す
+func (j *JWKS) PublicKey(ctx context.Context, kid string) (*rsa.PublicKey, error) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	now := time.Now()
+	key, ok := j.keys[kid]
+	expired := !now.Before(j.expiresAt)
+	if ok && !expired {
+		return key, nil
+	}
+
+	// 鍵のローテーション直後は未知の kid が来るため取得し直すが、短時間に何度も取得しない
+	if expired || now.Sub(j.fetchedAt) >= minRefreshInterval {
+		if err := j.refresh(ctx, now); err != nil {
+			j.lastErr = err
+			// 取得に失敗した場合はしばらく再取得せず、手元の鍵があれば使い続ける
+			j.expiresAt = now.Add(retryInterval)
+			if ok {
+				return key, nil
+			}
+			return nil, err
+		}
+		j.lastErr = nil
+	}
+
+	key, ok = j.keys[kid]
+	if !ok {
+		if j.lastErr != nil {
+			return nil, j.lastErr
+		}
+		return nil, ErrUnknownKey
+	}
+	return key, nil
+}
+
+func (j *JWKS) refresh(ctx context.Context, now time.Time) error {
+	j.fetchedAt = now
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, j.url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := j.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("auth: fetch jwks: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("auth: fetch jwks: unexpected status %d", resp.StatusCode)
+	}
+
+	var set struct {
+		Keys []struct {
+			Kty string `json:"kty"`
+			Kid string `json:"kid"`
+			N   string `json:"n"`
+			E   string `json:"e"`
+		} `json:"keys"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&set); err != nil {
+		return fmt.Errorf("auth: decode jwks: %w", err)
+	}
+
+	keys := make(map[string]*rsa.PublicKey, len(set.Keys))
+	for _, k := range set.Keys {
+		if k.Kty != "RSA" || k.Kid == "" {
+			continue
+		}
+		key, err := rsaPublicKey(k.N, k.E)
+		if err != nil {
+			return err
+		}
+		keys[k.Kid] = key
+	}
+
+	j.keys = keys
+	j.expiresAt = now.Add(maxAge(resp.Header.Get("Cache-Control")))
+	return nil
+}
+
+// rsaPublicKey JWK の n, e（base64url）から公開鍵を作る
+func rsaPublicKey(n, e string) (*rsa.PublicKey, error) {
+	nb, err := base64.RawURLEncoding.DecodeString(n)
+	if err != nil {
+		return nil, fmt.Errorf("auth: invalid jwk modulus: %w", err)
+	}
+	eb, err := base64.RawURLEncoding.DecodeString(e)
+	if err != nil {
+		return nil, fmt.Errorf("auth: invalid jwk exponent: %w", err)
+	}
+
+	exp := new(big.Int).SetBytes(eb)
+	if !exp.IsInt64() || exp.Int64() < 3 || exp.Int64() > 1<<31-1 {
+		return nil, fmt.Errorf("auth: invalid jwk exponent")
+	}
+
+	return &rsa.PublicKey{N: new(big.Int).SetBytes(nb), E: int(exp.Int64())}, nil
+}
+
+// maxAge Cache-Control の max-age を返す
+func maxAge(cacheControl string) time.Duration {
+	for _, directive := range strings.Split(cacheControl, ",") {
+		name, value, ok := strings.Cut(strings.TrimSpace(directive), "=")
+		if !ok || !strings.EqualFold(name, "max-age") {
+			continue
+		}
+		if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+			return time.Duration(seconds) * time.Second
+		}
+	}
+	return defaultKeyTTL
+}
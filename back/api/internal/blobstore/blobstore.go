@@ -0,0 +1,92 @@
+// Package blobstore は写真やコラージュ画像などのファイルの保存先を抽象化する。
+//
+// キーは "groups/{groupID}/{file}" のようなスラッシュ区切りの相対パス。
+// ローカルディスク（LocalStore）と S3 互換のオブジェクトストレージ（S3Store, MinIO を含む）の実装がある。
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"io"
+	"path"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrNotFound オブジェクトが存在しない
+	ErrNotFound = errors.New("blobstore: object not found")
+	// ErrInvalidKey 空のキー、絶対パスや .. を含むキー
+	ErrInvalidKey = errors.New("blobstore: invalid key")
+)
+
+// ObjectInfo オブジェクトのメタデータ
+type ObjectInfo struct {
+	Key         string
+	Size        int64
+	ContentType string
+	ETag        string
+	ModTime     time.Time
+}
+
+// Store ファイルの保存先
+type Store interface {
+	// Put r の内容を key に保存する（同じキーがあれば置き換える）。size が分からなければ -1
+	Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error
+
+	// Get key の内容を開く。呼び出し側が Close する
+	Get(ctx context.Context, key string) (io.ReadCloser, *ObjectInfo, error)
+
+	// Delete key を削除する（存在しなくてもエラーにしない）
+	Delete(ctx context.Context, key string) error
+
+	// Stat key のメタデータ
+	Stat(ctx context.Context, key string) (*ObjectInfo, error)
+
+	// List prefix で始まるオブジェクトをキーの順に返す
+	List(ctx context.Context, prefix string) ([]ObjectInfo, error)
+
+	// SignedURL 認証なしで method (GET / PUT) を expires の間だけ許可するURL
+	SignedURL(ctx context.Context, method, key string, expires time.Duration) (string, error)
+}
+
+// LegacyRoot 保存先を抽象化する前にファイルを置いていたディレクトリ
+const LegacyRoot = "/uploads/"
+
+// KeyFromFileURL DB の file_url からキーを取り出す
+// 以前のレコードは "/uploads/groups/..." の絶対パスなので先頭の /uploads/ を外す
+func KeyFromFileURL(fileURL string) string {
+	return strings.TrimPrefix(fileURL, LegacyRoot)
+}
+
+// validateKey キーが保存先の外を指さないか確認する
+func validateKey(key string) error {
+	if key == "" || strings.HasPrefix(key, "/") || strings.Contains(key, "\\") || path.Clean(key) != key || key == ".." || strings.HasPrefix(key, "../") {
+		return ErrInvalidKey
+	}
+	return nil
+}
+
+// ReadAll key の内容をすべて読む
+func ReadAll(ctx context.Context, s Store, key string) ([]byte, error) {
+	rc, _, err := s.Get(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+	return io.ReadAll(rc)
+}
+
+// DeletePrefix prefix で始まるオブジェクトをすべて削除
+func DeletePrefix(ctx context.Context, s Store, prefix string) error {
+	objects, err := s.List(ctx, prefix)
+	if err != nil {
+		return err
+	}
+	for _, o := range objects {
+		if err := s.Delete(ctx, o.Key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
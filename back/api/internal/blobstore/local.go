@@ -0,0 +1,273 @@
+package blobstore
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// MaxSignedPutSize 署名付きURLで LocalStore に PUT できる大きさの上限
+const MaxSignedPutSize = 32 << 20
+
+// tempPrefix 書き込み中のファイル（List には出さない）
+const tempPrefix = ".blob-"
+
+// LocalStore ローカルディスクに保存する Store
+// 署名付きURLは baseURL に mount した ServeHTTP で受ける（開発環境で S3 の代わりに使う）
+type LocalStore struct {
+	root    string
+	baseURL string
+	secret  []byte
+	now     func() time.Time
+}
+
+// NewLocalStore root 以下に保存する。baseURL は ServeHTTP を mount したURL（例: http://localhost:8080/api/blobs）
+func NewLocalStore(root, baseURL string, secret []byte) *LocalStore {
+	return &LocalStore{root: root, baseURL: strings.TrimSuffix(baseURL, "/"), secret: secret, now: time.Now}
+}
+
+func (s *LocalStore) path(key string) (string, error) {
+	if err := validateKey(key); err != nil {
+		return "", err
+	}
+	return filepath.Join(s.root, filepath.FromSlash(key)), nil
+}
+
+func (s *LocalStore) Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error {
+	p, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+		return err
+	}
+
+	// 書きかけのファイルを読まれないよう、一時ファイルに書いてから置き換える
+	tmp, err := os.CreateTemp(filepath.Dir(p), tempPrefix+"*")
+	if err != nil {
+		return err
+	}
+	n, err := io.Copy(tmp, r)
+	if err == nil && size >= 0 && n != size {
+		err = fmt.Errorf("blobstore: wrote %d bytes, want %d", n, size)
+	}
+	if cerr := tmp.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Chmod(tmp.Name(), 0644)
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), p)
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return nil
+}
+
+func (s *LocalStore) Get(ctx context.Context, key string) (io.ReadCloser, *ObjectInfo, error) {
+	p, err := s.path(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	f, err := os.Open(p)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil, ErrNotFound
+		}
+		return nil, nil, err
+	}
+	info, err := s.info(key, f)
+	if err != nil {
+		f.Close()
+		return nil, nil, err
+	}
+	return f, info, nil
+}
+
+func (s *LocalStore) Delete(ctx context.Context, key string) error {
+	p, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(p); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+func (s *LocalStore) Stat(ctx context.Context, key string) (*ObjectInfo, error) {
+	rc, info, err := s.Get(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	rc.Close()
+	return info, nil
+}
+
+// info ファイルのメタデータ。ディスクには Content-Type を持たないので先頭のバイトから判定する
+func (s *LocalStore) info(key string, f *os.File) (*ObjectInfo, error) {
+	st, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	if st.IsDir() {
+		return nil, ErrNotFound
+	}
+
+	head := make([]byte, 512)
+	n, err := f.ReadAt(head, 0)
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+
+	return &ObjectInfo{
+		Key:         key,
+		Size:        st.Size(),
+		ContentType: http.DetectContentType(head[:n]),
+		ETag:        fmt.Sprintf(`"%x-%x"`, st.ModTime().UnixNano(), st.Size()),
+		ModTime:     st.ModTime(),
+	}, nil
+}
+
+func (s *LocalStore) List(ctx context.Context, prefix string) ([]ObjectInfo, error) {
+	// prefix のディレクトリ部分から辿る
+	dir := s.root
+	if i := strings.LastIndex(prefix, "/"); i >= 0 {
+		p, err := s.path(prefix[:i])
+		if err != nil {
+			return nil, err
+		}
+		dir = p
+	}
+
+	var objects []ObjectInfo
+	err := filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if d.IsDir() || strings.HasPrefix(d.Name(), tempPrefix) {
+			return nil
+		}
+		rel, err := filepath.Rel(s.root, p)
+		if err != nil {
+			return err
+		}
+		key := filepath.ToSlash(rel)
+		if !strings.HasPrefix(key, prefix) {
+			return nil
+		}
+		st, err := d.Info()
+		if err != nil {
+			return err
+		}
+		objects = append(objects, ObjectInfo{
+			Key:     key,
+			Size:    st.Size(),
+			ETag:    fmt.Sprintf(`"%x-%x"`, st.ModTime().UnixNano(), st.Size()),
+			ModTime: st.ModTime(),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(objects, func(i, j int) bool { return objects[i].Key < objects[j].Key })
+	return objects, nil
+}
+
+func (s *LocalStore) SignedURL(ctx context.Context, method, key string, expires time.Duration) (string, error) {
+	if err := validateKey(key); err != nil {
+		return "", err
+	}
+	if method != http.MethodGet && method != http.MethodPut {
+		return "", fmt.Errorf("blobstore: unsupported method %s", method)
+	}
+
+	exp := strconv.FormatInt(s.now().Add(expires).Unix(), 10)
+	q := url.Values{}
+	q.Set("method", method)
+	q.Set("expires", exp)
+	q.Set("signature", s.sign(method, key, exp))
+	return s.baseURL + "/" + escapePath(key) + "?" + q.Encode(), nil
+}
+
+// sign 署名付きURLの署名（HMAC-SHA256）
+func (s *LocalStore) sign(method, key, expires string) string {
+	mac := hmac.New(sha256.New, s.secret)
+	fmt.Fprintf(mac, "%s\n%s\n%s", method, key, expires)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// ServeHTTP SignedURL で発行したURLへの GET / HEAD / PUT を受ける
+// mount したパスは http.StripPrefix で外しておく
+func (s *LocalStore) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	key := strings.TrimPrefix(r.URL.Path, "/")
+	q := r.URL.Query()
+
+	method := r.Method
+	if method == http.MethodHead {
+		method = http.MethodGet
+	}
+	exp, err := strconv.ParseInt(q.Get("expires"), 10, 64)
+	valid := err == nil && q.Get("method") == method &&
+		hmac.Equal([]byte(q.Get("signature")), []byte(s.sign(method, key, q.Get("expires"))))
+	if !valid || validateKey(key) != nil {
+		http.Error(w, "invalid signature", http.StatusForbidden)
+		return
+	}
+	if s.now().Unix() > exp {
+		http.Error(w, "signature expired", http.StatusForbidden)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet, http.MethodHead:
+		rc, info, err := s.Get(r.Context(), key)
+		if err != nil {
+			if err == ErrNotFound {
+				http.NotFound(w, r)
+			} else {
+				http.Error(w, "failed to read object", http.StatusInternalServerError)
+			}
+			return
+		}
+		defer rc.Close()
+		w.Header().Set("Content-Type", info.ContentType)
+		w.Header().Set("ETag", info.ETag)
+		http.ServeContent(w, r, "", info.ModTime, rc.(io.ReadSeeker))
+
+	case http.MethodPut:
+		if r.ContentLength > MaxSignedPutSize {
+			http.Error(w, "object too large", http.StatusRequestEntityTooLarge)
+			return
+		}
+		body := http.MaxBytesReader(w, r.Body, MaxSignedPutSize)
+		if err := s.Put(r.Context(), key, body, r.ContentLength, r.Header.Get("Content-Type")); err != nil {
+			http.Error(w, "failed to write object", http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+
+	default:
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+	}
+}
@@ -0,0 +1,77 @@
+package blobstore
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLocalStore(t *testing.T) {
+	ctx := context.Background()
+	mux := http.NewServeMux()
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	store := NewLocalStore(t.TempDir(), srv.URL+"/api/blobs", []byte("secret"))
+	mux.Handle("/api/blobs/", http.StripPrefix("/api/blobs", store))
+
+	if err := store.Put(ctx, "groups/g1/a.png", strings.NewReader("\x89PNG\r\n\x1a\n...."), -1, "image/png"); err != nil {
+		t.Fatalf("Put: %v", err)
+	}
+	if err := store.Put(ctx, "groups/g2/b.jpg", strings.NewReader("x"), 1, "image/jpeg"); err != nil {
+		t.Fatalf("Put: %v", err)
+	}
+
+	info, err := store.Stat(ctx, "groups/g1/a.png")
+	if err != nil || info.ContentType != "image/png" || info.Size != 12 {
+		t.Errorf("Stat = %+v, %v", info, err)
+	}
+	objects, err := store.List(ctx, "groups/g1")
+	if err != nil || len(objects) != 1 || objects[0].Key != "groups/g1/a.png" {
+		t.Errorf("List = %+v, %v", objects, err)
+	}
+	if _, err := store.Stat(ctx, "groups/../../etc/passwd"); err != ErrInvalidKey {
+		t.Errorf("Stat outside root = %v, want ErrInvalidKey", err)
+	}
+
+	// 署名付きURLで PUT して GET する
+	putURL, err := store.SignedURL(ctx, http.MethodPut, "groups/g1/direct.jpg", time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req, _ := http.NewRequest(http.MethodPut, putURL, strings.NewReader("direct"))
+	if resp, err := http.DefaultClient.Do(req); err != nil || resp.StatusCode != http.StatusOK {
+		t.Fatalf("signed PUT: %v %v", resp, err)
+	}
+	getURL, _ := store.SignedURL(ctx, http.MethodGet, "groups/g1/direct.jpg", time.Minute)
+	resp, err := http.Get(getURL)
+	if err != nil || resp.StatusCode != http.StatusOK {
+		t.Fatalf("signed GET: %v %v", resp, err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if string(body) != "direct" {
+		t.Errorf("signed GET body = %q", body)
+	}
+
+	// GET の署名で PUT はできない、期限切れは使えない
+	req, _ = http.NewRequest(http.MethodPut, getURL, strings.NewReader("overwrite"))
+	if resp, _ := http.DefaultClient.Do(req); resp.StatusCode != http.StatusForbidden {
+		t.Errorf("PUT with GET signature status = %d, want 403", resp.StatusCode)
+	}
+	store.now = func() time.Time { return time.Now().Add(2 * time.Minute) }
+	if resp, _ := http.Get(getURL); resp.StatusCode != http.StatusForbidden {
+		t.Errorf("expired URL status = %d, want 403", resp.StatusCode)
+	}
+
+	if err := store.Delete(ctx, "groups/g1/a.png"); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if _, _, err := store.Get(ctx, "groups/g1/a.png"); err != ErrNotFound {
+		t.Errorf("Get after delete = %v, want ErrNotFound", err)
+	}
+}
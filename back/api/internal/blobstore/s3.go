@@ -0,0 +1,272 @@
+package blobstore
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// S3Config S3 互換のオブジェクトストレージの接続先
+type S3Config struct {
+	// Endpoint 例: https://s3.ap-northeast-1.amazonaws.com, http://minio:9000
+	Endpoint string
+	// PublicEndpoint 署名付きURLに使うエンドポイント。APIから見えるホストと端末から見えるホストが違う場合に指定する（空なら Endpoint）
+	PublicEndpoint  string
+	Region          string
+	Bucket          string
+	AccessKeyID     string
+	SecretAccessKey string
+	// ForcePathStyle {endpoint}/{bucket}/{key} でアクセスする（MinIO）。false なら {bucket}.{host}/{key}
+	ForcePathStyle bool
+}
+
+// S3Store S3 互換のオブジェクトストレージに保存する Store（SigV4 で署名する）
+type S3Store struct {
+	endpoint       *url.URL
+	publicEndpoint *url.URL
+	bucket         string
+	pathStyle      bool
+	signer         signer
+	client         *http.Client
+	now            func() time.Time
+}
+
+func NewS3Store(cfg S3Config, client *http.Client) (*S3Store, error) {
+	if cfg.Bucket == "" {
+		return nil, fmt.Errorf("blobstore: bucket is required")
+	}
+	endpoint, err := url.Parse(strings.TrimSuffix(cfg.Endpoint, "/"))
+	if err != nil || endpoint.Host == "" {
+		return nil, fmt.Errorf("blobstore: invalid endpoint %q", cfg.Endpoint)
+	}
+	publicEndpoint := endpoint
+	if cfg.PublicEndpoint != "" {
+		publicEndpoint, err = url.Parse(strings.TrimSuffix(cfg.PublicEndpoint, "/"))
+		if err != nil || publicEndpoint.Host == "" {
+			return nil, fmt.Errorf("blobstore: invalid public endpoint %q", cfg.PublicEndpoint)
+		}
+	}
+	region := cfg.Region
+	if region == "" {
+		region = "us-east-1"
+	}
+	if client == nil {
+		client = &http.Client{Timeout: 60 * time.Second}
+	}
+
+	return &S3Store{
+		endpoint:       endpoint,
+		publicEndpoint: publicEndpoint,
+		bucket:         cfg.Bucket,
+		pathStyle:      cfg.ForcePathStyle,
+		signer: signer{
+			accessKeyID:     cfg.AccessKeyID,
+			secretAccessKey: cfg.SecretAccessKey,
+			region:          region,
+			service:         "s3",
+		},
+		client: client,
+		now:    time.Now,
+	}, nil
+}
+
+// objectURL key のURL（key が空ならバケット）
+func (s *S3Store) objectURL(endpoint *url.URL, key string) *url.URL {
+	u := *endpoint
+	prefix := u.Path
+	if s.pathStyle {
+		prefix += "/" + s.bucket
+	} else {
+		u.Host = s.bucket + "." + u.Host
+	}
+	u.Path = prefix + "/" + key
+	u.RawPath = uriEncode(prefix, false) + "/" + escapePath(key)
+	return &u
+}
+
+// do 署名したリクエストを送る。2xx 以外はエラー（404 は ErrNotFound）
+func (s *S3Store) do(ctx context.Context, method string, u *url.URL, body io.Reader, size int64, header http.Header) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), body)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	payloadHash := emptyPayloadHash
+	if body != nil {
+		payloadHash = unsignedPayload
+		req.ContentLength = size
+	}
+	s.signer.signRequest(req, payloadHash, s.now())
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode/100 == 2 {
+		return resp, nil
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, ErrNotFound
+	}
+	var e struct {
+		Code    string `xml:"Code"`
+		Message string `xml:"Message"`
+	}
+	data, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+	xml.Unmarshal(data, &e)
+	return nil, fmt.Errorf("blobstore: s3 %s %s: %d %s %s", method, u.Path, resp.StatusCode, e.Code, e.Message)
+}
+
+func (s *S3Store) Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error {
+	if err := validateKey(key); err != nil {
+		return err
+	}
+	header := http.Header{}
+	if contentType != "" {
+		header.Set("Content-Type", contentType)
+	}
+	if size < 0 {
+		// S3 は Content-Length の無い PUT を受け付けないので読み切ってから送る
+		data, err := io.ReadAll(r)
+		if err != nil {
+			return err
+		}
+		r, size = bytes.NewReader(data), int64(len(data))
+	}
+	if size == 0 {
+		// 空の本文は http.NoBody でないと Content-Length が付かない
+		r = http.NoBody
+	}
+
+	resp, err := s.do(ctx, http.MethodPut, s.objectURL(s.endpoint, key), r, size, header)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	return nil
+}
+
+func (s *S3Store) Get(ctx context.Context, key string) (io.ReadCloser, *ObjectInfo, error) {
+	if err := validateKey(key); err != nil {
+		return nil, nil, err
+	}
+	resp, err := s.do(ctx, http.MethodGet, s.objectURL(s.endpoint, key), nil, 0, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	return resp.Body, objectInfo(key, resp), nil
+}
+
+func (s *S3Store) Delete(ctx context.Context, key string) error {
+	if err := validateKey(key); err != nil {
+		return err
+	}
+	resp, err := s.do(ctx, http.MethodDelete, s.objectURL(s.endpoint, key), nil, 0, nil)
+	if err == ErrNotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	return nil
+}
+
+func (s *S3Store) Stat(ctx context.Context, key string) (*ObjectInfo, error) {
+	if err := validateKey(key); err != nil {
+		return nil, err
+	}
+	resp, err := s.do(ctx, http.MethodHead, s.objectURL(s.endpoint, key), nil, 0, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body.Close()
+	return objectInfo(key, resp), nil
+}
+
+func objectInfo(key string, resp *http.Response) *ObjectInfo {
+	info := &ObjectInfo{
+		Key:         key,
+		Size:        resp.ContentLength,
+		ContentType: resp.Header.Get("Content-Type"),
+		ETag:        resp.Header.Get("ETag"),
+	}
+	if t, err := http.ParseTime(resp.Header.Get("Last-Modified")); err == nil {
+		info.ModTime = t
+	}
+	return info
+}
+
+// listBucketResult ListObjectsV2 のレスポンス
+type listBucketResult struct {
+	IsTruncated           bool   `xml:"IsTruncated"`
+	NextContinuationToken string `xml:"NextContinuationToken"`
+	Contents              []struct {
+		Key          string    `xml:"Key"`
+		LastModified time.Time `xml:"LastModified"`
+		ETag         string    `xml:"ETag"`
+		Size         int64     `xml:"Size"`
+	} `xml:"Contents"`
+}
+
+func (s *S3Store) List(ctx context.Context, prefix string) ([]ObjectInfo, error) {
+	var objects []ObjectInfo
+	token := ""
+	for {
+		u := s.objectURL(s.endpoint, "")
+		q := url.Values{"list-type": {"2"}, "prefix": {prefix}}
+		if token != "" {
+			q.Set("continuation-token", token)
+		}
+		u.RawQuery = canonicalQuery(q)
+
+		resp, err := s.do(ctx, http.MethodGet, u, nil, 0, nil)
+		if err != nil {
+			return nil, err
+		}
+		var result listBucketResult
+		err = xml.NewDecoder(resp.Body).Decode(&result)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("blobstore: invalid ListObjectsV2 response: %w", err)
+		}
+
+		for _, c := range result.Contents {
+			objects = append(objects, ObjectInfo{Key: c.Key, Size: c.Size, ETag: c.ETag, ModTime: c.LastModified})
+		}
+		if !result.IsTruncated || result.NextContinuationToken == "" {
+			return objects, nil
+		}
+		token = result.NextContinuationToken
+	}
+}
+
+// SignedURL 署名付きURL（有効期限は SigV4 の上限の7日まで）
+func (s *S3Store) SignedURL(ctx context.Context, method, key string, expires time.Duration) (string, error) {
+	if err := validateKey(key); err != nil {
+		return "", err
+	}
+	if method != http.MethodGet && method != http.MethodPut {
+		return "", fmt.Errorf("blobstore: unsupported method %s", method)
+	}
+	if expires <= 0 || expires > 7*24*time.Hour {
+		return "", fmt.Errorf("blobstore: invalid expiry %s", expires)
+	}
+	return s.signer.presign(method, s.objectURL(s.publicEndpoint, key), expires, s.now()), nil
+}
+
+// String ログ用の接続先
+func (s *S3Store) String() string {
+	return s.objectURL(s.endpoint, "").String() + " (" + s.signer.region + ", path-style=" + strconv.FormatBool(s.pathStyle) + ")"
+}
@@ -0,0 +1,176 @@
+package blobstore
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// AWS Signature Version 4
+// https://docs.aws.amazon.com/AmazonS3/latest/API/sig-v4-authenticating-requests.html
+
+const (
+	sigV4Algorithm   = "AWS4-HMAC-SHA256"
+	sigV4TimeFormat  = "20060102T150405Z"
+	sigV4DateFormat  = "20060102"
+	unsignedPayload  = "UNSIGNED-PAYLOAD"
+	emptyPayloadHash = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+)
+
+// signer SigV4 で署名する認証情報とスコープ
+type signer struct {
+	accessKeyID     string
+	secretAccessKey string
+	region          string
+	service         string
+}
+
+// signRequest Authorization ヘッダーで署名する
+// host と x-amz-* ヘッダーを署名に含める
+func (s signer) signRequest(req *http.Request, payloadHash string, now time.Time) {
+	amzDate := now.UTC().Format(sigV4TimeFormat)
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	signed := []string{"host"}
+	for name := range req.Header {
+		if lower := strings.ToLower(name); strings.HasPrefix(lower, "x-amz-") {
+			signed = append(signed, lower)
+		}
+	}
+	sort.Strings(signed)
+
+	header := req.Header.Clone()
+	header.Set("Host", req.URL.Host)
+	creq := canonicalRequest(req.Method, req.URL.EscapedPath(), req.URL.Query(), header, signed, payloadHash)
+	scope := s.scope(now)
+	sig := s.signature(now, stringToSign(amzDate, scope, creq))
+
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		sigV4Algorithm, s.accessKeyID, scope, strings.Join(signed, ";"), sig))
+}
+
+// presign クエリ文字列で署名したURLを返す（署名するヘッダーは host のみ）
+func (s signer) presign(method string, u *url.URL, expires time.Duration, now time.Time) string {
+	amzDate := now.UTC().Format(sigV4TimeFormat)
+	scope := s.scope(now)
+
+	q := u.Query()
+	q.Set("X-Amz-Algorithm", sigV4Algorithm)
+	q.Set("X-Amz-Credential", s.accessKeyID+"/"+scope)
+	q.Set("X-Amz-Date", amzDate)
+	q.Set("X-Amz-Expires", strconv.Itoa(int(expires/time.Second)))
+	q.Set("X-Amz-SignedHeaders", "host")
+
+	header := http.Header{"Host": {u.Host}}
+	creq := canonicalRequest(method, u.EscapedPath(), q, header, []string{"host"}, unsignedPayload)
+	q.Set("X-Amz-Signature", s.signature(now, stringToSign(amzDate, scope, creq)))
+
+	signed := *u
+	signed.RawQuery = canonicalQuery(q)
+	return signed.String()
+}
+
+func (s signer) scope(now time.Time) string {
+	return strings.Join([]string{now.UTC().Format(sigV4DateFormat), s.region, s.service, "aws4_request"}, "/")
+}
+
+// signature 日付・リージョン・サービスから導出した鍵で署名する
+func (s signer) signature(now time.Time, toSign string) string {
+	key := hmacSHA256([]byte("AWS4"+s.secretAccessKey), now.UTC().Format(sigV4DateFormat))
+	key = hmacSHA256(key, s.region)
+	key = hmacSHA256(key, s.service)
+	key = hmacSHA256(key, "aws4_request")
+	return hex.EncodeToString(hmacSHA256(key, toSign))
+}
+
+func stringToSign(amzDate, scope, canonicalRequest string) string {
+	sum := sha256.Sum256([]byte(canonicalRequest))
+	return strings.Join([]string{sigV4Algorithm, amzDate, scope, hex.EncodeToString(sum[:])}, "\n")
+}
+
+// canonicalRequest 署名の対象になる正規化したリクエスト
+// uri はエンコード済みのパス、query には X-Amz-Signature を含めない
+func canonicalRequest(method, uri string, query url.Values, header http.Header, signedHeaders []string, payloadHash string) string {
+	if uri == "" {
+		uri = "/"
+	}
+	var headers strings.Builder
+	for _, name := range signedHeaders {
+		values := header.Values(name)
+		for i, v := range values {
+			values[i] = strings.Join(strings.Fields(v), " ")
+		}
+		headers.WriteString(name + ":" + strings.Join(values, ",") + "\n")
+	}
+
+	q := url.Values{}
+	for k, v := range query {
+		if k != "X-Amz-Signature" {
+			q[k] = v
+		}
+	}
+
+	return strings.Join([]string{
+		method,
+		uri,
+		canonicalQuery(q),
+		headers.String(),
+		strings.Join(signedHeaders, ";"),
+		payloadHash,
+	}, "\n")
+}
+
+// canonicalQuery キーと値をエンコードしてキーの順に並べたクエリ文字列
+func canonicalQuery(q url.Values) string {
+	keys := make([]string, 0, len(q))
+	for k := range q {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var pairs []string
+	for _, k := range keys {
+		values := append([]string(nil), q[k]...)
+		sort.Strings(values)
+		for _, v := range values {
+			pairs = append(pairs, uriEncode(k, true)+"="+uriEncode(v, true))
+		}
+	}
+	return strings.Join(pairs, "&")
+}
+
+// escapePath キーをURLのパスにする（/ はそのまま）
+func escapePath(key string) string {
+	return uriEncode(key, false)
+}
+
+// uriEncode SigV4 の URI エンコード（A-Z a-z 0-9 - _ . ~ 以外をエンコードする）
+func uriEncode(s string, encodeSlash bool) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case 'A' <= c && c <= 'Z', 'a' <= c && c <= 'z', '0' <= c && c <= '9', c == '-', c == '_', c == '.', c == '~':
+			b.WriteByte(c)
+		case c == '/' && !encodeSlash:
+			b.WriteByte(c)
+		default:
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
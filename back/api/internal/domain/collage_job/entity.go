@@ -0,0 +1,207 @@
+package collage_job
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Status ジョブのステータス
+type Status string
+
+const (
+	// StatusPending 実行待ち（run_at 以降に実行できる）
+	StatusPending Status = "pending"
+	// StatusRunning ワーカーがリースを持って実行中
+	StatusRunning Status = "running"
+	// StatusSucceeded 完了
+	StatusSucceeded Status = "succeeded"
+	// StatusDead 最大実行回数まで失敗した（デッドレター）
+	StatusDead Status = "dead"
+)
+
+// DefaultMaxAttempts 最大実行回数のデフォルト
+const DefaultMaxAttempts = 5
+
+// lastErrorMaxLength 保存する失敗理由の最大長
+const lastErrorMaxLength = 1000
+
+// CollageJob グループのコラージュ生成ジョブ
+type CollageJob struct {
+	jobID       uuid.UUID
+	groupID     string
+	status      Status
+	attempts    int
+	maxAttempts int
+	runAt       time.Time
+	lockedBy    *string
+	lockedUntil *time.Time
+	lastError   *string
+	createdAt   time.Time
+	updatedAt   time.Time
+}
+
+// NewCollageJob すぐに実行できるジョブを作成
+func NewCollageJob(groupID string, maxAttempts int) (*CollageJob, error) {
+	if groupID == "" {
+		return nil, ErrInvalidGroupID
+	}
+	if maxAttempts <= 0 {
+		return nil, ErrInvalidMaxAttempts
+	}
+
+	now := time.Now()
+	return &CollageJob{
+		jobID:       uuid.New(),
+		groupID:     groupID,
+		status:      StatusPending,
+		maxAttempts: maxAttempts,
+		runAt:       now,
+		createdAt:   now,
+		updatedAt:   now,
+	}, nil
+}
+
+// Reconstruct reconstructs a CollageJob from repository data
+func Reconstruct(
+	jobID uuid.UUID,
+	groupID string,
+	status Status,
+	attempts int,
+	maxAttempts int,
+	runAt time.Time,
+	lockedBy *string,
+	lockedUntil *time.Time,
+	lastError *string,
+	createdAt time.Time,
+	updatedAt time.Time,
+) (*CollageJob, error) {
+	return &CollageJob{
+		jobID:       jobID,
+		groupID:     groupID,
+		status:      status,
+		attempts:    attempts,
+		maxAttempts: maxAttempts,
+		runAt:       runAt,
+		lockedBy:    lockedBy,
+		lockedUntil: lockedUntil,
+		lastError:   lastError,
+		createdAt:   createdAt,
+		updatedAt:   updatedAt,
+	}, nil
+}
+
+// Getters
+func (j *CollageJob) JobID() uuid.UUID {
+	return j.jobID
+}
+
+func (j *CollageJob) GroupID() string {
+	return j.groupID
+}
+
+func (j *CollageJob) Status() Status {
+	return j.status
+}
+
+func (j *CollageJob) Attempts() int {
+	return j.attempts
+}
+
+func (j *CollageJob) MaxAttempts() int {
+	return j.maxAttempts
+}
+
+func (j *CollageJob) RunAt() time.Time {
+	return j.runAt
+}
+
+func (j *CollageJob) LockedBy() *string {
+	return j.lockedBy
+}
+
+func (j *CollageJob) LockedUntil() *time.Time {
+	return j.lockedUntil
+}
+
+func (j *CollageJob) LastError() *string {
+	return j.lastError
+}
+
+func (j *CollageJob) CreatedAt() time.Time {
+	return j.createdAt
+}
+
+func (j *CollageJob) UpdatedAt() time.Time {
+	return j.updatedAt
+}
+
+// IsFinished 完了またはデッドレターになっているか
+func (j *CollageJob) IsFinished() bool {
+	return j.status == StatusSucceeded || j.status == StatusDead
+}
+
+// Succeed 実行が成功したことを記録
+func (j *CollageJob) Succeed(now time.Time) error {
+	if j.status != StatusRunning {
+		return ErrJobNotRunning
+	}
+	j.status = StatusSucceeded
+	j.lastError = nil
+	j.updatedAt = now
+	return nil
+}
+
+// Fail 実行が失敗したことを記録
+// 最大実行回数に達していればデッドレターにし、そうでなければ backoff 後に再実行できるようにする
+func (j *CollageJob) Fail(cause error, now time.Time, backoff time.Duration) error {
+	if j.status != StatusRunning {
+		return ErrJobNotRunning
+	}
+
+	msg := cause.Error()
+	if len(msg) > lastErrorMaxLength {
+		msg = msg[:lastErrorMaxLength]
+	}
+	j.lastError = &msg
+	j.updatedAt = now
+
+	if j.attempts >= j.maxAttempts {
+		j.status = StatusDead
+		return nil
+	}
+	j.status = StatusPending
+	j.runAt = now.Add(backoff)
+	return nil
+}
+
+// Postpone まだ実行できる状態でなかったことを記録し、runAt に再実行できるようにする
+// 失敗ではないので、この実行は実行回数に数えない
+func (j *CollageJob) Postpone(runAt, now time.Time) error {
+	if j.status != StatusRunning {
+		return ErrJobNotRunning
+	}
+	j.attempts--
+	j.status = StatusPending
+	j.runAt = runAt
+	j.updatedAt = now
+	return nil
+}
+
+// Backoff n回目の失敗後に待つ時間（base * 2^(n-1)、max で頭打ち）
+func Backoff(attempts int, base, max time.Duration) time.Duration {
+	if attempts <= 0 {
+		return 0
+	}
+	d := base
+	for i := 1; i < attempts; i++ {
+		d *= 2
+		if d >= max {
+			return max
+		}
+	}
+	if d > max {
+		return max
+	}
+	return d
+}
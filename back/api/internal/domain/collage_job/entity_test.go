@@ -0,0 +1,115 @@
+package collage_job
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestNewCollageJob(t *testing.T) {
+	if _, err := NewCollageJob("", DefaultMaxAttempts); err != ErrInvalidGroupID {
+		t.Errorf("empty group ID: got %v, want %v", err, ErrInvalidGroupID)
+	}
+	if _, err := NewCollageJob("group-1", 0); err != ErrInvalidMaxAttempts {
+		t.Errorf("zero max attempts: got %v, want %v", err, ErrInvalidMaxAttempts)
+	}
+
+	job, err := NewCollageJob("group-1", 3)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if job.Status() != StatusPending || job.Attempts() != 0 || job.IsFinished() {
+		t.Errorf("new job should be pending with no attempts, got %s/%d", job.Status(), job.Attempts())
+	}
+}
+
+// running 実行中（attempts 回目）のジョブを作る
+func running(attempts, maxAttempts int) *CollageJob {
+	job, _ := Reconstruct(
+		uuid.New(), "group-1", StatusRunning, attempts, maxAttempts,
+		time.Now(), nil, nil, nil, time.Now(), time.Now(),
+	)
+	return job
+}
+
+func TestCollageJobFail(t *testing.T) {
+	now := time.Date(2025, 10, 1, 12, 0, 0, 0, time.UTC)
+	cause := errors.New("render failed")
+
+	t.Run("retries with backoff", func(t *testing.T) {
+		job := running(1, 3)
+		if err := job.Fail(cause, now, 10*time.Second); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if job.Status() != StatusPending {
+			t.Errorf("status = %s, want %s", job.Status(), StatusPending)
+		}
+		if !job.RunAt().Equal(now.Add(10 * time.Second)) {
+			t.Errorf("run_at = %v, want %v", job.RunAt(), now.Add(10*time.Second))
+		}
+		if job.LastError() == nil || *job.LastError() != "render failed" {
+			t.Errorf("last error = %v", job.LastError())
+		}
+	})
+
+	t.Run("dead letter after max attempts", func(t *testing.T) {
+		job := running(3, 3)
+		job.Fail(cause, now, 10*time.Second)
+		if job.Status() != StatusDead || !job.IsFinished() {
+			t.Errorf("status = %s, want %s", job.Status(), StatusDead)
+		}
+	})
+
+	t.Run("not running", func(t *testing.T) {
+		job, _ := NewCollageJob("group-1", 3)
+		if err := job.Fail(cause, now, time.Second); err != ErrJobNotRunning {
+			t.Errorf("got %v, want %v", err, ErrJobNotRunning)
+		}
+		if err := job.Succeed(now); err != ErrJobNotRunning {
+			t.Errorf("got %v, want %v", err, ErrJobNotRunning)
+		}
+	})
+}
+
+func TestBackoff(t *testing.T) {
+	base, max := 5*time.Second, time.Minute
+	tests := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{0, 0},
+		{1, 5 * time.Second},
+		{2, 10 * time.Second},
+		{3, 20 * time.Second},
+		{4, 40 * time.Second},
+		{5, time.Minute},
+		{50, time.Minute},
+	}
+	for _, tt := range tests {
+		if got := Backoff(tt.attempts, base, max); got != tt.want {
+			t.Errorf("Backoff(%d) = %v, want %v", tt.attempts, got, tt.want)
+		}
+	}
+}
+
+func TestCollageJobPostpone(t *testing.T) {
+	now := time.Date(2025, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	// 最後の実行でも後回しにした場合はデッドレターにならず、実行回数も戻る
+	job := running(3, 3)
+	if err := job.Postpone(now.Add(5*time.Second), now); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if job.Status() != StatusPending || job.Attempts() != 2 {
+		t.Errorf("got %s/%d, want %s/2", job.Status(), job.Attempts(), StatusPending)
+	}
+	if !job.RunAt().Equal(now.Add(5 * time.Second)) {
+		t.Errorf("run_at = %v, want %v", job.RunAt(), now.Add(5*time.Second))
+	}
+
+	if err := job.Postpone(now, now); err != ErrJobNotRunning {
+		t.Errorf("postpone pending job: got %v, want %v", err, ErrJobNotRunning)
+	}
+}
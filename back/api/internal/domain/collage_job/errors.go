@@ -0,0 +1,23 @@
+package collage_job
+
+import "errors"
+
+var (
+	// ErrInvalidGroupID group ID is invalid
+	ErrInvalidGroupID = errors.New("グループIDが無効です")
+
+	// ErrInvalidMaxAttempts max attempts is invalid
+	ErrInvalidMaxAttempts = errors.New("最大実行回数が無効です（1以上で指定してください）")
+
+	// ErrJobNotFound job not found
+	ErrJobNotFound = errors.New("コラージュ生成ジョブが見つかりません")
+
+	// ErrJobAlreadyQueued an unfinished job already exists for the group
+	ErrJobAlreadyQueued = errors.New("このグループのコラージュ生成ジョブは既に登録されています")
+
+	// ErrJobNotRunning job is not leased by a worker
+	ErrJobNotRunning = errors.New("コラージュ生成ジョブは実行中ではありません")
+
+	// ErrLeaseLost the lease expired and the job was taken by another worker
+	ErrLeaseLost = errors.New("コラージュ生成ジョブのリースが失われました")
+)
@@ -0,0 +1,22 @@
+package collage_job
+
+import (
+	"context"
+	"time"
+)
+
+type Repository interface {
+	// Enqueue registers a new job. Returns ErrJobAlreadyQueued if the group already has an unfinished job
+	Enqueue(ctx context.Context, job *CollageJob) error
+
+	// Claim leases up to limit runnable jobs (pending and due, or running with an expired lease) for workerID.
+	// Claimed jobs are running and their attempts are incremented
+	Claim(ctx context.Context, workerID string, lease time.Duration, limit int) ([]*CollageJob, error)
+
+	// Release persists the outcome of a claimed job and drops the lease.
+	// Returns ErrLeaseLost if workerID no longer holds the lease
+	Release(ctx context.Context, job *CollageJob, workerID string) error
+
+	// FindByGroupID finds jobs of a group, newest first
+	FindByGroupID(ctx context.Context, groupID string) ([]*CollageJob, error)
+}
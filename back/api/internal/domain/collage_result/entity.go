@@ -11,6 +11,7 @@ type CollageResult struct {
 	resultID         uuid.UUID
 	templateID       uuid.UUID
 	groupID          string
+	round            int
 	fileURL          string
 	targetUserNumber int
 	isNotification   bool
@@ -39,6 +40,7 @@ func NewCollageResult(templateID uuid.UUID, groupID, fileURL string, targetUserN
 		resultID:         uuid.New(),
 		templateID:       templateID,
 		groupID:          groupID,
+		round:            1,
 		fileURL:          fileURL,
 		targetUserNumber: targetUserNumber,
 		isNotification:   false,
@@ -51,6 +53,7 @@ func Reconstruct(
 	resultID uuid.UUID,
 	templateID uuid.UUID,
 	groupID string,
+	round int,
 	fileURL string,
 	targetUserNumber int,
 	isNotification bool,
@@ -60,6 +63,7 @@ func Reconstruct(
 		resultID:         resultID,
 		templateID:       templateID,
 		groupID:          groupID,
+		round:            round,
 		fileURL:          fileURL,
 		targetUserNumber: targetUserNumber,
 		isNotification:   isNotification,
@@ -80,6 +84,11 @@ func (cr *CollageResult) GroupID() string {
 	return cr.groupID
 }
 
+// Round コラージュにしたラウンドの番号
+func (cr *CollageResult) Round() int {
+	return cr.round
+}
+
 func (cr *CollageResult) FileURL() string {
 	return cr.fileURL
 }
@@ -101,6 +110,15 @@ func (cr *CollageResult) MarkAsNotified() {
 	cr.isNotification = true
 }
 
+// AssignRound コラージュにしたラウンドを設定
+func (cr *CollageResult) AssignRound(round int) error {
+	if round < 1 {
+		return ErrInvalidRound
+	}
+	cr.round = round
+	return nil
+}
+
 // Validation functions
 func validateFileURL(fileURL string) error {
 	if fileURL == "" {
@@ -111,3 +129,13 @@ func validateFileURL(fileURL string) error {
 	}
 	return nil
 }
+
+// Placement コラージュ内に配置した写真の位置とサイズ（出力画像のピクセル座標）
+type Placement struct {
+	ImageID   uuid.UUID
+	PositionX int
+	PositionY int
+	Width     int
+	Height    int
+	SortOrder int
+}
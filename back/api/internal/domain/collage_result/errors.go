@@ -15,6 +15,9 @@ var (
 	// ErrInvalidTargetUserNumber target user number is invalid
 	ErrInvalidTargetUserNumber = errors.New("対象ユーザー数が無効です（1以上で指定してください）")
 
+	// ErrInvalidRound round number is invalid
+	ErrInvalidRound = errors.New("ラウンド番号が無効です")
+
 	// ErrResultNotFound result not found
 	ErrResultNotFound = errors.New("コラージュ結果が見つかりません")
 
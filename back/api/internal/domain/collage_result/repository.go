@@ -2,6 +2,7 @@ package collage_result
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -10,12 +11,23 @@ type Repository interface {
 	// Create creates a new collage result
 	Create(ctx context.Context, result *CollageResult) error
 
+	// CreateWithPlacements creates a collage result and links its placed images in one transaction
+	CreateWithPlacements(ctx context.Context, result *CollageResult, placements []Placement) error
+
+	// CreateForCompletedSession creates a collage result with its placed images and marks the group's
+	// photo-taking session of the result's round as completed, all in one transaction.
+	// If the group is no longer taking photos for that round, nothing is written and it returns false
+	CreateForCompletedSession(ctx context.Context, result *CollageResult, placements []Placement, completedAt time.Time) (bool, error)
+
 	// FindByID finds a collage result by ID
 	FindByID(ctx context.Context, resultID uuid.UUID) (*CollageResult, error)
 
 	// FindByGroupID finds all collage results by group ID
 	FindByGroupID(ctx context.Context, groupID string, limit, offset int) ([]*CollageResult, error)
 
+	// FindLatestByGroupRound finds the latest collage result of a round
+	FindLatestByGroupRound(ctx context.Context, groupID string, round int) (*CollageResult, error)
+
 	// FindUnnotified finds all unnotified collage results
 	FindUnnotified(ctx context.Context, limit int) ([]*CollageResult, error)
 
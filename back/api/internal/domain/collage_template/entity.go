@@ -4,15 +4,35 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/jphacks/os_2502/back/api/internal/svgpath"
+)
+
+const (
+	// DefaultViewBox フレームのパスの座標系のデフォルト（単位正方形）
+	DefaultViewBox = "0 0 1 1"
+	// DefaultSize 生成するコラージュの幅・高さのデフォルト（ピクセル）
+	DefaultSize = 1000
+	// MaxSize 生成するコラージュの幅・高さの上限（ピクセル）
+	MaxSize = 4096
 )
 
 // CollageTemplate represents a collage template
+// フレーム（写真を配置する枠）は template_part として持つ
 type CollageTemplate struct {
 	templateID uuid.UUID
 	name       string
 	filePath   string
-	createdAt  time.Time
-	updatedAt  time.Time
+	sourceName *string
+	photoCount int
+	viewBox    string
+	width      int
+	height     int
+	// ownerUserID 作成者（組み込みテンプレートは nil）
+	ownerUserID *uuid.UUID
+	visibility  Visibility
+	forkedFrom  *uuid.UUID
+	createdAt   time.Time
+	updatedAt   time.Time
 }
 
 // NewCollageTemplate creates a new collage template
@@ -30,25 +50,73 @@ func NewCollageTemplate(name, filePath string) (*CollageTemplate, error) {
 		templateID: uuid.New(),
 		name:       name,
 		filePath:   filePath,
+		viewBox:    DefaultViewBox,
+		width:      DefaultSize,
+		height:     DefaultSize,
+		visibility: VisibilityPublic,
 		createdAt:  now,
 		updatedAt:  now,
 	}, nil
 }
 
+// NewCustomTemplate ユーザーが作成するテンプレート
+// レイアウトとフレームは UpdateLayout と ValidateFrames で設定・検証する
+func NewCustomTemplate(ownerUserID uuid.UUID, name string, visibility Visibility) (*CollageTemplate, error) {
+	if ownerUserID == uuid.Nil {
+		return nil, ErrInvalidOwner
+	}
+	if err := validateName(name); err != nil {
+		return nil, err
+	}
+	visibility, err := ParseVisibility(string(visibility))
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	return &CollageTemplate{
+		templateID:  uuid.New(),
+		name:        name,
+		viewBox:     DefaultViewBox,
+		width:       DefaultSize,
+		height:      DefaultSize,
+		ownerUserID: &ownerUserID,
+		visibility:  visibility,
+		createdAt:   now,
+		updatedAt:   now,
+	}, nil
+}
+
 // Reconstruct reconstructs a CollageTemplate from repository data
 func Reconstruct(
 	templateID uuid.UUID,
 	name string,
 	filePath string,
+	sourceName *string,
+	photoCount int,
+	viewBox string,
+	width int,
+	height int,
+	ownerUserID *uuid.UUID,
+	visibility Visibility,
+	forkedFrom *uuid.UUID,
 	createdAt time.Time,
 	updatedAt time.Time,
 ) (*CollageTemplate, error) {
 	return &CollageTemplate{
-		templateID: templateID,
-		name:       name,
-		filePath:   filePath,
-		createdAt:  createdAt,
-		updatedAt:  updatedAt,
+		templateID:  templateID,
+		name:        name,
+		filePath:    filePath,
+		sourceName:  sourceName,
+		photoCount:  photoCount,
+		viewBox:     viewBox,
+		width:       width,
+		height:      height,
+		ownerUserID: ownerUserID,
+		visibility:  visibility,
+		forkedFrom:  forkedFrom,
+		createdAt:   createdAt,
+		updatedAt:   updatedAt,
 	}, nil
 }
 
@@ -65,6 +133,66 @@ func (ct *CollageTemplate) FilePath() string {
 	return ct.filePath
 }
 
+// SourceName 組み込みテンプレートの templates.json での名前（ユーザーが作ったテンプレートは nil）
+func (ct *CollageTemplate) SourceName() *string {
+	return ct.sourceName
+}
+
+// IsBuiltin templates.json からインポートしたテンプレートかどうか
+func (ct *CollageTemplate) IsBuiltin() bool {
+	return ct.sourceName != nil
+}
+
+func (ct *CollageTemplate) PhotoCount() int {
+	return ct.photoCount
+}
+
+func (ct *CollageTemplate) ViewBox() string {
+	return ct.viewBox
+}
+
+func (ct *CollageTemplate) Width() int {
+	return ct.width
+}
+
+func (ct *CollageTemplate) Height() int {
+	return ct.height
+}
+
+// OwnerUserID 作成者（組み込みテンプレートは nil）
+func (ct *CollageTemplate) OwnerUserID() *uuid.UUID {
+	return ct.ownerUserID
+}
+
+func (ct *CollageTemplate) Visibility() Visibility {
+	return ct.visibility
+}
+
+// ForkedFrom 複製元のテンプレートID
+func (ct *CollageTemplate) ForkedFrom() *uuid.UUID {
+	return ct.forkedFrom
+}
+
+// IsOwnedBy userID が作成したテンプレートかどうか
+func (ct *CollageTemplate) IsOwnedBy(userID uuid.UUID) bool {
+	return ct.ownerUserID != nil && *ct.ownerUserID == userID
+}
+
+// CanBeViewedBy userID がテンプレートを参照・利用できるか
+// isFriend は userID が作成者のフレンドかどうか（公開範囲が friends のときだけ使う）
+func (ct *CollageTemplate) CanBeViewedBy(userID uuid.UUID, isFriend bool) bool {
+	if ct.ownerUserID == nil || ct.IsOwnedBy(userID) {
+		return true
+	}
+	switch ct.visibility {
+	case VisibilityPublic:
+		return true
+	case VisibilityFriends:
+		return isFriend
+	}
+	return false
+}
+
 func (ct *CollageTemplate) CreatedAt() time.Time {
 	return ct.createdAt
 }
@@ -93,6 +221,68 @@ func (ct *CollageTemplate) UpdateFilePath(filePath string) error {
 	return nil
 }
 
+// UpdateLayout フレームの座標系と出力サイズ、必要な写真の枚数を更新
+func (ct *CollageTemplate) UpdateLayout(viewBox string, width, height, photoCount int) error {
+	if _, err := svgpath.ParseViewBox(viewBox); err != nil {
+		return ErrInvalidViewBox
+	}
+	if width <= 0 || height <= 0 || width > MaxSize || height > MaxSize {
+		return ErrInvalidSize
+	}
+	if photoCount < 0 {
+		return ErrInvalidPhotoCount
+	}
+	ct.viewBox = viewBox
+	ct.width = width
+	ct.height = height
+	ct.photoCount = photoCount
+	ct.updatedAt = time.Now()
+	return nil
+}
+
+// Publish 公開範囲を変更（作成者のいる、ユーザーが作ったテンプレートのみ）
+func (ct *CollageTemplate) Publish(visibility Visibility) error {
+	if ct.ownerUserID == nil {
+		return ErrBuiltinTemplate
+	}
+	if visibility == "" {
+		return ErrInvalidVisibility
+	}
+	if _, err := ParseVisibility(string(visibility)); err != nil {
+		return err
+	}
+	ct.visibility = visibility
+	ct.updatedAt = time.Now()
+	return nil
+}
+
+// Fork ownerUserID が自分用に複製したテンプレートを作る（フレームは呼び出し元で複製する）
+// 複製したテンプレートは private から始まる
+func (ct *CollageTemplate) Fork(ownerUserID uuid.UUID, name string) (*CollageTemplate, error) {
+	if name == "" {
+		name = ct.name
+	}
+	forked, err := NewCustomTemplate(ownerUserID, name, VisibilityPrivate)
+	if err != nil {
+		return nil, err
+	}
+	if err := forked.UpdateLayout(ct.viewBox, ct.width, ct.height, ct.photoCount); err != nil {
+		return nil, err
+	}
+	id := ct.templateID
+	forked.forkedFrom = &id
+	return forked, nil
+}
+
+// MarkBuiltin templates.json の name と対応づける
+func (ct *CollageTemplate) MarkBuiltin(sourceName string) error {
+	if err := validateName(sourceName); err != nil {
+		return err
+	}
+	ct.sourceName = &sourceName
+	return nil
+}
+
 // Validation functions
 func validateName(name string) error {
 	if name == "" {
@@ -15,3 +15,63 @@ var (
 	// ErrTemplateAlreadyExists template already exists
 	ErrTemplateAlreadyExists = errors.New("このテンプレートは既に存在します")
 )
+
+// テンプレートのレイアウト
+var (
+	// ErrInvalidViewBox viewBox is invalid
+	ErrInvalidViewBox = errors.New("viewBoxが無効です（\"minX minY width height\" 形式で指定してください）")
+
+	// ErrInvalidSize output size is invalid
+	ErrInvalidSize = errors.New("コラージュのサイズが無効です（幅・高さは1〜4096ピクセルで指定してください）")
+
+	// ErrInvalidPhotoCount photo count is invalid
+	ErrInvalidPhotoCount = errors.New("写真の枚数が無効です")
+
+	// ErrInvalidFramePath frame path is invalid
+	ErrInvalidFramePath = errors.New("フレームのパスが無効です")
+)
+
+// ユーザーが作成するテンプレート
+var (
+	// ErrInvalidOwner owner is invalid
+	ErrInvalidOwner = errors.New("テンプレートの作成者が無効です")
+
+	// ErrInvalidVisibility visibility is invalid
+	ErrInvalidVisibility = errors.New("公開範囲が無効です（private / friends / public のいずれかを指定してください）")
+
+	// ErrBuiltinTemplate built-in templates cannot be changed
+	ErrBuiltinTemplate = errors.New("組み込みテンプレートは変更できません")
+
+	// ErrNotTemplateOwner caller is not the owner
+	ErrNotTemplateOwner = errors.New("このテンプレートを変更する権限がありません")
+
+	// ErrNoFrames no frames
+	ErrNoFrames = errors.New("フレームを1つ以上指定してください")
+
+	// ErrTooManyFrames too many frames
+	ErrTooManyFrames = errors.New("フレームが多すぎます（10個まで）")
+
+	// ErrPhotoCountMismatch photo count does not match the frames
+	ErrPhotoCountMismatch = errors.New("写真の枚数とフレームの数が一致しません")
+
+	// ErrFrameOutOfBounds frame is outside the viewBox
+	ErrFrameOutOfBounds = errors.New("フレームがviewBoxの外にはみ出しています")
+
+	// ErrFrameTooSmall frame is too small
+	ErrFrameTooSmall = errors.New("フレームが小さすぎます")
+
+	// ErrFramesOverlap frames overlap
+	ErrFramesOverlap = errors.New("フレーム同士が重なっています")
+
+	// ErrLowCoverage frames do not cover enough of the canvas
+	ErrLowCoverage = errors.New("フレームがキャンバスを十分に覆っていません（50%以上を覆うようにしてください）")
+)
+
+// プレビュー
+var (
+	// ErrInvalidPreviewSize preview size out of range
+	ErrInvalidPreviewSize = errors.New("プレビューのサイズは32〜2048で指定してください")
+
+	// ErrFrameNotFound frame number does not exist in the template
+	ErrFrameNotFound = errors.New("指定したフレームはテンプレートにありません")
+)
@@ -0,0 +1,72 @@
+package collage_template
+
+import (
+	"image"
+	"math"
+	"strconv"
+
+	"github.com/jphacks/os_2502/back/api/internal/domain/template_part"
+	"github.com/jphacks/os_2502/back/api/internal/svgpath"
+)
+
+// Frame 写真を1枚配置する枠
+type Frame struct {
+	// Number パーツ番号（1始まり）
+	Number int
+	// Path テンプレートの viewBox 座標系のSVGパス
+	Path string
+}
+
+// Frames パーツをパーツ番号順のフレームに変換
+// パスを持たないパーツは、ピクセル座標の矩形を viewBox 座標系の矩形パスにする
+func (ct *CollageTemplate) Frames(parts []*template_part.TemplatePart) []Frame {
+	vb, err := svgpath.ParseViewBox(ct.viewBox)
+	if err != nil {
+		vb, _ = svgpath.ParseViewBox(DefaultViewBox)
+	}
+	sx := vb.Width / float64(ct.width)
+	sy := vb.Height / float64(ct.height)
+
+	frames := make([]Frame, len(parts))
+	for i, p := range parts {
+		if path := p.Path(); path != nil {
+			frames[i] = Frame{Number: p.PartNumber(), Path: *path}
+			continue
+		}
+		frames[i] = Frame{
+			Number: p.PartNumber(),
+			Path: RectPath(
+				vb.MinX+float64(p.PositionX())*sx, vb.MinY+float64(p.PositionY())*sy,
+				float64(p.Width())*sx, float64(p.Height())*sy,
+			),
+		}
+	}
+	return frames
+}
+
+// FrameRect viewBox 座標系のパスを、コラージュのピクセル座標での外接矩形に変換（キャンバス内に収める）
+func (ct *CollageTemplate) FrameRect(path string) (image.Rectangle, error) {
+	vb, err := svgpath.ParseViewBox(ct.viewBox)
+	if err != nil {
+		return image.Rectangle{}, ErrInvalidViewBox
+	}
+	p, err := svgpath.Parse(path)
+	if err != nil || len(p) == 0 {
+		return image.Rectangle{}, ErrInvalidFramePath
+	}
+
+	min, max := vb.ToPixels(p, ct.width, ct.height).Bounds()
+	rect := image.Rect(
+		int(math.Floor(min.X)), int(math.Floor(min.Y)),
+		int(math.Ceil(max.X)), int(math.Ceil(max.Y)),
+	).Intersect(image.Rect(0, 0, ct.width, ct.height))
+	if rect.Empty() {
+		return image.Rectangle{}, ErrInvalidFramePath
+	}
+	return rect, nil
+}
+
+// formatCoord 浮動小数点の誤差が出ないよう小数点以下6桁で丸める
+func formatCoord(v float64) string {
+	return strconv.FormatFloat(math.Round(v*1e6)/1e6, 'f', -1, 64)
+}
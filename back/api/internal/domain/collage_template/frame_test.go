@@ -0,0 +1,62 @@
+package collage_template
+
+import (
+	"image"
+	"testing"
+
+	"github.com/jphacks/os_2502/back/api/internal/domain/template_part"
+)
+
+func TestFrameRectAndFrames(t *testing.T) {
+	tmpl, err := NewCollageTemplate("2人用_縦分割", "resources/templates.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 右半分のフレームは 500..1000 x 0..1000
+	rect, err := tmpl.FrameRect("M 0.5 0 L 1 0 L 1 1 L 0.5 1 Z")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := image.Rect(500, 0, 1000, 1000); rect != want {
+		t.Errorf("rect = %v, want %v", rect, want)
+	}
+
+	// キャンバスからはみ出した部分は切り詰める
+	rect, err = tmpl.FrameRect("M -0.5 -0.5 L 0.25 -0.5 L 0.25 0.25 Z")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := image.Rect(0, 0, 250, 250); rect != want {
+		t.Errorf("clipped rect = %v, want %v", rect, want)
+	}
+
+	if _, err := tmpl.FrameRect("M 2 2 L 3 2 L 3 3 Z"); err != ErrInvalidFramePath {
+		t.Errorf("err = %v, want ErrInvalidFramePath", err)
+	}
+
+	// パスの無いパーツは矩形のパスになり、パスのあるパーツはそのまま
+	rectPart, err := template_part.NewTemplatePart(tmpl.TemplateID(), 1, 0, 0, 500, 250, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pathPart, err := template_part.NewTemplatePart(tmpl.TemplateID(), 2, 500, 0, 500, 1000, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	triangle := "M 0.5 0 L 1 0 L 1 1 Z"
+	if err := pathPart.UpdatePath(&triangle); err != nil {
+		t.Fatal(err)
+	}
+
+	frames := tmpl.Frames([]*template_part.TemplatePart{rectPart, pathPart})
+	want := []Frame{
+		{Number: 1, Path: "M 0 0 H 0.5 V 0.25 H 0 Z"},
+		{Number: 2, Path: triangle},
+	}
+	for i := range want {
+		if frames[i] != want[i] {
+			t.Errorf("frames[%d] = %+v, want %+v", i, frames[i], want[i])
+		}
+	}
+}
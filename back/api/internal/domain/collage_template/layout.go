@@ -0,0 +1,116 @@
+package collage_template
+
+import (
+	"fmt"
+	"image"
+	"math"
+
+	"github.com/jphacks/os_2502/back/api/internal/svgpath"
+)
+
+const (
+	// MaxFrames 1つのテンプレートに置けるフレームの数の上限
+	MaxFrames = 10
+	// OverlapTolerance 2つのフレームの重なりが、小さい方のフレームの面積に占める割合の上限
+	// 隣り合うフレームの境界のアンチエイリアス分は重なりとみなさない
+	OverlapTolerance = 0.02
+	// MinCoverage フレーム全体がキャンバスを覆う割合の下限
+	MinCoverage = 0.5
+	// MinFrameArea 1つのフレームがキャンバスに占める割合の下限
+	MinFrameArea = 0.01
+
+	// validationGrid 重なりと面積を調べるときにラスタライズする解像度（長辺のピクセル数）
+	validationGrid = 256
+	// boundsEpsilon viewBox からのはみ出しとみなさない誤差（viewBox の幅・高さに対する割合）
+	boundsEpsilon = 1e-6
+)
+
+// RectPath viewBox 座標系の矩形をSVGパスにする
+func RectPath(x, y, width, height float64) string {
+	return fmt.Sprintf("M %s %s H %s V %s H %s Z",
+		formatCoord(x), formatCoord(y), formatCoord(x+width), formatCoord(y+height), formatCoord(x))
+}
+
+// ValidateFrames ユーザーが作成したフレームがテンプレートとして使えるか検証する
+//   - フレームの数が写真の枚数と一致する（1〜MaxFrames）
+//   - すべてのフレームが viewBox の中に収まっている
+//   - フレーム同士の重なりが OverlapTolerance 以下
+//   - フレーム全体がキャンバスの MinCoverage 以上を覆う
+func (ct *CollageTemplate) ValidateFrames(paths []string) error {
+	if len(paths) == 0 {
+		return ErrNoFrames
+	}
+	if len(paths) > MaxFrames {
+		return ErrTooManyFrames
+	}
+	if len(paths) != ct.photoCount {
+		return ErrPhotoCountMismatch
+	}
+
+	vb, err := svgpath.ParseViewBox(ct.viewBox)
+	if err != nil {
+		return ErrInvalidViewBox
+	}
+
+	// キャンバスと同じ縦横比の小さなグリッドで面積を測る
+	gw, gh := validationGrid, validationGrid
+	if ct.width > ct.height {
+		gh = max(1, int(math.Round(float64(validationGrid)*float64(ct.height)/float64(ct.width))))
+	} else if ct.height > ct.width {
+		gw = max(1, int(math.Round(float64(validationGrid)*float64(ct.width)/float64(ct.height))))
+	}
+	canvasArea := float64(gw * gh)
+
+	masks := make([]*image.Alpha, len(paths))
+	areas := make([]float64, len(paths))
+	for i, d := range paths {
+		p, err := svgpath.Parse(d)
+		if err != nil || len(p) == 0 {
+			return ErrInvalidFramePath
+		}
+
+		min, max := p.Bounds()
+		ex, ey := vb.Width*boundsEpsilon, vb.Height*boundsEpsilon
+		if min.X < vb.MinX-ex || min.Y < vb.MinY-ey || max.X > vb.MinX+vb.Width+ex || max.Y > vb.MinY+vb.Height+ey {
+			return ErrFrameOutOfBounds
+		}
+
+		masks[i] = svgpath.Rasterize(vb.ToPixels(p, gw, gh), gw, gh)
+		areas[i] = coverage(masks[i], nil)
+		if areas[i]/canvasArea < MinFrameArea {
+			return ErrFrameTooSmall
+		}
+	}
+
+	for i := range masks {
+		for j := i + 1; j < len(masks); j++ {
+			overlap := coverage(masks[i], masks[j])
+			if overlap/math.Min(areas[i], areas[j]) > OverlapTolerance {
+				return ErrFramesOverlap
+			}
+		}
+	}
+
+	union := image.NewAlpha(masks[0].Rect)
+	for _, m := range masks {
+		for k, a := range m.Pix {
+			union.Pix[k] = uint8(math.Min(255, float64(union.Pix[k])+float64(a)))
+		}
+	}
+	if coverage(union, nil)/canvasArea < MinCoverage {
+		return ErrLowCoverage
+	}
+	return nil
+}
+
+// coverage マスクが覆う面積（ピクセル数）。other を指定すると2つのマスクが共通して覆う面積
+func coverage(mask, other *image.Alpha) float64 {
+	var sum int
+	for k, a := range mask.Pix {
+		if other != nil && other.Pix[k] < a {
+			a = other.Pix[k]
+		}
+		sum += int(a)
+	}
+	return float64(sum) / 255
+}
@@ -0,0 +1,37 @@
+package collage_template
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestValidateFrames(t *testing.T) {
+	tmpl, err := NewCustomTemplate(uuid.New(), "自作", VisibilityPrivate)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := tmpl.UpdateLayout(DefaultViewBox, DefaultSize, DefaultSize, 2); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name  string
+		paths []string
+		want  error
+	}{
+		{"左右に分割", []string{RectPath(0, 0, 0.5, 1), RectPath(0.5, 0, 0.5, 1)}, nil},
+		{"斜めに分割", []string{"M 0 0 L 1 0 L 0 1 Z", "M 1 0 L 1 1 L 0 1 Z"}, nil},
+		{"枚数が違う", []string{RectPath(0, 0, 1, 1)}, ErrPhotoCountMismatch},
+		{"はみ出し", []string{RectPath(0, 0, 0.5, 1), RectPath(0.5, 0, 0.6, 1)}, ErrFrameOutOfBounds},
+		{"重なり", []string{RectPath(0, 0, 0.6, 1), RectPath(0.4, 0, 0.6, 1)}, ErrFramesOverlap},
+		{"覆う面積が小さい", []string{RectPath(0, 0, 0.2, 1), RectPath(0.5, 0, 0.2, 1)}, ErrLowCoverage},
+		{"小さすぎる", []string{RectPath(0, 0, 0.05, 0.05), RectPath(0.5, 0, 0.5, 1)}, ErrFrameTooSmall},
+		{"不正なパス", []string{"M 0 0 X", RectPath(0.5, 0, 0.5, 1)}, ErrInvalidFramePath},
+	}
+	for _, tt := range tests {
+		if err := tmpl.ValidateFrames(tt.paths); err != tt.want {
+			t.Errorf("%s: err = %v, want %v", tt.name, err, tt.want)
+		}
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/google/uuid"
+	"github.com/jphacks/os_2502/back/api/internal/domain/template_part"
 )
 
 type Repository interface {
@@ -16,7 +17,23 @@ type Repository interface {
 	// FindByName finds a collage template by name
 	FindByName(ctx context.Context, name string) (*CollageTemplate, error)
 
-	// List lists all collage templates
+	// FindBySourceName finds a built-in template by its name in templates.json
+	FindBySourceName(ctx context.Context, sourceName string) (*CollageTemplate, error)
+
+	// ListBuiltin lists the templates imported from templates.json
+	ListBuiltin(ctx context.Context) ([]*CollageTemplate, error)
+
+	// ListByOwner lists the templates created by a user
+	ListByOwner(ctx context.Context, ownerUserID uuid.UUID, limit, offset int) ([]*CollageTemplate, error)
+
+	// ListSharedByFriends フレンドが friends / public で公開しているテンプレートを更新が新しい順に取得
+	ListSharedByFriends(ctx context.Context, userID uuid.UUID, limit, offset int) ([]*CollageTemplate, error)
+
+	// SaveWithParts テンプレートとフレーム（パーツ）をまとめて保存（テンプレートが無ければ作成）
+	// パーツはパーツ番号で既存の行と照合して更新し、無くなった番号のパーツは削除する
+	SaveWithParts(ctx context.Context, template *CollageTemplate, parts []*template_part.TemplatePart) error
+
+	// List lists all public collage templates
 	List(ctx context.Context, limit, offset int) ([]*CollageTemplate, error)
 
 	// Update updates a collage template
@@ -0,0 +1,24 @@
+package collage_template
+
+// Visibility テンプレートの公開範囲
+type Visibility string
+
+const (
+	// VisibilityPrivate 作成者だけが使える
+	VisibilityPrivate Visibility = "private"
+	// VisibilityFriends 作成者のフレンドも使える
+	VisibilityFriends Visibility = "friends"
+	// VisibilityPublic 全員が使える（組み込みテンプレートもこれ）
+	VisibilityPublic Visibility = "public"
+)
+
+// ParseVisibility 文字列から公開範囲を取得（空の場合は private）
+func ParseVisibility(s string) (Visibility, error) {
+	switch Visibility(s) {
+	case "":
+		return VisibilityPrivate, nil
+	case VisibilityPrivate, VisibilityFriends, VisibilityPublic:
+		return Visibility(s), nil
+	}
+	return "", ErrInvalidVisibility
+}
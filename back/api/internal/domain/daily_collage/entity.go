@@ -0,0 +1,201 @@
+package daily_collage
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Status 毎日のコラージュのステータス
+type Status string
+
+const (
+	// StatusScheduled 撮影時刻を決めた（締め切りまで写真を受け付ける）
+	StatusScheduled Status = "scheduled"
+	// StatusRendering 締め切り後、ワーカーが生成中
+	StatusRendering Status = "rendering"
+	// StatusRendered 生成した
+	StatusRendered Status = "rendered"
+	// StatusFailed 生成できなかった（写真が1枚も無いなど）
+	StatusFailed Status = "failed"
+)
+
+// lastErrorMaxLength 保存する失敗理由の最大長
+const lastErrorMaxLength = 1000
+
+// DailyCollage 永続グループの1日分のコラージュ
+type DailyCollage struct {
+	dailyID    uuid.UUID
+	groupID    string
+	collageDay time.Time
+	templateID uuid.UUID
+	captureAt  time.Time
+	closesAt   time.Time
+	notifiedAt *time.Time
+	status     Status
+	resultID   *uuid.UUID
+	lastError  *string
+	createdAt  time.Time
+	updatedAt  time.Time
+}
+
+// NewDailyCollage creates the schedule of a day
+// captureAt から closesAt（対象日の終わり）まで写真を受け付ける
+func NewDailyCollage(groupID string, collageDay time.Time, templateID uuid.UUID, captureAt, closesAt time.Time) (*DailyCollage, error) {
+	if groupID == "" {
+		return nil, ErrInvalidGroupID
+	}
+	if collageDay.IsZero() {
+		return nil, ErrInvalidCollageDay
+	}
+	if templateID == uuid.Nil {
+		return nil, ErrInvalidTemplateID
+	}
+	if !captureAt.Before(closesAt) {
+		return nil, ErrInvalidCaptureTime
+	}
+
+	now := time.Now()
+	return &DailyCollage{
+		dailyID:    uuid.New(),
+		groupID:    groupID,
+		collageDay: collageDay,
+		templateID: templateID,
+		captureAt:  captureAt,
+		closesAt:   closesAt,
+		status:     StatusScheduled,
+		createdAt:  now,
+		updatedAt:  now,
+	}, nil
+}
+
+// Reconstruct reconstructs a DailyCollage from repository data
+func Reconstruct(
+	dailyID uuid.UUID,
+	groupID string,
+	collageDay time.Time,
+	templateID uuid.UUID,
+	captureAt time.Time,
+	closesAt time.Time,
+	notifiedAt *time.Time,
+	status Status,
+	resultID *uuid.UUID,
+	lastError *string,
+	createdAt time.Time,
+	updatedAt time.Time,
+) (*DailyCollage, error) {
+	return &DailyCollage{
+		dailyID:    dailyID,
+		groupID:    groupID,
+		collageDay: collageDay,
+		templateID: templateID,
+		captureAt:  captureAt,
+		closesAt:   closesAt,
+		notifiedAt: notifiedAt,
+		status:     status,
+		resultID:   resultID,
+		lastError:  lastError,
+		createdAt:  createdAt,
+		updatedAt:  updatedAt,
+	}, nil
+}
+
+// Getters
+func (d *DailyCollage) DailyID() uuid.UUID {
+	return d.dailyID
+}
+
+func (d *DailyCollage) GroupID() string {
+	return d.groupID
+}
+
+func (d *DailyCollage) CollageDay() time.Time {
+	return d.collageDay
+}
+
+func (d *DailyCollage) TemplateID() uuid.UUID {
+	return d.templateID
+}
+
+func (d *DailyCollage) CaptureAt() time.Time {
+	return d.captureAt
+}
+
+func (d *DailyCollage) ClosesAt() time.Time {
+	return d.closesAt
+}
+
+func (d *DailyCollage) NotifiedAt() *time.Time {
+	return d.notifiedAt
+}
+
+func (d *DailyCollage) Status() Status {
+	return d.status
+}
+
+func (d *DailyCollage) ResultID() *uuid.UUID {
+	return d.resultID
+}
+
+func (d *DailyCollage) LastError() *string {
+	return d.lastError
+}
+
+func (d *DailyCollage) CreatedAt() time.Time {
+	return d.createdAt
+}
+
+func (d *DailyCollage) UpdatedAt() time.Time {
+	return d.updatedAt
+}
+
+// CanAcceptPhoto checks if a photo for this day can be accepted now
+func (d *DailyCollage) CanAcceptPhoto(now time.Time) error {
+	if now.Before(d.captureAt) {
+		return ErrNotCaptureTime
+	}
+	if d.status != StatusScheduled || !now.Before(d.closesAt) {
+		return ErrUploadClosed
+	}
+	return nil
+}
+
+// Rendered records the generated collage
+func (d *DailyCollage) Rendered(resultID uuid.UUID, now time.Time) error {
+	if d.status != StatusRendering {
+		return ErrNotRendering
+	}
+	d.status = StatusRendered
+	d.resultID = &resultID
+	d.lastError = nil
+	d.updatedAt = now
+	return nil
+}
+
+// Fail records why the collage could not be generated
+func (d *DailyCollage) Fail(cause error, now time.Time) error {
+	if d.status != StatusRendering {
+		return ErrNotRendering
+	}
+	msg := cause.Error()
+	if len(msg) > lastErrorMaxLength {
+		msg = msg[:lastErrorMaxLength]
+	}
+	d.status = StatusFailed
+	d.lastError = &msg
+	d.updatedAt = now
+	return nil
+}
+
+// DayOf loc での t の日付を返す
+// collage_day は DATE 列で、接続のタイムゾーン (UTC) で保存されるので UTC の0時で表す
+func DayOf(t time.Time, loc *time.Location) time.Time {
+	y, m, d := t.In(loc).Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+// StartOf loc での collageDay の始まり（0時）
+func StartOf(collageDay time.Time, loc *time.Location) time.Time {
+	y, m, d := collageDay.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, loc)
+}
@@ -0,0 +1,35 @@
+package daily_collage
+
+import "errors"
+
+var (
+	// ErrInvalidGroupID group ID is invalid
+	ErrInvalidGroupID = errors.New("グループIDが無効です")
+
+	// ErrInvalidCollageDay collage day is invalid
+	ErrInvalidCollageDay = errors.New("コラージュ対象日が無効です")
+
+	// ErrInvalidTemplateID template ID is invalid
+	ErrInvalidTemplateID = errors.New("テンプレートIDが無効です")
+
+	// ErrInvalidCaptureTime capture time is not before the deadline
+	ErrInvalidCaptureTime = errors.New("撮影時刻は締め切りより前に設定してください")
+
+	// ErrDailyCollageNotFound the day has no schedule
+	ErrDailyCollageNotFound = errors.New("この日のコラージュは予定されていません")
+
+	// ErrAlreadyScheduled the day is already scheduled
+	ErrAlreadyScheduled = errors.New("この日のコラージュは既に予定されています")
+
+	// ErrNotCaptureTime the capture time of the day has not come yet
+	ErrNotCaptureTime = errors.New("今日の撮影時刻になっていません")
+
+	// ErrUploadClosed the day is over
+	ErrUploadClosed = errors.New("この日の写真の受付は締め切られました")
+
+	// ErrNotRendering the collage is not being generated
+	ErrNotRendering = errors.New("コラージュは生成中ではありません")
+
+	// ErrNoPartAssigned the member has no part for the day
+	ErrNoPartAssigned = errors.New("今日の担当パーツが割り当てられていません")
+)
@@ -0,0 +1,29 @@
+package daily_collage
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Repository interface {
+	// Create creates the schedule of a day. Returns ErrAlreadyScheduled if the group already has one for the day
+	Create(ctx context.Context, daily *DailyCollage) error
+
+	// FindByGroupAndDay finds the schedule of a group for a day
+	FindByGroupAndDay(ctx context.Context, groupID string, collageDay time.Time) (*DailyCollage, error)
+
+	// FindDueForNotification finds schedules whose capture time has come but whose members are not notified yet
+	FindDueForNotification(ctx context.Context, now time.Time, limit int) ([]*DailyCollage, error)
+
+	// MarkNotified sets notified_at if it is still unset, and reports whether this call set it
+	MarkNotified(ctx context.Context, dailyID uuid.UUID, now time.Time) (bool, error)
+
+	// ClaimClosed moves up to limit schedules past their deadline to rendering and returns them.
+	// Schedules stuck in rendering since before staleBefore are claimed again
+	ClaimClosed(ctx context.Context, now, staleBefore time.Time, limit int) ([]*DailyCollage, error)
+
+	// Update updates a schedule
+	Update(ctx context.Context, daily *DailyCollage) error
+}
@@ -25,12 +25,26 @@ const (
 	GroupStatusCountdown   GroupStatus = "countdown"    // カウントダウン中
 	GroupStatusPhotoTaking GroupStatus = "photo_taking" // 撮影中
 	GroupStatusCompleted   GroupStatus = "completed"    // 完了
+	GroupStatusFailed      GroupStatus = "failed"       // 撮影の締め切りまでに写真が揃わず失敗
 	GroupStatusExpired     GroupStatus = "expired"      // 期限切れ
 )
 
 const (
 	// SystemMaxMember is the system-wide maximum number of members in a group
 	SystemMaxMember = 100
+
+	// DefaultCaptureWindow is how long uploads are accepted after the scheduled capture time
+	DefaultCaptureWindow = 60 * time.Second
+
+	// UploadGracePeriod is how long after the deadline an upload started before the deadline can still complete
+	// (e.g. a resumable upload interrupted by a network drop). Collage generation waits for such uploads
+	// during this period, so it must fit within the collage job's retries
+	UploadGracePeriod = 60 * time.Second
+
+	// カウントダウンの秒数（グループごとに設定できる範囲）
+	DefaultCountdownSeconds = 10
+	MinCountdownSeconds     = 3
+	MaxCountdownSeconds     = 60
 )
 
 type Group struct {
@@ -45,8 +59,12 @@ type Group struct {
 	finalizedAt           *time.Time
 	countdownStartedAt    *time.Time
 	scheduledCaptureTime  *time.Time
+	captureDeadline       *time.Time
 	templateID            *string
 	expiresAt             *time.Time
+	countdownSeconds      int
+	autoStart             bool
+	currentRound          int
 	createdAt             time.Time
 	updatedAt             time.Time
 }
@@ -82,6 +100,8 @@ func NewGroup(ownerUserID, name string, groupType GroupType, expiresAt *time.Tim
 		scheduledCaptureTime: nil,
 		templateID:           nil,
 		expiresAt:            expiresAt,
+		countdownSeconds:     DefaultCountdownSeconds,
+		autoStart:            false,
 		createdAt:            now,
 		updatedAt:            now,
 	}, nil
@@ -94,9 +114,12 @@ func Reconstruct(
 	status GroupStatus,
 	maxMember, currentMemberCount int,
 	invitationToken string,
-	finalizedAt, countdownStartedAt, scheduledCaptureTime *time.Time,
+	finalizedAt, countdownStartedAt, scheduledCaptureTime, captureDeadline *time.Time,
 	templateID *string,
 	expiresAt *time.Time,
+	countdownSeconds int,
+	autoStart bool,
+	currentRound int,
 	createdAt, updatedAt time.Time,
 ) (*Group, error) {
 	if id == "" {
@@ -135,8 +158,12 @@ func Reconstruct(
 		finalizedAt:          finalizedAt,
 		countdownStartedAt:   countdownStartedAt,
 		scheduledCaptureTime: scheduledCaptureTime,
+		captureDeadline:      captureDeadline,
 		templateID:           templateID,
 		expiresAt:            expiresAt,
+		countdownSeconds:     countdownSeconds,
+		autoStart:            autoStart,
+		currentRound:         currentRound,
 		createdAt:            createdAt,
 		updatedAt:            updatedAt,
 	}, nil
@@ -187,6 +214,10 @@ func (g *Group) ScheduledCaptureTime() *time.Time {
 	return g.scheduledCaptureTime
 }
 
+func (g *Group) CaptureDeadline() *time.Time {
+	return g.captureDeadline
+}
+
 func (g *Group) TemplateID() *string {
 	return g.templateID
 }
@@ -195,6 +226,19 @@ func (g *Group) ExpiresAt() *time.Time {
 	return g.expiresAt
 }
 
+func (g *Group) CountdownSeconds() int {
+	return g.countdownSeconds
+}
+
+func (g *Group) AutoStart() bool {
+	return g.autoStart
+}
+
+// CurrentRound 現在（直近）のラウンド番号。まだ一度もカウントダウンしていない場合は 0
+func (g *Group) CurrentRound() int {
+	return g.currentRound
+}
+
 func (g *Group) CreatedAt() time.Time {
 	return g.createdAt
 }
@@ -227,6 +271,36 @@ func (g *Group) UpdateMaxMember(maxMember int) error {
 	return nil
 }
 
+// UpdateSessionSettings updates the countdown length and the auto start flag
+// 自動開始はサーバーがカウントダウンを始めるので、使うテンプレートを先に決めておく必要がある
+func (g *Group) UpdateSessionSettings(countdownSeconds int, autoStart bool, templateID *string) error {
+	if g.status != GroupStatusRecruiting && g.status != GroupStatusReadyCheck {
+		return ErrSessionAlreadyStarted
+	}
+	if countdownSeconds < MinCountdownSeconds || countdownSeconds > MaxCountdownSeconds {
+		return ErrInvalidCountdownSeconds
+	}
+	if templateID != nil && *templateID == "" {
+		templateID = nil
+	}
+	if templateID == nil {
+		templateID = g.templateID
+	}
+	if autoStart && templateID == nil {
+		return ErrAutoStartRequiresTemplate
+	}
+	g.countdownSeconds = countdownSeconds
+	g.autoStart = autoStart
+	g.templateID = templateID
+	g.updatedAt = time.Now()
+	return nil
+}
+
+// IsEveryoneReady checks if all finalized members are ready
+func (g *Group) IsEveryoneReady(readyCount int) bool {
+	return g.status == GroupStatusReadyCheck && g.currentMemberCount > 0 && readyCount >= g.currentMemberCount
+}
+
 // IncrementMemberCount increments the current member count
 func (g *Group) IncrementMemberCount() error {
 	if g.currentMemberCount >= g.maxMember {
@@ -264,32 +338,88 @@ func (g *Group) FinalizeMembers() error {
 	return nil
 }
 
-// StartCountdown starts the countdown and sets the scheduled capture time (10 seconds from now)
-func (g *Group) StartCountdown(countdownSeconds int, templateID string) error {
+// StartCountdown starts a new round: sets the scheduled capture time and the upload deadline
+func (g *Group) StartCountdown(countdownSeconds int, templateID string, captureWindow time.Duration) error {
 	if g.status != GroupStatusReadyCheck {
 		return ErrGroupNotReadyCheck
 	}
+	if captureWindow <= 0 {
+		captureWindow = DefaultCaptureWindow
+	}
 	now := time.Now()
 	scheduledTime := now.Add(time.Duration(countdownSeconds) * time.Second)
+	deadline := scheduledTime.Add(captureWindow)
 
 	g.status = GroupStatusCountdown
+	g.currentRound++
 	g.countdownStartedAt = &now
 	g.scheduledCaptureTime = &scheduledTime
+	g.captureDeadline = &deadline
 	g.templateID = &templateID
 	g.updatedAt = now
 	return nil
 }
 
-// StartPhotoTaking moves to photo taking status
-func (g *Group) StartPhotoTaking() error {
+// IsPhotoTakingDue checks if the countdown has reached the scheduled capture time
+func (g *Group) IsPhotoTakingDue(now time.Time) bool {
+	return g.status == GroupStatusCountdown &&
+		g.scheduledCaptureTime != nil && !now.Before(*g.scheduledCaptureTime)
+}
+
+// StartPhotoTaking moves to photo taking status once the scheduled capture time has passed
+func (g *Group) StartPhotoTaking(now time.Time) error {
 	if g.status != GroupStatusCountdown {
 		return ErrGroupNotCountdown
 	}
+	if !g.IsPhotoTakingDue(now) {
+		return ErrCaptureNotStarted
+	}
 	g.status = GroupStatusPhotoTaking
-	g.updatedAt = time.Now()
+	g.updatedAt = now
 	return nil
 }
 
+// AdvanceCapture moves a due countdown to photo taking and reports whether the status changed
+func (g *Group) AdvanceCapture(now time.Time) bool {
+	if !g.IsPhotoTakingDue(now) {
+		return false
+	}
+	return g.StartPhotoTaking(now) == nil
+}
+
+// IsCaptureDeadlinePassed checks if the upload deadline of the session has passed
+func (g *Group) IsCaptureDeadlinePassed(now time.Time) bool {
+	return g.captureDeadline != nil && now.After(*g.captureDeadline)
+}
+
+// CanAcceptPhoto checks if a photo taken in this session can be accepted now
+func (g *Group) CanAcceptPhoto(now time.Time) error {
+	if g.status != GroupStatusPhotoTaking {
+		return ErrGroupNotPhotoTaking
+	}
+	if g.IsCaptureDeadlinePassed(now) {
+		return ErrCaptureWindowClosed
+	}
+	return nil
+}
+
+// CanAcceptUpload checks if a photo whose upload started at startedAt can be accepted now
+// Uploads started before the deadline are accepted until UploadGracePeriod after it
+func (g *Group) CanAcceptUpload(startedAt, now time.Time) error {
+	if g.status != GroupStatusPhotoTaking {
+		return ErrGroupNotPhotoTaking
+	}
+	if g.IsCaptureDeadlinePassed(startedAt) || g.IsUploadGraceOver(now) {
+		return ErrCaptureWindowClosed
+	}
+	return nil
+}
+
+// IsUploadGraceOver checks if uploads started before the deadline can no longer complete
+func (g *Group) IsUploadGraceOver(now time.Time) bool {
+	return g.captureDeadline != nil && now.After(g.captureDeadline.Add(UploadGracePeriod))
+}
+
 // Complete completes the group
 func (g *Group) Complete() error {
 	if g.status != GroupStatusPhotoTaking {
@@ -300,6 +430,30 @@ func (g *Group) Complete() error {
 	return nil
 }
 
+// Fail fails the photo session (not enough photos by the deadline)
+func (g *Group) Fail() error {
+	if g.status != GroupStatusPhotoTaking {
+		return ErrGroupNotPhotoTaking
+	}
+	g.status = GroupStatusFailed
+	g.updatedAt = time.Now()
+	return nil
+}
+
+// StartNextRound resets a finished session so that the same members can shoot another round
+// メンバーの準備完了状態は呼び出し側でリセットする。テンプレートと撮影設定は引き継ぐ
+func (g *Group) StartNextRound() error {
+	if g.status != GroupStatusCompleted && g.status != GroupStatusFailed {
+		return ErrRoundNotFinished
+	}
+	g.status = GroupStatusReadyCheck
+	g.countdownStartedAt = nil
+	g.scheduledCaptureTime = nil
+	g.captureDeadline = nil
+	g.updatedAt = time.Now()
+	return nil
+}
+
 // Expire expires the group
 func (g *Group) Expire() error {
 	g.status = GroupStatusExpired
@@ -339,7 +493,7 @@ func isValidGroupType(gt GroupType) bool {
 func isValidGroupStatus(gs GroupStatus) bool {
 	switch gs {
 	case GroupStatusRecruiting, GroupStatusReadyCheck, GroupStatusCountdown,
-		GroupStatusPhotoTaking, GroupStatusCompleted, GroupStatusExpired:
+		GroupStatusPhotoTaking, GroupStatusCompleted, GroupStatusFailed, GroupStatusExpired:
 		return true
 	default:
 		return false
@@ -0,0 +1,184 @@
+package group
+
+import (
+	"testing"
+	"time"
+)
+
+// readyGroup 準備確認中のグループを作る
+func readyGroup(t *testing.T) *Group {
+	t.Helper()
+	g, err := NewGroup("owner-1", "テスト", GroupTypeLocalTemporary, nil)
+	if err != nil {
+		t.Fatalf("NewGroup: %v", err)
+	}
+	g.IncrementMemberCount()
+	if err := g.FinalizeMembers(); err != nil {
+		t.Fatalf("FinalizeMembers: %v", err)
+	}
+	return g
+}
+
+func TestCaptureSessionStateMachine(t *testing.T) {
+	g := readyGroup(t)
+	if err := g.StartCountdown(10, "template", 30*time.Second); err != nil {
+		t.Fatalf("StartCountdown: %v", err)
+	}
+
+	scheduled := *g.ScheduledCaptureTime()
+	if got := g.CaptureDeadline().Sub(scheduled); got != 30*time.Second {
+		t.Errorf("capture window = %v, want 30s", got)
+	}
+
+	// 撮影時刻前は撮影中に進めず、写真も受け付けない
+	before := scheduled.Add(-time.Second)
+	if g.AdvanceCapture(before) {
+		t.Error("advanced before the scheduled capture time")
+	}
+	if err := g.StartPhotoTaking(before); err != ErrCaptureNotStarted {
+		t.Errorf("StartPhotoTaking before schedule: got %v, want %v", err, ErrCaptureNotStarted)
+	}
+	if err := g.CanAcceptPhoto(before); err != ErrGroupNotPhotoTaking {
+		t.Errorf("CanAcceptPhoto during countdown: got %v, want %v", err, ErrGroupNotPhotoTaking)
+	}
+	if err := g.Complete(); err != ErrGroupNotPhotoTaking {
+		t.Errorf("Complete during countdown: got %v, want %v", err, ErrGroupNotPhotoTaking)
+	}
+
+	if !g.AdvanceCapture(scheduled) {
+		t.Fatal("did not advance at the scheduled capture time")
+	}
+	if g.Status() != GroupStatusPhotoTaking {
+		t.Fatalf("status = %s, want %s", g.Status(), GroupStatusPhotoTaking)
+	}
+	if g.AdvanceCapture(scheduled.Add(time.Second)) {
+		t.Error("advanced twice")
+	}
+
+	if err := g.CanAcceptPhoto(scheduled.Add(10 * time.Second)); err != nil {
+		t.Errorf("CanAcceptPhoto within window: %v", err)
+	}
+	after := g.CaptureDeadline().Add(time.Millisecond)
+	if !g.IsCaptureDeadlinePassed(after) {
+		t.Error("deadline not passed after the window")
+	}
+	if err := g.CanAcceptPhoto(after); err != ErrCaptureWindowClosed {
+		t.Errorf("CanAcceptPhoto after deadline: got %v, want %v", err, ErrCaptureWindowClosed)
+	}
+
+	// 締め切り前に始まったアップロードは猶予の間だけ受け付ける
+	started := g.CaptureDeadline().Add(-time.Second)
+	if err := g.CanAcceptUpload(started, after); err != nil {
+		t.Errorf("CanAcceptUpload started before deadline: %v", err)
+	}
+	if err := g.CanAcceptUpload(after, after); err != ErrCaptureWindowClosed {
+		t.Errorf("CanAcceptUpload started after deadline: got %v, want %v", err, ErrCaptureWindowClosed)
+	}
+	graceOver := g.CaptureDeadline().Add(UploadGracePeriod + time.Millisecond)
+	if !g.IsUploadGraceOver(graceOver) {
+		t.Error("grace not over after the grace period")
+	}
+	if err := g.CanAcceptUpload(started, graceOver); err != ErrCaptureWindowClosed {
+		t.Errorf("CanAcceptUpload after grace: got %v, want %v", err, ErrCaptureWindowClosed)
+	}
+
+	if err := g.Complete(); err != nil {
+		t.Fatalf("Complete: %v", err)
+	}
+	if err := g.Fail(); err != ErrGroupNotPhotoTaking {
+		t.Errorf("Fail after completion: got %v, want %v", err, ErrGroupNotPhotoTaking)
+	}
+}
+
+func TestCaptureSessionFail(t *testing.T) {
+	g := readyGroup(t)
+	g.StartCountdown(0, "template", 0)
+	if got := g.CaptureDeadline().Sub(*g.ScheduledCaptureTime()); got != DefaultCaptureWindow {
+		t.Errorf("default capture window = %v, want %v", got, DefaultCaptureWindow)
+	}
+
+	g.AdvanceCapture(time.Now())
+	if err := g.Fail(); err != nil {
+		t.Fatalf("Fail: %v", err)
+	}
+	if g.Status() != GroupStatusFailed {
+		t.Errorf("status = %s, want %s", g.Status(), GroupStatusFailed)
+	}
+}
+
+func TestUpdateSessionSettings(t *testing.T) {
+	g := readyGroup(t)
+
+	for _, seconds := range []int{MinCountdownSeconds - 1, MaxCountdownSeconds + 1} {
+		if err := g.UpdateSessionSettings(seconds, false, nil); err != ErrInvalidCountdownSeconds {
+			t.Errorf("countdown %d: got %v, want %v", seconds, err, ErrInvalidCountdownSeconds)
+		}
+	}
+	if err := g.UpdateSessionSettings(5, true, nil); err != ErrAutoStartRequiresTemplate {
+		t.Errorf("auto start without template: got %v, want %v", err, ErrAutoStartRequiresTemplate)
+	}
+
+	templateID := "template"
+	if err := g.UpdateSessionSettings(5, true, &templateID); err != nil {
+		t.Fatalf("UpdateSessionSettings: %v", err)
+	}
+	// テンプレートを省略した場合は選んであるものを使い続ける
+	if err := g.UpdateSessionSettings(7, true, nil); err != nil {
+		t.Fatalf("UpdateSessionSettings keeping template: %v", err)
+	}
+	if g.CountdownSeconds() != 7 || !g.AutoStart() || *g.TemplateID() != templateID {
+		t.Errorf("settings = (%d, %v, %s), want (7, true, %s)", g.CountdownSeconds(), g.AutoStart(), *g.TemplateID(), templateID)
+	}
+
+	if g.IsEveryoneReady(g.CurrentMemberCount() - 1) {
+		t.Error("everyone ready before the last member")
+	}
+	if !g.IsEveryoneReady(g.CurrentMemberCount()) {
+		t.Error("not everyone ready with all members")
+	}
+
+	g.StartCountdown(g.CountdownSeconds(), templateID, 0)
+	if got := g.ScheduledCaptureTime().Sub(*g.CountdownStartedAt()); got != 7*time.Second {
+		t.Errorf("countdown = %v, want 7s", got)
+	}
+	if err := g.UpdateSessionSettings(5, false, nil); err != ErrSessionAlreadyStarted {
+		t.Errorf("update after countdown: got %v, want %v", err, ErrSessionAlreadyStarted)
+	}
+	if g.IsEveryoneReady(g.CurrentMemberCount()) {
+		t.Error("everyone ready after the countdown started")
+	}
+}
+
+func TestStartNextRound(t *testing.T) {
+	g := readyGroup(t)
+	if err := g.StartNextRound(); err != ErrRoundNotFinished {
+		t.Errorf("StartNextRound before shooting: got %v, want %v", err, ErrRoundNotFinished)
+	}
+
+	g.StartCountdown(0, "template", 0)
+	if g.CurrentRound() != 1 {
+		t.Fatalf("round = %d, want 1", g.CurrentRound())
+	}
+	if err := g.StartNextRound(); err != ErrRoundNotFinished {
+		t.Errorf("StartNextRound during countdown: got %v, want %v", err, ErrRoundNotFinished)
+	}
+
+	g.AdvanceCapture(time.Now())
+	g.Complete()
+	if err := g.StartNextRound(); err != nil {
+		t.Fatalf("StartNextRound: %v", err)
+	}
+	if g.Status() != GroupStatusReadyCheck || g.ScheduledCaptureTime() != nil || g.CaptureDeadline() != nil {
+		t.Errorf("next round not reset: status=%s scheduled=%v deadline=%v", g.Status(), g.ScheduledCaptureTime(), g.CaptureDeadline())
+	}
+	if g.TemplateID() == nil || *g.TemplateID() != "template" {
+		t.Error("template not kept for the next round")
+	}
+
+	if err := g.StartCountdown(g.CountdownSeconds(), "template", 0); err != nil {
+		t.Fatalf("StartCountdown for round 2: %v", err)
+	}
+	if g.CurrentRound() != 2 {
+		t.Errorf("round = %d, want 2", g.CurrentRound())
+	}
+}
@@ -25,6 +25,16 @@ var (
 	ErrGroupNotReadyCheck  = errors.New("グループは準備確認中ではありません")
 	ErrGroupNotCountdown   = errors.New("グループはカウントダウン中ではありません")
 	ErrGroupNotPhotoTaking = errors.New("グループは撮影中ではありません")
+	ErrCaptureNotStarted   = errors.New("撮影時刻になっていません")
+	ErrCaptureWindowClosed = errors.New("撮影した写真の受付は締め切られました")
+
+	// Session settings errors
+	ErrInvalidCountdownSeconds   = errors.New("カウントダウンは3〜60秒で設定してください")
+	ErrSessionAlreadyStarted     = errors.New("撮影セッションは既に開始されています")
+	ErrAutoStartRequiresTemplate = errors.New("自動開始にはテンプレートの指定が必要です")
+	ErrTemplateRequired          = errors.New("テンプレートが指定されていません")
+	ErrTemplateMemberMismatch    = errors.New("テンプレートの写真の枚数がメンバー数と一致しません")
+	ErrRoundNotFinished          = errors.New("撮影が終わっていないため次のラウンドを開始できません")
 
 	// Token errors
 	ErrInvalidInvitationToken = errors.New("無効な招待トークンです")
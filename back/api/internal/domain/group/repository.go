@@ -1,6 +1,9 @@
 package group
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 // Repository はグループのリポジトリインターフェース
 type Repository interface {
@@ -19,9 +22,27 @@ type Repository interface {
 	// List は全グループを取得
 	List(ctx context.Context, limit, offset int) ([]*Group, error)
 
+	// FindByMemberUserID は userID がメンバーのグループを作成日時の新しい順に検索
+	FindByMemberUserID(ctx context.Context, userID string, limit, offset int) ([]*Group, error)
+
 	// Update はグループ情報を更新
 	Update(ctx context.Context, group *Group) error
 
+	// UpdateIfStatus は保存されているステータスが expected のままの場合だけグループ情報を更新し、更新したかどうかを返す
+	// 同じグループのステータスを同時に進めようとしたときに、先に更新した側だけを成功させる
+	UpdateIfStatus(ctx context.Context, group *Group, expected GroupStatus) (bool, error)
+
+	// IncrementMemberCount はメンバー募集中で定員に空きがある場合だけメンバー数を1増やし、増やしたかどうかを返す
+	// メンバー数以外の列は書き換えないので、同時に参加したりステータスが進んだりしても上書きしない
+	IncrementMemberCount(ctx context.Context, id string, updatedAt time.Time) (bool, error)
+
+	// DecrementMemberCount はメンバー募集中の場合だけメンバー数を1減らし、減らしたかどうかを返す
+	DecrementMemberCount(ctx context.Context, id string, updatedAt time.Time) (bool, error)
+
+	// FinalizeMembers はメンバー募集中でメンバーがいる場合だけ準備確認中にし、確定したかどうかを返す
+	// 定員は保存されているメンバー数で確定する
+	FinalizeMembers(ctx context.Context, id string, finalizedAt time.Time) (bool, error)
+
 	// Delete はグループを削除
 	Delete(ctx context.Context, id string) error
 
@@ -34,6 +55,13 @@ type Repository interface {
 	// FindByStatus はステータスでグループを検索
 	FindByStatus(ctx context.Context, status string, limit, offset int) ([]*Group, error)
 
-	// UpdateStatus はグループのステータスを更新
-	UpdateStatus(ctx context.Context, id string, status string) error
+	// FindActiveByType は期限切れになっていない指定タイプのグループを作成順に取得
+	FindActiveByType(ctx context.Context, groupType GroupType, limit, offset int) ([]*Group, error)
+
+	// ExpireOverdue は expires_at を過ぎた募集中・準備確認中のグループを期限切れにし、そのIDを返す
+	ExpireOverdue(ctx context.Context, now time.Time) ([]string, error)
+
+	// AbortStuckSessions は startedBefore より前にカウントダウンを始めたまま終わっていないセッションを打ち切り、
+	// グループを失敗（次のラウンドを始められる状態）にして返す。グループ自体は期限切れにしない
+	AbortStuckSessions(ctx context.Context, startedBefore time.Time) ([]*Group, error)
 }
@@ -6,15 +6,35 @@ import (
 	"github.com/google/uuid"
 )
 
+// ClockState 端末時計の同期状態
+type ClockState string
+
+const (
+	ClockStateSynced     ClockState = "synced"     // 同期済み
+	ClockStateUnsynced   ClockState = "unsynced"   // 一度も同期していない
+	ClockStateStale      ClockState = "stale"      // 最後の同期から時間が経っている
+	ClockStateUnreliable ClockState = "unreliable" // 往復遅延が大きく推定誤差が許容範囲を超える
+)
+
+const (
+	// ClockTolerance オフセット推定誤差（往復遅延の半分）の許容値。これを超えるとシャッターがずれて見える
+	ClockTolerance = 50 * time.Millisecond
+	// ClockSampleMaxAge 同期結果を信頼する期間。端末時計はこの間にもドリフトする
+	ClockSampleMaxAge = 10 * time.Minute
+)
+
 type GroupMember struct {
-	id          string
-	groupID     string
-	userID      string
-	isOwner     bool
-	readyStatus bool
-	readyAt     *time.Time
-	joinedAt    time.Time
-	updatedAt   time.Time
+	id            string
+	groupID       string
+	userID        string
+	isOwner       bool
+	readyStatus   bool
+	readyAt       *time.Time
+	clockOffset   *time.Duration
+	clockRTT      *time.Duration
+	clockSyncedAt *time.Time
+	joinedAt      time.Time
+	updatedAt     time.Time
 }
 
 // NewGroupMember creates a new group member
@@ -40,7 +60,7 @@ func NewGroupMember(groupID, userID string, isOwner bool) (*GroupMember, error)
 }
 
 // Reconstruct reconstructs a group member from repository
-func Reconstruct(id, groupID, userID string, isOwner, readyStatus bool, readyAt *time.Time, joinedAt, updatedAt time.Time) (*GroupMember, error) {
+func Reconstruct(id, groupID, userID string, isOwner, readyStatus bool, readyAt *time.Time, clockOffset, clockRTT *time.Duration, clockSyncedAt *time.Time, joinedAt, updatedAt time.Time) (*GroupMember, error) {
 	if id == "" {
 		return nil, ErrInvalidMemberID
 	}
@@ -52,14 +72,17 @@ func Reconstruct(id, groupID, userID string, isOwner, readyStatus bool, readyAt
 	}
 
 	return &GroupMember{
-		id:          id,
-		groupID:     groupID,
-		userID:      userID,
-		isOwner:     isOwner,
-		readyStatus: readyStatus,
-		readyAt:     readyAt,
-		joinedAt:    joinedAt,
-		updatedAt:   updatedAt,
+		id:            id,
+		groupID:       groupID,
+		userID:        userID,
+		isOwner:       isOwner,
+		readyStatus:   readyStatus,
+		readyAt:       readyAt,
+		clockOffset:   clockOffset,
+		clockRTT:      clockRTT,
+		clockSyncedAt: clockSyncedAt,
+		joinedAt:      joinedAt,
+		updatedAt:     updatedAt,
 	}, nil
 }
 
@@ -88,6 +111,19 @@ func (gm *GroupMember) ReadyAt() *time.Time {
 	return gm.readyAt
 }
 
+// ClockOffset サーバー時刻 - 端末時刻。未同期の場合は nil
+func (gm *GroupMember) ClockOffset() *time.Duration {
+	return gm.clockOffset
+}
+
+func (gm *GroupMember) ClockRTT() *time.Duration {
+	return gm.clockRTT
+}
+
+func (gm *GroupMember) ClockSyncedAt() *time.Time {
+	return gm.clockSyncedAt
+}
+
 func (gm *GroupMember) JoinedAt() time.Time {
 	return gm.joinedAt
 }
@@ -118,3 +154,40 @@ func (gm *GroupMember) CancelReady() error {
 	gm.updatedAt = time.Now()
 	return nil
 }
+
+// RecordClockSync records the estimated clock offset and round-trip time of the member's device
+func (gm *GroupMember) RecordClockSync(offset, rtt time.Duration) error {
+	if rtt < 0 {
+		return ErrInvalidClockSample
+	}
+	now := time.Now()
+	gm.clockOffset = &offset
+	gm.clockRTT = &rtt
+	gm.clockSyncedAt = &now
+	gm.updatedAt = now
+	return nil
+}
+
+// ClockState returns how trustworthy the recorded clock offset is at now
+func (gm *GroupMember) ClockState(now time.Time) ClockState {
+	if gm.clockOffset == nil || gm.clockRTT == nil || gm.clockSyncedAt == nil {
+		return ClockStateUnsynced
+	}
+	if now.Sub(*gm.clockSyncedAt) > ClockSampleMaxAge {
+		return ClockStateStale
+	}
+	// オフセットの推定誤差は最大で往復遅延の半分
+	if *gm.clockRTT/2 > ClockTolerance {
+		return ClockStateUnreliable
+	}
+	return ClockStateSynced
+}
+
+// DeviceTime converts a server instant into the member's device clock
+// 未同期の場合はサーバー時刻をそのまま返す
+func (gm *GroupMember) DeviceTime(serverTime time.Time) time.Time {
+	if gm.clockOffset == nil {
+		return serverTime
+	}
+	return serverTime.Add(-*gm.clockOffset)
+}
@@ -10,4 +10,5 @@ var (
 	ErrMemberAlreadyExists = errors.New("このメンバーは既に存在します")
 	ErrAlreadyReady        = errors.New("既に準備完了状態です")
 	ErrNotReady            = errors.New("準備完了状態ではありません")
+	ErrInvalidClockSample  = errors.New("無効な時刻同期の結果です")
 )
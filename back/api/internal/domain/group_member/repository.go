@@ -30,6 +30,9 @@ type Repository interface {
 	// CountReadyByGroupID counts ready members in a group
 	CountReadyByGroupID(ctx context.Context, groupID string) (int, error)
 
+	// ResetReadyByGroupID clears the ready status of every member in a group
+	ResetReadyByGroupID(ctx context.Context, groupID string) error
+
 	// IsOwner checks if a user is the owner of a group
 	IsOwner(ctx context.Context, groupID, userID string) (bool, error)
 }
@@ -0,0 +1,210 @@
+package group_part_assignment
+
+import (
+	"math/rand"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// HistoryLimit 自動割り当てで参照する過去の割り当ての件数
+const HistoryLimit = 500
+
+// Strategy パーツを自動で割り当てる方法
+type Strategy string
+
+const (
+	// StrategyRotation 前回と同じパーツにならないよう、パーツを順番に回す
+	StrategyRotation Strategy = "rotation"
+	// StrategyRandom ランダムに割り当てる
+	StrategyRandom Strategy = "random"
+	// StrategyManual オーナーが指定した割り当てだけを作る
+	StrategyManual Strategy = "manual"
+)
+
+// ParseStrategy 文字列から割り当て方法を取得（空の場合は rotation）
+func ParseStrategy(s string) (Strategy, error) {
+	switch Strategy(s) {
+	case "":
+		return StrategyRotation, nil
+	case StrategyRotation, StrategyRandom, StrategyManual:
+		return Strategy(s), nil
+	}
+	return "", ErrInvalidStrategy
+}
+
+// AssignInput パーツの自動割り当ての入力
+type AssignInput struct {
+	Strategy Strategy
+	// Members 割り当て対象のメンバー
+	Members []uuid.UUID
+	// Parts テンプレートのパーツ（パーツ番号順）
+	Parts []uuid.UUID
+	// History このグループの過去の割り当て（順不同）
+	History []*GroupPartAssignment
+	// Overrides オーナーが指定したメンバーとパーツ。どの方法でも最初に確定する
+	Overrides map[uuid.UUID]uuid.UUID
+	// Intn [0, n) の乱数（random で使う）
+	Intn func(n int) int
+}
+
+// Assign メンバーごとのパーツを決める
+// メンバーがパーツより多い場合は、これまでの担当回数が少ないメンバーから割り当てる
+func Assign(in AssignInput) (map[uuid.UUID]uuid.UUID, error) {
+	if len(in.Parts) == 0 {
+		return nil, ErrNoParts
+	}
+
+	isMember := make(map[uuid.UUID]bool, len(in.Members))
+	for _, m := range in.Members {
+		isMember[m] = true
+	}
+	free := make(map[uuid.UUID]bool, len(in.Parts))
+	for _, p := range in.Parts {
+		free[p] = true
+	}
+
+	result := make(map[uuid.UUID]uuid.UUID, len(in.Members))
+	for userID, partID := range in.Overrides {
+		if !isMember[userID] {
+			return nil, ErrOverrideNotMember
+		}
+		if _, ok := free[partID]; !ok {
+			return nil, ErrOverridePartNotInTemplate
+		}
+		if !free[partID] {
+			return nil, ErrDuplicatePartAssignment
+		}
+		free[partID] = false
+		result[userID] = partID
+	}
+
+	if in.Strategy == StrategyManual {
+		if len(result) == 0 {
+			return nil, ErrOverridesRequired
+		}
+		return result, nil
+	}
+
+	// 指定されていないメンバーを担当回数の少ない順に並べる
+	stats := historyStats(in.History)
+	rest := make([]uuid.UUID, 0, len(in.Members))
+	for _, m := range in.Members {
+		if _, ok := result[m]; !ok {
+			rest = append(rest, m)
+		}
+	}
+	sort.Slice(rest, func(i, j int) bool {
+		a, b := stats[rest[i]], stats[rest[j]]
+		if a.count != b.count {
+			return a.count < b.count
+		}
+		if !a.lastDay.Equal(b.lastDay) {
+			return a.lastDay.Before(b.lastDay)
+		}
+		return rest[i].String() < rest[j].String()
+	})
+
+	switch in.Strategy {
+	case StrategyRandom:
+		assignRandom(result, rest, in.Parts, free, in.Intn)
+	case StrategyRotation:
+		assignRotation(result, rest, in.Parts, free, stats)
+	default:
+		return nil, ErrInvalidStrategy
+	}
+	return result, nil
+}
+
+// memberHistory メンバーのこれまでの割り当て
+type memberHistory struct {
+	count    int
+	lastDay  time.Time
+	lastPart uuid.UUID
+}
+
+func historyStats(history []*GroupPartAssignment) map[uuid.UUID]memberHistory {
+	stats := make(map[uuid.UUID]memberHistory)
+	for _, a := range history {
+		s := stats[a.UserID()]
+		s.count++
+		if s.lastPart == uuid.Nil || a.CollageDay().After(s.lastDay) {
+			s.lastDay = a.CollageDay()
+			s.lastPart = a.PartID()
+		}
+		stats[a.UserID()] = s
+	}
+	return stats
+}
+
+// assignRotation 前回のパーツの次のパーツから順に空いているものを割り当てる
+// 初めてのメンバーは並び順でずらして始める。前回と同じパーツは他に空きが無いときだけ使う
+func assignRotation(result map[uuid.UUID]uuid.UUID, members, parts []uuid.UUID, free map[uuid.UUID]bool, stats map[uuid.UUID]memberHistory) {
+	index := make(map[uuid.UUID]int, len(parts))
+	for i, p := range parts {
+		index[p] = i
+	}
+
+	for i, m := range members {
+		if !anyFree(free) {
+			return
+		}
+		last := stats[m].lastPart
+		start := i % len(parts)
+		if idx, ok := index[last]; ok {
+			start = idx + 1
+		}
+
+		chosen := uuid.Nil
+		for j := 0; j < len(parts); j++ {
+			p := parts[(start+j)%len(parts)]
+			if !free[p] {
+				continue
+			}
+			if p == last {
+				if chosen == uuid.Nil {
+					chosen = p
+				}
+				continue
+			}
+			chosen = p
+			break
+		}
+		free[chosen] = false
+		result[m] = chosen
+	}
+}
+
+// assignRandom 空いているパーツをランダムに並べ替えて順に割り当てる
+func assignRandom(result map[uuid.UUID]uuid.UUID, members, parts []uuid.UUID, free map[uuid.UUID]bool, intn func(int) int) {
+	pool := make([]uuid.UUID, 0, len(parts))
+	for _, p := range parts {
+		if free[p] {
+			pool = append(pool, p)
+		}
+	}
+	if intn == nil {
+		intn = rand.Intn
+	}
+	for i := len(pool) - 1; i > 0; i-- {
+		j := intn(i + 1)
+		pool[i], pool[j] = pool[j], pool[i]
+	}
+	for i, m := range members {
+		if i >= len(pool) {
+			return
+		}
+		free[pool[i]] = false
+		result[m] = pool[i]
+	}
+}
+
+func anyFree(free map[uuid.UUID]bool) bool {
+	for _, ok := range free {
+		if ok {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,111 @@
+package group_part_assignment
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func newIDs(n int) []uuid.UUID {
+	ids := make([]uuid.UUID, n)
+	for i := range ids {
+		ids[i] = uuid.New()
+	}
+	return ids
+}
+
+func TestAssignRotation(t *testing.T) {
+	members := newIDs(3)
+	parts := newIDs(2)
+	day := time.Date(2025, 10, 18, 0, 0, 0, 0, time.UTC)
+
+	var history []*GroupPartAssignment
+	last := make(map[uuid.UUID]uuid.UUID)
+	turns := make(map[uuid.UUID]int)
+	for d := 0; d < 6; d++ {
+		collageDay := day.AddDate(0, 0, d)
+		plan, err := Assign(AssignInput{Strategy: StrategyRotation, Members: members, Parts: parts, History: history})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(plan) != len(parts) {
+			t.Fatalf("day %d: %d assignments, want %d", d, len(plan), len(parts))
+		}
+
+		used := make(map[uuid.UUID]bool)
+		for userID, partID := range plan {
+			if used[partID] {
+				t.Fatalf("day %d: part assigned twice", d)
+			}
+			used[partID] = true
+			if prev, ok := last[userID]; ok && prev == partID {
+				t.Errorf("day %d: member got the same part as last time", d)
+			}
+			last[userID] = partID
+			turns[userID]++
+
+			a, err := NewGroupPartAssignment("group-1", userID, partID, collageDay)
+			if err != nil {
+				t.Fatal(err)
+			}
+			history = append(history, a)
+		}
+	}
+
+	// 6日で2パーツなら3人とも4回ずつ担当する
+	for _, m := range members {
+		if turns[m] != 4 {
+			t.Errorf("member assigned %d times in 6 days, want 4", turns[m])
+		}
+	}
+}
+
+func TestAssignOverrides(t *testing.T) {
+	members := newIDs(3)
+	parts := newIDs(3)
+
+	plan, err := Assign(AssignInput{
+		Strategy:  StrategyRandom,
+		Members:   members,
+		Parts:     parts,
+		Overrides: map[uuid.UUID]uuid.UUID{members[0]: parts[2]},
+		Intn:      func(n int) int { return 0 },
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(plan) != 3 || plan[members[0]] != parts[2] {
+		t.Fatalf("override not applied: %v", plan)
+	}
+
+	manual, err := Assign(AssignInput{
+		Strategy:  StrategyManual,
+		Members:   members,
+		Parts:     parts,
+		Overrides: map[uuid.UUID]uuid.UUID{members[1]: parts[0]},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(manual) != 1 || manual[members[1]] != parts[0] {
+		t.Fatalf("manual should only contain the overrides: %v", manual)
+	}
+
+	tests := []struct {
+		name string
+		in   AssignInput
+		want error
+	}{
+		{"manual without overrides", AssignInput{Strategy: StrategyManual, Members: members, Parts: parts}, ErrOverridesRequired},
+		{"not a member", AssignInput{Strategy: StrategyRotation, Members: members, Parts: parts, Overrides: map[uuid.UUID]uuid.UUID{uuid.New(): parts[0]}}, ErrOverrideNotMember},
+		{"part not in template", AssignInput{Strategy: StrategyRotation, Members: members, Parts: parts, Overrides: map[uuid.UUID]uuid.UUID{members[0]: uuid.New()}}, ErrOverridePartNotInTemplate},
+		{"same part twice", AssignInput{Strategy: StrategyRotation, Members: members, Parts: parts, Overrides: map[uuid.UUID]uuid.UUID{members[0]: parts[0], members[1]: parts[0]}}, ErrDuplicatePartAssignment},
+		{"no parts", AssignInput{Strategy: StrategyRotation, Members: members}, ErrNoParts},
+	}
+	for _, tt := range tests {
+		if _, err := Assign(tt.in); err != tt.want {
+			t.Errorf("%s: err = %v, want %v", tt.name, err, tt.want)
+		}
+	}
+}
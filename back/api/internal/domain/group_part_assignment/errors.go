@@ -27,3 +27,24 @@ var (
 	// ErrDuplicatePartAssignment 同じグループ・日付で同じパーツが既に割り当てられている
 	ErrDuplicatePartAssignment = errors.New("同じグループ・日付で同じパーツが既に割り当てられています")
 )
+
+// 自動割り当てのエラー
+var (
+	// ErrInvalidStrategy 割り当て方法が無効
+	ErrInvalidStrategy = errors.New("割り当て方法が無効です（rotation / random / manual）")
+
+	// ErrTemplateMismatch 指定したテンプレートがグループのテンプレートと異なる
+	ErrTemplateMismatch = errors.New("指定したテンプレートはグループのテンプレートではありません")
+
+	// ErrNoParts テンプレートにパーツが無い
+	ErrNoParts = errors.New("テンプレートにパーツがありません")
+
+	// ErrOverrideNotMember 指定したユーザーがグループのメンバーではない
+	ErrOverrideNotMember = errors.New("指定したユーザーはグループのメンバーではありません")
+
+	// ErrOverridePartNotInTemplate 指定したパーツがテンプレートに含まれていない
+	ErrOverridePartNotInTemplate = errors.New("指定したパーツはテンプレートに含まれていません")
+
+	// ErrOverridesRequired manual では割り当ての指定が必要
+	ErrOverridesRequired = errors.New("manual ではメンバーとパーツの割り当てを指定してください")
+)
@@ -15,6 +15,12 @@ type Repository interface {
 	// 割り当てIDでグループパーツ割り当てを検索
 	FindByID(ctx context.Context, assignmentID uuid.UUID) (*GroupPartAssignment, error)
 
+	// 1日分の割り当てを1つのトランザクションでまとめて作成（重複があれば何も作らない）
+	CreateBatch(ctx context.Context, assignments []*GroupPartAssignment) error
+
+	// before より前の日のグループの割り当てを新しい順に取得
+	FindHistory(ctx context.Context, groupID string, before time.Time, limit int) ([]*GroupPartAssignment, error)
+
 	// グループIDとコラージュ日で割り当てを取得
 	FindByGroupAndDay(ctx context.Context, groupID string, collageDay time.Time) ([]*GroupPartAssignment, error)
 
@@ -33,6 +39,6 @@ type Repository interface {
 	// グループIDとコラージュ日で全ての割り当てを削除
 	DeleteByGroupAndDay(ctx context.Context, groupID string, collageDay time.Time) error
 
-	// 全グループパーツ割り当てを取得
-	List(ctx context.Context, limit, offset int) ([]*GroupPartAssignment, error)
+	// ユーザーがメンバーのグループの割り当てを新しい順に取得
+	FindByMemberUserID(ctx context.Context, userID string, limit, offset int) ([]*GroupPartAssignment, error)
 }
@@ -0,0 +1,234 @@
+package resumable_upload
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Status アップロードのステータス
+type Status string
+
+const (
+	// StatusUploading 受信中（受信済みの位置から再開できる）
+	StatusUploading Status = "uploading"
+	// StatusCompleted 全て受信して写真として記録した
+	StatusCompleted Status = "completed"
+)
+
+// metadataMaxLength 保存する Upload-Metadata の最大長
+const metadataMaxLength = 1000
+
+// ResumableUpload 分割して送られる写真のアップロード
+// 受信したデータは受信位置ごとのオブジェクト（パート）としてストレージに保存し、全て揃ったら連結して取り込む
+type ResumableUpload struct {
+	uploadID   uuid.UUID
+	groupID    string
+	userID     uuid.UUID
+	frameIndex int
+	length     int64
+	offset     int64
+	metadata   *string
+	status     Status
+	imageID    *uuid.UUID
+	expiresAt  time.Time
+	createdAt  time.Time
+	updatedAt  time.Time
+}
+
+// NewResumableUpload グループ撮影の写真のアップロードを作成
+func NewResumableUpload(groupID string, userID uuid.UUID, frameIndex int, length, maxLength int64, metadata *string, expiresAt time.Time) (*ResumableUpload, error) {
+	if groupID == "" {
+		return nil, ErrInvalidGroupID
+	}
+	if userID == uuid.Nil {
+		return nil, ErrInvalidUserID
+	}
+	if frameIndex < 0 {
+		return nil, ErrInvalidFrameIndex
+	}
+	if length <= 0 {
+		return nil, ErrInvalidLength
+	}
+	if length > maxLength {
+		return nil, ErrUploadTooLarge
+	}
+	if metadata != nil && len(*metadata) > metadataMaxLength {
+		return nil, ErrMetadataTooLong
+	}
+
+	now := time.Now()
+	return &ResumableUpload{
+		uploadID:   uuid.New(),
+		groupID:    groupID,
+		userID:     userID,
+		frameIndex: frameIndex,
+		length:     length,
+		metadata:   metadata,
+		status:     StatusUploading,
+		expiresAt:  expiresAt,
+		createdAt:  now,
+		updatedAt:  now,
+	}, nil
+}
+
+// Reconstruct reconstructs a ResumableUpload from repository data
+func Reconstruct(
+	uploadID uuid.UUID,
+	groupID string,
+	userID uuid.UUID,
+	frameIndex int,
+	length int64,
+	offset int64,
+	metadata *string,
+	status Status,
+	imageID *uuid.UUID,
+	expiresAt time.Time,
+	createdAt time.Time,
+	updatedAt time.Time,
+) (*ResumableUpload, error) {
+	return &ResumableUpload{
+		uploadID:   uploadID,
+		groupID:    groupID,
+		userID:     userID,
+		frameIndex: frameIndex,
+		length:     length,
+		offset:     offset,
+		metadata:   metadata,
+		status:     status,
+		imageID:    imageID,
+		expiresAt:  expiresAt,
+		createdAt:  createdAt,
+		updatedAt:  updatedAt,
+	}, nil
+}
+
+// Getters
+func (u *ResumableUpload) UploadID() uuid.UUID {
+	return u.uploadID
+}
+
+func (u *ResumableUpload) GroupID() string {
+	return u.groupID
+}
+
+func (u *ResumableUpload) UserID() uuid.UUID {
+	return u.userID
+}
+
+func (u *ResumableUpload) FrameIndex() int {
+	return u.frameIndex
+}
+
+func (u *ResumableUpload) Length() int64 {
+	return u.length
+}
+
+func (u *ResumableUpload) Offset() int64 {
+	return u.offset
+}
+
+func (u *ResumableUpload) Metadata() *string {
+	return u.metadata
+}
+
+func (u *ResumableUpload) Status() Status {
+	return u.status
+}
+
+func (u *ResumableUpload) ImageID() *uuid.UUID {
+	return u.imageID
+}
+
+func (u *ResumableUpload) ExpiresAt() time.Time {
+	return u.expiresAt
+}
+
+func (u *ResumableUpload) CreatedAt() time.Time {
+	return u.createdAt
+}
+
+func (u *ResumableUpload) UpdatedAt() time.Time {
+	return u.updatedAt
+}
+
+// Remaining まだ受信していないサイズ
+func (u *ResumableUpload) Remaining() int64 {
+	return u.length - u.offset
+}
+
+// IsReceived 全て受信したか
+func (u *ResumableUpload) IsReceived() bool {
+	return u.offset == u.length
+}
+
+// IsExpired 完了しないまま有効期限を過ぎたか
+func (u *ResumableUpload) IsExpired(now time.Time) bool {
+	return u.status != StatusCompleted && now.After(u.expiresAt)
+}
+
+// PartPrefix パートを保存するストレージのキーの接頭辞
+func (u *ResumableUpload) PartPrefix() string {
+	return "groups/" + u.groupID + "/resumable/" + u.uploadID.String() + "/"
+}
+
+// NewPartKey offset から始まるパートの新しいキー（辞書順が受信位置の順になるようにゼロ埋めする）
+// 同じ位置に同時に送られたパートが上書きし合わないよう、呼び出すたびに異なる値を末尾に付ける
+func (u *ResumableUpload) NewPartKey(offset int64) string {
+	return u.PartPrefix() + fmt.Sprintf("%012d-%s", offset, uuid.NewString())
+}
+
+// PartOffset パートのキーから受信位置を取り出す
+func (u *ResumableUpload) PartOffset(key string) (int64, error) {
+	name, ok := strings.CutPrefix(key, u.PartPrefix())
+	if !ok {
+		return 0, ErrInvalidPartKey
+	}
+	offset, _, _ := strings.Cut(name, "-")
+	n, err := strconv.ParseInt(offset, 10, 64)
+	if err != nil {
+		return 0, ErrInvalidPartKey
+	}
+	return n, nil
+}
+
+// CanAppend offset からデータを追加できるかチェック
+func (u *ResumableUpload) CanAppend(offset int64, now time.Time) error {
+	if u.status == StatusCompleted {
+		return ErrUploadCompleted
+	}
+	if u.IsExpired(now) {
+		return ErrUploadExpired
+	}
+	if offset != u.offset {
+		return ErrOffsetMismatch
+	}
+	return nil
+}
+
+// Advance n バイト受信したことを記録
+func (u *ResumableUpload) Advance(n int64, now time.Time) error {
+	if n < 0 || n > u.Remaining() {
+		return ErrExceedsLength
+	}
+	u.offset += n
+	u.updatedAt = now
+	return nil
+}
+
+// Complete 取り込んだ画像を記録して完了する
+func (u *ResumableUpload) Complete(imageID uuid.UUID, now time.Time) error {
+	if u.status == StatusCompleted {
+		return ErrUploadCompleted
+	}
+	if !u.IsReceived() {
+		return ErrNotReceived
+	}
+	u.status = StatusCompleted
+	u.imageID = &imageID
+	u.updatedAt = now
+	return nil
+}
@@ -0,0 +1,76 @@
+package resumable_upload
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestNewResumableUpload(t *testing.T) {
+	userID := uuid.New()
+	expiresAt := time.Now().Add(time.Hour)
+
+	if _, err := NewResumableUpload("group-1", userID, 0, 0, 100, nil, expiresAt); err != ErrInvalidLength {
+		t.Errorf("zero length: got %v, want %v", err, ErrInvalidLength)
+	}
+	if _, err := NewResumableUpload("group-1", userID, 0, 101, 100, nil, expiresAt); err != ErrUploadTooLarge {
+		t.Errorf("too large: got %v, want %v", err, ErrUploadTooLarge)
+	}
+
+	upload, err := NewResumableUpload("group-1", userID, 1, 100, 100, nil, expiresAt)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// パートのキーは辞書順が受信位置の順で、同じ位置でも毎回異なる
+	if k9, k10 := upload.NewPartKey(9), upload.NewPartKey(10); k9 >= k10 {
+		t.Errorf("part keys should sort by offset: %s >= %s", k9, k10)
+	}
+	key := upload.NewPartKey(10)
+	if key == upload.NewPartKey(10) {
+		t.Errorf("part keys at the same offset should differ: %s", key)
+	}
+	if offset, err := upload.PartOffset(key); err != nil || offset != 10 {
+		t.Errorf("PartOffset(%s) = %d, %v, want 10", key, offset, err)
+	}
+	if _, err := upload.PartOffset("groups/other/" + key); err != ErrInvalidPartKey {
+		t.Errorf("PartOffset of another prefix: got %v, want %v", err, ErrInvalidPartKey)
+	}
+}
+
+func TestResumableUploadAppend(t *testing.T) {
+	now := time.Date(2025, 10, 1, 12, 0, 0, 0, time.UTC)
+	upload, _ := NewResumableUpload("group-1", uuid.New(), 0, 10, 100, nil, now.Add(time.Hour))
+
+	if err := upload.CanAppend(5, now); err != ErrOffsetMismatch {
+		t.Errorf("wrong offset: got %v, want %v", err, ErrOffsetMismatch)
+	}
+	if err := upload.Advance(11, now); err != ErrExceedsLength {
+		t.Errorf("too much data: got %v, want %v", err, ErrExceedsLength)
+	}
+	if err := upload.Advance(6, now); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := upload.Complete(uuid.New(), now); err != ErrNotReceived {
+		t.Errorf("complete before received: got %v, want %v", err, ErrNotReceived)
+	}
+	if err := upload.CanAppend(6, now.Add(2*time.Hour)); err != ErrUploadExpired {
+		t.Errorf("after expiry: got %v, want %v", err, ErrUploadExpired)
+	}
+
+	if err := upload.CanAppend(6, now); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := upload.Advance(4, now); err != nil || !upload.IsReceived() {
+		t.Fatalf("should be received, offset %d: %v", upload.Offset(), err)
+	}
+	if err := upload.Complete(uuid.New(), now); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := upload.CanAppend(10, now); err != ErrUploadCompleted {
+		t.Errorf("append after completion: got %v, want %v", err, ErrUploadCompleted)
+	}
+	if upload.IsExpired(now.Add(2 * time.Hour)) {
+		t.Error("completed upload should not expire")
+	}
+}
@@ -0,0 +1,47 @@
+package resumable_upload
+
+import "errors"
+
+var (
+	// ErrInvalidGroupID group ID is invalid
+	ErrInvalidGroupID = errors.New("グループIDが無効です")
+
+	// ErrInvalidUserID user ID is invalid
+	ErrInvalidUserID = errors.New("ユーザーIDが無効です")
+
+	// ErrInvalidFrameIndex frame index is invalid
+	ErrInvalidFrameIndex = errors.New("フレーム番号が無効です")
+
+	// ErrInvalidLength upload length is invalid
+	ErrInvalidLength = errors.New("アップロードのサイズが無効です")
+
+	// ErrUploadTooLarge upload length exceeds the max size
+	ErrUploadTooLarge = errors.New("アップロードのサイズが上限を超えています")
+
+	// ErrMetadataTooLong upload metadata is too long
+	ErrMetadataTooLong = errors.New("アップロードのメタデータが長すぎます")
+
+	// ErrUploadNotFound upload not found
+	ErrUploadNotFound = errors.New("アップロードが見つかりません")
+
+	// ErrUploadExpired upload has expired before completion
+	ErrUploadExpired = errors.New("アップロードの有効期限が切れています")
+
+	// ErrUploadCompleted upload has already been completed
+	ErrUploadCompleted = errors.New("このアップロードは既に完了しています")
+)
+
+// データの追加
+var (
+	// ErrOffsetMismatch the offset does not match the received size
+	ErrOffsetMismatch = errors.New("アップロードの位置が受信済みのサイズと一致しません")
+
+	// ErrExceedsLength the data exceeds the declared upload length
+	ErrExceedsLength = errors.New("アップロードのデータが宣言したサイズを超えています")
+
+	// ErrNotReceived not all data has been received
+	ErrNotReceived = errors.New("アップロードのデータがまだ揃っていません")
+
+	// ErrInvalidPartKey the storage key is not a part of the upload
+	ErrInvalidPartKey = errors.New("アップロードのパートのキーが無効です")
+)
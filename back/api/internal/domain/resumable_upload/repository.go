@@ -0,0 +1,28 @@
+package resumable_upload
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Repository interface {
+	Create(ctx context.Context, upload *ResumableUpload) error
+	FindByID(ctx context.Context, uploadID uuid.UUID) (*ResumableUpload, error)
+
+	// UpdateOffset persists the advanced offset only if the stored offset is still from.
+	// Returns ErrOffsetMismatch if another request appended first
+	UpdateOffset(ctx context.Context, upload *ResumableUpload, from int64) error
+
+	// Update persists the status and the recorded image
+	Update(ctx context.Context, upload *ResumableUpload) error
+
+	// CountInProgress counts the group's uploads that are still receiving data,
+	// were created at or before startedBefore and have not expired at now
+	CountInProgress(ctx context.Context, groupID string, startedBefore, now time.Time) (int, error)
+
+	// FindExpired finds up to limit uploads whose expiry passed before the given time
+	FindExpired(ctx context.Context, before time.Time, limit int) ([]*ResumableUpload, error)
+	Delete(ctx context.Context, uploadID uuid.UUID) error
+}
@@ -0,0 +1,157 @@
+package session_round
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Status ラウンドのステータス
+type Status string
+
+const (
+	// StatusInProgress カウントダウン中または撮影中
+	StatusInProgress Status = "in_progress"
+	// StatusCompleted コラージュを生成した
+	StatusCompleted Status = "completed"
+	// StatusFailed 締め切りまでに写真が揃わなかった
+	StatusFailed Status = "failed"
+	// StatusAborted 終わらないまま打ち切られた（グループの期限切れ、または終わらないセッションの掃除）
+	StatusAborted Status = "aborted"
+)
+
+// SessionRound グループの1回分の撮影（カウントダウンの開始ごとに作られる）
+type SessionRound struct {
+	roundID              uuid.UUID
+	groupID              string
+	roundNumber          int
+	templateID           string
+	scheduledCaptureTime time.Time
+	captureDeadline      time.Time
+	status               Status
+	finishedAt           *time.Time
+	createdAt            time.Time
+	updatedAt            time.Time
+}
+
+// NewSessionRound creates a new in-progress round
+func NewSessionRound(groupID string, roundNumber int, templateID string, scheduledCaptureTime, captureDeadline time.Time) (*SessionRound, error) {
+	if groupID == "" {
+		return nil, ErrInvalidGroupID
+	}
+	if roundNumber < 1 {
+		return nil, ErrInvalidRoundNumber
+	}
+	if templateID == "" {
+		return nil, ErrInvalidTemplateID
+	}
+	if captureDeadline.Before(scheduledCaptureTime) {
+		return nil, ErrInvalidCaptureDeadline
+	}
+
+	now := time.Now()
+	return &SessionRound{
+		roundID:              uuid.New(),
+		groupID:              groupID,
+		roundNumber:          roundNumber,
+		templateID:           templateID,
+		scheduledCaptureTime: scheduledCaptureTime,
+		captureDeadline:      captureDeadline,
+		status:               StatusInProgress,
+		createdAt:            now,
+		updatedAt:            now,
+	}, nil
+}
+
+// Reconstruct reconstructs a SessionRound from repository data
+func Reconstruct(
+	roundID uuid.UUID,
+	groupID string,
+	roundNumber int,
+	templateID string,
+	scheduledCaptureTime time.Time,
+	captureDeadline time.Time,
+	status Status,
+	finishedAt *time.Time,
+	createdAt time.Time,
+	updatedAt time.Time,
+) (*SessionRound, error) {
+	return &SessionRound{
+		roundID:              roundID,
+		groupID:              groupID,
+		roundNumber:          roundNumber,
+		templateID:           templateID,
+		scheduledCaptureTime: scheduledCaptureTime,
+		captureDeadline:      captureDeadline,
+		status:               status,
+		finishedAt:           finishedAt,
+		createdAt:            createdAt,
+		updatedAt:            updatedAt,
+	}, nil
+}
+
+// Getters
+func (r *SessionRound) RoundID() uuid.UUID {
+	return r.roundID
+}
+
+func (r *SessionRound) GroupID() string {
+	return r.groupID
+}
+
+func (r *SessionRound) RoundNumber() int {
+	return r.roundNumber
+}
+
+func (r *SessionRound) TemplateID() string {
+	return r.templateID
+}
+
+func (r *SessionRound) ScheduledCaptureTime() time.Time {
+	return r.scheduledCaptureTime
+}
+
+func (r *SessionRound) CaptureDeadline() time.Time {
+	return r.captureDeadline
+}
+
+func (r *SessionRound) Status() Status {
+	return r.status
+}
+
+func (r *SessionRound) FinishedAt() *time.Time {
+	return r.finishedAt
+}
+
+func (r *SessionRound) CreatedAt() time.Time {
+	return r.createdAt
+}
+
+func (r *SessionRound) UpdatedAt() time.Time {
+	return r.updatedAt
+}
+
+// IsFinished checks if the round has ended (completed, failed or aborted)
+func (r *SessionRound) IsFinished() bool {
+	return r.status != StatusInProgress
+}
+
+// Complete marks the round as completed
+func (r *SessionRound) Complete(now time.Time) error {
+	return r.finish(StatusCompleted, now)
+}
+
+// Fail marks the round as failed
+func (r *SessionRound) Fail(now time.Time) error {
+	return r.finish(StatusFailed, now)
+}
+
+func (r *SessionRound) finish(status Status, now time.Time) error {
+	if r.IsFinished() {
+		return ErrRoundAlreadyFinished
+	}
+	r.status = status
+	r.finishedAt = &now
+	r.updatedAt = now
+	return nil
+}
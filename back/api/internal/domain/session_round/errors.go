@@ -0,0 +1,26 @@
+package session_round
+
+import "errors"
+
+var (
+	// ErrInvalidGroupID group ID is invalid
+	ErrInvalidGroupID = errors.New("グループIDが無効です")
+
+	// ErrInvalidRoundNumber round number is invalid
+	ErrInvalidRoundNumber = errors.New("ラウンド番号が無効です（1以上で指定してください）")
+
+	// ErrInvalidTemplateID template ID is invalid
+	ErrInvalidTemplateID = errors.New("テンプレートIDが無効です")
+
+	// ErrInvalidCaptureDeadline the deadline is before the scheduled capture time
+	ErrInvalidCaptureDeadline = errors.New("締め切りは撮影予定時刻より後に設定してください")
+
+	// ErrRoundNotFound round not found
+	ErrRoundNotFound = errors.New("ラウンドが見つかりません")
+
+	// ErrRoundAlreadyExists the group already has a round with the same number
+	ErrRoundAlreadyExists = errors.New("このラウンドは既に存在します")
+
+	// ErrRoundAlreadyFinished round has already ended
+	ErrRoundAlreadyFinished = errors.New("このラウンドは既に終了しています")
+)
@@ -0,0 +1,17 @@
+package session_round
+
+import "context"
+
+type Repository interface {
+	// Create creates a new round. Returns ErrRoundAlreadyExists if the group already has the round number
+	Create(ctx context.Context, round *SessionRound) error
+
+	// FindByGroupAndNumber finds a round by group ID and round number
+	FindByGroupAndNumber(ctx context.Context, groupID string, roundNumber int) (*SessionRound, error)
+
+	// FindByGroupID finds all rounds of a group, newest first
+	FindByGroupID(ctx context.Context, groupID string) ([]*SessionRound, error)
+
+	// Update updates a round
+	Update(ctx context.Context, round *SessionRound) error
+}
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/jphacks/os_2502/back/api/internal/svgpath"
 )
 
 type TemplatePart struct {
@@ -15,6 +16,7 @@ type TemplatePart struct {
 	positionY   int
 	width       int
 	height      int
+	path        *string
 	description *string
 	createdAt   time.Time
 	updatedAt   time.Time
@@ -54,7 +56,7 @@ func NewTemplatePart(
 func Reconstruct(
 	partID, templateID uuid.UUID,
 	partNumber, positionX, positionY, width, height int,
-	partName, description *string,
+	partName, description, path *string,
 	createdAt, updatedAt time.Time,
 ) (*TemplatePart, error) {
 	if partID == uuid.Nil {
@@ -79,6 +81,7 @@ func Reconstruct(
 		positionY:   positionY,
 		width:       width,
 		height:      height,
+		path:        path,
 		description: description,
 		createdAt:   createdAt,
 		updatedAt:   updatedAt,
@@ -118,6 +121,11 @@ func (tp *TemplatePart) Height() int {
 	return tp.height
 }
 
+// Path フレームの形（テンプレートの viewBox 座標系のSVGパス）。nil なら位置とサイズの矩形
+func (tp *TemplatePart) Path() *string {
+	return tp.path
+}
+
 func (tp *TemplatePart) Description() *string {
 	return tp.description
 }
@@ -154,3 +162,15 @@ func (tp *TemplatePart) UpdateDescription(description *string) {
 	tp.description = description
 	tp.updatedAt = time.Now()
 }
+
+// UpdatePath はフレームの形を更新（nil なら矩形に戻す）
+func (tp *TemplatePart) UpdatePath(path *string) error {
+	if path != nil {
+		if _, err := svgpath.Parse(*path); err != nil || *path == "" {
+			return ErrInvalidPath
+		}
+	}
+	tp.path = path
+	tp.updatedAt = time.Now()
+	return nil
+}
@@ -15,6 +15,9 @@ var (
 	// ErrInvalidDimensions サイズが無効
 	ErrInvalidDimensions = errors.New("幅と高さは0より大きい必要があります")
 
+	// ErrInvalidPath フレームのパスが無効
+	ErrInvalidPath = errors.New("フレームのパスが無効です（SVGのパスで指定してください）")
+
 	// ErrTemplatePartNotFound テンプレートパーツが見つからない
 	ErrTemplatePartNotFound = errors.New("テンプレートパーツが見つかりません")
 
@@ -29,6 +29,6 @@ type Repository interface {
 	// テンプレートIDに紐づく全てのパーツを削除
 	DeleteByTemplateID(ctx context.Context, templateID uuid.UUID) error
 
-	// 全テンプレートパーツを取得
+	// 公開されているテンプレートのパーツを取得
 	List(ctx context.Context, limit, offset int) ([]*TemplatePart, error)
 }
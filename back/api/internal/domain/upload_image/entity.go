@@ -12,12 +12,23 @@ type UploadImage struct {
 	fileURL    string
 	groupID    string
 	userID     uuid.UUID
+	partID     *uuid.UUID
+	frameIndex *int
+	round      int
+	capturedAt *time.Time
+	width      *int
+	height     *int
 	collageDay time.Time
 	createdAt  time.Time
 }
 
 // NewUploadImage creates a new upload image
-func NewUploadImage(fileURL, groupID string, userID uuid.UUID, collageDay time.Time) (*UploadImage, error) {
+// imageID は保存先のキーに含めるため、呼び出し側で先に決める
+func NewUploadImage(imageID uuid.UUID, fileURL, groupID string, userID uuid.UUID, collageDay time.Time) (*UploadImage, error) {
+	if imageID == uuid.Nil {
+		return nil, ErrInvalidImageID
+	}
+
 	if err := validateFileURL(fileURL); err != nil {
 		return nil, err
 	}
@@ -31,10 +42,11 @@ func NewUploadImage(fileURL, groupID string, userID uuid.UUID, collageDay time.T
 	}
 
 	return &UploadImage{
-		imageID:    uuid.New(),
+		imageID:    imageID,
 		fileURL:    fileURL,
 		groupID:    groupID,
 		userID:     userID,
+		round:      1,
 		collageDay: collageDay,
 		createdAt:  time.Now(),
 	}, nil
@@ -46,6 +58,12 @@ func Reconstruct(
 	fileURL string,
 	groupID string,
 	userID uuid.UUID,
+	partID *uuid.UUID,
+	frameIndex *int,
+	round int,
+	capturedAt *time.Time,
+	width *int,
+	height *int,
 	collageDay time.Time,
 	createdAt time.Time,
 ) (*UploadImage, error) {
@@ -54,6 +72,12 @@ func Reconstruct(
 		fileURL:    fileURL,
 		groupID:    groupID,
 		userID:     userID,
+		partID:     partID,
+		frameIndex: frameIndex,
+		round:      round,
+		capturedAt: capturedAt,
+		width:      width,
+		height:     height,
 		collageDay: collageDay,
 		createdAt:  createdAt,
 	}, nil
@@ -76,6 +100,36 @@ func (ui *UploadImage) UserID() uuid.UUID {
 	return ui.userID
 }
 
+// PartID 日替わりコラージュで割り当てられたテンプレートのパーツ。未指定の場合は nil
+func (ui *UploadImage) PartID() *uuid.UUID {
+	return ui.partID
+}
+
+// FrameIndex テンプレートのフレーム番号（frames配列の0始まりのインデックス）。未指定の場合は nil
+func (ui *UploadImage) FrameIndex() *int {
+	return ui.frameIndex
+}
+
+// Round 撮影したラウンドの番号
+func (ui *UploadImage) Round() int {
+	return ui.round
+}
+
+// CapturedAt EXIFから取得した撮影日時。不明な場合は nil
+func (ui *UploadImage) CapturedAt() *time.Time {
+	return ui.capturedAt
+}
+
+// OriginalWidth 向き補正後の元画像の幅。不明な場合は nil
+func (ui *UploadImage) OriginalWidth() *int {
+	return ui.width
+}
+
+// OriginalHeight 向き補正後の元画像の高さ。不明な場合は nil
+func (ui *UploadImage) OriginalHeight() *int {
+	return ui.height
+}
+
 func (ui *UploadImage) CollageDay() time.Time {
 	return ui.collageDay
 }
@@ -84,6 +138,44 @@ func (ui *UploadImage) CreatedAt() time.Time {
 	return ui.createdAt
 }
 
+// AssignFrame 撮影対象のフレームを設定
+func (ui *UploadImage) AssignFrame(frameIndex int) error {
+	if frameIndex < 0 {
+		return ErrInvalidFrameIndex
+	}
+	ui.frameIndex = &frameIndex
+	return nil
+}
+
+// AssignPart 撮影対象のパーツを設定
+func (ui *UploadImage) AssignPart(partID uuid.UUID) error {
+	if partID == uuid.Nil {
+		return ErrInvalidPartID
+	}
+	ui.partID = &partID
+	return nil
+}
+
+// AssignRound 撮影したラウンドを設定
+func (ui *UploadImage) AssignRound(round int) error {
+	if round < 1 {
+		return ErrInvalidRound
+	}
+	ui.round = round
+	return nil
+}
+
+// SetCaptureMetadata 取り込み時に読み取った撮影日時と元画像のサイズを設定
+func (ui *UploadImage) SetCaptureMetadata(capturedAt *time.Time, width, height int) error {
+	if width <= 0 || height <= 0 {
+		return ErrInvalidDimensions
+	}
+	ui.capturedAt = capturedAt
+	ui.width = &width
+	ui.height = &height
+	return nil
+}
+
 // Validation functions
 func validateFileURL(fileURL string) error {
 	if fileURL == "" {
@@ -3,6 +3,9 @@ package upload_image
 import "errors"
 
 var (
+	// ErrInvalidImageID image ID is invalid
+	ErrInvalidImageID = errors.New("画像IDが無効です")
+
 	// ErrInvalidFileURL file URL is invalid
 	ErrInvalidFileURL = errors.New("ファイルURLが無効です（1〜500文字で指定してください）")
 
@@ -12,6 +15,18 @@ var (
 	// ErrInvalidUserID user ID is invalid
 	ErrInvalidUserID = errors.New("ユーザーIDが無効です")
 
+	// ErrInvalidFrameIndex frame index is invalid
+	ErrInvalidFrameIndex = errors.New("フレーム番号が無効です")
+
+	// ErrInvalidPartID part ID is invalid
+	ErrInvalidPartID = errors.New("パーツIDが無効です")
+
+	// ErrInvalidRound round number is invalid
+	ErrInvalidRound = errors.New("ラウンド番号が無効です")
+
+	// ErrInvalidDimensions image dimensions are invalid
+	ErrInvalidDimensions = errors.New("画像サイズが無効です")
+
 	// ErrImageNotFound image not found
 	ErrImageNotFound = errors.New("画像が見つかりません")
 
@@ -21,3 +36,12 @@ var (
 	// ErrNotAuthorized not authorized to access this image
 	ErrNotAuthorized = errors.New("この画像にアクセスする権限がありません")
 )
+
+// フレーム
+var (
+	// ErrFrameOutOfRange frame index is outside the template's frames
+	ErrFrameOutOfRange = errors.New("フレーム番号がテンプレートのフレームの範囲外です")
+
+	// ErrFrameTaken another member already took a photo for the frame in this round
+	ErrFrameTaken = errors.New("このフレームは他のメンバーが撮影済みです")
+)
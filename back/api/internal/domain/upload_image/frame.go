@@ -0,0 +1,24 @@
+package upload_image
+
+import "github.com/google/uuid"
+
+// CheckFrame userID が frameIndex のフレームの写真を記録できるかチェック
+// photoCount はテンプレートのフレーム数、latest は現在のラウンドの各メンバーの最新の写真。
+// 本人の撮り直しは同じフレームでも受け付ける
+func CheckFrame(frameIndex, photoCount int, userID uuid.UUID, latest []*UploadImage) error {
+	if frameIndex < 0 {
+		return ErrInvalidFrameIndex
+	}
+	if frameIndex >= photoCount {
+		return ErrFrameOutOfRange
+	}
+	for _, img := range latest {
+		if img.UserID() == userID {
+			continue
+		}
+		if idx := img.FrameIndex(); idx != nil && *idx == frameIndex {
+			return ErrFrameTaken
+		}
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+package upload_image
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func framePhoto(t *testing.T, userID uuid.UUID, frameIndex int) *UploadImage {
+	t.Helper()
+	img, err := NewUploadImage(uuid.New(), "groups/g1/photo.jpg", "g1", userID, time.Now())
+	if err != nil {
+		t.Fatalf("NewUploadImage: %v", err)
+	}
+	if err := img.AssignFrame(frameIndex); err != nil {
+		t.Fatalf("AssignFrame: %v", err)
+	}
+	return img
+}
+
+func TestCheckFrame(t *testing.T) {
+	me, other := uuid.New(), uuid.New()
+	latest := []*UploadImage{framePhoto(t, me, 0), framePhoto(t, other, 1)}
+
+	tests := []struct {
+		name       string
+		frameIndex int
+		want       error
+	}{
+		{name: "free frame", frameIndex: 2, want: nil},
+		{name: "retake own frame", frameIndex: 0, want: nil},
+		{name: "negative", frameIndex: -1, want: ErrInvalidFrameIndex},
+		{name: "past the last frame", frameIndex: 3, want: ErrFrameOutOfRange},
+		{name: "taken by another member", frameIndex: 1, want: ErrFrameTaken},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := CheckFrame(tt.frameIndex, 3, me, latest); err != tt.want {
+				t.Errorf("CheckFrame(%d) = %v, want %v", tt.frameIndex, err, tt.want)
+			}
+		})
+	}
+}
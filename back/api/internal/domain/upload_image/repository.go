@@ -17,6 +17,9 @@ type Repository interface {
 	// FindByGroupID finds all upload images by group ID
 	FindByGroupID(ctx context.Context, groupID string, limit, offset int) ([]*UploadImage, error)
 
+	// FindLatestByGroupID finds the latest upload image of each member in the group's current round
+	FindLatestByGroupID(ctx context.Context, groupID string) ([]*UploadImage, error)
+
 	// FindByUserID finds all upload images by user ID
 	FindByUserID(ctx context.Context, userID uuid.UUID, limit, offset int) ([]*UploadImage, error)
 
@@ -0,0 +1,185 @@
+package upload_slot
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Status アップロード枠のステータス
+type Status string
+
+const (
+	// StatusPending 署名付きURLを発行済みで、確定を待っている
+	StatusPending Status = "pending"
+	// StatusConfirmed 確定して upload_images に記録した
+	StatusConfirmed Status = "confirmed"
+)
+
+// contentTypeExtensions 直接アップロードで受け付ける画像の形式と拡張子
+var contentTypeExtensions = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+	"image/webp": ".webp",
+}
+
+// UploadSlot 端末がストレージへ直接アップロードするための枠
+type UploadSlot struct {
+	slotID      uuid.UUID
+	groupID     string
+	userID      uuid.UUID
+	frameIndex  int
+	objectKey   string
+	contentType string
+	maxSize     int64
+	status      Status
+	imageID     *uuid.UUID
+	expiresAt   time.Time
+	createdAt   time.Time
+	updatedAt   time.Time
+}
+
+// NewUploadSlot グループ撮影の写真をアップロードする枠を作成
+// アップロード先は確定前の置き場（groups/{groupID}/incoming/）で、確定時に取り込んで本来のキーへ移す
+func NewUploadSlot(groupID string, userID uuid.UUID, frameIndex int, contentType string, maxSize int64, expiresAt time.Time) (*UploadSlot, error) {
+	if groupID == "" {
+		return nil, ErrInvalidGroupID
+	}
+	if userID == uuid.Nil {
+		return nil, ErrInvalidUserID
+	}
+	if frameIndex < 0 {
+		return nil, ErrInvalidFrameIndex
+	}
+	ext, ok := contentTypeExtensions[contentType]
+	if !ok {
+		return nil, ErrUnsupportedContentType
+	}
+	if maxSize <= 0 {
+		return nil, ErrInvalidMaxSize
+	}
+
+	now := time.Now()
+	slotID := uuid.New()
+	return &UploadSlot{
+		slotID:      slotID,
+		groupID:     groupID,
+		userID:      userID,
+		frameIndex:  frameIndex,
+		objectKey:   "groups/" + groupID + "/incoming/" + slotID.String() + ext,
+		contentType: contentType,
+		maxSize:     maxSize,
+		status:      StatusPending,
+		expiresAt:   expiresAt,
+		createdAt:   now,
+		updatedAt:   now,
+	}, nil
+}
+
+// Reconstruct reconstructs an UploadSlot from repository data
+func Reconstruct(
+	slotID uuid.UUID,
+	groupID string,
+	userID uuid.UUID,
+	frameIndex int,
+	objectKey string,
+	contentType string,
+	maxSize int64,
+	status Status,
+	imageID *uuid.UUID,
+	expiresAt time.Time,
+	createdAt time.Time,
+	updatedAt time.Time,
+) (*UploadSlot, error) {
+	return &UploadSlot{
+		slotID:      slotID,
+		groupID:     groupID,
+		userID:      userID,
+		frameIndex:  frameIndex,
+		objectKey:   objectKey,
+		contentType: contentType,
+		maxSize:     maxSize,
+		status:      status,
+		imageID:     imageID,
+		expiresAt:   expiresAt,
+		createdAt:   createdAt,
+		updatedAt:   updatedAt,
+	}, nil
+}
+
+// Getters
+func (s *UploadSlot) SlotID() uuid.UUID {
+	return s.slotID
+}
+
+func (s *UploadSlot) GroupID() string {
+	return s.groupID
+}
+
+func (s *UploadSlot) UserID() uuid.UUID {
+	return s.userID
+}
+
+func (s *UploadSlot) FrameIndex() int {
+	return s.frameIndex
+}
+
+func (s *UploadSlot) ObjectKey() string {
+	return s.objectKey
+}
+
+func (s *UploadSlot) ContentType() string {
+	return s.contentType
+}
+
+func (s *UploadSlot) MaxSize() int64 {
+	return s.maxSize
+}
+
+func (s *UploadSlot) Status() Status {
+	return s.status
+}
+
+func (s *UploadSlot) ImageID() *uuid.UUID {
+	return s.imageID
+}
+
+func (s *UploadSlot) ExpiresAt() time.Time {
+	return s.expiresAt
+}
+
+func (s *UploadSlot) CreatedAt() time.Time {
+	return s.createdAt
+}
+
+func (s *UploadSlot) UpdatedAt() time.Time {
+	return s.updatedAt
+}
+
+// CanConfirm 確定できる状態かチェック（未確定で、署名付きURLの有効期限内）
+func (s *UploadSlot) CanConfirm(now time.Time) error {
+	if s.status == StatusConfirmed {
+		return ErrSlotAlreadyConfirmed
+	}
+	if now.After(s.expiresAt) {
+		return ErrSlotExpired
+	}
+	return nil
+}
+
+// Confirm 取り込んだ画像を記録して確定する
+func (s *UploadSlot) Confirm(imageID uuid.UUID, now time.Time) error {
+	if err := s.CanConfirm(now); err != nil {
+		return err
+	}
+	s.status = StatusConfirmed
+	s.imageID = &imageID
+	s.updatedAt = now
+	return nil
+}
+
+// IsSupportedContentType 直接アップロードで受け付ける形式か
+func IsSupportedContentType(contentType string) bool {
+	_, ok := contentTypeExtensions[contentType]
+	return ok
+}
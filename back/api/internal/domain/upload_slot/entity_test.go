@@ -0,0 +1,56 @@
+package upload_slot
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestNewUploadSlot(t *testing.T) {
+	userID := uuid.New()
+	expiresAt := time.Now().Add(15 * time.Minute)
+
+	if _, err := NewUploadSlot("group-1", userID, -1, "image/jpeg", 1024, expiresAt); err != ErrInvalidFrameIndex {
+		t.Errorf("negative frame: got %v, want %v", err, ErrInvalidFrameIndex)
+	}
+	if _, err := NewUploadSlot("group-1", userID, 0, "image/gif", 1024, expiresAt); err != ErrUnsupportedContentType {
+		t.Errorf("gif: got %v, want %v", err, ErrUnsupportedContentType)
+	}
+
+	slot, err := NewUploadSlot("group-1", userID, 2, "image/png", 1024, expiresAt)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := "groups/group-1/incoming/" + slot.SlotID().String() + ".png"
+	if slot.ObjectKey() != want {
+		t.Errorf("object key = %s, want %s", slot.ObjectKey(), want)
+	}
+	if slot.Status() != StatusPending || slot.ImageID() != nil {
+		t.Errorf("new slot should be pending without image, got %s", slot.Status())
+	}
+	if !strings.HasPrefix(slot.ObjectKey(), "groups/group-1/") {
+		t.Errorf("object key should be under the group prefix: %s", slot.ObjectKey())
+	}
+}
+
+func TestUploadSlotConfirm(t *testing.T) {
+	now := time.Date(2025, 10, 1, 12, 0, 0, 0, time.UTC)
+	slot, _ := NewUploadSlot("group-1", uuid.New(), 0, "image/jpeg", 1024, now.Add(15*time.Minute))
+
+	if err := slot.Confirm(uuid.New(), now.Add(16*time.Minute)); err != ErrSlotExpired {
+		t.Errorf("confirm after expiry: got %v, want %v", err, ErrSlotExpired)
+	}
+
+	imageID := uuid.New()
+	if err := slot.Confirm(imageID, now.Add(time.Minute)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if slot.Status() != StatusConfirmed || slot.ImageID() == nil || *slot.ImageID() != imageID {
+		t.Errorf("slot should be confirmed with image %s, got %s", imageID, slot.Status())
+	}
+	if err := slot.Confirm(uuid.New(), now.Add(2*time.Minute)); err != ErrSlotAlreadyConfirmed {
+		t.Errorf("second confirm: got %v, want %v", err, ErrSlotAlreadyConfirmed)
+	}
+}
@@ -0,0 +1,41 @@
+package upload_slot
+
+import "errors"
+
+var (
+	// ErrInvalidGroupID group ID is invalid
+	ErrInvalidGroupID = errors.New("グループIDが無効です")
+
+	// ErrInvalidUserID user ID is invalid
+	ErrInvalidUserID = errors.New("ユーザーIDが無効です")
+
+	// ErrInvalidFrameIndex frame index is invalid
+	ErrInvalidFrameIndex = errors.New("フレーム番号が無効です")
+
+	// ErrUnsupportedContentType content type is not accepted for direct uploads
+	ErrUnsupportedContentType = errors.New("対応していない画像形式です（image/jpeg、image/png、image/webp のいずれかを指定してください）")
+
+	// ErrInvalidMaxSize max size is invalid
+	ErrInvalidMaxSize = errors.New("最大サイズが無効です")
+
+	// ErrSlotNotFound upload slot not found
+	ErrSlotNotFound = errors.New("アップロード枠が見つかりません")
+
+	// ErrSlotExpired the signed URL of the slot has expired
+	ErrSlotExpired = errors.New("アップロード枠の有効期限が切れています")
+
+	// ErrSlotAlreadyConfirmed the slot has already been confirmed
+	ErrSlotAlreadyConfirmed = errors.New("このアップロード枠は既に確定しています")
+)
+
+// 確定時のアップロード済みオブジェクトの確認
+var (
+	// ErrObjectNotUploaded nothing has been uploaded to the slot yet
+	ErrObjectNotUploaded = errors.New("画像がまだアップロードされていません")
+
+	// ErrObjectTooLarge the uploaded object exceeds the max size
+	ErrObjectTooLarge = errors.New("画像のサイズが上限を超えています")
+
+	// ErrContentTypeMismatch the uploaded object is not the declared image format
+	ErrContentTypeMismatch = errors.New("アップロードされた画像の形式が指定と一致しません")
+)
@@ -0,0 +1,18 @@
+package upload_slot
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Repository interface {
+	Create(ctx context.Context, slot *UploadSlot) error
+	FindByID(ctx context.Context, slotID uuid.UUID) (*UploadSlot, error)
+	Update(ctx context.Context, slot *UploadSlot) error
+
+	// FindExpiredPending finds up to limit slots that were never confirmed and expired before the given time
+	FindExpiredPending(ctx context.Context, before time.Time, limit int) ([]*UploadSlot, error)
+	Delete(ctx context.Context, slotID uuid.UUID) error
+}
@@ -0,0 +1,264 @@
+// Package exif は JPEG / WebP に埋め込まれた EXIF のうち、取り込み時に必要な項目だけを読む最小限のリーダー。
+package exif
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"strings"
+	"time"
+)
+
+// ErrNoExif EXIF が含まれていない
+var ErrNoExif = errors.New("exif: no exif data")
+
+// errMalformed EXIF の構造が壊れている
+var errMalformed = errors.New("exif: malformed data")
+
+// TIFF タグ
+const (
+	tagOrientation        = 0x0112
+	tagDateTime           = 0x0132
+	tagExifIFDPointer     = 0x8769
+	tagDateTimeOriginal   = 0x9003
+	tagOffsetTimeOriginal = 0x9011
+)
+
+// TIFF のデータ型
+const (
+	typeASCII = 2
+	typeShort = 3
+	typeLong  = 4
+)
+
+// Info 取り込み時に使う EXIF 情報
+type Info struct {
+	// Orientation は 1〜8。タグが無い場合は 1
+	Orientation int
+	// CapturedAt は撮影日時（DateTimeOriginal、無ければ DateTime）。不明な場合は nil
+	CapturedAt *time.Time
+}
+
+// ReadJPEG は JPEG のバイト列から APP1 の EXIF を探して読む。
+// loc は撮影日時にタイムゾーン情報（OffsetTimeOriginal）が無い場合に使う。
+func ReadJPEG(data []byte, loc *time.Location) (*Info, error) {
+	payload, err := findExifSegment(data)
+	if err != nil {
+		return nil, err
+	}
+	return parseTIFF(payload, loc)
+}
+
+// ReadWebP は WebP (RIFF) のチャンクから EXIF を探して読む
+func ReadWebP(data []byte, loc *time.Location) (*Info, error) {
+	if len(data) < 12 || string(data[0:4]) != "RIFF" || string(data[8:12]) != "WEBP" {
+		return nil, ErrNoExif
+	}
+
+	for pos := 12; pos+8 <= len(data); {
+		fourCC := string(data[pos : pos+4])
+		size := int(binary.LittleEndian.Uint32(data[pos+4:]))
+		end := pos + 8 + size
+		if size < 0 || end > len(data) || end < pos {
+			return nil, errMalformed
+		}
+		if fourCC == "EXIF" {
+			// 書き出したツールによっては JPEG と同じ "Exif\0\0" が前に付いている
+			return parseTIFF(bytes.TrimPrefix(data[pos+8:end], []byte("Exif\x00\x00")), loc)
+		}
+		// チャンクは偶数バイトに揃えられる
+		pos = end + size%2
+	}
+
+	return nil, ErrNoExif
+}
+
+// findExifSegment は JPEG のマーカーを走査し、"Exif\0\0" で始まる APP1 の TIFF 部分を返す
+func findExifSegment(data []byte) ([]byte, error) {
+	if len(data) < 4 || data[0] != 0xFF || data[1] != 0xD8 {
+		return nil, ErrNoExif
+	}
+
+	for pos := 2; pos+4 <= len(data); {
+		if data[pos] != 0xFF {
+			return nil, errMalformed
+		}
+		marker := data[pos+1]
+		// フィルバイト
+		if marker == 0xFF {
+			pos++
+			continue
+		}
+		// 長さを持たないマーカー
+		if marker == 0x01 || (marker >= 0xD0 && marker <= 0xD7) {
+			pos += 2
+			continue
+		}
+		// SOS 以降は画像データ
+		if marker == 0xDA || marker == 0xD9 {
+			break
+		}
+
+		length := int(binary.BigEndian.Uint16(data[pos+2:]))
+		end := pos + 2 + length
+		if length < 2 || end > len(data) {
+			return nil, errMalformed
+		}
+
+		body := data[pos+4 : end]
+		if marker == 0xE1 && bytes.HasPrefix(body, []byte("Exif\x00\x00")) {
+			return body[6:], nil
+		}
+		pos = end
+	}
+
+	return nil, ErrNoExif
+}
+
+// parseTIFF は TIFF ヘッダから IFD0 と Exif IFD を読む
+func parseTIFF(b []byte, loc *time.Location) (*Info, error) {
+	if len(b) < 8 {
+		return nil, errMalformed
+	}
+
+	var order binary.ByteOrder
+	switch string(b[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return nil, errMalformed
+	}
+	if order.Uint16(b[2:]) != 42 {
+		return nil, errMalformed
+	}
+
+	r := &reader{b: b, order: order}
+	info := &Info{Orientation: 1}
+
+	ifd0, err := r.readIFD(int(order.Uint32(b[4:])))
+	if err != nil {
+		return nil, err
+	}
+
+	if e, ok := ifd0[tagOrientation]; ok {
+		if v, ok := r.uint(e); ok && v >= 1 && v <= 8 {
+			info.Orientation = int(v)
+		}
+	}
+
+	dateTime, _ := r.ascii(ifd0[tagDateTime])
+	var original, offset string
+
+	if e, ok := ifd0[tagExifIFDPointer]; ok {
+		if off, ok := r.uint(e); ok {
+			// Exif IFD が壊れていても IFD0 の情報は使う
+			if exifIFD, err := r.readIFD(int(off)); err == nil {
+				original, _ = r.ascii(exifIFD[tagDateTimeOriginal])
+				offset, _ = r.ascii(exifIFD[tagOffsetTimeOriginal])
+			}
+		}
+	}
+
+	if original == "" {
+		original = dateTime
+	}
+	if t, ok := parseDateTime(original, offset, loc); ok {
+		info.CapturedAt = &t
+	}
+
+	return info, nil
+}
+
+// entry は IFD の1エントリ
+type entry struct {
+	typ   uint16
+	count uint32
+	value []byte // 値が4バイト以下なら値そのもの、それ以外はオフセット
+}
+
+type reader struct {
+	b     []byte
+	order binary.ByteOrder
+}
+
+func (r *reader) readIFD(off int) (map[uint16]entry, error) {
+	if off < 8 || off+2 > len(r.b) {
+		return nil, errMalformed
+	}
+	n := int(r.order.Uint16(r.b[off:]))
+	if off+2+n*12 > len(r.b) {
+		return nil, errMalformed
+	}
+
+	entries := make(map[uint16]entry, n)
+	for i := 0; i < n; i++ {
+		p := off + 2 + i*12
+		entries[r.order.Uint16(r.b[p:])] = entry{
+			typ:   r.order.Uint16(r.b[p+2:]),
+			count: r.order.Uint32(r.b[p+4:]),
+			value: r.b[p+8 : p+12],
+		}
+	}
+	return entries, nil
+}
+
+// uint は SHORT / LONG 型の最初の値を返す
+func (r *reader) uint(e entry) (uint32, bool) {
+	switch {
+	case e.count == 0:
+		return 0, false
+	case e.typ == typeShort:
+		return uint32(r.order.Uint16(e.value)), true
+	case e.typ == typeLong:
+		return r.order.Uint32(e.value), true
+	}
+	return 0, false
+}
+
+// ascii は ASCII 型の値を NUL と空白を除いて返す
+func (r *reader) ascii(e entry) (string, bool) {
+	if e.typ != typeASCII || e.count == 0 {
+		return "", false
+	}
+
+	var raw []byte
+	if e.count <= 4 {
+		raw = e.value[:e.count]
+	} else {
+		off := int(r.order.Uint32(e.value))
+		end := off + int(e.count)
+		if off < 0 || end > len(r.b) || end < off {
+			return "", false
+		}
+		raw = r.b[off:end]
+	}
+
+	if i := bytes.IndexByte(raw, 0); i >= 0 {
+		raw = raw[:i]
+	}
+	return strings.TrimSpace(string(raw)), true
+}
+
+// parseDateTime は "2006:01:02 15:04:05" 形式の日時を読む。offset は "+09:00" 形式
+func parseDateTime(value, offset string, loc *time.Location) (time.Time, bool) {
+	if value == "" || strings.HasPrefix(value, "0000") {
+		return time.Time{}, false
+	}
+
+	if offset != "" {
+		if t, err := time.Parse("2006:01:02 15:04:05-07:00", value+offset); err == nil {
+			return t, true
+		}
+	}
+
+	if loc == nil {
+		loc = time.UTC
+	}
+	t, err := time.ParseInLocation("2006:01:02 15:04:05", value, loc)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return t, true
+}
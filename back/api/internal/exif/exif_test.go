@@ -0,0 +1,168 @@
+package exif
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"testing"
+	"time"
+)
+
+// buildExif は IFD0 (Orientation, Exif IFD ポインタ) と Exif IFD (DateTimeOriginal, OffsetTimeOriginal) を持つ APP1 の中身を作る
+func buildExif(order binary.ByteOrder, orientation uint16, dateTime, offset string) []byte {
+	var b bytes.Buffer
+	if order == binary.LittleEndian {
+		b.WriteString("II")
+	} else {
+		b.WriteString("MM")
+	}
+	binary.Write(&b, order, uint16(42))
+	binary.Write(&b, order, uint32(8))
+
+	// IFD0: 2 エントリ
+	exifIFD := uint32(8 + 2 + 2*12 + 4)
+	binary.Write(&b, order, uint16(2))
+	binary.Write(&b, order, []uint16{tagOrientation, typeShort})
+	binary.Write(&b, order, uint32(1))
+	binary.Write(&b, order, []uint16{orientation, 0})
+	binary.Write(&b, order, []uint16{tagExifIFDPointer, typeLong})
+	binary.Write(&b, order, uint32(1))
+	binary.Write(&b, order, exifIFD)
+	binary.Write(&b, order, uint32(0))
+
+	// Exif IFD: 2 エントリ、文字列は後ろに置く
+	dataOff := exifIFD + 2 + 2*12 + 4
+	dt := append([]byte(dateTime), 0)
+	off := append([]byte(offset), 0)
+	binary.Write(&b, order, uint16(2))
+	binary.Write(&b, order, []uint16{tagDateTimeOriginal, typeASCII})
+	binary.Write(&b, order, uint32(len(dt)))
+	binary.Write(&b, order, dataOff)
+	binary.Write(&b, order, []uint16{tagOffsetTimeOriginal, typeASCII})
+	binary.Write(&b, order, uint32(len(off)))
+	binary.Write(&b, order, dataOff+uint32(len(dt)))
+	binary.Write(&b, order, uint32(0))
+	b.Write(dt)
+	b.Write(off)
+
+	return append([]byte("Exif\x00\x00"), b.Bytes()...)
+}
+
+// withAPP1 は JPEG の SOI 直後に APP1 セグメントを挿入する
+func withAPP1(jpg, payload []byte) []byte {
+	seg := []byte{0xFF, 0xE1, 0, 0}
+	binary.BigEndian.PutUint16(seg[2:], uint16(len(payload)+2))
+	out := append([]byte{}, jpg[:2]...)
+	out = append(out, seg...)
+	out = append(out, payload...)
+	return append(out, jpg[2:]...)
+}
+
+func testJPEG(t *testing.T, w, h int) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, image.NewRGBA(image.Rect(0, 0, w, h)), nil); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestReadJPEG(t *testing.T) {
+	base := testJPEG(t, 8, 4)
+	jst := time.FixedZone("JST", 9*60*60)
+
+	tests := []struct {
+		name            string
+		data            []byte
+		wantOrientation int
+		wantCapturedAt  time.Time
+		wantErr         bool
+	}{
+		{
+			name:            "big endian with offset",
+			data:            withAPP1(base, buildExif(binary.BigEndian, 6, "2025:10:18 14:03:05", "+09:00")),
+			wantOrientation: 6,
+			wantCapturedAt:  time.Date(2025, 10, 18, 14, 3, 5, 0, jst),
+		},
+		{
+			name:            "little endian without offset uses given location",
+			data:            withAPP1(base, buildExif(binary.LittleEndian, 8, "2025:01:02 03:04:05", "")),
+			wantOrientation: 8,
+			wantCapturedAt:  time.Date(2025, 1, 2, 3, 4, 5, 0, jst),
+		},
+		{
+			name:            "invalid orientation falls back to 1",
+			data:            withAPP1(base, buildExif(binary.BigEndian, 42, "2025:01:02 03:04:05", "")),
+			wantOrientation: 1,
+			wantCapturedAt:  time.Date(2025, 1, 2, 3, 4, 5, 0, jst),
+		},
+		{
+			name:    "no exif",
+			data:    base,
+			wantErr: true,
+		},
+		{
+			name:    "truncated exif",
+			data:    withAPP1(base, []byte("Exif\x00\x00MM\x00")),
+			wantErr: true,
+		},
+		{
+			name:    "not a jpeg",
+			data:    []byte("\x89PNG\r\n\x1a\n"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info, err := ReadJPEG(tt.data, jst)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ReadJPEG() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if info.Orientation != tt.wantOrientation {
+				t.Errorf("Orientation = %d, want %d", info.Orientation, tt.wantOrientation)
+			}
+			if info.CapturedAt == nil || !info.CapturedAt.Equal(tt.wantCapturedAt) {
+				t.Errorf("CapturedAt = %v, want %v", info.CapturedAt, tt.wantCapturedAt)
+			}
+		})
+	}
+}
+
+func TestOrient(t *testing.T) {
+	// 3x2 の画像の左上だけに印をつけ、各 orientation で印の移動先を確認する
+	src := image.NewRGBA(image.Rect(0, 0, 3, 2))
+	mark := color.RGBA{R: 255, A: 255}
+	src.SetRGBA(0, 0, mark)
+
+	tests := []struct {
+		orientation int
+		wantSize    image.Point
+		wantMark    image.Point
+	}{
+		{1, image.Pt(3, 2), image.Pt(0, 0)},
+		{2, image.Pt(3, 2), image.Pt(2, 0)},
+		{3, image.Pt(3, 2), image.Pt(2, 1)},
+		{4, image.Pt(3, 2), image.Pt(0, 1)},
+		{5, image.Pt(2, 3), image.Pt(0, 0)},
+		{6, image.Pt(2, 3), image.Pt(1, 0)},
+		{7, image.Pt(2, 3), image.Pt(1, 2)},
+		{8, image.Pt(2, 3), image.Pt(0, 2)},
+	}
+
+	for _, tt := range tests {
+		got := Orient(src, tt.orientation)
+		if size := got.Bounds().Size(); size != tt.wantSize {
+			t.Errorf("orientation %d: size = %v, want %v", tt.orientation, size, tt.wantSize)
+			continue
+		}
+		if c := color.RGBAModel.Convert(got.At(tt.wantMark.X, tt.wantMark.Y)); c != mark {
+			t.Errorf("orientation %d: mark not at %v", tt.orientation, tt.wantMark)
+		}
+	}
+}
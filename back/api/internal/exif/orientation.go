@@ -0,0 +1,59 @@
+package exif
+
+import (
+	"image"
+	"image/draw"
+)
+
+// SwapsAxes は orientation の適用で幅と高さが入れ替わるかを返す
+func SwapsAxes(orientation int) bool {
+	return orientation >= 5 && orientation <= 8
+}
+
+// Orient は EXIF Orientation に従って画像を正立させる。1 や範囲外の値ではそのまま返す
+func Orient(img image.Image, orientation int) image.Image {
+	if orientation <= 1 || orientation > 8 {
+		return img
+	}
+
+	b := img.Bounds()
+	src, ok := img.(*image.RGBA)
+	if !ok || b.Min != (image.Point{}) {
+		src = image.NewRGBA(image.Rect(0, 0, b.Dx(), b.Dy()))
+		draw.Draw(src, src.Bounds(), img, b.Min, draw.Src)
+	}
+
+	w, h := b.Dx(), b.Dy()
+	dw, dh := w, h
+	if SwapsAxes(orientation) {
+		dw, dh = h, w
+	}
+	dst := image.NewRGBA(image.Rect(0, 0, dw, dh))
+
+	for y := 0; y < h; y++ {
+		row := src.Pix[y*src.Stride : y*src.Stride+w*4]
+		for x := 0; x < w; x++ {
+			var dx, dy int
+			switch orientation {
+			case 2: // 左右反転
+				dx, dy = w-1-x, y
+			case 3: // 180度回転
+				dx, dy = w-1-x, h-1-y
+			case 4: // 上下反転
+				dx, dy = x, h-1-y
+			case 5: // 転置
+				dx, dy = y, x
+			case 6: // 時計回りに90度
+				dx, dy = h-1-y, x
+			case 7: // 反転置
+				dx, dy = h-1-y, w-1-x
+			case 8: // 反時計回りに90度
+				dx, dy = y, w-1-x
+			}
+			o := dst.PixOffset(dx, dy)
+			copy(dst.Pix[o:o+4], row[x*4:x*4+4])
+		}
+	}
+
+	return dst
+}
@@ -0,0 +1,19 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/jphacks/os_2502/back/api/internal/auth"
+	"github.com/jphacks/os_2502/back/api/internal/domain/user"
+)
+
+// currentUser 認証ミドルウェアが特定した呼び出し元のユーザーを返す。
+// いない場合は 401 を返して false
+func currentUser(w http.ResponseWriter, r *http.Request) (*user.User, bool) {
+	u, ok := auth.UserFromContext(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "ユーザー認証が必要です")
+		return nil, false
+	}
+	return u, true
+}
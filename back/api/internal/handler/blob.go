@@ -0,0 +1,45 @@
+package handler
+
+import (
+	"bytes"
+	"net/http"
+	"path"
+
+	"github.com/jphacks/os_2502/back/api/internal/blobstore"
+	"github.com/jphacks/os_2502/back/api/internal/rendition"
+)
+
+// serveCollageImage 保存先のコラージュ画像を返す
+// ?size=thumb|preview|full と ?format=jpeg|png|webp（無ければ Accept ヘッダー）でレンディションを選ぶ。
+// ETag / If-None-Match と Range は http.ServeContent に任せる
+func serveCollageImage(w http.ResponseWriter, r *http.Request, store blobstore.Store, key string) {
+	q := r.URL.Query()
+	size, format, err := rendition.Negotiate(q.Get("size"), q.Get("format"), r.Header.Get("Accept"))
+	switch err {
+	case nil:
+	case rendition.ErrInvalidSize:
+		respondError(w, http.StatusBadRequest, "sizeはthumb、preview、fullのいずれかを指定してください")
+		return
+	default:
+		respondError(w, http.StatusBadRequest, "formatはjpeg、png、webpのいずれかを指定してください")
+		return
+	}
+
+	obj, err := rendition.Open(r.Context(), store, key, size, format)
+	if err != nil {
+		if err == blobstore.ErrNotFound {
+			respondError(w, http.StatusNotFound, "コラージュ画像が見つかりません")
+		} else {
+			respondError(w, http.StatusInternalServerError, "コラージュ画像の読み込みに失敗しました")
+		}
+		return
+	}
+
+	// グループのメンバーだけが見られる画像なので共有キャッシュには載せない
+	w.Header().Set("Content-Type", obj.ContentType)
+	w.Header().Set("Content-Disposition", "inline; filename="+path.Base(obj.Key))
+	w.Header().Set("ETag", obj.ETag)
+	w.Header().Set("Vary", "Accept")
+	w.Header().Set("Cache-Control", "private, no-cache")
+	http.ServeContent(w, r, "", obj.ModTime, bytes.NewReader(obj.Data))
+}
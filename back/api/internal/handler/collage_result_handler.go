@@ -7,6 +7,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/jphacks/os_2502/back/api/internal/domain/collage_result"
+	"github.com/jphacks/os_2502/back/api/internal/policy"
 	"github.com/jphacks/os_2502/back/api/internal/usecase"
 )
 
@@ -53,6 +54,11 @@ func (h *CollageResultHandler) CreateResult(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
+	me, ok := currentUser(w, r)
+	if !ok {
+		return
+	}
+
 	var req CreateResultRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		respondError(w, http.StatusBadRequest, "リクエストボディが無効です")
@@ -65,11 +71,13 @@ func (h *CollageResultHandler) CreateResult(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
-	result, err := h.useCase.CreateResult(r.Context(), templateID, req.GroupID, req.FileURL, req.TargetUserNumber)
+	result, err := h.useCase.CreateResult(r.Context(), me.ID(), templateID, req.GroupID, req.FileURL, req.TargetUserNumber)
 	if err != nil {
 		switch err {
 		case collage_result.ErrInvalidTemplateID, collage_result.ErrInvalidGroupID, collage_result.ErrInvalidFileURL, collage_result.ErrInvalidTargetUserNumber:
 			respondError(w, http.StatusBadRequest, err.Error())
+		case policy.ErrForbidden:
+			respondError(w, http.StatusForbidden, err.Error())
 		default:
 			respondError(w, http.StatusInternalServerError, "コラージュ結果の作成に失敗しました")
 		}
@@ -85,6 +93,11 @@ func (h *CollageResultHandler) GetResult(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
+	me, ok := currentUser(w, r)
+	if !ok {
+		return
+	}
+
 	idStr := r.URL.Path[len("/api/results/"):]
 	if idStr == "" {
 		respondError(w, http.StatusBadRequest, "結果IDが必要です")
@@ -97,11 +110,14 @@ func (h *CollageResultHandler) GetResult(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	result, err := h.useCase.GetResult(r.Context(), id)
+	result, err := h.useCase.GetResult(r.Context(), id, me.ID())
 	if err != nil {
-		if err == collage_result.ErrResultNotFound {
+		switch err {
+		case collage_result.ErrResultNotFound:
 			respondError(w, http.StatusNotFound, err.Error())
-		} else {
+		case policy.ErrForbidden:
+			respondError(w, http.StatusForbidden, err.Error())
+		default:
 			respondError(w, http.StatusInternalServerError, "コラージュ結果の取得に失敗しました")
 		}
 		return
@@ -116,6 +132,11 @@ func (h *CollageResultHandler) GetResultsByGroup(w http.ResponseWriter, r *http.
 		return
 	}
 
+	me, ok := currentUser(w, r)
+	if !ok {
+		return
+	}
+
 	groupID := r.URL.Query().Get("group_id")
 	if groupID == "" {
 		respondError(w, http.StatusBadRequest, "グループIDが必要です")
@@ -140,9 +161,14 @@ func (h *CollageResultHandler) GetResultsByGroup(w http.ResponseWriter, r *http.
 		}
 	}
 
-	results, err := h.useCase.GetResultsByGroup(r.Context(), groupID, limit, offset)
+	results, err := h.useCase.GetResultsByGroup(r.Context(), me.ID(), groupID, limit, offset)
 	if err != nil {
-		respondError(w, http.StatusInternalServerError, "コラージュ結果一覧の取得に失敗しました")
+		switch err {
+		case policy.ErrForbidden:
+			respondError(w, http.StatusForbidden, err.Error())
+		default:
+			respondError(w, http.StatusInternalServerError, "コラージュ結果一覧の取得に失敗しました")
+		}
 		return
 	}
 
@@ -165,6 +191,11 @@ func (h *CollageResultHandler) MarkAsNotified(w http.ResponseWriter, r *http.Req
 		return
 	}
 
+	me, ok := currentUser(w, r)
+	if !ok {
+		return
+	}
+
 	idStr := r.URL.Path[len("/api/results/"):]
 	if idStr == "" || len(idStr) < len("/notify")+1 {
 		respondError(w, http.StatusBadRequest, "結果IDが必要です")
@@ -179,10 +210,13 @@ func (h *CollageResultHandler) MarkAsNotified(w http.ResponseWriter, r *http.Req
 		return
 	}
 
-	if err := h.useCase.MarkAsNotified(r.Context(), id); err != nil {
-		if err == collage_result.ErrResultNotFound {
+	if err := h.useCase.MarkAsNotified(r.Context(), id, me.ID()); err != nil {
+		switch err {
+		case collage_result.ErrResultNotFound:
 			respondError(w, http.StatusNotFound, err.Error())
-		} else {
+		case policy.ErrForbidden:
+			respondError(w, http.StatusForbidden, err.Error())
+		default:
 			respondError(w, http.StatusInternalServerError, "通知ステータスの更新に失敗しました")
 		}
 		return
@@ -197,6 +231,11 @@ func (h *CollageResultHandler) DeleteResult(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
+	me, ok := currentUser(w, r)
+	if !ok {
+		return
+	}
+
 	idStr := r.URL.Path[len("/api/results/"):]
 	if idStr == "" {
 		respondError(w, http.StatusBadRequest, "結果IDが必要です")
@@ -209,10 +248,13 @@ func (h *CollageResultHandler) DeleteResult(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
-	if err := h.useCase.DeleteResult(r.Context(), id); err != nil {
-		if err == collage_result.ErrResultNotFound {
+	if err := h.useCase.DeleteResult(r.Context(), id, me.ID()); err != nil {
+		switch err {
+		case collage_result.ErrResultNotFound:
 			respondError(w, http.StatusNotFound, err.Error())
-		} else {
+		case policy.ErrForbidden:
+			respondError(w, http.StatusForbidden, err.Error())
+		default:
 			respondError(w, http.StatusInternalServerError, "コラージュ結果の削除に失敗しました")
 		}
 		return
@@ -32,6 +32,11 @@ type TemplateResponse struct {
 	TemplateID string `json:"template_id"`
 	Name       string `json:"name"`
 	FilePath   string `json:"file_path"`
+	PhotoCount int    `json:"photo_count"`
+	ViewBox    string `json:"viewBox"`
+	Width      int    `json:"width"`
+	Height     int    `json:"height"`
+	Builtin    bool   `json:"builtin"`
 	CreatedAt  string `json:"created_at"`
 	UpdatedAt  string `json:"updated_at"`
 }
@@ -41,6 +46,11 @@ func toTemplateResponse(t *collage_template.CollageTemplate) TemplateResponse {
 		TemplateID: t.TemplateID().String(),
 		Name:       t.Name(),
 		FilePath:   t.FilePath(),
+		PhotoCount: t.PhotoCount(),
+		ViewBox:    t.ViewBox(),
+		Width:      t.Width(),
+		Height:     t.Height(),
+		Builtin:    t.IsBuiltin(),
 		CreatedAt:  t.CreatedAt().Format("2006-01-02T15:04:05Z07:00"),
 		UpdatedAt:  t.UpdatedAt().Format("2006-01-02T15:04:05Z07:00"),
 	}
@@ -80,6 +90,11 @@ func (h *CollageTemplateHandler) GetTemplate(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
+	me, ok := currentUser(w, r)
+	if !ok {
+		return
+	}
+
 	// URLパスからIDを取得
 	idStr := r.URL.Path[len("/api/templates/"):]
 	if idStr == "" {
@@ -93,7 +108,7 @@ func (h *CollageTemplateHandler) GetTemplate(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
-	template, err := h.useCase.GetTemplate(r.Context(), id)
+	template, err := h.useCase.GetTemplate(r.Context(), me.ID(), id)
 	if err != nil {
 		if err == collage_template.ErrTemplateNotFound {
 			respondError(w, http.StatusNotFound, err.Error())
@@ -0,0 +1,290 @@
+package handler
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jphacks/os_2502/back/api/internal/domain/collage_template"
+	"github.com/jphacks/os_2502/back/api/internal/usecase"
+)
+
+type CustomTemplateHandler struct {
+	useCase *usecase.CustomTemplateUseCase
+}
+
+func NewCustomTemplateHandler(useCase *usecase.CustomTemplateUseCase) *CustomTemplateHandler {
+	return &CustomTemplateHandler{useCase: useCase}
+}
+
+// CustomFrameRequest フレームの指定。path を省略した場合は x, y, width, height の矩形（viewBox 座標系）
+type CustomFrameRequest struct {
+	Path   string  `json:"path,omitempty"`
+	X      float64 `json:"x,omitempty"`
+	Y      float64 `json:"y,omitempty"`
+	Width  float64 `json:"width,omitempty"`
+	Height float64 `json:"height,omitempty"`
+}
+
+type CreateCustomTemplateRequest struct {
+	Name       string               `json:"name"`
+	ViewBox    string               `json:"viewBox,omitempty"`
+	Width      int                  `json:"width,omitempty"`
+	Height     int                  `json:"height,omitempty"`
+	PhotoCount int                  `json:"photo_count"`
+	Visibility string               `json:"visibility,omitempty"`
+	Frames     []CustomFrameRequest `json:"frames"`
+}
+
+type PublishTemplateRequest struct {
+	Visibility string `json:"visibility"`
+}
+
+type ForkTemplateRequest struct {
+	Name string `json:"name,omitempty"`
+}
+
+// CustomTemplateResponse テンプレートとフレーム、作成者と公開範囲
+type CustomTemplateResponse struct {
+	TemplateData
+	Visibility  string  `json:"visibility"`
+	OwnerUserID *string `json:"owner_user_id,omitempty"`
+	ForkedFrom  *string `json:"forked_from,omitempty"`
+	Builtin     bool    `json:"builtin"`
+	CreatedAt   string  `json:"created_at"`
+	UpdatedAt   string  `json:"updated_at"`
+}
+
+func toCustomTemplateResponse(layout *usecase.TemplateLayout) CustomTemplateResponse {
+	t := layout.Template
+	resp := CustomTemplateResponse{
+		TemplateData: toTemplateData(layout),
+		Visibility:   string(t.Visibility()),
+		Builtin:      t.IsBuiltin(),
+		CreatedAt:    t.CreatedAt().Format(time.RFC3339),
+		UpdatedAt:    t.UpdatedAt().Format(time.RFC3339),
+	}
+	if owner := t.OwnerUserID(); owner != nil {
+		id := owner.String()
+		resp.OwnerUserID = &id
+	}
+	if forkedFrom := t.ForkedFrom(); forkedFrom != nil {
+		id := forkedFrom.String()
+		resp.ForkedFrom = &id
+	}
+	return resp
+}
+
+func respondTemplateLayouts(w http.ResponseWriter, layouts []*usecase.TemplateLayout, limit, offset int) {
+	templates := make([]CustomTemplateResponse, len(layouts))
+	for i, l := range layouts {
+		templates[i] = toCustomTemplateResponse(l)
+	}
+	respondJSON(w, http.StatusOK, map[string]interface{}{
+		"templates": templates,
+		"limit":     limit,
+		"offset":    offset,
+		"count":     len(templates),
+	})
+}
+
+// respondCustomTemplateError テンプレートの作成・変更のエラーを返す
+func respondCustomTemplateError(w http.ResponseWriter, err error, fallback string) {
+	switch err {
+	case collage_template.ErrInvalidName, collage_template.ErrInvalidVisibility, collage_template.ErrInvalidViewBox,
+		collage_template.ErrInvalidSize, collage_template.ErrInvalidPhotoCount, collage_template.ErrInvalidFramePath,
+		collage_template.ErrNoFrames, collage_template.ErrTooManyFrames, collage_template.ErrPhotoCountMismatch,
+		collage_template.ErrFrameOutOfBounds, collage_template.ErrFrameTooSmall, collage_template.ErrFramesOverlap,
+		collage_template.ErrLowCoverage:
+		respondError(w, http.StatusBadRequest, err.Error())
+	case collage_template.ErrNotTemplateOwner, collage_template.ErrBuiltinTemplate:
+		respondError(w, http.StatusForbidden, err.Error())
+	case collage_template.ErrTemplateNotFound:
+		respondError(w, http.StatusNotFound, err.Error())
+	default:
+		respondError(w, http.StatusInternalServerError, fallback)
+	}
+}
+
+// parsePage ?limit= と ?offset= を読む（既定は 20 件）
+func parsePage(r *http.Request) (limit, offset int) {
+	limit = 20
+	if l, err := strconv.Atoi(r.URL.Query().Get("limit")); err == nil && l > 0 && l <= 100 {
+		limit = l
+	}
+	if o, err := strconv.Atoi(r.URL.Query().Get("offset")); err == nil && o >= 0 {
+		offset = o
+	}
+	return limit, offset
+}
+
+// customTemplateID /api/custom-templates/{id}... からテンプレートIDを取り出す
+func customTemplateID(path string) (uuid.UUID, bool) {
+	rest := strings.TrimPrefix(path, "/api/custom-templates/")
+	if i := strings.Index(rest, "/"); i >= 0 {
+		rest = rest[:i]
+	}
+	id, err := uuid.Parse(rest)
+	return id, err == nil
+}
+
+// CreateTemplate POST /api/custom-templates
+func (h *CustomTemplateHandler) CreateTemplate(w http.ResponseWriter, r *http.Request) {
+	me, ok := currentUser(w, r)
+	if !ok {
+		return
+	}
+
+	var req CreateCustomTemplateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "リクエストボディが無効です")
+		return
+	}
+
+	visibility, err := collage_template.ParseVisibility(req.Visibility)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	frames := make([]usecase.FrameInput, len(req.Frames))
+	for i, f := range req.Frames {
+		frames[i] = usecase.FrameInput{Path: f.Path, X: f.X, Y: f.Y, Width: f.Width, Height: f.Height}
+	}
+
+	layout, err := h.useCase.CreateTemplate(r.Context(), me.ID(), usecase.CustomTemplateInput{
+		Name:       req.Name,
+		ViewBox:    req.ViewBox,
+		Width:      req.Width,
+		Height:     req.Height,
+		PhotoCount: req.PhotoCount,
+		Visibility: visibility,
+		Frames:     frames,
+	})
+	if err != nil {
+		respondCustomTemplateError(w, err, "テンプレートの作成に失敗しました")
+		return
+	}
+
+	respondJSON(w, http.StatusCreated, toCustomTemplateResponse(layout))
+}
+
+// ListMyTemplates GET /api/custom-templates
+func (h *CustomTemplateHandler) ListMyTemplates(w http.ResponseWriter, r *http.Request) {
+	me, ok := currentUser(w, r)
+	if !ok {
+		return
+	}
+
+	limit, offset := parsePage(r)
+	layouts, err := h.useCase.ListMyTemplates(r.Context(), me.ID(), limit, offset)
+	if err != nil {
+		respondError(w, http.StatusInternalServerError, "テンプレート一覧の取得に失敗しました")
+		return
+	}
+	respondTemplateLayouts(w, layouts, limit, offset)
+}
+
+// ListSharedTemplates GET /api/custom-templates/shared
+func (h *CustomTemplateHandler) ListSharedTemplates(w http.ResponseWriter, r *http.Request) {
+	me, ok := currentUser(w, r)
+	if !ok {
+		return
+	}
+
+	limit, offset := parsePage(r)
+	layouts, err := h.useCase.ListSharedByFriends(r.Context(), me.ID(), limit, offset)
+	if err != nil {
+		respondError(w, http.StatusInternalServerError, "フレンドのテンプレートの取得に失敗しました")
+		return
+	}
+	respondTemplateLayouts(w, layouts, limit, offset)
+}
+
+// GetTemplate GET /api/custom-templates/{id}
+func (h *CustomTemplateHandler) GetTemplate(w http.ResponseWriter, r *http.Request) {
+	id, ok := customTemplateID(r.URL.Path)
+	if !ok {
+		respondError(w, http.StatusBadRequest, "無効なテンプレートIDです")
+		return
+	}
+
+	me, ok := currentUser(w, r)
+	if !ok {
+		return
+	}
+
+	layout, err := h.useCase.GetTemplate(r.Context(), me.ID(), id)
+	if err != nil {
+		respondCustomTemplateError(w, err, "テンプレートの取得に失敗しました")
+		return
+	}
+	respondJSON(w, http.StatusOK, toCustomTemplateResponse(layout))
+}
+
+// PublishTemplate POST /api/custom-templates/{id}/publish
+func (h *CustomTemplateHandler) PublishTemplate(w http.ResponseWriter, r *http.Request) {
+	id, ok := customTemplateID(r.URL.Path)
+	if !ok {
+		respondError(w, http.StatusBadRequest, "無効なテンプレートIDです")
+		return
+	}
+
+	me, ok := currentUser(w, r)
+	if !ok {
+		return
+	}
+
+	var req PublishTemplateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "リクエストボディが無効です")
+		return
+	}
+	if req.Visibility == "" {
+		req.Visibility = string(collage_template.VisibilityPublic)
+	}
+	visibility, err := collage_template.ParseVisibility(req.Visibility)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	layout, err := h.useCase.PublishTemplate(r.Context(), me.ID(), id, visibility)
+	if err != nil {
+		respondCustomTemplateError(w, err, "テンプレートの公開範囲の変更に失敗しました")
+		return
+	}
+	respondJSON(w, http.StatusOK, toCustomTemplateResponse(layout))
+}
+
+// ForkTemplate POST /api/custom-templates/{id}/fork
+func (h *CustomTemplateHandler) ForkTemplate(w http.ResponseWriter, r *http.Request) {
+	id, ok := customTemplateID(r.URL.Path)
+	if !ok {
+		respondError(w, http.StatusBadRequest, "無効なテンプレートIDです")
+		return
+	}
+
+	me, ok := currentUser(w, r)
+	if !ok {
+		return
+	}
+
+	// 本文は省略できる（元の名前で複製）
+	var req ForkTemplateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && err != io.EOF {
+		respondError(w, http.StatusBadRequest, "リクエストボディが無効です")
+		return
+	}
+
+	layout, err := h.useCase.ForkTemplate(r.Context(), me.ID(), id, req.Name)
+	if err != nil {
+		respondCustomTemplateError(w, err, "テンプレートの複製に失敗しました")
+		return
+	}
+	respondJSON(w, http.StatusCreated, toCustomTemplateResponse(layout))
+}
@@ -0,0 +1,279 @@
+package handler
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jphacks/os_2502/back/api/internal/blobstore"
+	"github.com/jphacks/os_2502/back/api/internal/domain/collage_result"
+	"github.com/jphacks/os_2502/back/api/internal/domain/daily_collage"
+	"github.com/jphacks/os_2502/back/api/internal/domain/upload_image"
+	"github.com/jphacks/os_2502/back/api/internal/ingest"
+	"github.com/jphacks/os_2502/back/api/internal/policy"
+	"github.com/jphacks/os_2502/back/api/internal/usecase"
+)
+
+type DailyCollageHandler struct {
+	useCase *usecase.DailyCollageUseCase
+	store   blobstore.Store
+}
+
+func NewDailyCollageHandler(useCase *usecase.DailyCollageUseCase, store blobstore.Store) *DailyCollageHandler {
+	return &DailyCollageHandler{useCase: useCase, store: store}
+}
+
+// DailyPartResponse メンバーが撮影するパーツ
+type DailyPartResponse struct {
+	PartID     string  `json:"part_id"`
+	PartNumber int     `json:"part_number"`
+	PartName   *string `json:"part_name,omitempty"`
+	PositionX  int     `json:"position_x"`
+	PositionY  int     `json:"position_y"`
+	Width      int     `json:"width"`
+	Height     int     `json:"height"`
+}
+
+type DailyCollageResponse struct {
+	CollageDay string `json:"collage_day"`
+	TemplateID string `json:"template_id"`
+	// CaptureAt 撮影時刻になるまでは明かさない
+	CaptureAt  *time.Time         `json:"capture_at,omitempty"`
+	ClosesAt   time.Time          `json:"closes_at"`
+	Status     string             `json:"status"`
+	Part       *DailyPartResponse `json:"part,omitempty"`
+	CollageURL *string            `json:"collage_url,omitempty"`
+}
+
+func toDailyCollageResponse(view *usecase.DailyView, now time.Time) DailyCollageResponse {
+	d := view.Collage
+	collageDay := d.CollageDay().Format(time.DateOnly)
+	resp := DailyCollageResponse{
+		CollageDay: collageDay,
+		TemplateID: d.TemplateID().String(),
+		ClosesAt:   d.ClosesAt(),
+		Status:     string(d.Status()),
+	}
+	if !now.Before(d.CaptureAt()) {
+		captureAt := d.CaptureAt()
+		resp.CaptureAt = &captureAt
+	}
+	if p := view.Part; p != nil {
+		resp.Part = &DailyPartResponse{
+			PartID:     p.PartID().String(),
+			PartNumber: p.PartNumber(),
+			PartName:   p.PartName(),
+			PositionX:  p.PositionX(),
+			PositionY:  p.PositionY(),
+			Width:      p.Width(),
+			Height:     p.Height(),
+		}
+	}
+	if d.Status() == daily_collage.StatusRendered {
+		url := "/api/groups/" + d.GroupID() + "/daily/collage?day=" + collageDay
+		resp.CollageURL = &url
+	}
+	return resp
+}
+
+// dailyGroupID /api/groups/{groupId}/daily... からグループIDを取り出す
+func dailyGroupID(path string) string {
+	rest := strings.TrimPrefix(path, "/api/groups/")
+	if i := strings.Index(rest, "/"); i >= 0 {
+		return rest[:i]
+	}
+	return ""
+}
+
+// parseCollageDay ?day=YYYY-MM-DD を読む（省略時は今日）
+func (h *DailyCollageHandler) parseCollageDay(r *http.Request) (time.Time, bool) {
+	v := r.URL.Query().Get("day")
+	if v == "" {
+		return h.useCase.Today(), true
+	}
+	day, err := time.Parse(time.DateOnly, v)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return day, true
+}
+
+func (h *DailyCollageHandler) GetDaily(w http.ResponseWriter, r *http.Request) {
+	groupID := dailyGroupID(r.URL.Path)
+	if groupID == "" {
+		respondError(w, http.StatusBadRequest, "グループIDが必要です")
+		return
+	}
+
+	me, ok := currentUser(w, r)
+	if !ok {
+		return
+	}
+
+	day, ok := h.parseCollageDay(r)
+	if !ok {
+		respondError(w, http.StatusBadRequest, "日付はYYYY-MM-DD形式で指定してください")
+		return
+	}
+
+	view, err := h.useCase.GetDay(r.Context(), groupID, me.ID(), day)
+	if err != nil {
+		switch err {
+		case policy.ErrForbidden:
+			respondError(w, http.StatusForbidden, err.Error())
+		case daily_collage.ErrDailyCollageNotFound:
+			respondError(w, http.StatusNotFound, err.Error())
+		default:
+			respondError(w, http.StatusInternalServerError, "今日のコラージュの取得に失敗しました")
+		}
+		return
+	}
+
+	respondJSON(w, http.StatusOK, toDailyCollageResponse(view, time.Now()))
+}
+
+func (h *DailyCollageHandler) UploadDailyPhoto(w http.ResponseWriter, r *http.Request) {
+	groupID := dailyGroupID(r.URL.Path)
+	if groupID == "" {
+		respondError(w, http.StatusBadRequest, "グループIDが必要です")
+		return
+	}
+
+	me, ok := currentUser(w, r)
+	if !ok {
+		return
+	}
+	userUUID := me.ID()
+	userID := userUUID.String()
+
+	// 撮影時刻前や締め切り後、担当パーツが無い場合は本文を読まずに断る
+	part, err := h.useCase.CheckDailyOpen(r.Context(), groupID, userUUID)
+	if err != nil {
+		respondDailyError(w, err)
+		return
+	}
+
+	if err := r.ParseMultipartForm(usecase.PhotoUploadMaxSize); err != nil {
+		respondError(w, http.StatusBadRequest, "マルチパートフォームの解析に失敗しました")
+		return
+	}
+
+	file, _, err := r.FormFile("photo")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "写真ファイルが必要です")
+		return
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(file)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "写真ファイルの読み込みに失敗しました")
+		return
+	}
+
+	// 中身を検証し、EXIFの向きを反映して位置情報などのメタデータを除去した JPEG にする
+	normalized, err := ingest.Normalize(data, time.Local)
+	if err != nil {
+		if ierr, ok := err.(*ingest.Error); ok {
+			respondIngestError(w, ierr)
+		} else {
+			respondError(w, http.StatusBadRequest, "画像の読み込みに失敗しました")
+		}
+		return
+	}
+
+	// 同じ秒に撮り直しても上書きし合わないよう、ファイル名には画像IDを含める
+	imageID := uuid.New()
+	filename := userID + "_part" + strconv.Itoa(part.PartNumber()) + "_" + imageID.String() + normalized.Ext
+	filepath := "groups/" + groupID + "/daily/" + filename
+
+	stored, err := usecase.SavePhoto(r.Context(), h.store, filepath, normalized)
+	if err != nil {
+		respondError(w, http.StatusInternalServerError, "ファイルの保存に失敗しました")
+		return
+	}
+
+	image, err := h.useCase.RecordDailyPhoto(r.Context(), imageID, filepath, groupID, userUUID, usecase.PhotoMetadata{
+		CapturedAt: normalized.CapturedAt,
+		Width:      normalized.Width,
+		Height:     normalized.Height,
+	})
+	if err != nil {
+		usecase.DeletePhoto(r.Context(), h.store, stored)
+		switch err {
+		case upload_image.ErrInvalidFileURL, upload_image.ErrInvalidGroupID, upload_image.ErrInvalidUserID,
+			upload_image.ErrInvalidPartID, upload_image.ErrInvalidDimensions:
+			respondError(w, http.StatusBadRequest, err.Error())
+		default:
+			respondDailyError(w, err)
+		}
+		return
+	}
+
+	respondJSON(w, http.StatusCreated, map[string]interface{}{
+		"message":     "写真がアップロードされました",
+		"image_id":    image.ImageID().String(),
+		"group_id":    groupID,
+		"user_id":     userID,
+		"collage_day": image.CollageDay().Format(time.DateOnly),
+		"part_id":     part.PartID().String(),
+		"part_number": part.PartNumber(),
+		"filename":    filename,
+		"renditions":  stored.Renditions,
+		"size":        len(normalized.Data),
+		"width":       normalized.Width,
+		"height":      normalized.Height,
+		"captured_at": normalized.CapturedAt,
+	})
+}
+
+// respondDailyError 毎日のコラージュの受付状態に関するエラーを返す
+func respondDailyError(w http.ResponseWriter, err error) {
+	switch err {
+	case policy.ErrForbidden:
+		respondError(w, http.StatusForbidden, err.Error())
+	case daily_collage.ErrDailyCollageNotFound:
+		respondError(w, http.StatusNotFound, err.Error())
+	case daily_collage.ErrNotCaptureTime, daily_collage.ErrUploadClosed, daily_collage.ErrNoPartAssigned:
+		respondError(w, http.StatusConflict, err.Error())
+	default:
+		respondError(w, http.StatusInternalServerError, "写真の登録に失敗しました")
+	}
+}
+
+func (h *DailyCollageHandler) GetDailyCollageImage(w http.ResponseWriter, r *http.Request) {
+	groupID := dailyGroupID(r.URL.Path)
+	if groupID == "" {
+		respondError(w, http.StatusBadRequest, "グループIDが必要です")
+		return
+	}
+
+	me, ok := currentUser(w, r)
+	if !ok {
+		return
+	}
+
+	day, ok := h.parseCollageDay(r)
+	if !ok {
+		respondError(w, http.StatusBadRequest, "日付はYYYY-MM-DD形式で指定してください")
+		return
+	}
+
+	result, err := h.useCase.GetCollage(r.Context(), groupID, me.ID().String(), day)
+	if err != nil {
+		switch err {
+		case policy.ErrForbidden:
+			respondError(w, http.StatusForbidden, err.Error())
+		case collage_result.ErrResultNotFound:
+			respondError(w, http.StatusNotFound, "コラージュ画像が見つかりません")
+		default:
+			respondError(w, http.StatusInternalServerError, "コラージュ画像の取得に失敗しました")
+		}
+		return
+	}
+
+	serveCollageImage(w, r, h.store, blobstore.KeyFromFileURL(result.FileURL()))
+}
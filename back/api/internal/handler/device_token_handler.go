@@ -62,18 +62,11 @@ func (h *DeviceTokenHandler) RegisterDeviceToken(w http.ResponseWriter, r *http.
 		return
 	}
 
-	// TODO: 実際の実装ではJWTトークンなどから現在のユーザーIDを取得する
-	userIDStr := r.Header.Get("X-User-ID")
-	if userIDStr == "" {
-		respondError(w, http.StatusUnauthorized, "ユーザー認証が必要です")
-		return
-	}
-
-	userID, err := uuid.Parse(userIDStr)
-	if err != nil {
-		respondError(w, http.StatusBadRequest, "無効なユーザーIDです")
+	me, ok := currentUser(w, r)
+	if !ok {
 		return
 	}
+	userID := me.ID()
 
 	var req RegisterDeviceTokenRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
@@ -102,6 +95,11 @@ func (h *DeviceTokenHandler) GetDeviceToken(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
+	me, ok := currentUser(w, r)
+	if !ok {
+		return
+	}
+
 	// URLパスからIDを取得
 	idStr := r.URL.Path[len("/api/device-tokens/"):]
 	if idStr == "" {
@@ -125,6 +123,12 @@ func (h *DeviceTokenHandler) GetDeviceToken(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
+	// 自分のデバイストークン以外は存在を明かさない
+	if token.UserID() != me.ID() {
+		respondError(w, http.StatusNotFound, device_token.ErrDeviceTokenNotFound.Error())
+		return
+	}
+
 	respondJSON(w, http.StatusOK, toDeviceTokenResponse(token))
 }
 
@@ -134,18 +138,11 @@ func (h *DeviceTokenHandler) GetUserDeviceTokens(w http.ResponseWriter, r *http.
 		return
 	}
 
-	// TODO: 実際の実装ではJWTトークンなどから現在のユーザーIDを取得する
-	userIDStr := r.Header.Get("X-User-ID")
-	if userIDStr == "" {
-		respondError(w, http.StatusUnauthorized, "ユーザー認証が必要です")
-		return
-	}
-
-	userID, err := uuid.Parse(userIDStr)
-	if err != nil {
-		respondError(w, http.StatusBadRequest, "無効なユーザーIDです")
+	me, ok := currentUser(w, r)
+	if !ok {
 		return
 	}
+	userID := me.ID()
 
 	limitStr := r.URL.Query().Get("limit")
 	offsetStr := r.URL.Query().Get("offset")
@@ -190,18 +187,11 @@ func (h *DeviceTokenHandler) DeactivateDeviceToken(w http.ResponseWriter, r *htt
 		return
 	}
 
-	// TODO: 実際の実装ではJWTトークンなどから現在のユーザーIDを取得する
-	userIDStr := r.Header.Get("X-User-ID")
-	if userIDStr == "" {
-		respondError(w, http.StatusUnauthorized, "ユーザー認証が必要です")
-		return
-	}
-
-	userID, err := uuid.Parse(userIDStr)
-	if err != nil {
-		respondError(w, http.StatusBadRequest, "無効なユーザーIDです")
+	me, ok := currentUser(w, r)
+	if !ok {
 		return
 	}
+	userID := me.ID()
 
 	// URLパスからIDを取得
 	idStr := r.URL.Path[len("/api/device-tokens/"):]
@@ -236,18 +226,11 @@ func (h *DeviceTokenHandler) DeleteDeviceToken(w http.ResponseWriter, r *http.Re
 		return
 	}
 
-	// TODO: 実際の実装ではJWTトークンなどから現在のユーザーIDを取得する
-	userIDStr := r.Header.Get("X-User-ID")
-	if userIDStr == "" {
-		respondError(w, http.StatusUnauthorized, "ユーザー認証が必要です")
-		return
-	}
-
-	userID, err := uuid.Parse(userIDStr)
-	if err != nil {
-		respondError(w, http.StatusBadRequest, "無効なユーザーIDです")
+	me, ok := currentUser(w, r)
+	if !ok {
 		return
 	}
+	userID := me.ID()
 
 	// URLパスからIDを取得
 	idStr := r.URL.Path[len("/api/device-tokens/"):]
@@ -47,12 +47,11 @@ func (h *FriendHandler) SendFriendRequest(w http.ResponseWriter, r *http.Request
 		return
 	}
 
-	// TODO: 実際の実装ではJWTトークンなどから現在のユーザーIDを取得する
-	requesterID := r.Header.Get("X-User-ID")
-	if requesterID == "" {
-		respondError(w, http.StatusUnauthorized, "ユーザー認証が必要です")
+	me, ok := currentUser(w, r)
+	if !ok {
 		return
 	}
+	requesterID := me.ID().String()
 
 	var req SendFriendRequestRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
@@ -82,12 +81,11 @@ func (h *FriendHandler) AcceptFriendRequest(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
-	// TODO: 実際の実装ではJWTトークンなどから現在のユーザーIDを取得する
-	userID := r.Header.Get("X-User-ID")
-	if userID == "" {
-		respondError(w, http.StatusUnauthorized, "ユーザー認証が必要です")
+	me, ok := currentUser(w, r)
+	if !ok {
 		return
 	}
+	userID := me.ID().String()
 
 	// URLパスからリクエストIDを取得
 	requestID := r.URL.Path[len("/api/friends/"):]
@@ -120,12 +118,11 @@ func (h *FriendHandler) RejectFriendRequest(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
-	// TODO: 実際の実装ではJWTトークンなどから現在のユーザーIDを取得する
-	userID := r.Header.Get("X-User-ID")
-	if userID == "" {
-		respondError(w, http.StatusUnauthorized, "ユーザー認証が必要です")
+	me, ok := currentUser(w, r)
+	if !ok {
 		return
 	}
+	userID := me.ID().String()
 
 	// URLパスからリクエストIDを取得
 	requestID := r.URL.Path[len("/api/friends/"):]
@@ -158,12 +155,11 @@ func (h *FriendHandler) CancelFriendRequest(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
-	// TODO: 実際の実装ではJWTトークンなどから現在のユーザーIDを取得する
-	userID := r.Header.Get("X-User-ID")
-	if userID == "" {
-		respondError(w, http.StatusUnauthorized, "ユーザー認証が必要です")
+	me, ok := currentUser(w, r)
+	if !ok {
 		return
 	}
+	userID := me.ID().String()
 
 	// URLパスからリクエストIDを取得
 	requestID := r.URL.Path[len("/api/friends/"):]
@@ -192,12 +188,11 @@ func (h *FriendHandler) GetFriends(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// TODO: 実際の実装ではJWTトークンなどから現在のユーザーIDを取得する
-	userID := r.Header.Get("X-User-ID")
-	if userID == "" {
-		respondError(w, http.StatusUnauthorized, "ユーザー認証が必要です")
+	me, ok := currentUser(w, r)
+	if !ok {
 		return
 	}
+	userID := me.ID().String()
 
 	limitStr := r.URL.Query().Get("limit")
 	offsetStr := r.URL.Query().Get("offset")
@@ -242,12 +237,11 @@ func (h *FriendHandler) GetPendingReceivedRequests(w http.ResponseWriter, r *htt
 		return
 	}
 
-	// TODO: 実際の実装ではJWTトークンなどから現在のユーザーIDを取得する
-	userID := r.Header.Get("X-User-ID")
-	if userID == "" {
-		respondError(w, http.StatusUnauthorized, "ユーザー認証が必要です")
+	me, ok := currentUser(w, r)
+	if !ok {
 		return
 	}
+	userID := me.ID().String()
 
 	limitStr := r.URL.Query().Get("limit")
 	offsetStr := r.URL.Query().Get("offset")
@@ -292,12 +286,11 @@ func (h *FriendHandler) GetPendingSentRequests(w http.ResponseWriter, r *http.Re
 		return
 	}
 
-	// TODO: 実際の実装ではJWTトークンなどから現在のユーザーIDを取得する
-	userID := r.Header.Get("X-User-ID")
-	if userID == "" {
-		respondError(w, http.StatusUnauthorized, "ユーザー認証が必要です")
+	me, ok := currentUser(w, r)
+	if !ok {
 		return
 	}
+	userID := me.ID().String()
 
 	limitStr := r.URL.Query().Get("limit")
 	offsetStr := r.URL.Query().Get("offset")
@@ -342,12 +335,11 @@ func (h *FriendHandler) RemoveFriend(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// TODO: 実際の実装ではJWTトークンなどから現在のユーザーIDを取得する
-	userID := r.Header.Get("X-User-ID")
-	if userID == "" {
-		respondError(w, http.StatusUnauthorized, "ユーザー認証が必要です")
+	me, ok := currentUser(w, r)
+	if !ok {
 		return
 	}
+	userID := me.ID().String()
 
 	friendUserID := r.URL.Query().Get("friend_user_id")
 	if friendUserID == "" {
@@ -4,43 +4,41 @@ import (
 	"encoding/json"
 	"io"
 	"net/http"
-	"os"
+	"path"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/google/uuid"
+	"github.com/jphacks/os_2502/back/api/internal/blobstore"
+	"github.com/jphacks/os_2502/back/api/internal/domain/collage_result"
+	"github.com/jphacks/os_2502/back/api/internal/domain/collage_template"
 	"github.com/jphacks/os_2502/back/api/internal/domain/group"
 	"github.com/jphacks/os_2502/back/api/internal/domain/group_member"
+	"github.com/jphacks/os_2502/back/api/internal/policy"
+	"github.com/jphacks/os_2502/back/api/internal/timesync"
 	"github.com/jphacks/os_2502/back/api/internal/usecase"
 )
 
 type GroupHandler struct {
-	useCase *usecase.GroupUseCase
+	useCase       *usecase.GroupUseCase
+	uploadImageUC *usecase.UploadImageUseCase
+	roundUC       *usecase.SessionRoundUseCase
+	photoUploadUC *usecase.PhotoUploadUseCase
+	authz         *policy.Policy
+	store         blobstore.Store
 }
 
-func NewGroupHandler(useCase *usecase.GroupUseCase) *GroupHandler {
-	return &GroupHandler{useCase: useCase}
+func NewGroupHandler(useCase *usecase.GroupUseCase, uploadImageUC *usecase.UploadImageUseCase, roundUC *usecase.SessionRoundUseCase, photoUploadUC *usecase.PhotoUploadUseCase, authz *policy.Policy, store blobstore.Store) *GroupHandler {
+	return &GroupHandler{useCase: useCase, uploadImageUC: uploadImageUC, roundUC: roundUC, photoUploadUC: photoUploadUC, authz: authz, store: store}
 }
 
 // Request/Response types
 
 type CreateGroupRequest struct {
-	OwnerUserID string `json:"owner_user_id"`
-	Name        string `json:"name"`
-	GroupType   string `json:"group_type"`           // "local_temporary", "global_temporary", "permanent"
-	ExpiresAt   string `json:"expires_at,omitempty"` // ISO 8601 format
-}
-
-type JoinGroupRequest struct {
-	UserID string `json:"user_id"`
-}
-
-type FinalizeGroupRequest struct {
-	UserID string `json:"user_id"`
-}
-
-type MarkReadyRequest struct {
-	UserID string `json:"user_id"`
+	Name      string `json:"name"`
+	GroupType string `json:"group_type"`           // "local_temporary", "global_temporary", "permanent"
+	ExpiresAt string `json:"expires_at,omitempty"` // ISO 8601 format
 }
 
 type GroupResponse struct {
@@ -55,12 +53,36 @@ type GroupResponse struct {
 	FinalizedAt          *string `json:"finalized_at,omitempty"`
 	CountdownStartedAt   *string `json:"countdown_started_at,omitempty"`
 	ScheduledCaptureTime *string `json:"scheduled_capture_time,omitempty"`
+	CaptureDeadline      *string `json:"capture_deadline,omitempty"`
 	TemplateID           *string `json:"template_id,omitempty"`
 	ExpiresAt            *string `json:"expires_at,omitempty"`
+	CountdownSeconds     int     `json:"countdown_seconds"`
+	AutoStart            bool    `json:"auto_start"`
+	CurrentRound         int     `json:"current_round"`
 	CreatedAt            string  `json:"created_at"`
 	UpdatedAt            string  `json:"updated_at"`
 }
 
+// SessionSettingsRequest 撮影セッションの設定
+type SessionSettingsRequest struct {
+	CountdownSeconds int     `json:"countdown_seconds"`
+	AutoStart        bool    `json:"auto_start"`
+	TemplateID       *string `json:"template_id,omitempty"` // 自動開始する場合に使うテンプレート
+}
+
+// RoundResponse 撮影ラウンドの履歴
+type RoundResponse struct {
+	RoundNumber          int     `json:"round_number"`
+	TemplateID           string  `json:"template_id"`
+	Status               string  `json:"status"`
+	ScheduledCaptureTime string  `json:"scheduled_capture_time"`
+	CaptureDeadline      string  `json:"capture_deadline"`
+	FinishedAt           *string `json:"finished_at,omitempty"`
+	CollageResultID      *string `json:"collage_result_id,omitempty"`
+	CollageURL           *string `json:"collage_url,omitempty"`
+	CreatedAt            string  `json:"created_at"`
+}
+
 type GroupMemberResponse struct {
 	ID          string  `json:"id"`
 	GroupID     string  `json:"group_id"`
@@ -71,6 +93,24 @@ type GroupMemberResponse struct {
 	JoinedAt    string  `json:"joined_at"`
 }
 
+type InviteUsersRequest struct {
+	UserIDs []string `json:"user_ids"`
+}
+
+type ClockSyncRequest struct {
+	Samples []timesync.Sample `json:"samples"`
+}
+
+type MemberClockResponse struct {
+	UserID        string  `json:"user_id"`
+	IsOwner       bool    `json:"is_owner"`
+	ClockState    string  `json:"clock_state"`
+	OutOfSync     bool    `json:"out_of_sync"`
+	ClockOffsetMS *int64  `json:"clock_offset_ms,omitempty"`
+	ClockRTTMS    *int64  `json:"clock_rtt_ms,omitempty"`
+	ClockSyncedAt *string `json:"clock_synced_at,omitempty"`
+}
+
 type GroupListResponse struct {
 	Groups     []GroupResponse `json:"groups"`
 	TotalCount int             `json:"total_count"`
@@ -88,6 +128,9 @@ func toGroupResponse(g *group.Group) GroupResponse {
 		MaxMember:          g.MaxMember(),
 		CurrentMemberCount: g.CurrentMemberCount(),
 		InvitationToken:    g.InvitationToken(),
+		CountdownSeconds:   g.CountdownSeconds(),
+		AutoStart:          g.AutoStart(),
+		CurrentRound:       g.CurrentRound(),
 		CreatedAt:          g.CreatedAt().Format(time.RFC3339),
 		UpdatedAt:          g.UpdatedAt().Format(time.RFC3339),
 	}
@@ -107,6 +150,11 @@ func toGroupResponse(g *group.Group) GroupResponse {
 		resp.ScheduledCaptureTime = &str
 	}
 
+	if captureDeadline := g.CaptureDeadline(); captureDeadline != nil {
+		str := captureDeadline.Format(time.RFC3339)
+		resp.CaptureDeadline = &str
+	}
+
 	if templateID := g.TemplateID(); templateID != nil {
 		resp.TemplateID = templateID
 	}
@@ -119,6 +167,32 @@ func toGroupResponse(g *group.Group) GroupResponse {
 	return resp
 }
 
+func toRoundResponse(h usecase.RoundHistory) RoundResponse {
+	round := h.Round
+	resp := RoundResponse{
+		RoundNumber:          round.RoundNumber(),
+		TemplateID:           round.TemplateID(),
+		Status:               string(round.Status()),
+		ScheduledCaptureTime: round.ScheduledCaptureTime().Format(time.RFC3339),
+		CaptureDeadline:      round.CaptureDeadline().Format(time.RFC3339),
+		CreatedAt:            round.CreatedAt().Format(time.RFC3339),
+	}
+
+	if finishedAt := round.FinishedAt(); finishedAt != nil {
+		str := finishedAt.Format(time.RFC3339)
+		resp.FinishedAt = &str
+	}
+
+	if h.Collage != nil {
+		resultID := h.Collage.ResultID().String()
+		url := "/api/groups/" + round.GroupID() + "/collage?round=" + strconv.Itoa(round.RoundNumber())
+		resp.CollageResultID = &resultID
+		resp.CollageURL = &url
+	}
+
+	return resp
+}
+
 func toGroupMemberResponse(m *group_member.GroupMember) GroupMemberResponse {
 	resp := GroupMemberResponse{
 		ID:          m.ID(),
@@ -137,10 +211,42 @@ func toGroupMemberResponse(m *group_member.GroupMember) GroupMemberResponse {
 	return resp
 }
 
+func toMemberClockResponse(m *group_member.GroupMember, now time.Time) MemberClockResponse {
+	state := m.ClockState(now)
+	resp := MemberClockResponse{
+		UserID:     m.UserID(),
+		IsOwner:    m.IsOwner(),
+		ClockState: string(state),
+		OutOfSync:  state != group_member.ClockStateSynced,
+	}
+
+	if offset := m.ClockOffset(); offset != nil {
+		ms := offset.Milliseconds()
+		resp.ClockOffsetMS = &ms
+	}
+
+	if rtt := m.ClockRTT(); rtt != nil {
+		ms := rtt.Milliseconds()
+		resp.ClockRTTMS = &ms
+	}
+
+	if syncedAt := m.ClockSyncedAt(); syncedAt != nil {
+		str := syncedAt.Format(time.RFC3339)
+		resp.ClockSyncedAt = &str
+	}
+
+	return resp
+}
+
 // Handlers
 
 // CreateGroup creates a new group
 func (h *GroupHandler) CreateGroup(w http.ResponseWriter, r *http.Request) {
+	me, ok := currentUser(w, r)
+	if !ok {
+		return
+	}
+
 	var req CreateGroupRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		respondError(w, http.StatusBadRequest, "リクエストボディが無効です")
@@ -174,7 +280,7 @@ func (h *GroupHandler) CreateGroup(w http.ResponseWriter, r *http.Request) {
 		expiresAt = &t
 	}
 
-	g, err := h.useCase.CreateGroup(r.Context(), req.OwnerUserID, req.Name, groupType, expiresAt)
+	g, err := h.useCase.CreateGroup(r.Context(), me.ID().String(), req.Name, groupType, expiresAt)
 	if err != nil {
 		switch err {
 		case group.ErrInvalidOwnerUserID, group.ErrInvalidName, group.ErrInvalidGroupType:
@@ -198,11 +304,19 @@ func (h *GroupHandler) GetGroupByID(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	g, err := h.useCase.GetGroupByID(r.Context(), id)
+	me, ok := currentUser(w, r)
+	if !ok {
+		return
+	}
+
+	g, err := h.useCase.GetGroupByID(r.Context(), id, me.ID().String())
 	if err != nil {
-		if err == group.ErrGroupNotFound {
+		switch err {
+		case group.ErrGroupNotFound:
 			respondError(w, http.StatusNotFound, err.Error())
-		} else {
+		case policy.ErrForbidden:
+			respondError(w, http.StatusForbidden, err.Error())
+		default:
 			respondError(w, http.StatusInternalServerError, "グループの取得に失敗しました")
 		}
 		return
@@ -240,12 +354,21 @@ func (h *GroupHandler) GetGroupsByOwnerUserID(w http.ResponseWriter, r *http.Req
 		return
 	}
 
+	me, ok := currentUser(w, r)
+	if !ok {
+		return
+	}
+
 	limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
 	offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
 
-	groups, err := h.useCase.GetGroupsByOwnerUserID(r.Context(), ownerUserID, limit, offset)
+	groups, err := h.useCase.GetGroupsByOwnerUserID(r.Context(), ownerUserID, me.ID().String(), limit, offset)
 	if err != nil {
-		respondError(w, http.StatusInternalServerError, "グループの取得に失敗しました")
+		if err == policy.ErrForbidden {
+			respondError(w, http.StatusForbidden, "他のユーザーのグループは取得できません")
+		} else {
+			respondError(w, http.StatusInternalServerError, "グループの取得に失敗しました")
+		}
 		return
 	}
 
@@ -268,13 +391,12 @@ func (h *GroupHandler) JoinGroup(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	var req JoinGroupRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		respondError(w, http.StatusBadRequest, "リクエストボディが無効です")
+	me, ok := currentUser(w, r)
+	if !ok {
 		return
 	}
 
-	g, err := h.useCase.JoinGroup(r.Context(), token, req.UserID)
+	g, err := h.useCase.JoinGroup(r.Context(), token, me.ID().String())
 	if err != nil {
 		switch err {
 		case group.ErrGroupNotFound:
@@ -296,6 +418,56 @@ func (h *GroupHandler) JoinGroup(w http.ResponseWriter, r *http.Request) {
 	respondJSON(w, http.StatusOK, toGroupResponse(g))
 }
 
+// InviteUsers sends invitation notifications to the given users (owner only)
+func (h *GroupHandler) InviteUsers(w http.ResponseWriter, r *http.Request) {
+	groupID := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/api/groups/"), "/invite")
+	if groupID == "" {
+		respondError(w, http.StatusBadRequest, "グループIDが必要です")
+		return
+	}
+
+	me, ok := currentUser(w, r)
+	if !ok {
+		return
+	}
+
+	var req InviteUsersRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "リクエストボディが無効です")
+		return
+	}
+	if len(req.UserIDs) == 0 {
+		respondError(w, http.StatusBadRequest, "招待するユーザーIDが必要です")
+		return
+	}
+	for _, id := range req.UserIDs {
+		if _, err := uuid.Parse(id); err != nil {
+			respondError(w, http.StatusBadRequest, "無効なユーザーIDです")
+			return
+		}
+	}
+
+	invited, err := h.useCase.InviteUsers(r.Context(), groupID, me.ID().String(), req.UserIDs)
+	if err != nil {
+		switch err {
+		case group.ErrGroupNotFound:
+			respondError(w, http.StatusNotFound, err.Error())
+		case policy.ErrForbidden:
+			respondError(w, http.StatusForbidden, err.Error())
+		case group.ErrGroupNotRecruiting, group.ErrGroupFull, group.ErrGroupExpired:
+			respondError(w, http.StatusBadRequest, err.Error())
+		default:
+			respondError(w, http.StatusInternalServerError, "招待の送信に失敗しました")
+		}
+		return
+	}
+
+	respondJSON(w, http.StatusOK, map[string]interface{}{
+		"invited_user_ids": invited,
+		"count":            len(invited),
+	})
+}
+
 // FinalizeGroupMembers finalizes group members (owner only)
 func (h *GroupHandler) FinalizeGroupMembers(w http.ResponseWriter, r *http.Request) {
 	groupID := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/api/groups/"), "/finalize")
@@ -304,19 +476,18 @@ func (h *GroupHandler) FinalizeGroupMembers(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
-	var req FinalizeGroupRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		respondError(w, http.StatusBadRequest, "リクエストボディが無効です")
+	me, ok := currentUser(w, r)
+	if !ok {
 		return
 	}
 
-	g, err := h.useCase.FinalizeGroupMembers(r.Context(), groupID, req.UserID)
+	g, err := h.useCase.FinalizeGroupMembers(r.Context(), groupID, me.ID().String())
 	if err != nil {
 		switch err {
 		case group.ErrGroupNotFound:
 			respondError(w, http.StatusNotFound, err.Error())
-		case group.ErrInvalidOwnerUserID:
-			respondError(w, http.StatusForbidden, "オーナーのみ実行できます")
+		case policy.ErrForbidden:
+			respondError(w, http.StatusForbidden, err.Error())
 		case group.ErrGroupNotRecruiting:
 			respondError(w, http.StatusBadRequest, err.Error())
 		case group.ErrNoMembers:
@@ -338,17 +509,18 @@ func (h *GroupHandler) MarkMemberReady(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	var req MarkReadyRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		respondError(w, http.StatusBadRequest, "リクエストボディが無効です")
+	me, ok := currentUser(w, r)
+	if !ok {
 		return
 	}
 
-	err := h.useCase.MarkMemberReady(r.Context(), groupID, req.UserID)
+	err := h.useCase.MarkMemberReady(r.Context(), groupID, me.ID().String())
 	if err != nil {
 		switch err {
 		case group_member.ErrMemberNotFound:
 			respondError(w, http.StatusNotFound, "メンバーが見つかりません")
+		case policy.ErrForbidden:
+			respondError(w, http.StatusForbidden, err.Error())
 		case group_member.ErrAlreadyReady:
 			respondError(w, http.StatusBadRequest, err.Error())
 		default:
@@ -360,6 +532,79 @@ func (h *GroupHandler) MarkMemberReady(w http.ResponseWriter, r *http.Request) {
 	respondJSON(w, http.StatusOK, map[string]string{"message": "準備完了にしました"})
 }
 
+func (h *GroupHandler) CancelMemberReady(w http.ResponseWriter, r *http.Request) {
+	groupID := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/api/groups/"), "/ready")
+	if groupID == "" {
+		respondError(w, http.StatusBadRequest, "グループIDが必要です")
+		return
+	}
+
+	me, ok := currentUser(w, r)
+	if !ok {
+		return
+	}
+
+	err := h.useCase.CancelMemberReady(r.Context(), groupID, me.ID().String())
+	if err != nil {
+		switch err {
+		case group.ErrGroupNotFound:
+			respondError(w, http.StatusNotFound, err.Error())
+		case group_member.ErrMemberNotFound:
+			respondError(w, http.StatusNotFound, "メンバーが見つかりません")
+		case policy.ErrForbidden:
+			respondError(w, http.StatusForbidden, err.Error())
+		case group_member.ErrNotReady:
+			respondError(w, http.StatusBadRequest, err.Error())
+		case group.ErrSessionAlreadyStarted:
+			respondError(w, http.StatusConflict, err.Error())
+		default:
+			respondError(w, http.StatusInternalServerError, "準備完了の取り消しに失敗しました")
+		}
+		return
+	}
+
+	respondJSON(w, http.StatusOK, map[string]string{"message": "準備完了を取り消しました"})
+}
+
+func (h *GroupHandler) UpdateSessionSettings(w http.ResponseWriter, r *http.Request) {
+	groupID := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/api/groups/"), "/settings")
+	if groupID == "" {
+		respondError(w, http.StatusBadRequest, "グループIDが必要です")
+		return
+	}
+
+	me, ok := currentUser(w, r)
+	if !ok {
+		return
+	}
+
+	var req SessionSettingsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "リクエストボディが無効です")
+		return
+	}
+
+	g, err := h.useCase.UpdateSessionSettings(r.Context(), groupID, me.ID().String(), req.CountdownSeconds, req.AutoStart, req.TemplateID)
+	if err != nil {
+		switch err {
+		case group.ErrGroupNotFound:
+			respondError(w, http.StatusNotFound, err.Error())
+		case policy.ErrForbidden:
+			respondError(w, http.StatusForbidden, err.Error())
+		case group.ErrInvalidCountdownSeconds, group.ErrAutoStartRequiresTemplate, collage_template.ErrTemplateNotFound,
+			group.ErrTemplateMemberMismatch:
+			respondError(w, http.StatusBadRequest, err.Error())
+		case group.ErrSessionAlreadyStarted:
+			respondError(w, http.StatusConflict, err.Error())
+		default:
+			respondError(w, http.StatusInternalServerError, "撮影設定の更新に失敗しました")
+		}
+		return
+	}
+
+	respondJSON(w, http.StatusOK, toGroupResponse(g))
+}
+
 // GetGroupMembers retrieves all members of a group
 func (h *GroupHandler) GetGroupMembers(w http.ResponseWriter, r *http.Request) {
 	groupID := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/api/groups/"), "/members")
@@ -368,9 +613,18 @@ func (h *GroupHandler) GetGroupMembers(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	members, err := h.useCase.GetGroupMembers(r.Context(), groupID)
+	me, ok := currentUser(w, r)
+	if !ok {
+		return
+	}
+
+	members, err := h.useCase.GetGroupMembers(r.Context(), groupID, me.ID().String())
 	if err != nil {
-		respondError(w, http.StatusInternalServerError, "メンバーの取得に失敗しました")
+		if err == policy.ErrForbidden {
+			respondError(w, http.StatusForbidden, err.Error())
+		} else {
+			respondError(w, http.StatusInternalServerError, "メンバーの取得に失敗しました")
+		}
 		return
 	}
 
@@ -393,11 +647,11 @@ func (h *GroupHandler) LeaveGroup(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	userID := r.URL.Query().Get("user_id")
-	if userID == "" {
-		respondError(w, http.StatusBadRequest, "ユーザーIDが必要です")
+	me, ok := currentUser(w, r)
+	if !ok {
 		return
 	}
+	userID := me.ID().String()
 
 	err := h.useCase.LeaveGroup(r.Context(), groupID, userID)
 	if err != nil {
@@ -427,19 +681,19 @@ func (h *GroupHandler) DeleteGroup(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	userID := r.URL.Query().Get("user_id")
-	if userID == "" {
-		respondError(w, http.StatusBadRequest, "ユーザーIDが必要です")
+	me, ok := currentUser(w, r)
+	if !ok {
 		return
 	}
+	userID := me.ID().String()
 
 	err := h.useCase.DeleteGroup(r.Context(), groupID, userID)
 	if err != nil {
 		switch err {
 		case group.ErrGroupNotFound:
 			respondError(w, http.StatusNotFound, err.Error())
-		case group.ErrInvalidOwnerUserID:
-			respondError(w, http.StatusForbidden, "オーナーのみ削除できます")
+		case policy.ErrForbidden:
+			respondError(w, http.StatusForbidden, err.Error())
 		default:
 			respondError(w, http.StatusInternalServerError, "グループの削除に失敗しました")
 		}
@@ -459,8 +713,12 @@ func (h *GroupHandler) StartCountdown(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	me, ok := currentUser(w, r)
+	if !ok {
+		return
+	}
+
 	var req struct {
-		UserID     string `json:"user_id"`
 		TemplateID string `json:"template_id"`
 	}
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
@@ -468,36 +726,117 @@ func (h *GroupHandler) StartCountdown(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if req.UserID == "" {
-		respondError(w, http.StatusBadRequest, "ユーザーIDが必要です")
+	g, err := h.useCase.StartCountdown(r.Context(), groupID, me.ID().String(), req.TemplateID)
+	if err != nil {
+		switch err {
+		case group.ErrGroupNotFound:
+			respondError(w, http.StatusNotFound, err.Error())
+		case policy.ErrForbidden:
+			respondError(w, http.StatusForbidden, err.Error())
+		case group.ErrGroupNotReadyCheck:
+			respondError(w, http.StatusBadRequest, "全員の準備が完了していません")
+		case group.ErrTemplateRequired:
+			respondError(w, http.StatusBadRequest, "テンプレートIDが必要です")
+		case collage_template.ErrTemplateNotFound, group.ErrTemplateMemberMismatch:
+			respondError(w, http.StatusBadRequest, err.Error())
+		default:
+			respondError(w, http.StatusInternalServerError, "カウントダウンの開始に失敗しました")
+		}
+		return
+	}
+
+	respondJSON(w, http.StatusOK, toGroupResponse(g))
+}
+
+// RecordClockSync records the member's device clock offset from time sync samples
+func (h *GroupHandler) RecordClockSync(w http.ResponseWriter, r *http.Request) {
+	groupID := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/api/groups/"), "/clock-sync")
+	if groupID == "" {
+		respondError(w, http.StatusBadRequest, "グループIDが必要です")
 		return
 	}
 
-	if req.TemplateID == "" {
-		respondError(w, http.StatusBadRequest, "テンプレートIDが必要です")
+	me, ok := currentUser(w, r)
+	if !ok {
 		return
 	}
 
-	g, err := h.useCase.StartCountdown(r.Context(), groupID, req.UserID, req.TemplateID)
+	var req ClockSyncRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "リクエストボディが無効です")
+		return
+	}
+
+	m, err := h.useCase.RecordClockSync(r.Context(), groupID, me.ID().String(), req.Samples)
+	if err != nil {
+		switch err {
+		case group_member.ErrMemberNotFound:
+			respondError(w, http.StatusNotFound, "メンバーが見つかりません")
+		case policy.ErrForbidden:
+			respondError(w, http.StatusForbidden, err.Error())
+		case group_member.ErrInvalidClockSample:
+			respondError(w, http.StatusBadRequest, err.Error())
+		default:
+			respondError(w, http.StatusInternalServerError, "時刻同期の記録に失敗しました")
+		}
+		return
+	}
+
+	respondJSON(w, http.StatusOK, toMemberClockResponse(m, time.Now()))
+}
+
+// GetClockStatus lists each member's clock sync state so the owner can spot out-of-sync devices (owner only)
+func (h *GroupHandler) GetClockStatus(w http.ResponseWriter, r *http.Request) {
+	groupID := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/api/groups/"), "/clock-status")
+	if groupID == "" {
+		respondError(w, http.StatusBadRequest, "グループIDが必要です")
+		return
+	}
+
+	me, ok := currentUser(w, r)
+	if !ok {
+		return
+	}
+	userID := me.ID().String()
+
+	members, err := h.useCase.GetClockStatus(r.Context(), groupID, userID)
 	if err != nil {
 		switch err {
 		case group.ErrGroupNotFound:
 			respondError(w, http.StatusNotFound, err.Error())
-		case group.ErrInvalidOwnerUserID:
-			respondError(w, http.StatusForbidden, "オーナーのみ撮影開始できます")
-		case group.ErrGroupNotReadyCheck:
-			respondError(w, http.StatusBadRequest, "全員の準備が完了していません")
+		case policy.ErrForbidden:
+			respondError(w, http.StatusForbidden, err.Error())
 		default:
-			respondError(w, http.StatusInternalServerError, "カウントダウンの開始に失敗しました")
+			respondError(w, http.StatusInternalServerError, "時刻同期状態の取得に失敗しました")
 		}
 		return
 	}
 
-	respondJSON(w, http.StatusOK, toGroupResponse(g))
+	now := time.Now()
+	memberResponses := make([]MemberClockResponse, len(members))
+	outOfSync := 0
+	for i, m := range members {
+		memberResponses[i] = toMemberClockResponse(m, now)
+		if memberResponses[i].OutOfSync {
+			outOfSync++
+		}
+	}
+
+	respondJSON(w, http.StatusOK, map[string]interface{}{
+		"members":         memberResponses,
+		"out_of_sync":     outOfSync,
+		"tolerance_ms":    group_member.ClockTolerance.Milliseconds(),
+		"max_age_seconds": int(group_member.ClockSampleMaxAge.Seconds()),
+	})
 }
 
-// ListGroups retrieves all groups, optionally filtered by owner_user_id
+// ListGroups retrieves the caller's groups, optionally only those owned by the caller (owner_user_id)
 func (h *GroupHandler) ListGroups(w http.ResponseWriter, r *http.Request) {
+	me, ok := currentUser(w, r)
+	if !ok {
+		return
+	}
+
 	limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
 	offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
 	ownerUserID := r.URL.Query().Get("owner_user_id")
@@ -505,15 +844,19 @@ func (h *GroupHandler) ListGroups(w http.ResponseWriter, r *http.Request) {
 	var groups []*group.Group
 	var err error
 
-	// owner_user_idが指定されている場合はそのユーザーのグループのみを取得
+	// 自分がメンバーのグループだけを返す。owner_user_id は自分のIDのみ指定できる
 	if ownerUserID != "" {
-		groups, err = h.useCase.GetGroupsByOwnerUserID(r.Context(), ownerUserID, limit, offset)
+		groups, err = h.useCase.GetGroupsByOwnerUserID(r.Context(), ownerUserID, me.ID().String(), limit, offset)
 	} else {
-		groups, err = h.useCase.ListGroups(r.Context(), limit, offset)
+		groups, err = h.useCase.ListGroups(r.Context(), me.ID().String(), limit, offset)
 	}
 
 	if err != nil {
-		respondError(w, http.StatusInternalServerError, "グループの取得に失敗しました")
+		if err == policy.ErrForbidden {
+			respondError(w, http.StatusForbidden, "他のユーザーのグループは取得できません")
+		} else {
+			respondError(w, http.StatusInternalServerError, "グループの取得に失敗しました")
+		}
 		return
 	}
 
@@ -543,16 +886,32 @@ func (h *GroupHandler) UploadPhoto(w http.ResponseWriter, r *http.Request) {
 	}
 	groupID := pathParts[3] // /api/groups/{groupId}/photos -> index 3 is groupId
 
-	// Parse multipart form
-	if err := r.ParseMultipartForm(10 << 20); err != nil { // 10 MB limit
-		respondError(w, http.StatusBadRequest, "マルチパートフォームの解析に失敗しました")
+	me, ok := currentUser(w, r)
+	if !ok {
+		return
+	}
+	userUUID := me.ID()
+	userID := userUUID.String()
+
+	// ファイルを書き込む前にメンバーかチェック
+	if err := h.authz.CanUploadToGroup(r.Context(), userID, groupID); err != nil {
+		if err == policy.ErrForbidden {
+			respondError(w, http.StatusForbidden, err.Error())
+		} else {
+			respondError(w, http.StatusInternalServerError, "権限の確認に失敗しました")
+		}
+		return
+	}
+
+	// 撮影時刻前や締め切り後は本文を読まずに断る
+	if err := h.uploadImageUC.CheckCaptureOpen(r.Context(), groupID); err != nil {
+		respondCaptureError(w, err)
 		return
 	}
 
-	// Get user_id from form
-	userID := r.FormValue("user_id")
-	if userID == "" {
-		respondError(w, http.StatusBadRequest, "user_idが必要です")
+	// Parse multipart form
+	if err := r.ParseMultipartForm(usecase.PhotoUploadMaxSize); err != nil {
+		respondError(w, http.StatusBadRequest, "マルチパートフォームの解析に失敗しました")
 		return
 	}
 
@@ -563,62 +922,123 @@ func (h *GroupHandler) UploadPhoto(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	frameIndex, err := strconv.Atoi(frameIndexStr)
-	if err != nil {
+	if err != nil || frameIndex < 0 {
 		respondError(w, http.StatusBadRequest, "frame_indexが無効です")
 		return
 	}
 
 	// Get photo file
-	file, header, err := r.FormFile("photo")
+	file, _, err := r.FormFile("photo")
 	if err != nil {
 		respondError(w, http.StatusBadRequest, "写真ファイルが必要です")
 		return
 	}
 	defer file.Close()
 
-	// Save file to storage
-	uploadDir := "/uploads/groups/" + groupID
-	if err := os.MkdirAll(uploadDir, 0755); err != nil {
-		respondError(w, http.StatusInternalServerError, "アップロードディレクトリの作成に失敗しました")
-		return
-	}
-
-	// Generate unique filename
-	ext := ".jpg"
-	if idx := strings.LastIndex(header.Filename, "."); idx != -1 {
-		ext = header.Filename[idx:]
-	}
-	filename := userID + "_frame" + frameIndexStr + "_" + strconv.FormatInt(time.Now().Unix(), 10) + ext
-	filepath := uploadDir + "/" + filename
-
-	// Create the file
-	dst, err := os.Create(filepath)
+	data, err := io.ReadAll(file)
 	if err != nil {
-		respondError(w, http.StatusInternalServerError, "ファイルの作成に失敗しました")
+		respondError(w, http.StatusBadRequest, "写真ファイルの読み込みに失敗しました")
 		return
 	}
-	defer dst.Close()
 
-	// Copy uploaded file to destination
-	if _, err := io.Copy(dst, file); err != nil {
-		respondError(w, http.StatusInternalServerError, "ファイルの保存に失敗しました")
+	// 拡張子やヘッダーは信用せず、中身を検証して正規化したうえで保存・記録する（撮り直しは最新のものが採用される）
+	photo, err := h.photoUploadUC.IngestPhoto(r.Context(), groupID, userUUID, frameIndex, time.Now(), data)
+	if err != nil {
+		respondPhotoUploadError(w, err, "写真の登録に失敗しました")
 		return
 	}
 
 	respondJSON(w, http.StatusCreated, map[string]interface{}{
 		"message":     "写真がアップロードされました",
+		"image_id":    photo.Image.ImageID().String(),
 		"group_id":    groupID,
 		"user_id":     userID,
 		"frame_index": frameIndex,
-		"filename":    filename,
-		"filepath":    filepath,
-		"size":        header.Size,
+		"filename":    path.Base(photo.Key),
+		"filepath":    photo.Key,
+		"renditions":  photo.Renditions,
+		"size":        photo.Size,
+		"width":       photo.Width,
+		"height":      photo.Height,
+		"captured_at": photo.CapturedAt,
 	})
 }
 
-// GetCollageImage グループIDでコラージュ画像を取得
+// respondCaptureError 撮影の受付状態に関するエラーを返す
+func respondCaptureError(w http.ResponseWriter, err error) {
+	switch err {
+	case group.ErrGroupNotFound:
+		respondError(w, http.StatusNotFound, err.Error())
+	case group.ErrGroupNotPhotoTaking, group.ErrCaptureWindowClosed:
+		respondError(w, http.StatusConflict, err.Error())
+	default:
+		respondError(w, http.StatusInternalServerError, "グループの状態の確認に失敗しました")
+	}
+}
+
+func (h *GroupHandler) ListRounds(w http.ResponseWriter, r *http.Request) {
+	groupID := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/api/groups/"), "/rounds")
+	if groupID == "" {
+		respondError(w, http.StatusBadRequest, "グループIDが必要です")
+		return
+	}
+
+	me, ok := currentUser(w, r)
+	if !ok {
+		return
+	}
+
+	history, err := h.roundUC.ListRounds(r.Context(), groupID, me.ID().String())
+	if err != nil {
+		if err == policy.ErrForbidden {
+			respondError(w, http.StatusForbidden, err.Error())
+		} else {
+			respondError(w, http.StatusInternalServerError, "ラウンドの取得に失敗しました")
+		}
+		return
+	}
+
+	rounds := make([]RoundResponse, len(history))
+	for i, item := range history {
+		rounds[i] = toRoundResponse(item)
+	}
+
+	respondJSON(w, http.StatusOK, map[string]interface{}{"rounds": rounds})
+}
+
+func (h *GroupHandler) StartNextRound(w http.ResponseWriter, r *http.Request) {
+	groupID := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/api/groups/"), "/rounds")
+	if groupID == "" {
+		respondError(w, http.StatusBadRequest, "グループIDが必要です")
+		return
+	}
+
+	me, ok := currentUser(w, r)
+	if !ok {
+		return
+	}
+
+	g, err := h.useCase.StartNextRound(r.Context(), groupID, me.ID().String())
+	if err != nil {
+		switch err {
+		case group.ErrGroupNotFound:
+			respondError(w, http.StatusNotFound, err.Error())
+		case policy.ErrForbidden:
+			respondError(w, http.StatusForbidden, err.Error())
+		case group.ErrRoundNotFinished:
+			respondError(w, http.StatusConflict, err.Error())
+		default:
+			respondError(w, http.StatusInternalServerError, "次のラウンドの開始に失敗しました")
+		}
+		return
+	}
+
+	respondJSON(w, http.StatusOK, toGroupResponse(g))
+}
+
+// GetCollageImage グループIDでコラージュ画像を取得（?round= でラウンド、?size= / ?format= でレンディションを指定）
 func (h *GroupHandler) GetCollageImage(w http.ResponseWriter, r *http.Request) {
-	if r.Method != http.MethodGet {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
 		respondError(w, http.StatusMethodNotAllowed, "メソッドが許可されていません")
 		return
 	}
@@ -632,30 +1052,40 @@ func (h *GroupHandler) GetCollageImage(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// コラージュ画像のパス
-	collagePath := "/uploads/collages/" + groupID + "_collage.jpg"
-
-	// ファイルの存在確認
-	if _, err := os.Stat(collagePath); os.IsNotExist(err) {
-		respondError(w, http.StatusNotFound, "コラージュ画像が見つかりません")
+	me, ok := currentUser(w, r)
+	if !ok {
 		return
 	}
 
-	// ファイルを開く
-	file, err := os.Open(collagePath)
-	if err != nil {
-		respondError(w, http.StatusInternalServerError, "コラージュ画像の読み込みに失敗しました")
-		return
+	// ?round= が無い場合はコラージュができている最新のラウンド
+	round := 0
+	if v := r.URL.Query().Get("round"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 {
+			respondError(w, http.StatusBadRequest, "ラウンド番号が無効です")
+			return
+		}
+		round = n
 	}
-	defer file.Close()
-
-	// ヘッダーを設定
-	w.Header().Set("Content-Type", "image/jpeg")
-	w.Header().Set("Content-Disposition", "inline; filename="+groupID+"_collage.jpg")
 
-	// ファイルをレスポンスに書き込み
-	if _, err := io.Copy(w, file); err != nil {
-		// エラーが発生してもヘッダーは既に送信されている可能性があるため、ログのみ
-		println("Error writing collage image:", err.Error())
+	var collageKey string
+	result, err := h.roundUC.GetCollage(r.Context(), groupID, me.ID().String(), round)
+	switch {
+	case err == nil:
+		collageKey = blobstore.KeyFromFileURL(result.FileURL())
+	case err == collage_result.ErrResultNotFound && round <= 1:
+		// コラージュ結果を記録する前に生成された古いコラージュ
+		collageKey = "collages/" + groupID + "_collage.jpg"
+	case err == policy.ErrForbidden:
+		respondError(w, http.StatusForbidden, err.Error())
+		return
+	case err == collage_result.ErrResultNotFound:
+		respondError(w, http.StatusNotFound, "コラージュ画像が見つかりません")
+		return
+	default:
+		respondError(w, http.StatusInternalServerError, "コラージュ画像の取得に失敗しました")
+		return
 	}
+
+	serveCollageImage(w, r, h.store, collageKey)
 }
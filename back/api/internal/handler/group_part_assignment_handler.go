@@ -4,10 +4,14 @@ import (
 	"encoding/json"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/jphacks/os_2502/back/api/internal/domain/collage_template"
+	"github.com/jphacks/os_2502/back/api/internal/domain/group"
 	"github.com/jphacks/os_2502/back/api/internal/domain/group_part_assignment"
+	"github.com/jphacks/os_2502/back/api/internal/policy"
 	"github.com/jphacks/os_2502/back/api/internal/usecase"
 )
 
@@ -53,6 +57,11 @@ func (h *GroupPartAssignmentHandler) CreateGroupPartAssignment(w http.ResponseWr
 		return
 	}
 
+	me, ok := currentUser(w, r)
+	if !ok {
+		return
+	}
+
 	var req CreateGroupPartAssignmentRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		respondError(w, http.StatusBadRequest, "リクエストボディが無効です")
@@ -77,13 +86,20 @@ func (h *GroupPartAssignmentHandler) CreateGroupPartAssignment(w http.ResponseWr
 		return
 	}
 
-	gpa, err := h.useCase.CreateGroupPartAssignment(r.Context(), req.GroupID, userID, partID, collageDay)
+	gpa, err := h.useCase.CreateGroupPartAssignment(r.Context(), me.ID(), req.GroupID, userID, partID, collageDay)
 	if err != nil {
 		switch err {
-		case group_part_assignment.ErrInvalidGroupID, group_part_assignment.ErrInvalidUserID, group_part_assignment.ErrInvalidPartID, group_part_assignment.ErrInvalidCollageDay:
+		case group_part_assignment.ErrInvalidGroupID, group_part_assignment.ErrInvalidUserID, group_part_assignment.ErrInvalidPartID, group_part_assignment.ErrInvalidCollageDay,
+			group_part_assignment.ErrOverridePartNotInTemplate:
 			respondError(w, http.StatusBadRequest, err.Error())
-		case group_part_assignment.ErrGroupPartAssignmentAlreadyExists:
+		case group_part_assignment.ErrNoParts, group.ErrTemplateRequired:
+			respondError(w, http.StatusUnprocessableEntity, err.Error())
+		case group.ErrGroupNotFound, collage_template.ErrTemplateNotFound:
+			respondError(w, http.StatusNotFound, err.Error())
+		case group_part_assignment.ErrGroupPartAssignmentAlreadyExists, group_part_assignment.ErrDuplicatePartAssignment:
 			respondError(w, http.StatusConflict, err.Error())
+		case policy.ErrForbidden:
+			respondError(w, http.StatusForbidden, err.Error())
 		default:
 			respondError(w, http.StatusInternalServerError, "グループパーツ割り当ての作成に失敗しました")
 		}
@@ -99,6 +115,11 @@ func (h *GroupPartAssignmentHandler) GetGroupPartAssignment(w http.ResponseWrite
 		return
 	}
 
+	me, ok := currentUser(w, r)
+	if !ok {
+		return
+	}
+
 	// URLパスからIDを取得
 	idStr := r.URL.Path[len("/api/group-part-assignments/"):]
 	if idStr == "" {
@@ -112,11 +133,14 @@ func (h *GroupPartAssignmentHandler) GetGroupPartAssignment(w http.ResponseWrite
 		return
 	}
 
-	gpa, err := h.useCase.GetGroupPartAssignmentByID(r.Context(), id)
+	gpa, err := h.useCase.GetGroupPartAssignmentByID(r.Context(), id, me.ID())
 	if err != nil {
-		if err == group_part_assignment.ErrGroupPartAssignmentNotFound {
+		switch err {
+		case group_part_assignment.ErrGroupPartAssignmentNotFound:
 			respondError(w, http.StatusNotFound, err.Error())
-		} else {
+		case policy.ErrForbidden:
+			respondError(w, http.StatusForbidden, err.Error())
+		default:
 			respondError(w, http.StatusInternalServerError, "グループパーツ割り当ての取得に失敗しました")
 		}
 		return
@@ -131,6 +155,11 @@ func (h *GroupPartAssignmentHandler) DeleteGroupPartAssignment(w http.ResponseWr
 		return
 	}
 
+	me, ok := currentUser(w, r)
+	if !ok {
+		return
+	}
+
 	// URLパスからIDを取得
 	idStr := r.URL.Path[len("/api/group-part-assignments/"):]
 	if idStr == "" {
@@ -144,10 +173,13 @@ func (h *GroupPartAssignmentHandler) DeleteGroupPartAssignment(w http.ResponseWr
 		return
 	}
 
-	if err := h.useCase.DeleteGroupPartAssignment(r.Context(), id); err != nil {
-		if err == group_part_assignment.ErrGroupPartAssignmentNotFound {
+	if err := h.useCase.DeleteGroupPartAssignment(r.Context(), id, me.ID()); err != nil {
+		switch err {
+		case group_part_assignment.ErrGroupPartAssignmentNotFound:
 			respondError(w, http.StatusNotFound, err.Error())
-		} else {
+		case policy.ErrForbidden:
+			respondError(w, http.StatusForbidden, err.Error())
+		default:
 			respondError(w, http.StatusInternalServerError, "グループパーツ割り当ての削除に失敗しました")
 		}
 		return
@@ -162,6 +194,11 @@ func (h *GroupPartAssignmentHandler) ListGroupPartAssignments(w http.ResponseWri
 		return
 	}
 
+	me, ok := currentUser(w, r)
+	if !ok {
+		return
+	}
+
 	// クエリパラメータからlimitとoffsetを取得
 	limitStr := r.URL.Query().Get("limit")
 	offsetStr := r.URL.Query().Get("offset")
@@ -181,7 +218,7 @@ func (h *GroupPartAssignmentHandler) ListGroupPartAssignments(w http.ResponseWri
 		}
 	}
 
-	assignments, err := h.useCase.ListGroupPartAssignments(r.Context(), limit, offset)
+	assignments, err := h.useCase.ListGroupPartAssignments(r.Context(), me.ID(), limit, offset)
 	if err != nil {
 		respondError(w, http.StatusInternalServerError, "グループパーツ割り当て一覧の取得に失敗しました")
 		return
@@ -206,6 +243,11 @@ func (h *GroupPartAssignmentHandler) GetGroupPartAssignmentsByGroupAndDay(w http
 		return
 	}
 
+	me, ok := currentUser(w, r)
+	if !ok {
+		return
+	}
+
 	groupID := r.URL.Query().Get("group_id")
 	if groupID == "" {
 		respondError(w, http.StatusBadRequest, "グループIDが必要です")
@@ -224,9 +266,14 @@ func (h *GroupPartAssignmentHandler) GetGroupPartAssignmentsByGroupAndDay(w http
 		return
 	}
 
-	assignments, err := h.useCase.GetGroupPartAssignmentsByGroupAndDay(r.Context(), groupID, collageDay)
+	assignments, err := h.useCase.GetGroupPartAssignmentsByGroupAndDay(r.Context(), me.ID(), groupID, collageDay)
 	if err != nil {
-		respondError(w, http.StatusInternalServerError, "グループパーツ割り当ての取得に失敗しました")
+		switch err {
+		case policy.ErrForbidden:
+			respondError(w, http.StatusForbidden, err.Error())
+		default:
+			respondError(w, http.StatusInternalServerError, "グループパーツ割り当ての取得に失敗しました")
+		}
 		return
 	}
 
@@ -247,17 +294,11 @@ func (h *GroupPartAssignmentHandler) GetGroupPartAssignmentByUserGroupAndDay(w h
 		return
 	}
 
-	userIDStr := r.URL.Query().Get("user_id")
-	if userIDStr == "" {
-		respondError(w, http.StatusBadRequest, "ユーザーIDが必要です")
-		return
-	}
-
-	userID, err := uuid.Parse(userIDStr)
-	if err != nil {
-		respondError(w, http.StatusBadRequest, "無効なユーザーIDです")
+	me, ok := currentUser(w, r)
+	if !ok {
 		return
 	}
+	userID := me.ID()
 
 	groupID := r.URL.Query().Get("group_id")
 	if groupID == "" {
@@ -279,9 +320,12 @@ func (h *GroupPartAssignmentHandler) GetGroupPartAssignmentByUserGroupAndDay(w h
 
 	gpa, err := h.useCase.GetGroupPartAssignmentByUserGroupAndDay(r.Context(), userID, groupID, collageDay)
 	if err != nil {
-		if err == group_part_assignment.ErrGroupPartAssignmentNotFound {
+		switch err {
+		case group_part_assignment.ErrGroupPartAssignmentNotFound:
 			respondError(w, http.StatusNotFound, err.Error())
-		} else {
+		case policy.ErrForbidden:
+			respondError(w, http.StatusForbidden, err.Error())
+		default:
 			respondError(w, http.StatusInternalServerError, "グループパーツ割り当ての取得に失敗しました")
 		}
 		return
@@ -296,6 +340,11 @@ func (h *GroupPartAssignmentHandler) GetGroupPartAssignmentsByPartID(w http.Resp
 		return
 	}
 
+	me, ok := currentUser(w, r)
+	if !ok {
+		return
+	}
+
 	partIDStr := r.URL.Query().Get("part_id")
 	if partIDStr == "" {
 		respondError(w, http.StatusBadRequest, "パーツIDが必要です")
@@ -308,7 +357,7 @@ func (h *GroupPartAssignmentHandler) GetGroupPartAssignmentsByPartID(w http.Resp
 		return
 	}
 
-	assignments, err := h.useCase.GetGroupPartAssignmentsByPartID(r.Context(), partID)
+	assignments, err := h.useCase.GetGroupPartAssignmentsByPartID(r.Context(), me.ID(), partID)
 	if err != nil {
 		respondError(w, http.StatusInternalServerError, "グループパーツ割り当ての取得に失敗しました")
 		return
@@ -324,3 +373,113 @@ func (h *GroupPartAssignmentHandler) GetGroupPartAssignmentsByPartID(w http.Resp
 		"count":                  len(responses),
 	})
 }
+
+type PartOverrideRequest struct {
+	UserID string `json:"user_id"`
+	PartID string `json:"part_id"`
+}
+
+type AutoAssignPartsRequest struct {
+	CollageDay string `json:"collage_day"`
+	// Strategy rotation（デフォルト） / random / manual
+	Strategy string `json:"strategy,omitempty"`
+	// TemplateID 省略時はグループのテンプレート。オーナーのみ指定でき、グループのテンプレートと一致する必要がある
+	TemplateID string                `json:"template_id,omitempty"`
+	Overrides  []PartOverrideRequest `json:"overrides,omitempty"`
+}
+
+// AutoAssignParts POST /api/groups/{groupId}/part-assignments
+// その日の割り当てがまだ無ければ全メンバー分を作成して 201、既にあればその割り当てを 200 で返す
+func (h *GroupPartAssignmentHandler) AutoAssignParts(w http.ResponseWriter, r *http.Request) {
+	groupID := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/api/groups/"), "/part-assignments")
+	if groupID == "" {
+		respondError(w, http.StatusBadRequest, "グループIDが必要です")
+		return
+	}
+
+	me, ok := currentUser(w, r)
+	if !ok {
+		return
+	}
+
+	var req AutoAssignPartsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "リクエストボディが無効です")
+		return
+	}
+
+	collageDay, err := time.Parse("2006-01-02", req.CollageDay)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "無効なコラージュ日です (形式: YYYY-MM-DD)")
+		return
+	}
+
+	strategy, err := group_part_assignment.ParseStrategy(req.Strategy)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	in := usecase.AutoAssignInput{Strategy: strategy}
+	if req.TemplateID != "" {
+		if in.TemplateID, err = uuid.Parse(req.TemplateID); err != nil {
+			respondError(w, http.StatusBadRequest, "無効なテンプレートIDです")
+			return
+		}
+	}
+	if len(req.Overrides) > 0 {
+		in.Overrides = make(map[uuid.UUID]uuid.UUID, len(req.Overrides))
+		for _, o := range req.Overrides {
+			userID, err := uuid.Parse(o.UserID)
+			if err != nil {
+				respondError(w, http.StatusBadRequest, "無効なユーザーIDです")
+				return
+			}
+			partID, err := uuid.Parse(o.PartID)
+			if err != nil {
+				respondError(w, http.StatusBadRequest, "無効なパーツIDです")
+				return
+			}
+			if _, dup := in.Overrides[userID]; dup {
+				respondError(w, http.StatusBadRequest, "同じユーザーに複数のパーツが指定されています")
+				return
+			}
+			in.Overrides[userID] = partID
+		}
+	}
+
+	assignments, created, err := h.useCase.AutoAssignParts(r.Context(), me.ID(), groupID, collageDay, in)
+	if err != nil {
+		switch err {
+		case group_part_assignment.ErrInvalidStrategy, group_part_assignment.ErrOverrideNotMember,
+			group_part_assignment.ErrOverridePartNotInTemplate, group_part_assignment.ErrOverridesRequired,
+			group_part_assignment.ErrDuplicatePartAssignment, group_part_assignment.ErrInvalidCollageDay,
+			group_part_assignment.ErrTemplateMismatch:
+			respondError(w, http.StatusBadRequest, err.Error())
+		case group_part_assignment.ErrNoParts, group.ErrTemplateRequired:
+			respondError(w, http.StatusUnprocessableEntity, err.Error())
+		case group.ErrGroupNotFound, collage_template.ErrTemplateNotFound:
+			respondError(w, http.StatusNotFound, err.Error())
+		case policy.ErrForbidden:
+			respondError(w, http.StatusForbidden, err.Error())
+		default:
+			respondError(w, http.StatusInternalServerError, "パーツの割り当てに失敗しました")
+		}
+		return
+	}
+
+	responses := make([]GroupPartAssignmentResponse, 0, len(assignments))
+	for _, gpa := range assignments {
+		responses = append(responses, toGroupPartAssignmentResponse(gpa))
+	}
+
+	status := http.StatusOK
+	if created {
+		status = http.StatusCreated
+	}
+	respondJSON(w, status, map[string]interface{}{
+		"group_part_assignments": responses,
+		"count":                  len(responses),
+		"created":                created,
+	})
+}
@@ -0,0 +1,178 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jphacks/os_2502/back/api/internal/domain/group"
+	"github.com/jphacks/os_2502/back/api/internal/domain/upload_image"
+	"github.com/jphacks/os_2502/back/api/internal/domain/upload_slot"
+	"github.com/jphacks/os_2502/back/api/internal/ingest"
+	"github.com/jphacks/os_2502/back/api/internal/policy"
+	"github.com/jphacks/os_2502/back/api/internal/usecase"
+)
+
+// PhotoUploadHandler ストレージへ直接アップロードする写真の受け付け
+// マルチパートの POST /api/groups/{id}/photos はフォールバックとして残す
+type PhotoUploadHandler struct {
+	useCase *usecase.PhotoUploadUseCase
+}
+
+func NewPhotoUploadHandler(useCase *usecase.PhotoUploadUseCase) *PhotoUploadHandler {
+	return &PhotoUploadHandler{useCase: useCase}
+}
+
+type RequestUploadSlotRequest struct {
+	FrameIndex  *int   `json:"frame_index"`
+	ContentType string `json:"content_type"`
+}
+
+type UploadSlotResponse struct {
+	UploadID   string            `json:"upload_id"`
+	GroupID    string            `json:"group_id"`
+	FrameIndex int               `json:"frame_index"`
+	UploadURL  string            `json:"upload_url"`
+	Method     string            `json:"method"`
+	Headers    map[string]string `json:"headers"`
+	MaxSize    int64             `json:"max_size"`
+	ExpiresAt  string            `json:"expires_at"`
+}
+
+// RequestUploadSlot POST /api/groups/{id}/uploads
+// フレームの写真をアップロードする署名付きPUT URLを発行する
+func (h *PhotoUploadHandler) RequestUploadSlot(w http.ResponseWriter, r *http.Request) {
+	groupID := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/api/groups/"), "/uploads")
+	if groupID == "" || strings.Contains(groupID, "/") {
+		respondError(w, http.StatusBadRequest, "無効なURLです")
+		return
+	}
+
+	me, ok := currentUser(w, r)
+	if !ok {
+		return
+	}
+
+	var req RequestUploadSlotRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "リクエストボディが無効です")
+		return
+	}
+	if req.FrameIndex == nil {
+		respondError(w, http.StatusBadRequest, "frame_indexが必要です")
+		return
+	}
+	if req.ContentType == "" {
+		req.ContentType = "image/jpeg"
+	}
+
+	grant, err := h.useCase.RequestSlot(r.Context(), groupID, me.ID(), *req.FrameIndex, req.ContentType)
+	if err != nil {
+		respondPhotoUploadError(w, err, "アップロード枠の発行に失敗しました")
+		return
+	}
+
+	slot := grant.Slot
+	respondJSON(w, http.StatusCreated, UploadSlotResponse{
+		UploadID:   slot.SlotID().String(),
+		GroupID:    slot.GroupID(),
+		FrameIndex: slot.FrameIndex(),
+		UploadURL:  grant.URL,
+		Method:     grant.Method,
+		Headers:    grant.Headers,
+		MaxSize:    slot.MaxSize(),
+		ExpiresAt:  slot.ExpiresAt().Format(time.RFC3339),
+	})
+}
+
+// ConfirmUploadSlot POST /api/groups/{id}/uploads/{upload_id}/confirm
+// アップロードされた写真を確認して記録する
+func (h *PhotoUploadHandler) ConfirmUploadSlot(w http.ResponseWriter, r *http.Request) {
+	// /api/groups/{id}/uploads/{upload_id}/confirm
+	pathParts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	if len(pathParts) != 6 || pathParts[3] != "uploads" {
+		respondError(w, http.StatusBadRequest, "無効なURLです")
+		return
+	}
+	groupID := pathParts[2]
+	slotID, err := uuid.Parse(pathParts[4])
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "無効なアップロードIDです")
+		return
+	}
+
+	me, ok := currentUser(w, r)
+	if !ok {
+		return
+	}
+
+	photo, err := h.useCase.ConfirmSlot(r.Context(), groupID, slotID, me.ID())
+	if err != nil {
+		respondPhotoUploadError(w, err, "写真の登録に失敗しました")
+		return
+	}
+
+	respondJSON(w, http.StatusCreated, map[string]interface{}{
+		"message":     "写真がアップロードされました",
+		"upload_id":   slotID.String(),
+		"image_id":    photo.Image.ImageID().String(),
+		"group_id":    groupID,
+		"user_id":     me.ID().String(),
+		"frame_index": photo.Image.FrameIndex(),
+		"filepath":    photo.Key,
+		"renditions":  photo.Renditions,
+		"size":        photo.Size,
+		"width":       photo.Width,
+		"height":      photo.Height,
+		"captured_at": photo.CapturedAt,
+	})
+}
+
+// respondPhotoUploadError 直接アップロードのエラーを返す
+func respondPhotoUploadError(w http.ResponseWriter, err error, fallback string) {
+	switch err {
+	case upload_slot.ErrInvalidFrameIndex, upload_slot.ErrUnsupportedContentType,
+		upload_slot.ErrObjectTooLarge, upload_slot.ErrContentTypeMismatch,
+		upload_image.ErrInvalidFrameIndex, upload_image.ErrInvalidDimensions:
+		respondError(w, http.StatusBadRequest, err.Error())
+	case ingest.ErrUnsupportedFormat, ingest.ErrCorruptImage, ingest.ErrFileTooLarge,
+		ingest.ErrDimensionsTooLarge, ingest.ErrTooManyPixels:
+		respondIngestError(w, err.(*ingest.Error))
+	case upload_image.ErrFrameOutOfRange, group.ErrTemplateRequired:
+		respondError(w, http.StatusBadRequest, err.Error())
+	case upload_image.ErrFrameTaken:
+		respondError(w, http.StatusConflict, err.Error())
+	case policy.ErrForbidden:
+		respondError(w, http.StatusForbidden, err.Error())
+	case upload_slot.ErrSlotNotFound:
+		respondError(w, http.StatusNotFound, err.Error())
+	case upload_slot.ErrObjectNotUploaded, upload_slot.ErrSlotAlreadyConfirmed:
+		respondError(w, http.StatusConflict, err.Error())
+	case upload_slot.ErrSlotExpired:
+		respondError(w, http.StatusGone, err.Error())
+	case group.ErrGroupNotFound, group.ErrGroupNotPhotoTaking, group.ErrCaptureWindowClosed:
+		respondCaptureError(w, err)
+	default:
+		respondError(w, http.StatusInternalServerError, fallback)
+	}
+}
+
+// ingestErrorMessages 取り込みのエラーコードごとのメッセージ
+var ingestErrorMessages = map[string]string{
+	ingest.ErrUnsupportedFormat.Code:  "対応していない画像形式です（JPEG、PNG、WebPのいずれかを指定してください）",
+	ingest.ErrCorruptImage.Code:       "画像が壊れているため読み込めません",
+	ingest.ErrFileTooLarge.Code:       "画像のファイルサイズが大きすぎます",
+	ingest.ErrDimensionsTooLarge.Code: "画像の縦横のサイズが大きすぎます",
+	ingest.ErrTooManyPixels.Code:      "画像の画素数が多すぎます",
+}
+
+// respondIngestError 写真の取り込みで断った理由をエラーコード付きで返す
+func respondIngestError(w http.ResponseWriter, err *ingest.Error) {
+	status := http.StatusBadRequest
+	if err == ingest.ErrFileTooLarge {
+		status = http.StatusRequestEntityTooLarge
+	}
+	respondErrorWithCode(w, status, err.Code, ingestErrorMessages[err.Code])
+}
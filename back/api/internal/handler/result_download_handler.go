@@ -6,7 +6,9 @@ import (
 	"strconv"
 
 	"github.com/google/uuid"
+	"github.com/jphacks/os_2502/back/api/internal/domain/collage_result"
 	"github.com/jphacks/os_2502/back/api/internal/domain/result_download"
+	"github.com/jphacks/os_2502/back/api/internal/policy"
 	"github.com/jphacks/os_2502/back/api/internal/usecase"
 )
 
@@ -42,18 +44,11 @@ func (h *ResultDownloadHandler) RecordDownload(w http.ResponseWriter, r *http.Re
 		return
 	}
 
-	// TODO: 実際の実装ではJWTトークンなどから現在のユーザーIDを取得する
-	userIDStr := r.Header.Get("X-User-ID")
-	if userIDStr == "" {
-		respondError(w, http.StatusUnauthorized, "ユーザー認証が必要です")
-		return
-	}
-
-	userID, err := uuid.Parse(userIDStr)
-	if err != nil {
-		respondError(w, http.StatusBadRequest, "無効なユーザーIDです")
+	me, ok := currentUser(w, r)
+	if !ok {
 		return
 	}
+	userID := me.ID()
 
 	var req RecordDownloadRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
@@ -72,6 +67,10 @@ func (h *ResultDownloadHandler) RecordDownload(w http.ResponseWriter, r *http.Re
 		switch err {
 		case result_download.ErrInvalidResultID, result_download.ErrInvalidUserID:
 			respondError(w, http.StatusBadRequest, err.Error())
+		case collage_result.ErrResultNotFound:
+			respondError(w, http.StatusNotFound, err.Error())
+		case policy.ErrForbidden:
+			respondError(w, http.StatusForbidden, err.Error())
 		default:
 			respondError(w, http.StatusInternalServerError, "ダウンロード記録の保存に失敗しました")
 		}
@@ -87,6 +86,11 @@ func (h *ResultDownloadHandler) GetDownloadsByResult(w http.ResponseWriter, r *h
 		return
 	}
 
+	me, ok := currentUser(w, r)
+	if !ok {
+		return
+	}
+
 	resultIDStr := r.URL.Query().Get("result_id")
 	if resultIDStr == "" {
 		respondError(w, http.StatusBadRequest, "結果IDが必要です")
@@ -117,9 +121,16 @@ func (h *ResultDownloadHandler) GetDownloadsByResult(w http.ResponseWriter, r *h
 		}
 	}
 
-	downloads, err := h.useCase.GetDownloadsByResult(r.Context(), resultID, limit, offset)
+	downloads, err := h.useCase.GetDownloadsByResult(r.Context(), resultID, me.ID(), limit, offset)
 	if err != nil {
-		respondError(w, http.StatusInternalServerError, "ダウンロード履歴の取得に失敗しました")
+		switch err {
+		case collage_result.ErrResultNotFound:
+			respondError(w, http.StatusNotFound, err.Error())
+		case policy.ErrForbidden:
+			respondError(w, http.StatusForbidden, err.Error())
+		default:
+			respondError(w, http.StatusInternalServerError, "ダウンロード履歴の取得に失敗しました")
+		}
 		return
 	}
 
@@ -142,6 +153,11 @@ func (h *ResultDownloadHandler) GetDownloadCount(w http.ResponseWriter, r *http.
 		return
 	}
 
+	me, ok := currentUser(w, r)
+	if !ok {
+		return
+	}
+
 	resultIDStr := r.URL.Query().Get("result_id")
 	if resultIDStr == "" {
 		respondError(w, http.StatusBadRequest, "結果IDが必要です")
@@ -154,9 +170,16 @@ func (h *ResultDownloadHandler) GetDownloadCount(w http.ResponseWriter, r *http.
 		return
 	}
 
-	count, err := h.useCase.GetDownloadCount(r.Context(), resultID)
+	count, err := h.useCase.GetDownloadCount(r.Context(), resultID, me.ID())
 	if err != nil {
-		respondError(w, http.StatusInternalServerError, "ダウンロード数の取得に失敗しました")
+		switch err {
+		case collage_result.ErrResultNotFound:
+			respondError(w, http.StatusNotFound, err.Error())
+		case policy.ErrForbidden:
+			respondError(w, http.StatusForbidden, err.Error())
+		default:
+			respondError(w, http.StatusInternalServerError, "ダウンロード数の取得に失敗しました")
+		}
 		return
 	}
 
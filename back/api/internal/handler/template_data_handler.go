@@ -1,11 +1,10 @@
 package handler
 
 import (
-	"encoding/json"
 	"fmt"
 	"net/http"
-	"os"
-	"path/filepath"
+
+	"github.com/jphacks/os_2502/back/api/internal/usecase"
 )
 
 // TemplateFrame represents a frame in a collage template
@@ -16,20 +15,38 @@ type TemplateFrame struct {
 
 // TemplateData represents a collage template with frames
 type TemplateData struct {
+	TemplateID string          `json:"template_id"`
 	Name       string          `json:"name"`
 	PhotoCount int             `json:"photo_count"`
 	ViewBox    string          `json:"viewBox"`
+	Width      int             `json:"width"`
+	Height     int             `json:"height"`
 	Frames     []TemplateFrame `json:"frames"`
 }
 
+func toTemplateData(layout *usecase.TemplateLayout) TemplateData {
+	t := layout.Template
+	frames := make([]TemplateFrame, len(layout.Frames))
+	for i, f := range layout.Frames {
+		frames[i] = TemplateFrame{ID: f.Number, Path: f.Path}
+	}
+	return TemplateData{
+		TemplateID: t.TemplateID().String(),
+		Name:       t.Name(),
+		PhotoCount: t.PhotoCount(),
+		ViewBox:    t.ViewBox(),
+		Width:      t.Width(),
+		Height:     t.Height(),
+		Frames:     frames,
+	}
+}
+
 type TemplateDataHandler struct {
-	templatesPath string
+	useCase *usecase.CollageTemplateUseCase
 }
 
-func NewTemplateDataHandler() *TemplateDataHandler {
-	return &TemplateDataHandler{
-		templatesPath: "resources/templates.json",
-	}
+func NewTemplateDataHandler(useCase *usecase.CollageTemplateUseCase) *TemplateDataHandler {
+	return &TemplateDataHandler{useCase: useCase}
 }
 
 // GetTemplates returns all available collage templates
@@ -39,29 +56,15 @@ func (h *TemplateDataHandler) GetTemplates(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
-	// templates.jsonファイルを読み込む
-	filePath := h.templatesPath
-	if !filepath.IsAbs(filePath) {
-		// 相対パスの場合、プロジェクトルートからの相対パスとして解決
-		wd, err := os.Getwd()
-		if err != nil {
-			respondError(w, http.StatusInternalServerError, "ワーキングディレクトリの取得に失敗しました")
-			return
-		}
-		filePath = filepath.Join(wd, filePath)
-	}
-
-	data, err := os.ReadFile(filePath)
+	layouts, err := h.useCase.ListBuiltinLayouts(r.Context(), 0)
 	if err != nil {
-		respondError(w, http.StatusInternalServerError, "テンプレートファイルの読み込みに失敗しました")
+		respondError(w, http.StatusInternalServerError, "テンプレートの取得に失敗しました")
 		return
 	}
 
-	// JSONをパース
-	var templates []TemplateData
-	if err := json.Unmarshal(data, &templates); err != nil {
-		respondError(w, http.StatusInternalServerError, "テンプレートデータの解析に失敗しました")
-		return
+	templates := make([]TemplateData, len(layouts))
+	for i, l := range layouts {
+		templates[i] = toTemplateData(l)
 	}
 
 	respondJSON(w, http.StatusOK, map[string]interface{}{
@@ -90,35 +93,16 @@ func (h *TemplateDataHandler) GetTemplateByPhotoCount(w http.ResponseWriter, r *
 		return
 	}
 
-	// templates.jsonファイルを読み込む
-	filePath := h.templatesPath
-	if !filepath.IsAbs(filePath) {
-		wd, err := os.Getwd()
+	// photo_countでフィルタリング
+	var filteredTemplates []TemplateData
+	if photoCount > 0 {
+		layouts, err := h.useCase.ListBuiltinLayouts(r.Context(), photoCount)
 		if err != nil {
-			respondError(w, http.StatusInternalServerError, "ワーキングディレクトリの取得に失敗しました")
+			respondError(w, http.StatusInternalServerError, "テンプレートの取得に失敗しました")
 			return
 		}
-		filePath = filepath.Join(wd, filePath)
-	}
-
-	data, err := os.ReadFile(filePath)
-	if err != nil {
-		respondError(w, http.StatusInternalServerError, "テンプレートファイルの読み込みに失敗しました")
-		return
-	}
-
-	// JSONをパース
-	var allTemplates []TemplateData
-	if err := json.Unmarshal(data, &allTemplates); err != nil {
-		respondError(w, http.StatusInternalServerError, "テンプレートデータの解析に失敗しました")
-		return
-	}
-
-	// photo_countでフィルタリング
-	var filteredTemplates []TemplateData
-	for _, t := range allTemplates {
-		if t.PhotoCount == photoCount {
-			filteredTemplates = append(filteredTemplates, t)
+		for _, l := range layouts {
+			filteredTemplates = append(filteredTemplates, toTemplateData(l))
 		}
 	}
 
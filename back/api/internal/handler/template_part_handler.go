@@ -53,6 +53,7 @@ type TemplatePartResponse struct {
 	PositionY   int     `json:"position_y"`
 	Width       int     `json:"width"`
 	Height      int     `json:"height"`
+	Path        *string `json:"path,omitempty"`
 	Description *string `json:"description,omitempty"`
 	CreatedAt   string  `json:"created_at"`
 	UpdatedAt   string  `json:"updated_at"`
@@ -69,18 +70,38 @@ func toTemplatePartResponse(tp *template_part.TemplatePart) TemplatePartResponse
 		PositionY:   tp.PositionY(),
 		Width:       tp.Width(),
 		Height:      tp.Height(),
+		Path:        tp.Path(),
 		Description: tp.Description(),
 		CreatedAt:   tp.CreatedAt().Format("2006-01-02T15:04:05Z07:00"),
 		UpdatedAt:   tp.UpdatedAt().Format("2006-01-02T15:04:05Z07:00"),
 	}
 }
 
+// respondTemplatePartError パーツの取得・変更のエラーを返す（テンプレートのエラーはカスタムテンプレートと同じ扱い）
+func respondTemplatePartError(w http.ResponseWriter, err error, fallback string) {
+	switch err {
+	case template_part.ErrInvalidTemplateID, template_part.ErrInvalidPartNumber, template_part.ErrInvalidDimensions:
+		respondError(w, http.StatusBadRequest, err.Error())
+	case template_part.ErrDuplicatePartNumber, template_part.ErrTemplatePartAlreadyExists:
+		respondError(w, http.StatusConflict, err.Error())
+	case template_part.ErrTemplatePartNotFound:
+		respondError(w, http.StatusNotFound, err.Error())
+	default:
+		respondCustomTemplateError(w, err, fallback)
+	}
+}
+
 func (h *TemplatePartHandler) CreateTemplatePart(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodPost {
 		respondError(w, http.StatusMethodNotAllowed, "メソッドが許可されていません")
 		return
 	}
 
+	me, ok := currentUser(w, r)
+	if !ok {
+		return
+	}
+
 	var req CreateTemplatePartRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		respondError(w, http.StatusBadRequest, "リクエストボディが無効です")
@@ -95,6 +116,7 @@ func (h *TemplatePartHandler) CreateTemplatePart(w http.ResponseWriter, r *http.
 
 	tp, err := h.useCase.CreateTemplatePart(
 		r.Context(),
+		me.ID(),
 		templateID,
 		req.PartNumber,
 		req.PositionX,
@@ -105,14 +127,7 @@ func (h *TemplatePartHandler) CreateTemplatePart(w http.ResponseWriter, r *http.
 		req.Description,
 	)
 	if err != nil {
-		switch err {
-		case template_part.ErrInvalidTemplateID, template_part.ErrInvalidPartNumber, template_part.ErrInvalidDimensions:
-			respondError(w, http.StatusBadRequest, err.Error())
-		case template_part.ErrDuplicatePartNumber, template_part.ErrTemplatePartAlreadyExists:
-			respondError(w, http.StatusConflict, err.Error())
-		default:
-			respondError(w, http.StatusInternalServerError, "テンプレートパーツの作成に失敗しました")
-		}
+		respondTemplatePartError(w, err, "テンプレートパーツの作成に失敗しました")
 		return
 	}
 
@@ -125,6 +140,11 @@ func (h *TemplatePartHandler) GetTemplatePart(w http.ResponseWriter, r *http.Req
 		return
 	}
 
+	me, ok := currentUser(w, r)
+	if !ok {
+		return
+	}
+
 	// URLパスからIDを取得
 	idStr := r.URL.Path[len("/api/template-parts/"):]
 	if idStr == "" {
@@ -138,7 +158,7 @@ func (h *TemplatePartHandler) GetTemplatePart(w http.ResponseWriter, r *http.Req
 		return
 	}
 
-	tp, err := h.useCase.GetTemplatePartByID(r.Context(), id)
+	tp, err := h.useCase.GetTemplatePartByID(r.Context(), me.ID(), id)
 	if err != nil {
 		if err == template_part.ErrTemplatePartNotFound {
 			respondError(w, http.StatusNotFound, err.Error())
@@ -157,6 +177,11 @@ func (h *TemplatePartHandler) UpdateTemplatePartPosition(w http.ResponseWriter,
 		return
 	}
 
+	me, ok := currentUser(w, r)
+	if !ok {
+		return
+	}
+
 	// URLパスからIDを取得
 	idStr := r.URL.Path[len("/api/template-parts/"):]
 	if idStr == "" || len(idStr) < len("/position")+1 {
@@ -178,16 +203,9 @@ func (h *TemplatePartHandler) UpdateTemplatePartPosition(w http.ResponseWriter,
 		return
 	}
 
-	tp, err := h.useCase.UpdateTemplatePartPosition(r.Context(), id, req.PositionX, req.PositionY, req.Width, req.Height)
+	tp, err := h.useCase.UpdateTemplatePartPosition(r.Context(), me.ID(), id, req.PositionX, req.PositionY, req.Width, req.Height)
 	if err != nil {
-		switch err {
-		case template_part.ErrInvalidDimensions:
-			respondError(w, http.StatusBadRequest, err.Error())
-		case template_part.ErrTemplatePartNotFound:
-			respondError(w, http.StatusNotFound, err.Error())
-		default:
-			respondError(w, http.StatusInternalServerError, "テンプレートパーツの位置更新に失敗しました")
-		}
+		respondTemplatePartError(w, err, "テンプレートパーツの位置更新に失敗しました")
 		return
 	}
 
@@ -200,6 +218,11 @@ func (h *TemplatePartHandler) UpdateTemplatePartName(w http.ResponseWriter, r *h
 		return
 	}
 
+	me, ok := currentUser(w, r)
+	if !ok {
+		return
+	}
+
 	// URLパスからIDを取得
 	idStr := r.URL.Path[len("/api/template-parts/"):]
 	if idStr == "" || len(idStr) < len("/name")+1 {
@@ -221,13 +244,9 @@ func (h *TemplatePartHandler) UpdateTemplatePartName(w http.ResponseWriter, r *h
 		return
 	}
 
-	tp, err := h.useCase.UpdateTemplatePartName(r.Context(), id, req.PartName)
+	tp, err := h.useCase.UpdateTemplatePartName(r.Context(), me.ID(), id, req.PartName)
 	if err != nil {
-		if err == template_part.ErrTemplatePartNotFound {
-			respondError(w, http.StatusNotFound, err.Error())
-		} else {
-			respondError(w, http.StatusInternalServerError, "テンプレートパーツ名の更新に失敗しました")
-		}
+		respondTemplatePartError(w, err, "テンプレートパーツ名の更新に失敗しました")
 		return
 	}
 
@@ -240,6 +259,11 @@ func (h *TemplatePartHandler) UpdateTemplatePartDescription(w http.ResponseWrite
 		return
 	}
 
+	me, ok := currentUser(w, r)
+	if !ok {
+		return
+	}
+
 	// URLパスからIDを取得
 	idStr := r.URL.Path[len("/api/template-parts/"):]
 	if idStr == "" || len(idStr) < len("/description")+1 {
@@ -261,13 +285,9 @@ func (h *TemplatePartHandler) UpdateTemplatePartDescription(w http.ResponseWrite
 		return
 	}
 
-	tp, err := h.useCase.UpdateTemplatePartDescription(r.Context(), id, req.Description)
+	tp, err := h.useCase.UpdateTemplatePartDescription(r.Context(), me.ID(), id, req.Description)
 	if err != nil {
-		if err == template_part.ErrTemplatePartNotFound {
-			respondError(w, http.StatusNotFound, err.Error())
-		} else {
-			respondError(w, http.StatusInternalServerError, "テンプレートパーツ説明の更新に失敗しました")
-		}
+		respondTemplatePartError(w, err, "テンプレートパーツ説明の更新に失敗しました")
 		return
 	}
 
@@ -280,6 +300,11 @@ func (h *TemplatePartHandler) DeleteTemplatePart(w http.ResponseWriter, r *http.
 		return
 	}
 
+	me, ok := currentUser(w, r)
+	if !ok {
+		return
+	}
+
 	// URLパスからIDを取得
 	idStr := r.URL.Path[len("/api/template-parts/"):]
 	if idStr == "" {
@@ -293,12 +318,8 @@ func (h *TemplatePartHandler) DeleteTemplatePart(w http.ResponseWriter, r *http.
 		return
 	}
 
-	if err := h.useCase.DeleteTemplatePart(r.Context(), id); err != nil {
-		if err == template_part.ErrTemplatePartNotFound {
-			respondError(w, http.StatusNotFound, err.Error())
-		} else {
-			respondError(w, http.StatusInternalServerError, "テンプレートパーツの削除に失敗しました")
-		}
+	if err := h.useCase.DeleteTemplatePart(r.Context(), me.ID(), id); err != nil {
+		respondTemplatePartError(w, err, "テンプレートパーツの削除に失敗しました")
 		return
 	}
 
@@ -355,6 +376,11 @@ func (h *TemplatePartHandler) GetTemplatePartsByTemplateID(w http.ResponseWriter
 		return
 	}
 
+	me, ok := currentUser(w, r)
+	if !ok {
+		return
+	}
+
 	templateIDStr := r.URL.Query().Get("template_id")
 	if templateIDStr == "" {
 		respondError(w, http.StatusBadRequest, "テンプレートIDが必要です")
@@ -367,9 +393,9 @@ func (h *TemplatePartHandler) GetTemplatePartsByTemplateID(w http.ResponseWriter
 		return
 	}
 
-	parts, err := h.useCase.GetTemplatePartsByTemplateID(r.Context(), templateID)
+	parts, err := h.useCase.GetTemplatePartsByTemplateID(r.Context(), me.ID(), templateID)
 	if err != nil {
-		respondError(w, http.StatusInternalServerError, "テンプレートパーツの取得に失敗しました")
+		respondTemplatePartError(w, err, "テンプレートパーツの取得に失敗しました")
 		return
 	}
 
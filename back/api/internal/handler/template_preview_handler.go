@@ -0,0 +1,89 @@
+package handler
+
+import (
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jphacks/os_2502/back/api/internal/domain/collage_template"
+	"github.com/jphacks/os_2502/back/api/internal/usecase"
+)
+
+type TemplatePreviewHandler struct {
+	useCase *usecase.TemplatePreviewUseCase
+}
+
+func NewTemplatePreviewHandler(useCase *usecase.TemplatePreviewUseCase) *TemplatePreviewHandler {
+	return &TemplatePreviewHandler{useCase: useCase}
+}
+
+// GetPreview GET /api/templates/{id}/preview?size=512&highlight=2
+// フレームを色分けして番号を振った PNG を返す。highlight を指定するとそのフレームだけ色を残して縁取りする
+// ETag はテンプレートのフレームとサイズから決まり、If-None-Match が一致すれば 304 を返す
+func (h *TemplatePreviewHandler) GetPreview(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		respondError(w, http.StatusMethodNotAllowed, "メソッドが許可されていません")
+		return
+	}
+
+	rest := strings.TrimPrefix(r.URL.Path, "/api/templates/")
+	id, err := uuid.Parse(strings.TrimSuffix(rest, "/preview"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "無効なテンプレートIDです")
+		return
+	}
+
+	query := r.URL.Query()
+	var size, highlight int
+	if s := query.Get("size"); s != "" {
+		if size, err = strconv.Atoi(s); err != nil {
+			respondError(w, http.StatusBadRequest, "sizeは数値である必要があります")
+			return
+		}
+	}
+	if s := query.Get("highlight"); s != "" {
+		if highlight, err = strconv.Atoi(s); err != nil || highlight < 1 {
+			respondError(w, http.StatusBadRequest, "highlightはフレーム番号で指定してください")
+			return
+		}
+	}
+
+	me, ok := currentUser(w, r)
+	if !ok {
+		return
+	}
+
+	result, err := h.useCase.GetPreview(r.Context(), me.ID(), id, size, highlight)
+	if err != nil {
+		switch err {
+		case collage_template.ErrInvalidPreviewSize, collage_template.ErrFrameNotFound:
+			respondError(w, http.StatusBadRequest, err.Error())
+		case collage_template.ErrTemplateNotFound:
+			respondError(w, http.StatusNotFound, err.Error())
+		default:
+			respondError(w, http.StatusInternalServerError, "プレビューの作成に失敗しました")
+		}
+		return
+	}
+
+	file, err := os.Open(result.Path)
+	if err != nil {
+		respondError(w, http.StatusInternalServerError, "プレビューの読み込みに失敗しました")
+		return
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		respondError(w, http.StatusInternalServerError, "プレビューの読み込みに失敗しました")
+		return
+	}
+
+	// テンプレートによっては公開範囲が限られるので共有キャッシュには載せない
+	w.Header().Set("Content-Type", "image/png")
+	w.Header().Set("ETag", result.ETag)
+	w.Header().Set("Cache-Control", "private, no-cache")
+	http.ServeContent(w, r, "", info.ModTime(), file)
+}
@@ -0,0 +1,40 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/jphacks/os_2502/back/api/internal/timesync"
+)
+
+// TimeSyncRequest 時刻同期リクエスト
+type TimeSyncRequest struct {
+	// ClientTransmit クライアントが送信した時刻（Unix マイクロ秒）
+	ClientTransmit int64 `json:"client_transmit_us"`
+}
+
+type TimeSyncHandler struct{}
+
+func NewTimeSyncHandler() *TimeSyncHandler {
+	return &TimeSyncHandler{}
+}
+
+// Sync returns the server receive and transmit timestamps for an NTP-style exchange
+func (h *TimeSyncHandler) Sync(w http.ResponseWriter, r *http.Request) {
+	// 受信時刻はできるだけ早く記録する
+	received := time.Now()
+
+	var req TimeSyncRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "リクエストボディが無効です")
+		return
+	}
+	if req.ClientTransmit <= 0 {
+		respondError(w, http.StatusBadRequest, "client_transmit_usが必要です")
+		return
+	}
+
+	w.Header().Set("Cache-Control", "no-store")
+	respondJSON(w, http.StatusOK, timesync.NewReply(req.ClientTransmit, received))
+}
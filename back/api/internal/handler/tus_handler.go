@@ -0,0 +1,255 @@
+package handler
+
+import (
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jphacks/os_2502/back/api/internal/domain/resumable_upload"
+	"github.com/jphacks/os_2502/back/api/internal/usecase"
+)
+
+// tus 1.0 の再開可能なアップロード
+// https://tus.io/protocols/resumable-upload
+const (
+	tusVersion    = "1.0.0"
+	tusExtensions = "creation,expiration,termination"
+	// tusContentType PATCH の本文の Content-Type
+	tusContentType = "application/offset+octet-stream"
+	// tusUploadsPath アップロードを作成するURL（個々のアップロードは {tusUploadsPath}/{upload_id}）
+	tusUploadsPath = "/api/uploads"
+)
+
+// TusHandler POST /api/uploads で作成し、HEAD で受信済みの位置を確認して PATCH で続きを送る
+// Upload-Metadata の group_id と frame_index でグループ撮影のフレームと紐付ける
+type TusHandler struct {
+	useCase *usecase.ResumableUploadUseCase
+}
+
+func NewTusHandler(useCase *usecase.ResumableUploadUseCase) *TusHandler {
+	return &TusHandler{useCase: useCase}
+}
+
+// TusMethod リクエストのメソッド（PATCH や DELETE を送れないクライアントは X-HTTP-Method-Override で指定する）
+func TusMethod(r *http.Request) string {
+	if m := r.Header.Get("X-HTTP-Method-Override"); m != "" {
+		return strings.ToUpper(m)
+	}
+	return r.Method
+}
+
+// checkResumable Tus-Resumable ヘッダーを付け、クライアントのバージョンが違えば 412 を返す
+func (h *TusHandler) checkResumable(w http.ResponseWriter, r *http.Request) bool {
+	w.Header().Set("Tus-Resumable", tusVersion)
+	if r.Header.Get("Tus-Resumable") != tusVersion {
+		w.Header().Set("Tus-Version", tusVersion)
+		respondError(w, http.StatusPreconditionFailed, "対応していないtusのバージョンです")
+		return false
+	}
+	return true
+}
+
+// Options OPTIONS /api/uploads サーバーが対応している機能
+func (h *TusHandler) Options(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Tus-Resumable", tusVersion)
+	w.Header().Set("Tus-Version", tusVersion)
+	w.Header().Set("Tus-Extension", tusExtensions)
+	w.Header().Set("Tus-Max-Size", strconv.FormatInt(usecase.PhotoUploadMaxSize, 10))
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// CreateUpload POST /api/uploads
+func (h *TusHandler) CreateUpload(w http.ResponseWriter, r *http.Request) {
+	if !h.checkResumable(w, r) {
+		return
+	}
+
+	me, ok := currentUser(w, r)
+	if !ok {
+		return
+	}
+
+	if r.Header.Get("Upload-Defer-Length") != "" {
+		respondError(w, http.StatusBadRequest, "Upload-Lengthが必要です")
+		return
+	}
+	length, err := strconv.ParseInt(r.Header.Get("Upload-Length"), 10, 64)
+	if err != nil || length < 0 {
+		respondError(w, http.StatusBadRequest, "Upload-Lengthが無効です")
+		return
+	}
+
+	rawMetadata := r.Header.Get("Upload-Metadata")
+	metadata, err := parseTusMetadata(rawMetadata)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Upload-Metadataが無効です")
+		return
+	}
+	groupID := metadata["group_id"]
+	if groupID == "" {
+		respondError(w, http.StatusBadRequest, "Upload-Metadataにgroup_idが必要です")
+		return
+	}
+	frameIndex, err := strconv.Atoi(metadata["frame_index"])
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Upload-Metadataのframe_indexが無効です")
+		return
+	}
+
+	var metadataPtr *string
+	if rawMetadata != "" {
+		metadataPtr = &rawMetadata
+	}
+	upload, err := h.useCase.CreateUpload(r.Context(), groupID, me.ID(), frameIndex, length, metadataPtr)
+	if err != nil {
+		respondResumableUploadError(w, err, "アップロードの作成に失敗しました")
+		return
+	}
+
+	w.Header().Set("Location", tusUploadsPath+"/"+upload.UploadID().String())
+	w.Header().Set("Upload-Expires", upload.ExpiresAt().UTC().Format(http.TimeFormat))
+	w.WriteHeader(http.StatusCreated)
+}
+
+// uploadIDFromPath /api/uploads/{upload_id}
+func uploadIDFromPath(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	id, err := uuid.Parse(strings.TrimPrefix(r.URL.Path, tusUploadsPath+"/"))
+	if err != nil {
+		respondError(w, http.StatusNotFound, resumable_upload.ErrUploadNotFound.Error())
+		return uuid.Nil, false
+	}
+	return id, true
+}
+
+// HeadUpload HEAD /api/uploads/{upload_id} 受信済みの位置
+func (h *TusHandler) HeadUpload(w http.ResponseWriter, r *http.Request) {
+	if !h.checkResumable(w, r) {
+		return
+	}
+	uploadID, ok := uploadIDFromPath(w, r)
+	if !ok {
+		return
+	}
+	me, ok := currentUser(w, r)
+	if !ok {
+		return
+	}
+
+	upload, err := h.useCase.GetUpload(r.Context(), uploadID, me.ID())
+	if err != nil {
+		respondResumableUploadError(w, err, "アップロードの取得に失敗しました")
+		return
+	}
+
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Upload-Offset", strconv.FormatInt(upload.Offset(), 10))
+	w.Header().Set("Upload-Length", strconv.FormatInt(upload.Length(), 10))
+	w.Header().Set("Upload-Expires", upload.ExpiresAt().UTC().Format(http.TimeFormat))
+	if metadata := upload.Metadata(); metadata != nil {
+		w.Header().Set("Upload-Metadata", *metadata)
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// PatchUpload PATCH /api/uploads/{upload_id} Upload-Offset の位置から本文のデータを追加
+func (h *TusHandler) PatchUpload(w http.ResponseWriter, r *http.Request) {
+	if !h.checkResumable(w, r) {
+		return
+	}
+	uploadID, ok := uploadIDFromPath(w, r)
+	if !ok {
+		return
+	}
+	me, ok := currentUser(w, r)
+	if !ok {
+		return
+	}
+
+	if r.Header.Get("Content-Type") != tusContentType {
+		respondError(w, http.StatusUnsupportedMediaType, "Content-Typeはapplication/offset+octet-streamを指定してください")
+		return
+	}
+	offset, err := strconv.ParseInt(r.Header.Get("Upload-Offset"), 10, 64)
+	if err != nil || offset < 0 {
+		respondError(w, http.StatusBadRequest, "Upload-Offsetが無効です")
+		return
+	}
+
+	upload, _, err := h.useCase.AppendData(r.Context(), uploadID, me.ID(), offset, r.Body)
+	if upload != nil {
+		w.Header().Set("Upload-Offset", strconv.FormatInt(upload.Offset(), 10))
+		w.Header().Set("Upload-Expires", upload.ExpiresAt().UTC().Format(http.TimeFormat))
+	}
+	if err != nil {
+		respondResumableUploadError(w, err, "アップロードの保存に失敗しました")
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// TerminateUpload DELETE /api/uploads/{upload_id}
+func (h *TusHandler) TerminateUpload(w http.ResponseWriter, r *http.Request) {
+	if !h.checkResumable(w, r) {
+		return
+	}
+	uploadID, ok := uploadIDFromPath(w, r)
+	if !ok {
+		return
+	}
+	me, ok := currentUser(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.useCase.TerminateUpload(r.Context(), uploadID, me.ID()); err != nil {
+		respondResumableUploadError(w, err, "アップロードの中止に失敗しました")
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// parseTusMetadata Upload-Metadata（"key base64value" をカンマで区切ったもの）を読む
+func parseTusMetadata(header string) (map[string]string, error) {
+	metadata := map[string]string{}
+	if strings.TrimSpace(header) == "" {
+		return metadata, nil
+	}
+	for _, pair := range strings.Split(header, ",") {
+		fields := strings.Fields(pair)
+		switch len(fields) {
+		case 1:
+			metadata[fields[0]] = ""
+		case 2:
+			value, err := base64.StdEncoding.DecodeString(fields[1])
+			if err != nil {
+				return nil, err
+			}
+			metadata[fields[0]] = string(value)
+		default:
+			return nil, errors.New("tus: invalid metadata pair")
+		}
+	}
+	return metadata, nil
+}
+
+// respondResumableUploadError 再開可能なアップロードのエラーを返す
+// 取り込みのエラーは直接アップロードと同じ
+func respondResumableUploadError(w http.ResponseWriter, err error, fallback string) {
+	switch err {
+	case resumable_upload.ErrInvalidFrameIndex, resumable_upload.ErrInvalidLength, resumable_upload.ErrMetadataTooLong:
+		respondError(w, http.StatusBadRequest, err.Error())
+	case resumable_upload.ErrUploadTooLarge, resumable_upload.ErrExceedsLength:
+		respondError(w, http.StatusRequestEntityTooLarge, err.Error())
+	case resumable_upload.ErrUploadNotFound:
+		respondError(w, http.StatusNotFound, err.Error())
+	case resumable_upload.ErrOffsetMismatch, resumable_upload.ErrUploadCompleted, resumable_upload.ErrNotReceived:
+		respondError(w, http.StatusConflict, err.Error())
+	case resumable_upload.ErrUploadExpired:
+		respondError(w, http.StatusGone, err.Error())
+	default:
+		respondPhotoUploadError(w, err, fallback)
+	}
+}
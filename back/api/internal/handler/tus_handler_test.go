@@ -0,0 +1,112 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jphacks/os_2502/back/api/internal/auth"
+	"github.com/jphacks/os_2502/back/api/internal/blobstore"
+	"github.com/jphacks/os_2502/back/api/internal/domain/resumable_upload"
+	"github.com/jphacks/os_2502/back/api/internal/domain/user"
+	"github.com/jphacks/os_2502/back/api/internal/usecase"
+)
+
+// memUploads メモリ上の再開可能なアップロード
+type memUploads struct {
+	resumable_upload.Repository
+	uploads map[uuid.UUID]*resumable_upload.ResumableUpload
+}
+
+func (m *memUploads) FindByID(ctx context.Context, uploadID uuid.UUID) (*resumable_upload.ResumableUpload, error) {
+	u, ok := m.uploads[uploadID]
+	if !ok {
+		return nil, resumable_upload.ErrUploadNotFound
+	}
+	return u, nil
+}
+
+func (m *memUploads) UpdateOffset(ctx context.Context, upload *resumable_upload.ResumableUpload, from int64) error {
+	return nil
+}
+
+func (m *memUploads) Delete(ctx context.Context, uploadID uuid.UUID) error {
+	delete(m.uploads, uploadID)
+	return nil
+}
+
+func TestTusHandler(t *testing.T) {
+	store := blobstore.NewLocalStore(t.TempDir(), "", nil)
+	me, err := user.Reconstruct(uuid.New(), "firebase-uid", "me", nil, time.Now(), time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	upload, err := resumable_upload.NewResumableUpload("g", me.ID(), 0, 10, usecase.PhotoUploadMaxSize, nil, time.Now().Add(time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	repo := &memUploads{uploads: map[uuid.UUID]*resumable_upload.ResumableUpload{upload.UploadID(): upload}}
+	h := NewTusHandler(usecase.NewResumableUploadUseCase(repo, nil, store))
+	path := tusUploadsPath + "/" + upload.UploadID().String()
+
+	do := func(method, offset, body string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(method, path, strings.NewReader(body))
+		r = r.WithContext(auth.WithUser(r.Context(), me))
+		r.Header.Set("Tus-Resumable", tusVersion)
+		w := httptest.NewRecorder()
+		switch method {
+		case http.MethodHead:
+			h.HeadUpload(w, r)
+		case http.MethodPatch:
+			r.Header.Set("Content-Type", tusContentType)
+			r.Header.Set("Upload-Offset", offset)
+			h.PatchUpload(w, r)
+		case http.MethodDelete:
+			h.TerminateUpload(w, r)
+		}
+		return w
+	}
+
+	if w := do(http.MethodPatch, "0", "abcd"); w.Code != http.StatusNoContent || w.Header().Get("Upload-Offset") != "4" {
+		t.Fatalf("PATCH: status %d, offset %q, want 204 and 4", w.Code, w.Header().Get("Upload-Offset"))
+	}
+
+	// HEAD で受信済みの位置を返す
+	w := do(http.MethodHead, "", "")
+	if w.Code != http.StatusOK || w.Header().Get("Upload-Offset") != "4" || w.Header().Get("Upload-Length") != "10" {
+		t.Errorf("HEAD: status %d, offset %q, length %q, want 200, 4, 10",
+			w.Code, w.Header().Get("Upload-Offset"), w.Header().Get("Upload-Length"))
+	}
+
+	// 受信済みの位置と違う位置からは送れない
+	if w := do(http.MethodPatch, "0", "abcd"); w.Code != http.StatusConflict {
+		t.Errorf("PATCH with stale offset: status %d, want 409", w.Code)
+	}
+
+	// Upload-Length を超えるデータは受け取らない
+	if w := do(http.MethodPatch, "4", "efghijk"); w.Code != http.StatusRequestEntityTooLarge {
+		t.Errorf("PATCH over Upload-Length: status %d, want 413", w.Code)
+	}
+	if upload.Offset() != 4 {
+		t.Errorf("offset after rejected PATCH = %d, want 4", upload.Offset())
+	}
+
+	// 中止すると受信済みのデータも削除され、以降は見つからない
+	if w := do(http.MethodDelete, "", ""); w.Code != http.StatusNoContent {
+		t.Fatalf("DELETE: status %d, want 204", w.Code)
+	}
+	if w := do(http.MethodHead, "", ""); w.Code != http.StatusNotFound {
+		t.Errorf("HEAD after termination: status %d, want 404", w.Code)
+	}
+	parts, err := store.List(context.Background(), upload.PartPrefix())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(parts) != 0 {
+		t.Errorf("parts left after termination: %+v", parts)
+	}
+}
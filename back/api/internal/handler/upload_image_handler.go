@@ -8,6 +8,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/jphacks/os_2502/back/api/internal/domain/upload_image"
+	"github.com/jphacks/os_2502/back/api/internal/policy"
 	"github.com/jphacks/os_2502/back/api/internal/usecase"
 )
 
@@ -26,23 +27,35 @@ type UploadImageRequest struct {
 }
 
 type UploadImageResponse struct {
-	ImageID    string `json:"image_id"`
-	FileURL    string `json:"file_url"`
-	GroupID    string `json:"group_id"`
-	UserID     string `json:"user_id"`
-	CollageDay string `json:"collage_day"`
-	CreatedAt  string `json:"created_at"`
+	ImageID        string  `json:"image_id"`
+	FileURL        string  `json:"file_url"`
+	GroupID        string  `json:"group_id"`
+	UserID         string  `json:"user_id"`
+	FrameIndex     *int    `json:"frame_index,omitempty"`
+	CapturedAt     *string `json:"captured_at,omitempty"`
+	OriginalWidth  *int    `json:"original_width,omitempty"`
+	OriginalHeight *int    `json:"original_height,omitempty"`
+	CollageDay     string  `json:"collage_day"`
+	CreatedAt      string  `json:"created_at"`
 }
 
 func toUploadImageResponse(ui *upload_image.UploadImage) UploadImageResponse {
-	return UploadImageResponse{
-		ImageID:    ui.ImageID().String(),
-		FileURL:    ui.FileURL(),
-		GroupID:    ui.GroupID(),
-		UserID:     ui.UserID().String(),
-		CollageDay: ui.CollageDay().Format("2006-01-02"),
-		CreatedAt:  ui.CreatedAt().Format("2006-01-02T15:04:05Z07:00"),
-	}
+	res := UploadImageResponse{
+		ImageID:        ui.ImageID().String(),
+		FileURL:        ui.FileURL(),
+		GroupID:        ui.GroupID(),
+		UserID:         ui.UserID().String(),
+		FrameIndex:     ui.FrameIndex(),
+		OriginalWidth:  ui.OriginalWidth(),
+		OriginalHeight: ui.OriginalHeight(),
+		CollageDay:     ui.CollageDay().Format("2006-01-02"),
+		CreatedAt:      ui.CreatedAt().Format("2006-01-02T15:04:05Z07:00"),
+	}
+	if t := ui.CapturedAt(); t != nil {
+		capturedAt := t.Format("2006-01-02T15:04:05Z07:00")
+		res.CapturedAt = &capturedAt
+	}
+	return res
 }
 
 func (h *UploadImageHandler) UploadImage(w http.ResponseWriter, r *http.Request) {
@@ -51,18 +64,11 @@ func (h *UploadImageHandler) UploadImage(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	// TODO: 実際の実装ではJWTトークンなどから現在のユーザーIDを取得する
-	userIDStr := r.Header.Get("X-User-ID")
-	if userIDStr == "" {
-		respondError(w, http.StatusUnauthorized, "ユーザー認証が必要です")
-		return
-	}
-
-	userID, err := uuid.Parse(userIDStr)
-	if err != nil {
-		respondError(w, http.StatusBadRequest, "無効なユーザーIDです")
+	me, ok := currentUser(w, r)
+	if !ok {
 		return
 	}
+	userID := me.ID()
 
 	var req UploadImageRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
@@ -81,6 +87,8 @@ func (h *UploadImageHandler) UploadImage(w http.ResponseWriter, r *http.Request)
 		switch err {
 		case upload_image.ErrInvalidFileURL, upload_image.ErrInvalidGroupID, upload_image.ErrInvalidUserID:
 			respondError(w, http.StatusBadRequest, err.Error())
+		case policy.ErrForbidden:
+			respondError(w, http.StatusForbidden, err.Error())
 		default:
 			respondError(w, http.StatusInternalServerError, "画像のアップロードに失敗しました")
 		}
@@ -96,6 +104,11 @@ func (h *UploadImageHandler) GetImage(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	me, ok := currentUser(w, r)
+	if !ok {
+		return
+	}
+
 	idStr := r.URL.Path[len("/api/images/"):]
 	if idStr == "" {
 		respondError(w, http.StatusBadRequest, "画像IDが必要です")
@@ -108,11 +121,14 @@ func (h *UploadImageHandler) GetImage(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	image, err := h.useCase.GetImage(r.Context(), id)
+	image, err := h.useCase.GetImage(r.Context(), id, me.ID())
 	if err != nil {
-		if err == upload_image.ErrImageNotFound {
+		switch err {
+		case upload_image.ErrImageNotFound:
 			respondError(w, http.StatusNotFound, err.Error())
-		} else {
+		case policy.ErrForbidden:
+			respondError(w, http.StatusForbidden, err.Error())
+		default:
 			respondError(w, http.StatusInternalServerError, "画像の取得に失敗しました")
 		}
 		return
@@ -127,6 +143,11 @@ func (h *UploadImageHandler) GetImagesByGroup(w http.ResponseWriter, r *http.Req
 		return
 	}
 
+	me, ok := currentUser(w, r)
+	if !ok {
+		return
+	}
+
 	groupID := r.URL.Query().Get("group_id")
 	if groupID == "" {
 		respondError(w, http.StatusBadRequest, "グループIDが必要です")
@@ -151,9 +172,13 @@ func (h *UploadImageHandler) GetImagesByGroup(w http.ResponseWriter, r *http.Req
 		}
 	}
 
-	images, err := h.useCase.GetImagesByGroup(r.Context(), groupID, limit, offset)
+	images, err := h.useCase.GetImagesByGroup(r.Context(), groupID, me.ID(), limit, offset)
 	if err != nil {
-		respondError(w, http.StatusInternalServerError, "画像一覧の取得に失敗しました")
+		if err == policy.ErrForbidden {
+			respondError(w, http.StatusForbidden, err.Error())
+		} else {
+			respondError(w, http.StatusInternalServerError, "画像一覧の取得に失敗しました")
+		}
 		return
 	}
 
@@ -176,17 +201,11 @@ func (h *UploadImageHandler) DeleteImage(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	userIDStr := r.Header.Get("X-User-ID")
-	if userIDStr == "" {
-		respondError(w, http.StatusUnauthorized, "ユーザー認証が必要です")
-		return
-	}
-
-	userID, err := uuid.Parse(userIDStr)
-	if err != nil {
-		respondError(w, http.StatusBadRequest, "無効なユーザーIDです")
+	me, ok := currentUser(w, r)
+	if !ok {
 		return
 	}
+	userID := me.ID()
 
 	idStr := r.URL.Path[len("/api/images/"):]
 	if idStr == "" {
@@ -6,7 +6,9 @@ import (
 	"strconv"
 
 	"github.com/google/uuid"
+	"github.com/jphacks/os_2502/back/api/internal/domain/collage_result"
 	"github.com/jphacks/os_2502/back/api/internal/domain/upload_images_collage_result"
+	"github.com/jphacks/os_2502/back/api/internal/policy"
 	"github.com/jphacks/os_2502/back/api/internal/usecase"
 )
 
@@ -70,6 +72,11 @@ func (h *UploadImagesCollageResultHandler) CreateUploadImagesCollageResult(w htt
 		return
 	}
 
+	me, ok := currentUser(w, r)
+	if !ok {
+		return
+	}
+
 	var req CreateUploadImagesCollageResultRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		respondError(w, http.StatusBadRequest, "リクエストボディが無効です")
@@ -90,6 +97,7 @@ func (h *UploadImagesCollageResultHandler) CreateUploadImagesCollageResult(w htt
 
 	uicr, err := h.useCase.CreateUploadImagesCollageResult(
 		r.Context(),
+		me.ID(),
 		imageID,
 		resultID,
 		req.PositionX,
@@ -104,6 +112,10 @@ func (h *UploadImagesCollageResultHandler) CreateUploadImagesCollageResult(w htt
 			respondError(w, http.StatusBadRequest, err.Error())
 		case upload_images_collage_result.ErrUploadImagesCollageResultAlreadyExists:
 			respondError(w, http.StatusConflict, err.Error())
+		case collage_result.ErrResultNotFound:
+			respondError(w, http.StatusNotFound, err.Error())
+		case policy.ErrForbidden:
+			respondError(w, http.StatusForbidden, err.Error())
 		default:
 			respondError(w, http.StatusInternalServerError, "画像コラージュ結果の作成に失敗しました")
 		}
@@ -119,6 +131,11 @@ func (h *UploadImagesCollageResultHandler) GetUploadImagesCollageResultByImageID
 		return
 	}
 
+	me, ok := currentUser(w, r)
+	if !ok {
+		return
+	}
+
 	imageIDStr := r.URL.Query().Get("image_id")
 	if imageIDStr == "" {
 		respondError(w, http.StatusBadRequest, "画像IDが必要です")
@@ -143,11 +160,16 @@ func (h *UploadImagesCollageResultHandler) GetUploadImagesCollageResultByImageID
 		return
 	}
 
-	uicr, err := h.useCase.GetUploadImagesCollageResultByImageIDAndResultID(r.Context(), imageID, resultID)
+	uicr, err := h.useCase.GetUploadImagesCollageResultByImageIDAndResultID(r.Context(), me.ID(), imageID, resultID)
 	if err != nil {
-		if err == upload_images_collage_result.ErrUploadImagesCollageResultNotFound {
+		switch err {
+		case upload_images_collage_result.ErrUploadImagesCollageResultNotFound:
 			respondError(w, http.StatusNotFound, err.Error())
-		} else {
+		case collage_result.ErrResultNotFound:
+			respondError(w, http.StatusNotFound, err.Error())
+		case policy.ErrForbidden:
+			respondError(w, http.StatusForbidden, err.Error())
+		default:
 			respondError(w, http.StatusInternalServerError, "画像コラージュ結果の取得に失敗しました")
 		}
 		return
@@ -162,6 +184,11 @@ func (h *UploadImagesCollageResultHandler) UpdateUploadImagesCollageResultPositi
 		return
 	}
 
+	me, ok := currentUser(w, r)
+	if !ok {
+		return
+	}
+
 	imageIDStr := r.URL.Query().Get("image_id")
 	if imageIDStr == "" {
 		respondError(w, http.StatusBadRequest, "画像IDが必要です")
@@ -192,13 +219,17 @@ func (h *UploadImagesCollageResultHandler) UpdateUploadImagesCollageResultPositi
 		return
 	}
 
-	uicr, err := h.useCase.UpdateUploadImagesCollageResultPosition(r.Context(), imageID, resultID, req.PositionX, req.PositionY, req.Width, req.Height)
+	uicr, err := h.useCase.UpdateUploadImagesCollageResultPosition(r.Context(), me.ID(), imageID, resultID, req.PositionX, req.PositionY, req.Width, req.Height)
 	if err != nil {
 		switch err {
 		case upload_images_collage_result.ErrInvalidDimensions:
 			respondError(w, http.StatusBadRequest, err.Error())
 		case upload_images_collage_result.ErrUploadImagesCollageResultNotFound:
 			respondError(w, http.StatusNotFound, err.Error())
+		case collage_result.ErrResultNotFound:
+			respondError(w, http.StatusNotFound, err.Error())
+		case policy.ErrForbidden:
+			respondError(w, http.StatusForbidden, err.Error())
 		default:
 			respondError(w, http.StatusInternalServerError, "画像コラージュ結果の位置更新に失敗しました")
 		}
@@ -214,6 +245,11 @@ func (h *UploadImagesCollageResultHandler) UpdateUploadImagesCollageResultSortOr
 		return
 	}
 
+	me, ok := currentUser(w, r)
+	if !ok {
+		return
+	}
+
 	imageIDStr := r.URL.Query().Get("image_id")
 	if imageIDStr == "" {
 		respondError(w, http.StatusBadRequest, "画像IDが必要です")
@@ -244,11 +280,16 @@ func (h *UploadImagesCollageResultHandler) UpdateUploadImagesCollageResultSortOr
 		return
 	}
 
-	uicr, err := h.useCase.UpdateUploadImagesCollageResultSortOrder(r.Context(), imageID, resultID, req.SortOrder)
+	uicr, err := h.useCase.UpdateUploadImagesCollageResultSortOrder(r.Context(), me.ID(), imageID, resultID, req.SortOrder)
 	if err != nil {
-		if err == upload_images_collage_result.ErrUploadImagesCollageResultNotFound {
+		switch err {
+		case upload_images_collage_result.ErrUploadImagesCollageResultNotFound:
 			respondError(w, http.StatusNotFound, err.Error())
-		} else {
+		case collage_result.ErrResultNotFound:
+			respondError(w, http.StatusNotFound, err.Error())
+		case policy.ErrForbidden:
+			respondError(w, http.StatusForbidden, err.Error())
+		default:
 			respondError(w, http.StatusInternalServerError, "画像コラージュ結果のソート順更新に失敗しました")
 		}
 		return
@@ -263,6 +304,11 @@ func (h *UploadImagesCollageResultHandler) DeleteUploadImagesCollageResult(w htt
 		return
 	}
 
+	me, ok := currentUser(w, r)
+	if !ok {
+		return
+	}
+
 	imageIDStr := r.URL.Query().Get("image_id")
 	if imageIDStr == "" {
 		respondError(w, http.StatusBadRequest, "画像IDが必要です")
@@ -287,10 +333,15 @@ func (h *UploadImagesCollageResultHandler) DeleteUploadImagesCollageResult(w htt
 		return
 	}
 
-	if err := h.useCase.DeleteUploadImagesCollageResult(r.Context(), imageID, resultID); err != nil {
-		if err == upload_images_collage_result.ErrUploadImagesCollageResultNotFound {
+	if err := h.useCase.DeleteUploadImagesCollageResult(r.Context(), me.ID(), imageID, resultID); err != nil {
+		switch err {
+		case upload_images_collage_result.ErrUploadImagesCollageResultNotFound:
+			respondError(w, http.StatusNotFound, err.Error())
+		case collage_result.ErrResultNotFound:
 			respondError(w, http.StatusNotFound, err.Error())
-		} else {
+		case policy.ErrForbidden:
+			respondError(w, http.StatusForbidden, err.Error())
+		default:
 			respondError(w, http.StatusInternalServerError, "画像コラージュ結果の削除に失敗しました")
 		}
 		return
@@ -305,6 +356,11 @@ func (h *UploadImagesCollageResultHandler) ListUploadImagesCollageResults(w http
 		return
 	}
 
+	me, ok := currentUser(w, r)
+	if !ok {
+		return
+	}
+
 	// クエリパラメータからlimitとoffsetを取得
 	limitStr := r.URL.Query().Get("limit")
 	offsetStr := r.URL.Query().Get("offset")
@@ -324,7 +380,7 @@ func (h *UploadImagesCollageResultHandler) ListUploadImagesCollageResults(w http
 		}
 	}
 
-	results, err := h.useCase.ListUploadImagesCollageResults(r.Context(), limit, offset)
+	results, err := h.useCase.ListUploadImagesCollageResults(r.Context(), me.ID(), limit, offset)
 	if err != nil {
 		respondError(w, http.StatusInternalServerError, "画像コラージュ結果一覧の取得に失敗しました")
 		return
@@ -349,6 +405,11 @@ func (h *UploadImagesCollageResultHandler) GetUploadImagesCollageResultsByImageI
 		return
 	}
 
+	me, ok := currentUser(w, r)
+	if !ok {
+		return
+	}
+
 	imageIDStr := r.URL.Query().Get("image_id")
 	if imageIDStr == "" {
 		respondError(w, http.StatusBadRequest, "画像IDが必要です")
@@ -361,7 +422,7 @@ func (h *UploadImagesCollageResultHandler) GetUploadImagesCollageResultsByImageI
 		return
 	}
 
-	results, err := h.useCase.GetUploadImagesCollageResultsByImageID(r.Context(), imageID)
+	results, err := h.useCase.GetUploadImagesCollageResultsByImageID(r.Context(), me.ID(), imageID)
 	if err != nil {
 		respondError(w, http.StatusInternalServerError, "画像コラージュ結果の取得に失敗しました")
 		return
@@ -384,6 +445,11 @@ func (h *UploadImagesCollageResultHandler) GetUploadImagesCollageResultsByResult
 		return
 	}
 
+	me, ok := currentUser(w, r)
+	if !ok {
+		return
+	}
+
 	resultIDStr := r.URL.Query().Get("result_id")
 	if resultIDStr == "" {
 		respondError(w, http.StatusBadRequest, "結果IDが必要です")
@@ -396,9 +462,16 @@ func (h *UploadImagesCollageResultHandler) GetUploadImagesCollageResultsByResult
 		return
 	}
 
-	results, err := h.useCase.GetUploadImagesCollageResultsByResultID(r.Context(), resultID)
+	results, err := h.useCase.GetUploadImagesCollageResultsByResultID(r.Context(), me.ID(), resultID)
 	if err != nil {
-		respondError(w, http.StatusInternalServerError, "画像コラージュ結果の取得に失敗しました")
+		switch err {
+		case collage_result.ErrResultNotFound:
+			respondError(w, http.StatusNotFound, err.Error())
+		case policy.ErrForbidden:
+			respondError(w, http.StatusForbidden, err.Error())
+		default:
+			respondError(w, http.StatusInternalServerError, "画像コラージュ結果の取得に失敗しました")
+		}
 		return
 	}
 
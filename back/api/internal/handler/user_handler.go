@@ -6,6 +6,7 @@ import (
 	"strconv"
 
 	"github.com/google/uuid"
+	"github.com/jphacks/os_2502/back/api/internal/auth"
 	"github.com/jphacks/os_2502/back/api/internal/domain/user"
 	"github.com/jphacks/os_2502/back/api/internal/usecase"
 )
@@ -19,8 +20,7 @@ func NewUserHandler(useCase *usecase.UserUseCase) *UserHandler {
 }
 
 type CreateUserRequest struct {
-	FirebaseUID string `json:"firebase_uid"`
-	Name        string `json:"name"`
+	Name string `json:"name"`
 }
 
 type UpdateUserRequest struct {
@@ -39,6 +39,8 @@ type UserResponse struct {
 type ErrorResponse struct {
 	Error   string `json:"error"`
 	Message string `json:"message"`
+	// Code クライアントが判別するためのエラーコード（写真の取り込みなど、必要な場合のみ）
+	Code string `json:"code,omitempty"`
 }
 
 // UserエンティティをUserResponseに変換
@@ -59,13 +61,20 @@ func (h *UserHandler) CreateUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// Firebase UID は検証済みのトークンから取る
+	token, ok := auth.TokenFromContext(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "ユーザー認証が必要です")
+		return
+	}
+
 	var req CreateUserRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		respondError(w, http.StatusBadRequest, "リクエストボディが無効です")
 		return
 	}
 
-	u, err := h.useCase.CreateUser(r.Context(), req.FirebaseUID, req.Name)
+	u, err := h.useCase.CreateUser(r.Context(), token.UID, req.Name)
 	if err != nil {
 		switch err {
 		case user.ErrInvalidFirebaseUID, user.ErrInvalidName:
@@ -119,13 +128,14 @@ func (h *UserHandler) GetUserByFirebaseUID(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
-	firebaseUID := r.URL.Query().Get("firebase_uid")
-	if firebaseUID == "" {
-		respondError(w, http.StatusBadRequest, "Firebase UIDが必要です")
+	// 自分が登録済みかの確認に使うため、トークンの Firebase UID で検索する
+	token, ok := auth.TokenFromContext(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "ユーザー認証が必要です")
 		return
 	}
 
-	u, err := h.useCase.GetUserByFirebaseUID(r.Context(), firebaseUID)
+	u, err := h.useCase.GetUserByFirebaseUID(r.Context(), token.UID)
 	if err != nil {
 		if err == user.ErrUserNotFound {
 			respondError(w, http.StatusNotFound, err.Error())
@@ -157,6 +167,10 @@ func (h *UserHandler) UpdateUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if !isSelf(w, r, id) {
+		return
+	}
+
 	var req UpdateUserRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		respondError(w, http.StatusBadRequest, "リクエストボディが無効です")
@@ -198,6 +212,10 @@ func (h *UserHandler) DeleteUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if !isSelf(w, r, id) {
+		return
+	}
+
 	if err := h.useCase.DeleteUser(r.Context(), id); err != nil {
 		if err == user.ErrUserNotFound {
 			respondError(w, http.StatusNotFound, err.Error())
@@ -275,6 +293,10 @@ func (h *UserHandler) SetUsername(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if !isSelf(w, r, id) {
+		return
+	}
+
 	var req struct {
 		Username string `json:"username"`
 	}
@@ -375,6 +397,19 @@ func (h *UserHandler) SearchUsersByUsername(w http.ResponseWriter, r *http.Reque
 	})
 }
 
+// isSelf id が呼び出し元のユーザーか確認し、違う場合は 403 を返して false
+func isSelf(w http.ResponseWriter, r *http.Request, id uuid.UUID) bool {
+	me, ok := currentUser(w, r)
+	if !ok {
+		return false
+	}
+	if me.ID() != id {
+		respondError(w, http.StatusForbidden, "他のユーザーは変更できません")
+		return false
+	}
+	return true
+}
+
 // respondJSON JSONレスポンスを返す
 func respondJSON(w http.ResponseWriter, status int, data interface{}) {
 	w.Header().Set("Content-Type", "application/json")
@@ -389,3 +424,12 @@ func respondError(w http.ResponseWriter, status int, message string) {
 		Message: message,
 	})
 }
+
+// respondErrorWithCode エラーコード付きのエラーレスポンス
+func respondErrorWithCode(w http.ResponseWriter, status int, code, message string) {
+	respondJSON(w, status, ErrorResponse{
+		Error:   http.StatusText(status),
+		Message: message,
+		Code:    code,
+	})
+}
@@ -5,10 +5,13 @@ import (
 	"encoding/json"
 	"log"
 	"net/http"
-	"sync"
 	"time"
 
 	"github.com/gorilla/websocket"
+	"github.com/jphacks/os_2502/back/api/internal/policy"
+	"github.com/jphacks/os_2502/back/api/internal/realtime"
+	"github.com/jphacks/os_2502/back/api/internal/timesync"
+	"github.com/jphacks/os_2502/back/api/internal/usecase"
 	"github.com/jphacks/os_2502/back/api/internal/worker"
 )
 
@@ -21,29 +24,95 @@ var upgrader = websocket.Upgrader{
 	},
 }
 
+const (
+	// pingInterval ハートビートの間隔
+	pingInterval = 30 * time.Second
+	// pongWait この時間内に pong が返らなければ切断
+	pongWait = 2 * pingInterval
+	// writeWait 1回の書き込みのタイムアウト
+	writeWait = 10 * time.Second
+)
+
+// クライアントから送られるメッセージの種類
+const (
+	// messageTimeSync 時刻同期の1回分の交換。timesync.Reply を返す
+	messageTimeSync = "time_sync"
+	// messageClockSync 時刻同期の結果の報告。メンバーの時計のずれを記録する
+	messageClockSync = "clock_sync"
+)
+
+// clientMessage クライアントから送られるメッセージ
+type clientMessage struct {
+	Type           string            `json:"type"`
+	ClientTransmit int64             `json:"client_transmit_us,omitempty"`
+	Samples        []timesync.Sample `json:"samples,omitempty"`
+}
+
+// timeSyncMessage time_sync への応答
+type timeSyncMessage struct {
+	Type string `json:"type"`
+	timesync.Reply
+}
+
+// clockSyncMessage clock_sync への応答
+type clockSyncMessage struct {
+	Type  string               `json:"type"`
+	Clock *MemberClockResponse `json:"clock,omitempty"`
+	Error string               `json:"error,omitempty"`
+}
+
 // WebSocketHandler WebSocketハンドラー
 type WebSocketHandler struct {
 	monitor *worker.UploadMonitor
-	clients map[string]map[*websocket.Conn]bool
-	mu      sync.RWMutex
+	hub     *realtime.Hub
+	groupUC *usecase.GroupUseCase
+	authz   *policy.Policy
 }
 
 // NewWebSocketHandler WebSocketハンドラーを作成
-func NewWebSocketHandler(monitor *worker.UploadMonitor) *WebSocketHandler {
+func NewWebSocketHandler(monitor *worker.UploadMonitor, hub *realtime.Hub, groupUC *usecase.GroupUseCase, authz *policy.Policy) *WebSocketHandler {
 	return &WebSocketHandler{
 		monitor: monitor,
-		clients: make(map[string]map[*websocket.Conn]bool),
+		hub:     hub,
+		groupUC: groupUC,
+		authz:   authz,
+	}
+}
+
+// authorizeGroup グループのメンバーでなければ403を返す
+func (h *WebSocketHandler) authorizeGroup(w http.ResponseWriter, r *http.Request, userID, groupID string) bool {
+	if err := h.authz.CanViewGroup(r.Context(), userID, groupID); err != nil {
+		if err == policy.ErrForbidden {
+			respondError(w, http.StatusForbidden, err.Error())
+		} else {
+			respondError(w, http.StatusInternalServerError, "権限の確認に失敗しました")
+		}
+		return false
 	}
+	return true
 }
 
-// HandleUploadStatus アップロード状況のWebSocket接続
-func (h *WebSocketHandler) HandleUploadStatus(w http.ResponseWriter, r *http.Request) {
+// HandleGroupEvents グループのイベントを配信するWebSocket接続
+// ユースケースが発行したイベントをそのまま送るため、接続ごとのDB問い合わせは行わない。
+// 同じ接続で time_sync / clock_sync メッセージによる時刻同期も受け付ける
+func (h *WebSocketHandler) HandleGroupEvents(w http.ResponseWriter, r *http.Request) {
 	groupID := r.URL.Query().Get("group_id")
 	if groupID == "" {
 		http.Error(w, "group_id is required", http.StatusBadRequest)
 		return
 	}
 
+	me, ok := currentUser(w, r)
+	if !ok {
+		return
+	}
+	userID := me.ID().String()
+
+	// アップグレード前にメンバーかチェック（アップグレード後はステータスコードを返せない）
+	if !h.authorizeGroup(w, r, userID, groupID) {
+		return
+	}
+
 	// WebSocketにアップグレード
 	conn, err := upgrader.Upgrade(w, r, nil)
 	if err != nil {
@@ -52,132 +121,111 @@ func (h *WebSocketHandler) HandleUploadStatus(w http.ResponseWriter, r *http.Req
 	}
 	defer conn.Close()
 
-	// クライアントを登録
-	h.registerClient(groupID, conn)
-	defer h.unregisterClient(groupID, conn)
+	// イベントを購読
+	sub := h.hub.Subscribe(groupID)
+	defer sub.Close()
 
 	log.Printf("WebSocket client connected for group %s", groupID)
 
-	// コンテキストを作成
-	ctx, cancel := context.WithCancel(r.Context())
-	defer cancel()
+	// 読み取りループからの応答。送信時刻を書き込み直前に決めるため、メッセージを作る関数を渡す
+	replies := make(chan func() interface{}, 8)
+
+	// クライアントからのメッセージ、切断、pong を処理する読み取りループ
+	closed := make(chan struct{})
+	go func() {
+		defer close(closed)
+		conn.SetReadDeadline(time.Now().Add(pongWait))
+		conn.SetPongHandler(func(string) error {
+			return conn.SetReadDeadline(time.Now().Add(pongWait))
+		})
+		for {
+			_, data, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			received := time.Now()
 
-	// ハートビート用のゴルーチン
-	go h.heartbeat(ctx, conn)
+			reply := h.handleClientMessage(r.Context(), groupID, userID, data, received)
+			if reply == nil {
+				continue
+			}
+			select {
+			case replies <- reply:
+			default:
+				log.Printf("⚠️ Dropping reply for group %s: writer is busy", groupID)
+			}
+		}
+	}()
 
-	// 定期的にステータスを送信
-	ticker := time.NewTicker(2 * time.Second)
+	// 書き込みはこのゴルーチンだけで行う
+	ticker := time.NewTicker(pingInterval)
 	defer ticker.Stop()
 
 	for {
 		select {
-		case <-ticker.C:
-			// ステータスを取得
-			status, err := h.monitor.CheckUploadStatus(ctx, groupID)
-			if err != nil {
-				log.Printf("Failed to check upload status: %v", err)
-				continue
+		case event, ok := <-sub.Events():
+			if !ok {
+				// 配信が追いつかず購読が切られた
+				conn.WriteControl(websocket.CloseMessage,
+					websocket.FormatCloseMessage(websocket.CloseTryAgainLater, "subscriber too slow"),
+					time.Now().Add(writeWait))
+				return
 			}
 
-			// JSON に変換
-			data, err := json.Marshal(status)
-			if err != nil {
-				log.Printf("Failed to marshal status: %v", err)
-				continue
+			conn.SetWriteDeadline(time.Now().Add(writeWait))
+			if err := conn.WriteJSON(event); err != nil {
+				log.Printf("Failed to send message: %v", err)
+				return
 			}
 
-			// クライアントに送信
-			if err := conn.WriteMessage(websocket.TextMessage, data); err != nil {
+		case reply := <-replies:
+			conn.SetWriteDeadline(time.Now().Add(writeWait))
+			if err := conn.WriteJSON(reply()); err != nil {
 				log.Printf("Failed to send message: %v", err)
 				return
 			}
 
-			// 全員アップロード完了の場合は接続を閉じる
-			if status.Uploaded >= status.Total && status.Status == "completed" {
-				log.Printf("All uploads completed for group %s, closing connection", groupID)
-
-				// 完了メッセージを送信
-				completedMsg := map[string]interface{}{
-					"type":    "completed",
-					"message": "コラージュ生成中...",
-				}
-				msgData, _ := json.Marshal(completedMsg)
-				conn.WriteMessage(websocket.TextMessage, msgData)
-
-				time.Sleep(1 * time.Second)
+		case <-ticker.C:
+			if err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(writeWait)); err != nil {
+				log.Printf("Failed to send ping: %v", err)
 				return
 			}
 
-		case <-ctx.Done():
+		case <-closed:
+			log.Printf("WebSocket client disconnected for group %s", groupID)
 			return
-		}
-	}
-}
-
-// registerClient クライアントを登録
-func (h *WebSocketHandler) registerClient(groupID string, conn *websocket.Conn) {
-	h.mu.Lock()
-	defer h.mu.Unlock()
-
-	if h.clients[groupID] == nil {
-		h.clients[groupID] = make(map[*websocket.Conn]bool)
-	}
-	h.clients[groupID][conn] = true
-}
-
-// unregisterClient クライアントを登録解除
-func (h *WebSocketHandler) unregisterClient(groupID string, conn *websocket.Conn) {
-	h.mu.Lock()
-	defer h.mu.Unlock()
 
-	if h.clients[groupID] != nil {
-		delete(h.clients[groupID], conn)
-		if len(h.clients[groupID]) == 0 {
-			delete(h.clients, groupID)
+		case <-r.Context().Done():
+			return
 		}
 	}
 }
 
-// BroadcastToGroup グループの全クライアントにメッセージを送信
-func (h *WebSocketHandler) BroadcastToGroup(groupID string, message interface{}) {
-	h.mu.RLock()
-	clients := h.clients[groupID]
-	h.mu.RUnlock()
-
-	if clients == nil {
-		return
-	}
-
-	data, err := json.Marshal(message)
-	if err != nil {
-		log.Printf("Failed to marshal message: %v", err)
-		return
+// handleClientMessage クライアントからのメッセージを処理し、返信が必要なら返信を作る関数を返す
+func (h *WebSocketHandler) handleClientMessage(ctx context.Context, groupID, userID string, data []byte, received time.Time) func() interface{} {
+	var msg clientMessage
+	if err := json.Unmarshal(data, &msg); err != nil {
+		return nil
 	}
 
-	for conn := range clients {
-		if err := conn.WriteMessage(websocket.TextMessage, data); err != nil {
-			log.Printf("Failed to send message to client: %v", err)
+	switch msg.Type {
+	case messageTimeSync:
+		return func() interface{} {
+			return timeSyncMessage{Type: messageTimeSync, Reply: timesync.NewReply(msg.ClientTransmit, received)}
 		}
-	}
-}
-
-// heartbeat ハートビート
-func (h *WebSocketHandler) heartbeat(ctx context.Context, conn *websocket.Conn) {
-	ticker := time.NewTicker(30 * time.Second)
-	defer ticker.Stop()
-
-	for {
-		select {
-		case <-ticker.C:
-			if err := conn.WriteMessage(websocket.PingMessage, []byte{}); err != nil {
-				log.Printf("Failed to send ping: %v", err)
-				return
-			}
 
-		case <-ctx.Done():
-			return
+	case messageClockSync:
+		result := clockSyncMessage{Type: messageClockSync}
+		if m, err := h.groupUC.RecordClockSync(ctx, groupID, userID, msg.Samples); err != nil {
+			result.Error = err.Error()
+		} else {
+			clock := toMemberClockResponse(m, time.Now())
+			result.Clock = &clock
 		}
+		return func() interface{} { return result }
 	}
+
+	return nil
 }
 
 // HandleStatus ステータス確認用のHTTPエンドポイント（ポーリング用）
@@ -193,6 +241,14 @@ func (h *WebSocketHandler) HandleStatus(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
+	me, ok := currentUser(w, r)
+	if !ok {
+		return
+	}
+	if !h.authorizeGroup(w, r, me.ID().String(), groupID) {
+		return
+	}
+
 	// ステータスを取得
 	status, err := h.monitor.CheckUploadStatus(r.Context(), groupID)
 	if err != nil {
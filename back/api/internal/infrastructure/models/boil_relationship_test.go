@@ -8,8 +8,14 @@ import "testing"
 // TestToOne tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToOne(t *testing.T) {
+	t.Run("CollageJobToGroupUsingGroup", testCollageJobToOneGroupUsingGroup)
 	t.Run("CollageResultToGroupUsingGroup", testCollageResultToOneGroupUsingGroup)
 	t.Run("CollageResultToCollagesTemplateUsingTemplate", testCollageResultToOneCollagesTemplateUsingTemplate)
+	t.Run("CollagesTemplateToCollagesTemplateUsingForkedFromCollagesTemplate", testCollagesTemplateToOneCollagesTemplateUsingForkedFromCollagesTemplate)
+	t.Run("CollagesTemplateToUserUsingOwnerUser", testCollagesTemplateToOneUserUsingOwnerUser)
+	t.Run("DailyCollageToGroupUsingGroup", testDailyCollageToOneGroupUsingGroup)
+	t.Run("DailyCollageToCollageResultUsingResult", testDailyCollageToOneCollageResultUsingResult)
+	t.Run("DailyCollageToCollagesTemplateUsingTemplate", testDailyCollageToOneCollagesTemplateUsingTemplate)
 	t.Run("DeviceTokenToUserUsingUser", testDeviceTokenToOneUserUsingUser)
 	t.Run("FriendToUserUsingAddressee", testFriendToOneUserUsingAddressee)
 	t.Run("FriendToUserUsingRequester", testFriendToOneUserUsingRequester)
@@ -19,14 +25,23 @@ func TestToOne(t *testing.T) {
 	t.Run("GroupPartAssignmentToTemplatePartUsingPart", testGroupPartAssignmentToOneTemplatePartUsingPart)
 	t.Run("GroupPartAssignmentToUserUsingUser", testGroupPartAssignmentToOneUserUsingUser)
 	t.Run("GroupToUserUsingOwnerUser", testGroupToOneUserUsingOwnerUser)
+	t.Run("GroupToCollagesTemplateUsingTemplate", testGroupToOneCollagesTemplateUsingTemplate)
 	t.Run("ResultDownloadToCollageResultUsingResult", testResultDownloadToOneCollageResultUsingResult)
 	t.Run("ResultDownloadToUserUsingUser", testResultDownloadToOneUserUsingUser)
+	t.Run("ResumableUploadToGroupUsingGroup", testResumableUploadToOneGroupUsingGroup)
+	t.Run("ResumableUploadToUploadImageUsingImage", testResumableUploadToOneUploadImageUsingImage)
+	t.Run("ResumableUploadToUserUsingUser", testResumableUploadToOneUserUsingUser)
+	t.Run("SessionRoundToGroupUsingGroup", testSessionRoundToOneGroupUsingGroup)
+	t.Run("SessionRoundToCollagesTemplateUsingTemplate", testSessionRoundToOneCollagesTemplateUsingTemplate)
 	t.Run("TemplatePartToCollagesTemplateUsingTemplate", testTemplatePartToOneCollagesTemplateUsingTemplate)
 	t.Run("UploadImageToGroupUsingGroup", testUploadImageToOneGroupUsingGroup)
 	t.Run("UploadImageToTemplatePartUsingPart", testUploadImageToOneTemplatePartUsingPart)
 	t.Run("UploadImageToUserUsingUser", testUploadImageToOneUserUsingUser)
 	t.Run("UploadImagesCollageResultToUploadImageUsingImage", testUploadImagesCollageResultToOneUploadImageUsingImage)
 	t.Run("UploadImagesCollageResultToCollageResultUsingResult", testUploadImagesCollageResultToOneCollageResultUsingResult)
+	t.Run("UploadSlotToGroupUsingGroup", testUploadSlotToOneGroupUsingGroup)
+	t.Run("UploadSlotToUploadImageUsingImage", testUploadSlotToOneUploadImageUsingImage)
+	t.Run("UploadSlotToUserUsingUser", testUploadSlotToOneUserUsingUser)
 }
 
 // TestOneToOne tests cannot be run in parallel
@@ -36,17 +51,30 @@ func TestOneToOne(t *testing.T) {}
 // TestToMany tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToMany(t *testing.T) {
+	t.Run("CollageResultToResultDailyCollages", testCollageResultToManyResultDailyCollages)
 	t.Run("CollageResultToResultResultDownloads", testCollageResultToManyResultResultDownloads)
 	t.Run("CollageResultToResultUploadImagesCollageResults", testCollageResultToManyResultUploadImagesCollageResults)
 	t.Run("CollagesTemplateToTemplateCollageResults", testCollagesTemplateToManyTemplateCollageResults)
+	t.Run("CollagesTemplateToForkedFromCollagesTemplates", testCollagesTemplateToManyForkedFromCollagesTemplates)
+	t.Run("CollagesTemplateToTemplateDailyCollages", testCollagesTemplateToManyTemplateDailyCollages)
+	t.Run("CollagesTemplateToTemplateGroups", testCollagesTemplateToManyTemplateGroups)
+	t.Run("CollagesTemplateToTemplateSessionRounds", testCollagesTemplateToManyTemplateSessionRounds)
 	t.Run("CollagesTemplateToTemplateTemplateParts", testCollagesTemplateToManyTemplateTemplateParts)
+	t.Run("GroupToCollageJobs", testGroupToManyCollageJobs)
 	t.Run("GroupToCollageResults", testGroupToManyCollageResults)
+	t.Run("GroupToDailyCollages", testGroupToManyDailyCollages)
 	t.Run("GroupToGroupMembers", testGroupToManyGroupMembers)
 	t.Run("GroupToGroupPartAssignments", testGroupToManyGroupPartAssignments)
+	t.Run("GroupToResumableUploads", testGroupToManyResumableUploads)
+	t.Run("GroupToSessionRounds", testGroupToManySessionRounds)
 	t.Run("GroupToUploadImages", testGroupToManyUploadImages)
+	t.Run("GroupToUploadSlots", testGroupToManyUploadSlots)
 	t.Run("TemplatePartToPartGroupPartAssignments", testTemplatePartToManyPartGroupPartAssignments)
 	t.Run("TemplatePartToPartUploadImages", testTemplatePartToManyPartUploadImages)
+	t.Run("UploadImageToImageResumableUploads", testUploadImageToManyImageResumableUploads)
 	t.Run("UploadImageToImageUploadImagesCollageResults", testUploadImageToManyImageUploadImagesCollageResults)
+	t.Run("UploadImageToImageUploadSlots", testUploadImageToManyImageUploadSlots)
+	t.Run("UserToOwnerUserCollagesTemplates", testUserToManyOwnerUserCollagesTemplates)
 	t.Run("UserToDeviceTokens", testUserToManyDeviceTokens)
 	t.Run("UserToAddresseeFriends", testUserToManyAddresseeFriends)
 	t.Run("UserToRequesterFriends", testUserToManyRequesterFriends)
@@ -54,14 +82,22 @@ func TestToMany(t *testing.T) {
 	t.Run("UserToGroupPartAssignments", testUserToManyGroupPartAssignments)
 	t.Run("UserToOwnerUserGroups", testUserToManyOwnerUserGroups)
 	t.Run("UserToResultDownloads", testUserToManyResultDownloads)
+	t.Run("UserToResumableUploads", testUserToManyResumableUploads)
 	t.Run("UserToUploadImages", testUserToManyUploadImages)
+	t.Run("UserToUploadSlots", testUserToManyUploadSlots)
 }
 
 // TestToOneSet tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToOneSet(t *testing.T) {
+	t.Run("CollageJobToGroupUsingCollageJobs", testCollageJobToOneSetOpGroupUsingGroup)
 	t.Run("CollageResultToGroupUsingCollageResults", testCollageResultToOneSetOpGroupUsingGroup)
 	t.Run("CollageResultToCollagesTemplateUsingTemplateCollageResults", testCollageResultToOneSetOpCollagesTemplateUsingTemplate)
+	t.Run("CollagesTemplateToCollagesTemplateUsingForkedFromCollagesTemplates", testCollagesTemplateToOneSetOpCollagesTemplateUsingForkedFromCollagesTemplate)
+	t.Run("CollagesTemplateToUserUsingOwnerUserCollagesTemplates", testCollagesTemplateToOneSetOpUserUsingOwnerUser)
+	t.Run("DailyCollageToGroupUsingDailyCollages", testDailyCollageToOneSetOpGroupUsingGroup)
+	t.Run("DailyCollageToCollageResultUsingResultDailyCollages", testDailyCollageToOneSetOpCollageResultUsingResult)
+	t.Run("DailyCollageToCollagesTemplateUsingTemplateDailyCollages", testDailyCollageToOneSetOpCollagesTemplateUsingTemplate)
 	t.Run("DeviceTokenToUserUsingDeviceTokens", testDeviceTokenToOneSetOpUserUsingUser)
 	t.Run("FriendToUserUsingAddresseeFriends", testFriendToOneSetOpUserUsingAddressee)
 	t.Run("FriendToUserUsingRequesterFriends", testFriendToOneSetOpUserUsingRequester)
@@ -71,20 +107,35 @@ func TestToOneSet(t *testing.T) {
 	t.Run("GroupPartAssignmentToTemplatePartUsingPartGroupPartAssignments", testGroupPartAssignmentToOneSetOpTemplatePartUsingPart)
 	t.Run("GroupPartAssignmentToUserUsingGroupPartAssignments", testGroupPartAssignmentToOneSetOpUserUsingUser)
 	t.Run("GroupToUserUsingOwnerUserGroups", testGroupToOneSetOpUserUsingOwnerUser)
+	t.Run("GroupToCollagesTemplateUsingTemplateGroups", testGroupToOneSetOpCollagesTemplateUsingTemplate)
 	t.Run("ResultDownloadToCollageResultUsingResultResultDownloads", testResultDownloadToOneSetOpCollageResultUsingResult)
 	t.Run("ResultDownloadToUserUsingResultDownloads", testResultDownloadToOneSetOpUserUsingUser)
+	t.Run("ResumableUploadToGroupUsingResumableUploads", testResumableUploadToOneSetOpGroupUsingGroup)
+	t.Run("ResumableUploadToUploadImageUsingImageResumableUploads", testResumableUploadToOneSetOpUploadImageUsingImage)
+	t.Run("ResumableUploadToUserUsingResumableUploads", testResumableUploadToOneSetOpUserUsingUser)
+	t.Run("SessionRoundToGroupUsingSessionRounds", testSessionRoundToOneSetOpGroupUsingGroup)
+	t.Run("SessionRoundToCollagesTemplateUsingTemplateSessionRounds", testSessionRoundToOneSetOpCollagesTemplateUsingTemplate)
 	t.Run("TemplatePartToCollagesTemplateUsingTemplateTemplateParts", testTemplatePartToOneSetOpCollagesTemplateUsingTemplate)
 	t.Run("UploadImageToGroupUsingUploadImages", testUploadImageToOneSetOpGroupUsingGroup)
 	t.Run("UploadImageToTemplatePartUsingPartUploadImages", testUploadImageToOneSetOpTemplatePartUsingPart)
 	t.Run("UploadImageToUserUsingUploadImages", testUploadImageToOneSetOpUserUsingUser)
 	t.Run("UploadImagesCollageResultToUploadImageUsingImageUploadImagesCollageResults", testUploadImagesCollageResultToOneSetOpUploadImageUsingImage)
 	t.Run("UploadImagesCollageResultToCollageResultUsingResultUploadImagesCollageResults", testUploadImagesCollageResultToOneSetOpCollageResultUsingResult)
+	t.Run("UploadSlotToGroupUsingUploadSlots", testUploadSlotToOneSetOpGroupUsingGroup)
+	t.Run("UploadSlotToUploadImageUsingImageUploadSlots", testUploadSlotToOneSetOpUploadImageUsingImage)
+	t.Run("UploadSlotToUserUsingUploadSlots", testUploadSlotToOneSetOpUserUsingUser)
 }
 
 // TestToOneRemove tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToOneRemove(t *testing.T) {
+	t.Run("CollagesTemplateToCollagesTemplateUsingForkedFromCollagesTemplates", testCollagesTemplateToOneRemoveOpCollagesTemplateUsingForkedFromCollagesTemplate)
+	t.Run("CollagesTemplateToUserUsingOwnerUserCollagesTemplates", testCollagesTemplateToOneRemoveOpUserUsingOwnerUser)
+	t.Run("DailyCollageToCollageResultUsingResultDailyCollages", testDailyCollageToOneRemoveOpCollageResultUsingResult)
+	t.Run("GroupToCollagesTemplateUsingTemplateGroups", testGroupToOneRemoveOpCollagesTemplateUsingTemplate)
+	t.Run("ResumableUploadToUploadImageUsingImageResumableUploads", testResumableUploadToOneRemoveOpUploadImageUsingImage)
 	t.Run("UploadImageToTemplatePartUsingPartUploadImages", testUploadImageToOneRemoveOpTemplatePartUsingPart)
+	t.Run("UploadSlotToUploadImageUsingImageUploadSlots", testUploadSlotToOneRemoveOpUploadImageUsingImage)
 }
 
 // TestOneToOneSet tests cannot be run in parallel
@@ -98,17 +149,30 @@ func TestOneToOneRemove(t *testing.T) {}
 // TestToManyAdd tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToManyAdd(t *testing.T) {
+	t.Run("CollageResultToResultDailyCollages", testCollageResultToManyAddOpResultDailyCollages)
 	t.Run("CollageResultToResultResultDownloads", testCollageResultToManyAddOpResultResultDownloads)
 	t.Run("CollageResultToResultUploadImagesCollageResults", testCollageResultToManyAddOpResultUploadImagesCollageResults)
 	t.Run("CollagesTemplateToTemplateCollageResults", testCollagesTemplateToManyAddOpTemplateCollageResults)
+	t.Run("CollagesTemplateToForkedFromCollagesTemplates", testCollagesTemplateToManyAddOpForkedFromCollagesTemplates)
+	t.Run("CollagesTemplateToTemplateDailyCollages", testCollagesTemplateToManyAddOpTemplateDailyCollages)
+	t.Run("CollagesTemplateToTemplateGroups", testCollagesTemplateToManyAddOpTemplateGroups)
+	t.Run("CollagesTemplateToTemplateSessionRounds", testCollagesTemplateToManyAddOpTemplateSessionRounds)
 	t.Run("CollagesTemplateToTemplateTemplateParts", testCollagesTemplateToManyAddOpTemplateTemplateParts)
+	t.Run("GroupToCollageJobs", testGroupToManyAddOpCollageJobs)
 	t.Run("GroupToCollageResults", testGroupToManyAddOpCollageResults)
+	t.Run("GroupToDailyCollages", testGroupToManyAddOpDailyCollages)
 	t.Run("GroupToGroupMembers", testGroupToManyAddOpGroupMembers)
 	t.Run("GroupToGroupPartAssignments", testGroupToManyAddOpGroupPartAssignments)
+	t.Run("GroupToResumableUploads", testGroupToManyAddOpResumableUploads)
+	t.Run("GroupToSessionRounds", testGroupToManyAddOpSessionRounds)
 	t.Run("GroupToUploadImages", testGroupToManyAddOpUploadImages)
+	t.Run("GroupToUploadSlots", testGroupToManyAddOpUploadSlots)
 	t.Run("TemplatePartToPartGroupPartAssignments", testTemplatePartToManyAddOpPartGroupPartAssignments)
 	t.Run("TemplatePartToPartUploadImages", testTemplatePartToManyAddOpPartUploadImages)
+	t.Run("UploadImageToImageResumableUploads", testUploadImageToManyAddOpImageResumableUploads)
 	t.Run("UploadImageToImageUploadImagesCollageResults", testUploadImageToManyAddOpImageUploadImagesCollageResults)
+	t.Run("UploadImageToImageUploadSlots", testUploadImageToManyAddOpImageUploadSlots)
+	t.Run("UserToOwnerUserCollagesTemplates", testUserToManyAddOpOwnerUserCollagesTemplates)
 	t.Run("UserToDeviceTokens", testUserToManyAddOpDeviceTokens)
 	t.Run("UserToAddresseeFriends", testUserToManyAddOpAddresseeFriends)
 	t.Run("UserToRequesterFriends", testUserToManyAddOpRequesterFriends)
@@ -116,17 +180,31 @@ func TestToManyAdd(t *testing.T) {
 	t.Run("UserToGroupPartAssignments", testUserToManyAddOpGroupPartAssignments)
 	t.Run("UserToOwnerUserGroups", testUserToManyAddOpOwnerUserGroups)
 	t.Run("UserToResultDownloads", testUserToManyAddOpResultDownloads)
+	t.Run("UserToResumableUploads", testUserToManyAddOpResumableUploads)
 	t.Run("UserToUploadImages", testUserToManyAddOpUploadImages)
+	t.Run("UserToUploadSlots", testUserToManyAddOpUploadSlots)
 }
 
 // TestToManySet tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToManySet(t *testing.T) {
+	t.Run("CollageResultToResultDailyCollages", testCollageResultToManySetOpResultDailyCollages)
+	t.Run("CollagesTemplateToForkedFromCollagesTemplates", testCollagesTemplateToManySetOpForkedFromCollagesTemplates)
+	t.Run("CollagesTemplateToTemplateGroups", testCollagesTemplateToManySetOpTemplateGroups)
 	t.Run("TemplatePartToPartUploadImages", testTemplatePartToManySetOpPartUploadImages)
+	t.Run("UploadImageToImageResumableUploads", testUploadImageToManySetOpImageResumableUploads)
+	t.Run("UploadImageToImageUploadSlots", testUploadImageToManySetOpImageUploadSlots)
+	t.Run("UserToOwnerUserCollagesTemplates", testUserToManySetOpOwnerUserCollagesTemplates)
 }
 
 // TestToManyRemove tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToManyRemove(t *testing.T) {
+	t.Run("CollageResultToResultDailyCollages", testCollageResultToManyRemoveOpResultDailyCollages)
+	t.Run("CollagesTemplateToForkedFromCollagesTemplates", testCollagesTemplateToManyRemoveOpForkedFromCollagesTemplates)
+	t.Run("CollagesTemplateToTemplateGroups", testCollagesTemplateToManyRemoveOpTemplateGroups)
 	t.Run("TemplatePartToPartUploadImages", testTemplatePartToManyRemoveOpPartUploadImages)
+	t.Run("UploadImageToImageResumableUploads", testUploadImageToManyRemoveOpImageResumableUploads)
+	t.Run("UploadImageToImageUploadSlots", testUploadImageToManyRemoveOpImageUploadSlots)
+	t.Run("UserToOwnerUserCollagesTemplates", testUserToManyRemoveOpOwnerUserCollagesTemplates)
 }
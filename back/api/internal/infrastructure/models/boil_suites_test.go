@@ -12,175 +12,234 @@ import "testing"
 // It does NOT run each operation group in parallel.
 // Separating the tests thusly grants avoidance of Postgres deadlocks.
 func TestParent(t *testing.T) {
+	t.Run("CollageJobs", testCollageJobs)
 	t.Run("CollageResults", testCollageResults)
 	t.Run("CollagesTemplates", testCollagesTemplates)
+	t.Run("DailyCollages", testDailyCollages)
 	t.Run("DeviceTokens", testDeviceTokens)
 	t.Run("Friends", testFriends)
 	t.Run("GroupMembers", testGroupMembers)
 	t.Run("GroupPartAssignments", testGroupPartAssignments)
 	t.Run("Groups", testGroups)
 	t.Run("ResultDownloads", testResultDownloads)
+	t.Run("ResumableUploads", testResumableUploads)
+	t.Run("SessionRounds", testSessionRounds)
 	t.Run("TemplateParts", testTemplateParts)
 	t.Run("UploadImages", testUploadImages)
 	t.Run("UploadImagesCollageResults", testUploadImagesCollageResults)
+	t.Run("UploadSlots", testUploadSlots)
 	t.Run("Users", testUsers)
 }
 
 func TestDelete(t *testing.T) {
+	t.Run("CollageJobs", testCollageJobsDelete)
 	t.Run("CollageResults", testCollageResultsDelete)
 	t.Run("CollagesTemplates", testCollagesTemplatesDelete)
+	t.Run("DailyCollages", testDailyCollagesDelete)
 	t.Run("DeviceTokens", testDeviceTokensDelete)
 	t.Run("Friends", testFriendsDelete)
 	t.Run("GroupMembers", testGroupMembersDelete)
 	t.Run("GroupPartAssignments", testGroupPartAssignmentsDelete)
 	t.Run("Groups", testGroupsDelete)
 	t.Run("ResultDownloads", testResultDownloadsDelete)
+	t.Run("ResumableUploads", testResumableUploadsDelete)
+	t.Run("SessionRounds", testSessionRoundsDelete)
 	t.Run("TemplateParts", testTemplatePartsDelete)
 	t.Run("UploadImages", testUploadImagesDelete)
 	t.Run("UploadImagesCollageResults", testUploadImagesCollageResultsDelete)
+	t.Run("UploadSlots", testUploadSlotsDelete)
 	t.Run("Users", testUsersDelete)
 }
 
 func TestQueryDeleteAll(t *testing.T) {
+	t.Run("CollageJobs", testCollageJobsQueryDeleteAll)
 	t.Run("CollageResults", testCollageResultsQueryDeleteAll)
 	t.Run("CollagesTemplates", testCollagesTemplatesQueryDeleteAll)
+	t.Run("DailyCollages", testDailyCollagesQueryDeleteAll)
 	t.Run("DeviceTokens", testDeviceTokensQueryDeleteAll)
 	t.Run("Friends", testFriendsQueryDeleteAll)
 	t.Run("GroupMembers", testGroupMembersQueryDeleteAll)
 	t.Run("GroupPartAssignments", testGroupPartAssignmentsQueryDeleteAll)
 	t.Run("Groups", testGroupsQueryDeleteAll)
 	t.Run("ResultDownloads", testResultDownloadsQueryDeleteAll)
+	t.Run("ResumableUploads", testResumableUploadsQueryDeleteAll)
+	t.Run("SessionRounds", testSessionRoundsQueryDeleteAll)
 	t.Run("TemplateParts", testTemplatePartsQueryDeleteAll)
 	t.Run("UploadImages", testUploadImagesQueryDeleteAll)
 	t.Run("UploadImagesCollageResults", testUploadImagesCollageResultsQueryDeleteAll)
+	t.Run("UploadSlots", testUploadSlotsQueryDeleteAll)
 	t.Run("Users", testUsersQueryDeleteAll)
 }
 
 func TestSliceDeleteAll(t *testing.T) {
+	t.Run("CollageJobs", testCollageJobsSliceDeleteAll)
 	t.Run("CollageResults", testCollageResultsSliceDeleteAll)
 	t.Run("CollagesTemplates", testCollagesTemplatesSliceDeleteAll)
+	t.Run("DailyCollages", testDailyCollagesSliceDeleteAll)
 	t.Run("DeviceTokens", testDeviceTokensSliceDeleteAll)
 	t.Run("Friends", testFriendsSliceDeleteAll)
 	t.Run("GroupMembers", testGroupMembersSliceDeleteAll)
 	t.Run("GroupPartAssignments", testGroupPartAssignmentsSliceDeleteAll)
 	t.Run("Groups", testGroupsSliceDeleteAll)
 	t.Run("ResultDownloads", testResultDownloadsSliceDeleteAll)
+	t.Run("ResumableUploads", testResumableUploadsSliceDeleteAll)
+	t.Run("SessionRounds", testSessionRoundsSliceDeleteAll)
 	t.Run("TemplateParts", testTemplatePartsSliceDeleteAll)
 	t.Run("UploadImages", testUploadImagesSliceDeleteAll)
 	t.Run("UploadImagesCollageResults", testUploadImagesCollageResultsSliceDeleteAll)
+	t.Run("UploadSlots", testUploadSlotsSliceDeleteAll)
 	t.Run("Users", testUsersSliceDeleteAll)
 }
 
 func TestExists(t *testing.T) {
+	t.Run("CollageJobs", testCollageJobsExists)
 	t.Run("CollageResults", testCollageResultsExists)
 	t.Run("CollagesTemplates", testCollagesTemplatesExists)
+	t.Run("DailyCollages", testDailyCollagesExists)
 	t.Run("DeviceTokens", testDeviceTokensExists)
 	t.Run("Friends", testFriendsExists)
 	t.Run("GroupMembers", testGroupMembersExists)
 	t.Run("GroupPartAssignments", testGroupPartAssignmentsExists)
 	t.Run("Groups", testGroupsExists)
 	t.Run("ResultDownloads", testResultDownloadsExists)
+	t.Run("ResumableUploads", testResumableUploadsExists)
+	t.Run("SessionRounds", testSessionRoundsExists)
 	t.Run("TemplateParts", testTemplatePartsExists)
 	t.Run("UploadImages", testUploadImagesExists)
 	t.Run("UploadImagesCollageResults", testUploadImagesCollageResultsExists)
+	t.Run("UploadSlots", testUploadSlotsExists)
 	t.Run("Users", testUsersExists)
 }
 
 func TestFind(t *testing.T) {
+	t.Run("CollageJobs", testCollageJobsFind)
 	t.Run("CollageResults", testCollageResultsFind)
 	t.Run("CollagesTemplates", testCollagesTemplatesFind)
+	t.Run("DailyCollages", testDailyCollagesFind)
 	t.Run("DeviceTokens", testDeviceTokensFind)
 	t.Run("Friends", testFriendsFind)
 	t.Run("GroupMembers", testGroupMembersFind)
 	t.Run("GroupPartAssignments", testGroupPartAssignmentsFind)
 	t.Run("Groups", testGroupsFind)
 	t.Run("ResultDownloads", testResultDownloadsFind)
+	t.Run("ResumableUploads", testResumableUploadsFind)
+	t.Run("SessionRounds", testSessionRoundsFind)
 	t.Run("TemplateParts", testTemplatePartsFind)
 	t.Run("UploadImages", testUploadImagesFind)
 	t.Run("UploadImagesCollageResults", testUploadImagesCollageResultsFind)
+	t.Run("UploadSlots", testUploadSlotsFind)
 	t.Run("Users", testUsersFind)
 }
 
 func TestBind(t *testing.T) {
+	t.Run("CollageJobs", testCollageJobsBind)
 	t.Run("CollageResults", testCollageResultsBind)
 	t.Run("CollagesTemplates", testCollagesTemplatesBind)
+	t.Run("DailyCollages", testDailyCollagesBind)
 	t.Run("DeviceTokens", testDeviceTokensBind)
 	t.Run("Friends", testFriendsBind)
 	t.Run("GroupMembers", testGroupMembersBind)
 	t.Run("GroupPartAssignments", testGroupPartAssignmentsBind)
 	t.Run("Groups", testGroupsBind)
 	t.Run("ResultDownloads", testResultDownloadsBind)
+	t.Run("ResumableUploads", testResumableUploadsBind)
+	t.Run("SessionRounds", testSessionRoundsBind)
 	t.Run("TemplateParts", testTemplatePartsBind)
 	t.Run("UploadImages", testUploadImagesBind)
 	t.Run("UploadImagesCollageResults", testUploadImagesCollageResultsBind)
+	t.Run("UploadSlots", testUploadSlotsBind)
 	t.Run("Users", testUsersBind)
 }
 
 func TestOne(t *testing.T) {
+	t.Run("CollageJobs", testCollageJobsOne)
 	t.Run("CollageResults", testCollageResultsOne)
 	t.Run("CollagesTemplates", testCollagesTemplatesOne)
+	t.Run("DailyCollages", testDailyCollagesOne)
 	t.Run("DeviceTokens", testDeviceTokensOne)
 	t.Run("Friends", testFriendsOne)
 	t.Run("GroupMembers", testGroupMembersOne)
 	t.Run("GroupPartAssignments", testGroupPartAssignmentsOne)
 	t.Run("Groups", testGroupsOne)
 	t.Run("ResultDownloads", testResultDownloadsOne)
+	t.Run("ResumableUploads", testResumableUploadsOne)
+	t.Run("SessionRounds", testSessionRoundsOne)
 	t.Run("TemplateParts", testTemplatePartsOne)
 	t.Run("UploadImages", testUploadImagesOne)
 	t.Run("UploadImagesCollageResults", testUploadImagesCollageResultsOne)
+	t.Run("UploadSlots", testUploadSlotsOne)
 	t.Run("Users", testUsersOne)
 }
 
 func TestAll(t *testing.T) {
+	t.Run("CollageJobs", testCollageJobsAll)
 	t.Run("CollageResults", testCollageResultsAll)
 	t.Run("CollagesTemplates", testCollagesTemplatesAll)
+	t.Run("DailyCollages", testDailyCollagesAll)
 	t.Run("DeviceTokens", testDeviceTokensAll)
 	t.Run("Friends", testFriendsAll)
 	t.Run("GroupMembers", testGroupMembersAll)
 	t.Run("GroupPartAssignments", testGroupPartAssignmentsAll)
 	t.Run("Groups", testGroupsAll)
 	t.Run("ResultDownloads", testResultDownloadsAll)
+	t.Run("ResumableUploads", testResumableUploadsAll)
+	t.Run("SessionRounds", testSessionRoundsAll)
 	t.Run("TemplateParts", testTemplatePartsAll)
 	t.Run("UploadImages", testUploadImagesAll)
 	t.Run("UploadImagesCollageResults", testUploadImagesCollageResultsAll)
+	t.Run("UploadSlots", testUploadSlotsAll)
 	t.Run("Users", testUsersAll)
 }
 
 func TestCount(t *testing.T) {
+	t.Run("CollageJobs", testCollageJobsCount)
 	t.Run("CollageResults", testCollageResultsCount)
 	t.Run("CollagesTemplates", testCollagesTemplatesCount)
+	t.Run("DailyCollages", testDailyCollagesCount)
 	t.Run("DeviceTokens", testDeviceTokensCount)
 	t.Run("Friends", testFriendsCount)
 	t.Run("GroupMembers", testGroupMembersCount)
 	t.Run("GroupPartAssignments", testGroupPartAssignmentsCount)
 	t.Run("Groups", testGroupsCount)
 	t.Run("ResultDownloads", testResultDownloadsCount)
+	t.Run("ResumableUploads", testResumableUploadsCount)
+	t.Run("SessionRounds", testSessionRoundsCount)
 	t.Run("TemplateParts", testTemplatePartsCount)
 	t.Run("UploadImages", testUploadImagesCount)
 	t.Run("UploadImagesCollageResults", testUploadImagesCollageResultsCount)
+	t.Run("UploadSlots", testUploadSlotsCount)
 	t.Run("Users", testUsersCount)
 }
 
 func TestHooks(t *testing.T) {
+	t.Run("CollageJobs", testCollageJobsHooks)
 	t.Run("CollageResults", testCollageResultsHooks)
 	t.Run("CollagesTemplates", testCollagesTemplatesHooks)
+	t.Run("DailyCollages", testDailyCollagesHooks)
 	t.Run("DeviceTokens", testDeviceTokensHooks)
 	t.Run("Friends", testFriendsHooks)
 	t.Run("GroupMembers", testGroupMembersHooks)
 	t.Run("GroupPartAssignments", testGroupPartAssignmentsHooks)
 	t.Run("Groups", testGroupsHooks)
 	t.Run("ResultDownloads", testResultDownloadsHooks)
+	t.Run("ResumableUploads", testResumableUploadsHooks)
+	t.Run("SessionRounds", testSessionRoundsHooks)
 	t.Run("TemplateParts", testTemplatePartsHooks)
 	t.Run("UploadImages", testUploadImagesHooks)
 	t.Run("UploadImagesCollageResults", testUploadImagesCollageResultsHooks)
+	t.Run("UploadSlots", testUploadSlotsHooks)
 	t.Run("Users", testUsersHooks)
 }
 
 func TestInsert(t *testing.T) {
+	t.Run("CollageJobs", testCollageJobsInsert)
+	t.Run("CollageJobs", testCollageJobsInsertWhitelist)
 	t.Run("CollageResults", testCollageResultsInsert)
 	t.Run("CollageResults", testCollageResultsInsertWhitelist)
 	t.Run("CollagesTemplates", testCollagesTemplatesInsert)
 	t.Run("CollagesTemplates", testCollagesTemplatesInsertWhitelist)
+	t.Run("DailyCollages", testDailyCollagesInsert)
+	t.Run("DailyCollages", testDailyCollagesInsertWhitelist)
 	t.Run("DeviceTokens", testDeviceTokensInsert)
 	t.Run("DeviceTokens", testDeviceTokensInsertWhitelist)
 	t.Run("Friends", testFriendsInsert)
@@ -193,87 +252,118 @@ func TestInsert(t *testing.T) {
 	t.Run("Groups", testGroupsInsertWhitelist)
 	t.Run("ResultDownloads", testResultDownloadsInsert)
 	t.Run("ResultDownloads", testResultDownloadsInsertWhitelist)
+	t.Run("ResumableUploads", testResumableUploadsInsert)
+	t.Run("ResumableUploads", testResumableUploadsInsertWhitelist)
+	t.Run("SessionRounds", testSessionRoundsInsert)
+	t.Run("SessionRounds", testSessionRoundsInsertWhitelist)
 	t.Run("TemplateParts", testTemplatePartsInsert)
 	t.Run("TemplateParts", testTemplatePartsInsertWhitelist)
 	t.Run("UploadImages", testUploadImagesInsert)
 	t.Run("UploadImages", testUploadImagesInsertWhitelist)
 	t.Run("UploadImagesCollageResults", testUploadImagesCollageResultsInsert)
 	t.Run("UploadImagesCollageResults", testUploadImagesCollageResultsInsertWhitelist)
+	t.Run("UploadSlots", testUploadSlotsInsert)
+	t.Run("UploadSlots", testUploadSlotsInsertWhitelist)
 	t.Run("Users", testUsersInsert)
 	t.Run("Users", testUsersInsertWhitelist)
 }
 
 func TestReload(t *testing.T) {
+	t.Run("CollageJobs", testCollageJobsReload)
 	t.Run("CollageResults", testCollageResultsReload)
 	t.Run("CollagesTemplates", testCollagesTemplatesReload)
+	t.Run("DailyCollages", testDailyCollagesReload)
 	t.Run("DeviceTokens", testDeviceTokensReload)
 	t.Run("Friends", testFriendsReload)
 	t.Run("GroupMembers", testGroupMembersReload)
 	t.Run("GroupPartAssignments", testGroupPartAssignmentsReload)
 	t.Run("Groups", testGroupsReload)
 	t.Run("ResultDownloads", testResultDownloadsReload)
+	t.Run("ResumableUploads", testResumableUploadsReload)
+	t.Run("SessionRounds", testSessionRoundsReload)
 	t.Run("TemplateParts", testTemplatePartsReload)
 	t.Run("UploadImages", testUploadImagesReload)
 	t.Run("UploadImagesCollageResults", testUploadImagesCollageResultsReload)
+	t.Run("UploadSlots", testUploadSlotsReload)
 	t.Run("Users", testUsersReload)
 }
 
 func TestReloadAll(t *testing.T) {
+	t.Run("CollageJobs", testCollageJobsReloadAll)
 	t.Run("CollageResults", testCollageResultsReloadAll)
 	t.Run("CollagesTemplates", testCollagesTemplatesReloadAll)
+	t.Run("DailyCollages", testDailyCollagesReloadAll)
 	t.Run("DeviceTokens", testDeviceTokensReloadAll)
 	t.Run("Friends", testFriendsReloadAll)
 	t.Run("GroupMembers", testGroupMembersReloadAll)
 	t.Run("GroupPartAssignments", testGroupPartAssignmentsReloadAll)
 	t.Run("Groups", testGroupsReloadAll)
 	t.Run("ResultDownloads", testResultDownloadsReloadAll)
+	t.Run("ResumableUploads", testResumableUploadsReloadAll)
+	t.Run("SessionRounds", testSessionRoundsReloadAll)
 	t.Run("TemplateParts", testTemplatePartsReloadAll)
 	t.Run("UploadImages", testUploadImagesReloadAll)
 	t.Run("UploadImagesCollageResults", testUploadImagesCollageResultsReloadAll)
+	t.Run("UploadSlots", testUploadSlotsReloadAll)
 	t.Run("Users", testUsersReloadAll)
 }
 
 func TestSelect(t *testing.T) {
+	t.Run("CollageJobs", testCollageJobsSelect)
 	t.Run("CollageResults", testCollageResultsSelect)
 	t.Run("CollagesTemplates", testCollagesTemplatesSelect)
+	t.Run("DailyCollages", testDailyCollagesSelect)
 	t.Run("DeviceTokens", testDeviceTokensSelect)
 	t.Run("Friends", testFriendsSelect)
 	t.Run("GroupMembers", testGroupMembersSelect)
 	t.Run("GroupPartAssignments", testGroupPartAssignmentsSelect)
 	t.Run("Groups", testGroupsSelect)
 	t.Run("ResultDownloads", testResultDownloadsSelect)
+	t.Run("ResumableUploads", testResumableUploadsSelect)
+	t.Run("SessionRounds", testSessionRoundsSelect)
 	t.Run("TemplateParts", testTemplatePartsSelect)
 	t.Run("UploadImages", testUploadImagesSelect)
 	t.Run("UploadImagesCollageResults", testUploadImagesCollageResultsSelect)
+	t.Run("UploadSlots", testUploadSlotsSelect)
 	t.Run("Users", testUsersSelect)
 }
 
 func TestUpdate(t *testing.T) {
+	t.Run("CollageJobs", testCollageJobsUpdate)
 	t.Run("CollageResults", testCollageResultsUpdate)
 	t.Run("CollagesTemplates", testCollagesTemplatesUpdate)
+	t.Run("DailyCollages", testDailyCollagesUpdate)
 	t.Run("DeviceTokens", testDeviceTokensUpdate)
 	t.Run("Friends", testFriendsUpdate)
 	t.Run("GroupMembers", testGroupMembersUpdate)
 	t.Run("GroupPartAssignments", testGroupPartAssignmentsUpdate)
 	t.Run("Groups", testGroupsUpdate)
 	t.Run("ResultDownloads", testResultDownloadsUpdate)
+	t.Run("ResumableUploads", testResumableUploadsUpdate)
+	t.Run("SessionRounds", testSessionRoundsUpdate)
 	t.Run("TemplateParts", testTemplatePartsUpdate)
 	t.Run("UploadImages", testUploadImagesUpdate)
 	t.Run("UploadImagesCollageResults", testUploadImagesCollageResultsUpdate)
+	t.Run("UploadSlots", testUploadSlotsUpdate)
 	t.Run("Users", testUsersUpdate)
 }
 
 func TestSliceUpdateAll(t *testing.T) {
+	t.Run("CollageJobs", testCollageJobsSliceUpdateAll)
 	t.Run("CollageResults", testCollageResultsSliceUpdateAll)
 	t.Run("CollagesTemplates", testCollagesTemplatesSliceUpdateAll)
+	t.Run("DailyCollages", testDailyCollagesSliceUpdateAll)
 	t.Run("DeviceTokens", testDeviceTokensSliceUpdateAll)
 	t.Run("Friends", testFriendsSliceUpdateAll)
 	t.Run("GroupMembers", testGroupMembersSliceUpdateAll)
 	t.Run("GroupPartAssignments", testGroupPartAssignmentsSliceUpdateAll)
 	t.Run("Groups", testGroupsSliceUpdateAll)
 	t.Run("ResultDownloads", testResultDownloadsSliceUpdateAll)
+	t.Run("ResumableUploads", testResumableUploadsSliceUpdateAll)
+	t.Run("SessionRounds", testSessionRoundsSliceUpdateAll)
 	t.Run("TemplateParts", testTemplatePartsSliceUpdateAll)
 	t.Run("UploadImages", testUploadImagesSliceUpdateAll)
 	t.Run("UploadImagesCollageResults", testUploadImagesCollageResultsSliceUpdateAll)
+	t.Run("UploadSlots", testUploadSlotsSliceUpdateAll)
 	t.Run("Users", testUsersSliceUpdateAll)
 }
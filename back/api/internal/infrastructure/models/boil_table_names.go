@@ -4,29 +4,39 @@
 package models
 
 var TableNames = struct {
+	CollageJobs                string
 	CollageResults             string
 	CollagesTemplate           string
+	DailyCollages              string
 	DeviceTokens               string
 	Friends                    string
 	GroupMembers               string
 	GroupPartAssignments       string
 	Groups                     string
 	ResultDownload             string
+	ResumableUploads           string
+	SessionRounds              string
 	TemplateParts              string
 	UploadImages               string
 	UploadImagesCollageResults string
+	UploadSlots                string
 	Users                      string
 }{
+	CollageJobs:                "collage_jobs",
 	CollageResults:             "collage_results",
 	CollagesTemplate:           "collages_template",
+	DailyCollages:              "daily_collages",
 	DeviceTokens:               "device_tokens",
 	Friends:                    "friends",
 	GroupMembers:               "group_members",
 	GroupPartAssignments:       "group_part_assignments",
 	Groups:                     "groups",
 	ResultDownload:             "result_download",
+	ResumableUploads:           "resumable_uploads",
+	SessionRounds:              "session_rounds",
 	TemplateParts:              "template_parts",
 	UploadImages:               "upload_images",
 	UploadImagesCollageResults: "upload_images_collage_results",
+	UploadSlots:                "upload_slots",
 	Users:                      "users",
 }
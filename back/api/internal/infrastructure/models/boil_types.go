@@ -101,6 +101,7 @@ const (
 	GroupsStatusCountdown   string = "countdown"
 	GroupsStatusPhotoTaking string = "photo_taking"
 	GroupsStatusCompleted   string = "completed"
+	GroupsStatusFailed      string = "failed"
 	GroupsStatusExpired     string = "expired"
 )
 
@@ -111,6 +112,7 @@ func AllGroupsStatus() []string {
 		GroupsStatusCountdown,
 		GroupsStatusPhotoTaking,
 		GroupsStatusCompleted,
+		GroupsStatusFailed,
 		GroupsStatusExpired,
 	}
 }
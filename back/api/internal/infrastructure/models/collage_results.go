@@ -29,6 +29,8 @@ type CollageResult struct {
 	TemplateID string `boil:"template_id" json:"template_id" toml:"template_id" yaml:"template_id"`
 	// ã‚°ãƒ«ãƒ¼ãƒ—ID
 	GroupID string `boil:"group_id" json:"group_id" toml:"group_id" yaml:"group_id"`
+	// ãƒ©ã‚¦ãƒ³ãƒ‰ç•ªå·
+	RoundNumber int `boil:"round_number" json:"round_number" toml:"round_number" yaml:"round_number"`
 	// ã‚³ãƒ©ãƒ¼ã‚¸ãƒ¥ç”»åƒURL
 	FileURL string `boil:"file_url" json:"file_url" toml:"file_url" yaml:"file_url"`
 	// å¯¾è±¡ãƒ¦ãƒ¼ã‚¶ãƒ¼æ•°
@@ -46,6 +48,7 @@ var CollageResultColumns = struct {
 	ResultID         string
 	TemplateID       string
 	GroupID          string
+	RoundNumber      string
 	FileURL          string
 	TargetUserNumber string
 	IsNotification   string
@@ -54,6 +57,7 @@ var CollageResultColumns = struct {
 	ResultID:         "result_id",
 	TemplateID:       "template_id",
 	GroupID:          "group_id",
+	RoundNumber:      "round_number",
 	FileURL:          "file_url",
 	TargetUserNumber: "target_user_number",
 	IsNotification:   "is_notification",
@@ -64,6 +68,7 @@ var CollageResultTableColumns = struct {
 	ResultID         string
 	TemplateID       string
 	GroupID          string
+	RoundNumber      string
 	FileURL          string
 	TargetUserNumber string
 	IsNotification   string
@@ -72,6 +77,7 @@ var CollageResultTableColumns = struct {
 	ResultID:         "collage_results.result_id",
 	TemplateID:       "collage_results.template_id",
 	GroupID:          "collage_results.group_id",
+	RoundNumber:      "collage_results.round_number",
 	FileURL:          "collage_results.file_url",
 	TargetUserNumber: "collage_results.target_user_number",
 	IsNotification:   "collage_results.is_notification",
@@ -80,54 +86,6 @@ var CollageResultTableColumns = struct {
 
 // Generated where
 
-type whereHelperstring struct{ field string }
-
-func (w whereHelperstring) EQ(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperstring) NEQ(x string) qm.QueryMod   { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperstring) LT(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperstring) LTE(x string) qm.QueryMod   { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperstring) GT(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperstring) GTE(x string) qm.QueryMod   { return qmhelper.Where(w.field, qmhelper.GTE, x) }
-func (w whereHelperstring) LIKE(x string) qm.QueryMod  { return qm.Where(w.field+" LIKE ?", x) }
-func (w whereHelperstring) NLIKE(x string) qm.QueryMod { return qm.Where(w.field+" NOT LIKE ?", x) }
-func (w whereHelperstring) IN(slice []string) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
-}
-func (w whereHelperstring) NIN(slice []string) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
-}
-
-type whereHelperint struct{ field string }
-
-func (w whereHelperint) EQ(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperint) NEQ(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperint) LT(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperint) LTE(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperint) GT(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperint) GTE(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
-func (w whereHelperint) IN(slice []int) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
-}
-func (w whereHelperint) NIN(slice []int) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
-}
-
 type whereHelperbool struct{ field string }
 
 func (w whereHelperbool) EQ(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
@@ -137,31 +95,11 @@ func (w whereHelperbool) LTE(x bool) qm.QueryMod { return qmhelper.Where(w.field
 func (w whereHelperbool) GT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
 func (w whereHelperbool) GTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
 
-type whereHelpertime_Time struct{ field string }
-
-func (w whereHelpertime_Time) EQ(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.EQ, x)
-}
-func (w whereHelpertime_Time) NEQ(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.NEQ, x)
-}
-func (w whereHelpertime_Time) LT(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
-}
-func (w whereHelpertime_Time) LTE(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
-}
-func (w whereHelpertime_Time) GT(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
-}
-func (w whereHelpertime_Time) GTE(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
-}
-
 var CollageResultWhere = struct {
 	ResultID         whereHelperstring
 	TemplateID       whereHelperstring
 	GroupID          whereHelperstring
+	RoundNumber      whereHelperint
 	FileURL          whereHelperstring
 	TargetUserNumber whereHelperint
 	IsNotification   whereHelperbool
@@ -170,6 +108,7 @@ var CollageResultWhere = struct {
 	ResultID:         whereHelperstring{field: "`collage_results`.`result_id`"},
 	TemplateID:       whereHelperstring{field: "`collage_results`.`template_id`"},
 	GroupID:          whereHelperstring{field: "`collage_results`.`group_id`"},
+	RoundNumber:      whereHelperint{field: "`collage_results`.`round_number`"},
 	FileURL:          whereHelperstring{field: "`collage_results`.`file_url`"},
 	TargetUserNumber: whereHelperint{field: "`collage_results`.`target_user_number`"},
 	IsNotification:   whereHelperbool{field: "`collage_results`.`is_notification`"},
@@ -180,11 +119,13 @@ var CollageResultWhere = struct {
 var CollageResultRels = struct {
 	Group                            string
 	Template                         string
+	ResultDailyCollages              string
 	ResultResultDownloads            string
 	ResultUploadImagesCollageResults string
 }{
 	Group:                            "Group",
 	Template:                         "Template",
+	ResultDailyCollages:              "ResultDailyCollages",
 	ResultResultDownloads:            "ResultResultDownloads",
 	ResultUploadImagesCollageResults: "ResultUploadImagesCollageResults",
 }
@@ -193,6 +134,7 @@ var CollageResultRels = struct {
 type collageResultR struct {
 	Group                            *Group                         `boil:"Group" json:"Group" toml:"Group" yaml:"Group"`
 	Template                         *CollagesTemplate              `boil:"Template" json:"Template" toml:"Template" yaml:"Template"`
+	ResultDailyCollages              DailyCollageSlice              `boil:"ResultDailyCollages" json:"ResultDailyCollages" toml:"ResultDailyCollages" yaml:"ResultDailyCollages"`
 	ResultResultDownloads            ResultDownloadSlice            `boil:"ResultResultDownloads" json:"ResultResultDownloads" toml:"ResultResultDownloads" yaml:"ResultResultDownloads"`
 	ResultUploadImagesCollageResults UploadImagesCollageResultSlice `boil:"ResultUploadImagesCollageResults" json:"ResultUploadImagesCollageResults" toml:"ResultUploadImagesCollageResults" yaml:"ResultUploadImagesCollageResults"`
 }
@@ -234,6 +176,22 @@ func (r *collageResultR) GetTemplate() *CollagesTemplate {
 	return r.Template
 }
 
+func (o *CollageResult) GetResultDailyCollages() DailyCollageSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetResultDailyCollages()
+}
+
+func (r *collageResultR) GetResultDailyCollages() DailyCollageSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.ResultDailyCollages
+}
+
 func (o *CollageResult) GetResultResultDownloads() ResultDownloadSlice {
 	if o == nil {
 		return nil
@@ -270,9 +228,9 @@ func (r *collageResultR) GetResultUploadImagesCollageResults() UploadImagesColla
 type collageResultL struct{}
 
 var (
-	collageResultAllColumns            = []string{"result_id", "template_id", "group_id", "file_url", "target_user_number", "is_notification", "created_at"}
+	collageResultAllColumns            = []string{"result_id", "template_id", "group_id", "round_number", "file_url", "target_user_number", "is_notification", "created_at"}
 	collageResultColumnsWithoutDefault = []string{"result_id", "template_id", "group_id", "file_url", "target_user_number"}
-	collageResultColumnsWithDefault    = []string{"is_notification", "created_at"}
+	collageResultColumnsWithDefault    = []string{"round_number", "is_notification", "created_at"}
 	collageResultPrimaryKeyColumns     = []string{"result_id"}
 	collageResultGeneratedColumns      = []string{}
 )
@@ -604,6 +562,20 @@ func (o *CollageResult) Template(mods ...qm.QueryMod) collagesTemplateQuery {
 	return CollagesTemplates(queryMods...)
 }
 
+// ResultDailyCollages retrieves all the daily_collage's DailyCollages with an executor via result_id column.
+func (o *CollageResult) ResultDailyCollages(mods ...qm.QueryMod) dailyCollageQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`daily_collages`.`result_id`=?", o.ResultID),
+	)
+
+	return DailyCollages(queryMods...)
+}
+
 // ResultResultDownloads retrieves all the result_download's ResultDownloads with an executor via result_id column.
 func (o *CollageResult) ResultResultDownloads(mods ...qm.QueryMod) resultDownloadQuery {
 	var queryMods []qm.QueryMod
@@ -872,6 +844,119 @@ func (collageResultL) LoadTemplate(ctx context.Context, e boil.ContextExecutor,
 	return nil
 }
 
+// LoadResultDailyCollages allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (collageResultL) LoadResultDailyCollages(ctx context.Context, e boil.ContextExecutor, singular bool, maybeCollageResult interface{}, mods queries.Applicator) error {
+	var slice []*CollageResult
+	var object *CollageResult
+
+	if singular {
+		var ok bool
+		object, ok = maybeCollageResult.(*CollageResult)
+		if !ok {
+			object = new(CollageResult)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeCollageResult)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeCollageResult))
+			}
+		}
+	} else {
+		s, ok := maybeCollageResult.(*[]*CollageResult)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeCollageResult)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeCollageResult))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &collageResultR{}
+		}
+		args[object.ResultID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &collageResultR{}
+			}
+			args[obj.ResultID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`daily_collages`),
+		qm.WhereIn(`daily_collages.result_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load daily_collages")
+	}
+
+	var resultSlice []*DailyCollage
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice daily_collages")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on daily_collages")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for daily_collages")
+	}
+
+	if len(dailyCollageAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ResultDailyCollages = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &dailyCollageR{}
+			}
+			foreign.R.Result = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ResultID, foreign.ResultID) {
+				local.R.ResultDailyCollages = append(local.R.ResultDailyCollages, foreign)
+				if foreign.R == nil {
+					foreign.R = &dailyCollageR{}
+				}
+				foreign.R.Result = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadResultResultDownloads allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
 func (collageResultL) LoadResultResultDownloads(ctx context.Context, e boil.ContextExecutor, singular bool, maybeCollageResult interface{}, mods queries.Applicator) error {
@@ -1192,6 +1277,133 @@ func (o *CollageResult) SetTemplate(ctx context.Context, exec boil.ContextExecut
 	return nil
 }
 
+// AddResultDailyCollages adds the given related objects to the existing relationships
+// of the collage_result, optionally inserting them as new records.
+// Appends related to o.R.ResultDailyCollages.
+// Sets related.R.Result appropriately.
+func (o *CollageResult) AddResultDailyCollages(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*DailyCollage) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.ResultID, o.ResultID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `daily_collages` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"result_id"}),
+				strmangle.WhereClause("`", "`", 0, dailyCollagePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ResultID, rel.DailyID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.ResultID, o.ResultID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &collageResultR{
+			ResultDailyCollages: related,
+		}
+	} else {
+		o.R.ResultDailyCollages = append(o.R.ResultDailyCollages, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &dailyCollageR{
+				Result: o,
+			}
+		} else {
+			rel.R.Result = o
+		}
+	}
+	return nil
+}
+
+// SetResultDailyCollages removes all previously related items of the
+// collage_result replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Result's ResultDailyCollages accordingly.
+// Replaces o.R.ResultDailyCollages with related.
+// Sets related.R.Result's ResultDailyCollages accordingly.
+func (o *CollageResult) SetResultDailyCollages(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*DailyCollage) error {
+	query := "update `daily_collages` set `result_id` = null where `result_id` = ?"
+	values := []interface{}{o.ResultID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.ResultDailyCollages {
+			queries.SetScanner(&rel.ResultID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Result = nil
+		}
+		o.R.ResultDailyCollages = nil
+	}
+
+	return o.AddResultDailyCollages(ctx, exec, insert, related...)
+}
+
+// RemoveResultDailyCollages relationships from objects passed in.
+// Removes related items from R.ResultDailyCollages (uses pointer comparison, removal does not keep order)
+// Sets related.R.Result.
+func (o *CollageResult) RemoveResultDailyCollages(ctx context.Context, exec boil.ContextExecutor, related ...*DailyCollage) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.ResultID, nil)
+		if rel.R != nil {
+			rel.R.Result = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("result_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.ResultDailyCollages {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.ResultDailyCollages)
+			if ln > 1 && i < ln-1 {
+				o.R.ResultDailyCollages[i] = o.R.ResultDailyCollages[ln-1]
+			}
+			o.R.ResultDailyCollages = o.R.ResultDailyCollages[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
 // AddResultResultDownloads adds the given related objects to the existing relationships
 // of the collage_result, optionally inserting them as new records.
 // Appends related to o.R.ResultResultDownloads.
@@ -494,6 +494,83 @@ func testCollageResultsInsertWhitelist(t *testing.T) {
 	}
 }
 
+func testCollageResultToManyResultDailyCollages(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a CollageResult
+	var b, c DailyCollage
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, collageResultDBTypes, true, collageResultColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CollageResult struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, dailyCollageDBTypes, false, dailyCollageColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, dailyCollageDBTypes, false, dailyCollageColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&b.ResultID, a.ResultID)
+	queries.Assign(&c.ResultID, a.ResultID)
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.ResultDailyCollages().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if queries.Equal(v.ResultID, b.ResultID) {
+			bFound = true
+		}
+		if queries.Equal(v.ResultID, c.ResultID) {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := CollageResultSlice{&a}
+	if err = a.L.LoadResultDailyCollages(ctx, tx, false, (*[]*CollageResult)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.ResultDailyCollages); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.ResultDailyCollages = nil
+	if err = a.L.LoadResultDailyCollages(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.ResultDailyCollages); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
 func testCollageResultToManyResultResultDownloads(t *testing.T) {
 	var err error
 	ctx := context.Background()
@@ -650,6 +727,257 @@ func testCollageResultToManyResultUploadImagesCollageResults(t *testing.T) {
 	}
 }
 
+func testCollageResultToManyAddOpResultDailyCollages(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a CollageResult
+	var b, c, d, e DailyCollage
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, collageResultDBTypes, false, strmangle.SetComplement(collageResultPrimaryKeyColumns, collageResultColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*DailyCollage{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, dailyCollageDBTypes, false, strmangle.SetComplement(dailyCollagePrimaryKeyColumns, dailyCollageColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*DailyCollage{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddResultDailyCollages(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if !queries.Equal(a.ResultID, first.ResultID) {
+			t.Error("foreign key was wrong value", a.ResultID, first.ResultID)
+		}
+		if !queries.Equal(a.ResultID, second.ResultID) {
+			t.Error("foreign key was wrong value", a.ResultID, second.ResultID)
+		}
+
+		if first.R.Result != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Result != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.ResultDailyCollages[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.ResultDailyCollages[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.ResultDailyCollages().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testCollageResultToManySetOpResultDailyCollages(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a CollageResult
+	var b, c, d, e DailyCollage
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, collageResultDBTypes, false, strmangle.SetComplement(collageResultPrimaryKeyColumns, collageResultColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*DailyCollage{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, dailyCollageDBTypes, false, strmangle.SetComplement(dailyCollagePrimaryKeyColumns, dailyCollageColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetResultDailyCollages(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.ResultDailyCollages().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetResultDailyCollages(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.ResultDailyCollages().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.ResultID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.ResultID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+	if !queries.Equal(a.ResultID, d.ResultID) {
+		t.Error("foreign key was wrong value", a.ResultID, d.ResultID)
+	}
+	if !queries.Equal(a.ResultID, e.ResultID) {
+		t.Error("foreign key was wrong value", a.ResultID, e.ResultID)
+	}
+
+	if b.R.Result != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.Result != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.Result != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.Result != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if a.R.ResultDailyCollages[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.ResultDailyCollages[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testCollageResultToManyRemoveOpResultDailyCollages(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a CollageResult
+	var b, c, d, e DailyCollage
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, collageResultDBTypes, false, strmangle.SetComplement(collageResultPrimaryKeyColumns, collageResultColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*DailyCollage{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, dailyCollageDBTypes, false, strmangle.SetComplement(dailyCollagePrimaryKeyColumns, dailyCollageColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddResultDailyCollages(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.ResultDailyCollages().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemoveResultDailyCollages(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.ResultDailyCollages().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.ResultID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.ResultID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+
+	if b.R.Result != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.Result != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.Result != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+	if e.R.Result != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+
+	if len(a.R.ResultDailyCollages) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.ResultDailyCollages[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.ResultDailyCollages[0] != &e {
+		t.Error("relationship to e should have been preserved")
+	}
+}
+
 func testCollageResultToManyAddOpResultResultDownloads(t *testing.T) {
 	var err error
 
@@ -1111,7 +1439,7 @@ func testCollageResultsSelect(t *testing.T) {
 }
 
 var (
-	collageResultDBTypes = map[string]string{`ResultID`: `char`, `TemplateID`: `char`, `GroupID`: `char`, `FileURL`: `varchar`, `TargetUserNumber`: `int`, `IsNotification`: `tinyint`, `CreatedAt`: `timestamp`}
+	collageResultDBTypes = map[string]string{`ResultID`: `char`, `TemplateID`: `char`, `GroupID`: `char`, `RoundNumber`: `int`, `FileURL`: `varchar`, `TargetUserNumber`: `int`, `IsNotification`: `tinyint`, `CreatedAt`: `timestamp`}
 	_                    = bytes.MinRead
 )
 
@@ -13,6 +13,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/aarondl/null/v8"
 	"github.com/aarondl/sqlboiler/v4/boil"
 	"github.com/aarondl/sqlboiler/v4/queries"
 	"github.com/aarondl/sqlboiler/v4/queries/qm"
@@ -27,8 +28,24 @@ type CollagesTemplate struct {
 	TemplateID string `boil:"template_id" json:"template_id" toml:"template_id" yaml:"template_id"`
 	// ãƒ†ãƒ³ãƒ—ãƒ¬ãƒ¼ãƒˆå
 	Name string `boil:"name" json:"name" toml:"name" yaml:"name"`
-	// ãƒ†ãƒ³ãƒ—ãƒ¬ãƒ¼ãƒˆãƒ•ã‚¡ã‚¤ãƒ«ãƒ‘ã‚¹
-	FilePath string `boil:"file_path" json:"file_path" toml:"file_path" yaml:"file_path"`
+	// ãƒ†ãƒ³ãƒ—ãƒ¬ãƒ¼ãƒˆãƒ•ã‚¡ã‚¤ãƒ«ãƒ‘ã‚¹ï¼ˆãƒ¦ãƒ¼ã‚¶ãƒ¼ãŒä½œæˆã—ãŸãƒ†ãƒ³ãƒ—ãƒ¬ãƒ¼ãƒˆã¯ NULLï¼‰
+	FilePath null.String `boil:"file_path" json:"file_path,omitempty" toml:"file_path" yaml:"file_path,omitempty"`
+	// çµ„ã¿è¾¼ã¿ãƒ†ãƒ³ãƒ—ãƒ¬ãƒ¼ãƒˆã® templates.json ã§ã®åå‰ï¼ˆãƒ¦ãƒ¼ã‚¶ãƒ¼ãŒä½œã£ãŸãƒ†ãƒ³ãƒ—ãƒ¬ãƒ¼ãƒˆã¯ NULLï¼‰
+	SourceName null.String `boil:"source_name" json:"source_name,omitempty" toml:"source_name" yaml:"source_name,omitempty"`
+	// ä½œæˆè€…ã®ãƒ¦ãƒ¼ã‚¶ãƒ¼IDï¼ˆçµ„ã¿è¾¼ã¿ãƒ†ãƒ³ãƒ—ãƒ¬ãƒ¼ãƒˆã¯ NULLï¼‰
+	OwnerUserID null.String `boil:"owner_user_id" json:"owner_user_id,omitempty" toml:"owner_user_id" yaml:"owner_user_id,omitempty"`
+	// å…¬é–‹ç¯„å›² (private / friends / public)
+	Visibility string `boil:"visibility" json:"visibility" toml:"visibility" yaml:"visibility"`
+	// è¤‡è£½å…ƒã®ãƒ†ãƒ³ãƒ—ãƒ¬ãƒ¼ãƒˆID
+	ForkedFrom null.String `boil:"forked_from" json:"forked_from,omitempty" toml:"forked_from" yaml:"forked_from,omitempty"`
+	// å¿…è¦ãªå†™çœŸã®æžšæ•°
+	PhotoCount int `boil:"photo_count" json:"photo_count" toml:"photo_count" yaml:"photo_count"`
+	// ãƒ•ãƒ¬ãƒ¼ãƒ ã®ãƒ‘ã‚¹ã®åº§æ¨™ç³»ï¼ˆSVGã®viewBoxï¼‰
+	ViewBox string `boil:"view_box" json:"view_box" toml:"view_box" yaml:"view_box"`
+	// ç”Ÿæˆã™ã‚‹ã‚³ãƒ©ãƒ¼ã‚¸ãƒ¥ã®å¹…ï¼ˆãƒ”ã‚¯ã‚»ãƒ«ï¼‰
+	Width int `boil:"width" json:"width" toml:"width" yaml:"width"`
+	// ç”Ÿæˆã™ã‚‹ã‚³ãƒ©ãƒ¼ã‚¸ãƒ¥ã®é«˜ã•ï¼ˆãƒ”ã‚¯ã‚»ãƒ«ï¼‰
+	Height int `boil:"height" json:"height" toml:"height" yaml:"height"`
 	// ä½œæˆæ—¥æ™‚
 	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
 	// æ›´æ–°æ—¥æ™‚
@@ -39,62 +56,128 @@ type CollagesTemplate struct {
 }
 
 var CollagesTemplateColumns = struct {
-	TemplateID string
-	Name       string
-	FilePath   string
-	CreatedAt  string
-	UpdatedAt  string
+	TemplateID  string
+	Name        string
+	FilePath    string
+	SourceName  string
+	OwnerUserID string
+	Visibility  string
+	ForkedFrom  string
+	PhotoCount  string
+	ViewBox     string
+	Width       string
+	Height      string
+	CreatedAt   string
+	UpdatedAt   string
 }{
-	TemplateID: "template_id",
-	Name:       "name",
-	FilePath:   "file_path",
-	CreatedAt:  "created_at",
-	UpdatedAt:  "updated_at",
+	TemplateID:  "template_id",
+	Name:        "name",
+	FilePath:    "file_path",
+	SourceName:  "source_name",
+	OwnerUserID: "owner_user_id",
+	Visibility:  "visibility",
+	ForkedFrom:  "forked_from",
+	PhotoCount:  "photo_count",
+	ViewBox:     "view_box",
+	Width:       "width",
+	Height:      "height",
+	CreatedAt:   "created_at",
+	UpdatedAt:   "updated_at",
 }
 
 var CollagesTemplateTableColumns = struct {
-	TemplateID string
-	Name       string
-	FilePath   string
-	CreatedAt  string
-	UpdatedAt  string
+	TemplateID  string
+	Name        string
+	FilePath    string
+	SourceName  string
+	OwnerUserID string
+	Visibility  string
+	ForkedFrom  string
+	PhotoCount  string
+	ViewBox     string
+	Width       string
+	Height      string
+	CreatedAt   string
+	UpdatedAt   string
 }{
-	TemplateID: "collages_template.template_id",
-	Name:       "collages_template.name",
-	FilePath:   "collages_template.file_path",
-	CreatedAt:  "collages_template.created_at",
-	UpdatedAt:  "collages_template.updated_at",
+	TemplateID:  "collages_template.template_id",
+	Name:        "collages_template.name",
+	FilePath:    "collages_template.file_path",
+	SourceName:  "collages_template.source_name",
+	OwnerUserID: "collages_template.owner_user_id",
+	Visibility:  "collages_template.visibility",
+	ForkedFrom:  "collages_template.forked_from",
+	PhotoCount:  "collages_template.photo_count",
+	ViewBox:     "collages_template.view_box",
+	Width:       "collages_template.width",
+	Height:      "collages_template.height",
+	CreatedAt:   "collages_template.created_at",
+	UpdatedAt:   "collages_template.updated_at",
 }
 
 // Generated where
 
 var CollagesTemplateWhere = struct {
-	TemplateID whereHelperstring
-	Name       whereHelperstring
-	FilePath   whereHelperstring
-	CreatedAt  whereHelpertime_Time
-	UpdatedAt  whereHelpertime_Time
+	TemplateID  whereHelperstring
+	Name        whereHelperstring
+	FilePath    whereHelpernull_String
+	SourceName  whereHelpernull_String
+	OwnerUserID whereHelpernull_String
+	Visibility  whereHelperstring
+	ForkedFrom  whereHelpernull_String
+	PhotoCount  whereHelperint
+	ViewBox     whereHelperstring
+	Width       whereHelperint
+	Height      whereHelperint
+	CreatedAt   whereHelpertime_Time
+	UpdatedAt   whereHelpertime_Time
 }{
-	TemplateID: whereHelperstring{field: "`collages_template`.`template_id`"},
-	Name:       whereHelperstring{field: "`collages_template`.`name`"},
-	FilePath:   whereHelperstring{field: "`collages_template`.`file_path`"},
-	CreatedAt:  whereHelpertime_Time{field: "`collages_template`.`created_at`"},
-	UpdatedAt:  whereHelpertime_Time{field: "`collages_template`.`updated_at`"},
+	TemplateID:  whereHelperstring{field: "`collages_template`.`template_id`"},
+	Name:        whereHelperstring{field: "`collages_template`.`name`"},
+	FilePath:    whereHelpernull_String{field: "`collages_template`.`file_path`"},
+	SourceName:  whereHelpernull_String{field: "`collages_template`.`source_name`"},
+	OwnerUserID: whereHelpernull_String{field: "`collages_template`.`owner_user_id`"},
+	Visibility:  whereHelperstring{field: "`collages_template`.`visibility`"},
+	ForkedFrom:  whereHelpernull_String{field: "`collages_template`.`forked_from`"},
+	PhotoCount:  whereHelperint{field: "`collages_template`.`photo_count`"},
+	ViewBox:     whereHelperstring{field: "`collages_template`.`view_box`"},
+	Width:       whereHelperint{field: "`collages_template`.`width`"},
+	Height:      whereHelperint{field: "`collages_template`.`height`"},
+	CreatedAt:   whereHelpertime_Time{field: "`collages_template`.`created_at`"},
+	UpdatedAt:   whereHelpertime_Time{field: "`collages_template`.`updated_at`"},
 }
 
 // CollagesTemplateRels is where relationship names are stored.
 var CollagesTemplateRels = struct {
-	TemplateCollageResults string
-	TemplateTemplateParts  string
+	ForkedFromCollagesTemplate  string
+	OwnerUser                   string
+	TemplateCollageResults      string
+	ForkedFromCollagesTemplates string
+	TemplateDailyCollages       string
+	TemplateGroups              string
+	TemplateSessionRounds       string
+	TemplateTemplateParts       string
 }{
-	TemplateCollageResults: "TemplateCollageResults",
-	TemplateTemplateParts:  "TemplateTemplateParts",
+	ForkedFromCollagesTemplate:  "ForkedFromCollagesTemplate",
+	OwnerUser:                   "OwnerUser",
+	TemplateCollageResults:      "TemplateCollageResults",
+	ForkedFromCollagesTemplates: "ForkedFromCollagesTemplates",
+	TemplateDailyCollages:       "TemplateDailyCollages",
+	TemplateGroups:              "TemplateGroups",
+	TemplateSessionRounds:       "TemplateSessionRounds",
+	TemplateTemplateParts:       "TemplateTemplateParts",
 }
 
 // collagesTemplateR is where relationships are stored.
 type collagesTemplateR struct {
-	TemplateCollageResults CollageResultSlice `boil:"TemplateCollageResults" json:"TemplateCollageResults" toml:"TemplateCollageResults" yaml:"TemplateCollageResults"`
-	TemplateTemplateParts  TemplatePartSlice  `boil:"TemplateTemplateParts" json:"TemplateTemplateParts" toml:"TemplateTemplateParts" yaml:"TemplateTemplateParts"`
+	ForkedFromCollagesTemplate  *CollagesTemplate     `boil:"ForkedFromCollagesTemplate" json:"ForkedFromCollagesTemplate" toml:"ForkedFromCollagesTemplate" yaml:"ForkedFromCollagesTemplate"`
+	OwnerUser                   *User                 `boil:"OwnerUser" json:"OwnerUser" toml:"OwnerUser" yaml:"OwnerUser"`
+	TemplateCollageResults      CollageResultSlice    `boil:"TemplateCollageResults" json:"TemplateCollageResults" toml:"TemplateCollageResults" yaml:"TemplateCollageResults"`
+	ForkedFromCollagesTemplates CollagesTemplateSlice `boil:"ForkedFromCollagesTemplates" json:"ForkedFromCollagesTemplates" toml:"ForkedFromCollagesTemplates" yaml:"ForkedFromCollagesTemplates"`
+	TemplateDailyCollages       DailyCollageSlice     `boil:"TemplateDailyCollages" json:"TemplateDailyCollages" toml:"TemplateDailyCollages" yaml:"TemplateDailyCollages"`
+	TemplateGroups              GroupSlice            `boil:"TemplateGroups" json:"TemplateGroups" toml:"TemplateGroups" yaml:"TemplateGroups"`
+	TemplateSessionRounds       SessionRoundSlice     `boil:"TemplateSessionRounds" json:"TemplateSessionRounds" toml:"TemplateSessionRounds" yaml:"TemplateSessionRounds"`
+	TemplateTemplateParts       TemplatePartSlice     `boil:"TemplateTemplateParts" json:"TemplateTemplateParts" toml:"TemplateTemplateParts" yaml:"TemplateTemplateParts"`
 }
 
 // NewStruct creates a new relationship struct
@@ -102,6 +185,38 @@ func (*collagesTemplateR) NewStruct() *collagesTemplateR {
 	return &collagesTemplateR{}
 }
 
+func (o *CollagesTemplate) GetForkedFromCollagesTemplate() *CollagesTemplate {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetForkedFromCollagesTemplate()
+}
+
+func (r *collagesTemplateR) GetForkedFromCollagesTemplate() *CollagesTemplate {
+	if r == nil {
+		return nil
+	}
+
+	return r.ForkedFromCollagesTemplate
+}
+
+func (o *CollagesTemplate) GetOwnerUser() *User {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetOwnerUser()
+}
+
+func (r *collagesTemplateR) GetOwnerUser() *User {
+	if r == nil {
+		return nil
+	}
+
+	return r.OwnerUser
+}
+
 func (o *CollagesTemplate) GetTemplateCollageResults() CollageResultSlice {
 	if o == nil {
 		return nil
@@ -118,6 +233,70 @@ func (r *collagesTemplateR) GetTemplateCollageResults() CollageResultSlice {
 	return r.TemplateCollageResults
 }
 
+func (o *CollagesTemplate) GetForkedFromCollagesTemplates() CollagesTemplateSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetForkedFromCollagesTemplates()
+}
+
+func (r *collagesTemplateR) GetForkedFromCollagesTemplates() CollagesTemplateSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.ForkedFromCollagesTemplates
+}
+
+func (o *CollagesTemplate) GetTemplateDailyCollages() DailyCollageSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetTemplateDailyCollages()
+}
+
+func (r *collagesTemplateR) GetTemplateDailyCollages() DailyCollageSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.TemplateDailyCollages
+}
+
+func (o *CollagesTemplate) GetTemplateGroups() GroupSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetTemplateGroups()
+}
+
+func (r *collagesTemplateR) GetTemplateGroups() GroupSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.TemplateGroups
+}
+
+func (o *CollagesTemplate) GetTemplateSessionRounds() SessionRoundSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetTemplateSessionRounds()
+}
+
+func (r *collagesTemplateR) GetTemplateSessionRounds() SessionRoundSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.TemplateSessionRounds
+}
+
 func (o *CollagesTemplate) GetTemplateTemplateParts() TemplatePartSlice {
 	if o == nil {
 		return nil
@@ -138,9 +317,9 @@ func (r *collagesTemplateR) GetTemplateTemplateParts() TemplatePartSlice {
 type collagesTemplateL struct{}
 
 var (
-	collagesTemplateAllColumns            = []string{"template_id", "name", "file_path", "created_at", "updated_at"}
-	collagesTemplateColumnsWithoutDefault = []string{"template_id", "name", "file_path"}
-	collagesTemplateColumnsWithDefault    = []string{"created_at", "updated_at"}
+	collagesTemplateAllColumns            = []string{"template_id", "name", "file_path", "source_name", "owner_user_id", "visibility", "forked_from", "photo_count", "view_box", "width", "height", "created_at", "updated_at"}
+	collagesTemplateColumnsWithoutDefault = []string{"template_id", "name", "file_path", "source_name", "owner_user_id", "forked_from"}
+	collagesTemplateColumnsWithDefault    = []string{"visibility", "photo_count", "view_box", "width", "height", "created_at", "updated_at"}
 	collagesTemplatePrimaryKeyColumns     = []string{"template_id"}
 	collagesTemplateGeneratedColumns      = []string{}
 )
@@ -450,6 +629,28 @@ func (q collagesTemplateQuery) Exists(ctx context.Context, exec boil.ContextExec
 	return count > 0, nil
 }
 
+// ForkedFromCollagesTemplate pointed to by the foreign key.
+func (o *CollagesTemplate) ForkedFromCollagesTemplate(mods ...qm.QueryMod) collagesTemplateQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`template_id` = ?", o.ForkedFrom),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return CollagesTemplates(queryMods...)
+}
+
+// OwnerUser pointed to by the foreign key.
+func (o *CollagesTemplate) OwnerUser(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`id` = ?", o.OwnerUserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
 // TemplateCollageResults retrieves all the collage_result's CollageResults with an executor via template_id column.
 func (o *CollagesTemplate) TemplateCollageResults(mods ...qm.QueryMod) collageResultQuery {
 	var queryMods []qm.QueryMod
@@ -464,6 +665,62 @@ func (o *CollagesTemplate) TemplateCollageResults(mods ...qm.QueryMod) collageRe
 	return CollageResults(queryMods...)
 }
 
+// ForkedFromCollagesTemplates retrieves all the collages_template's CollagesTemplates with an executor via forked_from column.
+func (o *CollagesTemplate) ForkedFromCollagesTemplates(mods ...qm.QueryMod) collagesTemplateQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`collages_template`.`forked_from`=?", o.TemplateID),
+	)
+
+	return CollagesTemplates(queryMods...)
+}
+
+// TemplateDailyCollages retrieves all the daily_collage's DailyCollages with an executor via template_id column.
+func (o *CollagesTemplate) TemplateDailyCollages(mods ...qm.QueryMod) dailyCollageQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`daily_collages`.`template_id`=?", o.TemplateID),
+	)
+
+	return DailyCollages(queryMods...)
+}
+
+// TemplateGroups retrieves all the group's Groups with an executor via template_id column.
+func (o *CollagesTemplate) TemplateGroups(mods ...qm.QueryMod) groupQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`groups`.`template_id`=?", o.TemplateID),
+	)
+
+	return Groups(queryMods...)
+}
+
+// TemplateSessionRounds retrieves all the session_round's SessionRounds with an executor via template_id column.
+func (o *CollagesTemplate) TemplateSessionRounds(mods ...qm.QueryMod) sessionRoundQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`session_rounds`.`template_id`=?", o.TemplateID),
+	)
+
+	return SessionRounds(queryMods...)
+}
+
 // TemplateTemplateParts retrieves all the template_part's TemplateParts with an executor via template_id column.
 func (o *CollagesTemplate) TemplateTemplateParts(mods ...qm.QueryMod) templatePartQuery {
 	var queryMods []qm.QueryMod
@@ -478,9 +735,9 @@ func (o *CollagesTemplate) TemplateTemplateParts(mods ...qm.QueryMod) templatePa
 	return TemplateParts(queryMods...)
 }
 
-// LoadTemplateCollageResults allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (collagesTemplateL) LoadTemplateCollageResults(ctx context.Context, e boil.ContextExecutor, singular bool, maybeCollagesTemplate interface{}, mods queries.Applicator) error {
+// LoadForkedFromCollagesTemplate allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (collagesTemplateL) LoadForkedFromCollagesTemplate(ctx context.Context, e boil.ContextExecutor, singular bool, maybeCollagesTemplate interface{}, mods queries.Applicator) error {
 	var slice []*CollagesTemplate
 	var object *CollagesTemplate
 
@@ -511,13 +768,20 @@ func (collagesTemplateL) LoadTemplateCollageResults(ctx context.Context, e boil.
 		if object.R == nil {
 			object.R = &collagesTemplateR{}
 		}
-		args[object.TemplateID] = struct{}{}
+		if !queries.IsNil(object.ForkedFrom) {
+			args[object.ForkedFrom] = struct{}{}
+		}
+
 	} else {
 		for _, obj := range slice {
 			if obj.R == nil {
 				obj.R = &collagesTemplateR{}
 			}
-			args[obj.TemplateID] = struct{}{}
+
+			if !queries.IsNil(obj.ForkedFrom) {
+				args[obj.ForkedFrom] = struct{}{}
+			}
+
 		}
 	}
 
@@ -533,8 +797,8 @@ func (collagesTemplateL) LoadTemplateCollageResults(ctx context.Context, e boil.
 	}
 
 	query := NewQuery(
-		qm.From(`collage_results`),
-		qm.WhereIn(`collage_results.template_id in ?`, argsSlice...),
+		qm.From(`collages_template`),
+		qm.WhereIn(`collages_template.template_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -542,47 +806,51 @@ func (collagesTemplateL) LoadTemplateCollageResults(ctx context.Context, e boil.
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load collage_results")
+		return errors.Wrap(err, "failed to eager load CollagesTemplate")
 	}
 
-	var resultSlice []*CollageResult
+	var resultSlice []*CollagesTemplate
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice collage_results")
+		return errors.Wrap(err, "failed to bind eager loaded slice CollagesTemplate")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on collage_results")
+		return errors.Wrap(err, "failed to close results of eager load for collages_template")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for collage_results")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for collages_template")
 	}
 
-	if len(collageResultAfterSelectHooks) != 0 {
+	if len(collagesTemplateAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
 			}
 		}
 	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
 	if singular {
-		object.R.TemplateCollageResults = resultSlice
-		for _, foreign := range resultSlice {
-			if foreign.R == nil {
-				foreign.R = &collageResultR{}
-			}
-			foreign.R.Template = object
+		foreign := resultSlice[0]
+		object.R.ForkedFromCollagesTemplate = foreign
+		if foreign.R == nil {
+			foreign.R = &collagesTemplateR{}
 		}
+		foreign.R.ForkedFromCollagesTemplates = append(foreign.R.ForkedFromCollagesTemplates, object)
 		return nil
 	}
 
-	for _, foreign := range resultSlice {
-		for _, local := range slice {
-			if local.TemplateID == foreign.TemplateID {
-				local.R.TemplateCollageResults = append(local.R.TemplateCollageResults, foreign)
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.ForkedFrom, foreign.TemplateID) {
+				local.R.ForkedFromCollagesTemplate = foreign
 				if foreign.R == nil {
-					foreign.R = &collageResultR{}
+					foreign.R = &collagesTemplateR{}
 				}
-				foreign.R.Template = local
+				foreign.R.ForkedFromCollagesTemplates = append(foreign.R.ForkedFromCollagesTemplates, local)
 				break
 			}
 		}
@@ -591,9 +859,9 @@ func (collagesTemplateL) LoadTemplateCollageResults(ctx context.Context, e boil.
 	return nil
 }
 
-// LoadTemplateTemplateParts allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (collagesTemplateL) LoadTemplateTemplateParts(ctx context.Context, e boil.ContextExecutor, singular bool, maybeCollagesTemplate interface{}, mods queries.Applicator) error {
+// LoadOwnerUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (collagesTemplateL) LoadOwnerUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeCollagesTemplate interface{}, mods queries.Applicator) error {
 	var slice []*CollagesTemplate
 	var object *CollagesTemplate
 
@@ -624,13 +892,20 @@ func (collagesTemplateL) LoadTemplateTemplateParts(ctx context.Context, e boil.C
 		if object.R == nil {
 			object.R = &collagesTemplateR{}
 		}
-		args[object.TemplateID] = struct{}{}
+		if !queries.IsNil(object.OwnerUserID) {
+			args[object.OwnerUserID] = struct{}{}
+		}
+
 	} else {
 		for _, obj := range slice {
 			if obj.R == nil {
 				obj.R = &collagesTemplateR{}
 			}
-			args[obj.TemplateID] = struct{}{}
+
+			if !queries.IsNil(obj.OwnerUserID) {
+				args[obj.OwnerUserID] = struct{}{}
+			}
+
 		}
 	}
 
@@ -646,8 +921,8 @@ func (collagesTemplateL) LoadTemplateTemplateParts(ctx context.Context, e boil.C
 	}
 
 	query := NewQuery(
-		qm.From(`template_parts`),
-		qm.WhereIn(`template_parts.template_id in ?`, argsSlice...),
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -655,47 +930,51 @@ func (collagesTemplateL) LoadTemplateTemplateParts(ctx context.Context, e boil.C
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load template_parts")
+		return errors.Wrap(err, "failed to eager load User")
 	}
 
-	var resultSlice []*TemplatePart
+	var resultSlice []*User
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice template_parts")
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on template_parts")
+		return errors.Wrap(err, "failed to close results of eager load for users")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for template_parts")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
 	}
 
-	if len(templatePartAfterSelectHooks) != 0 {
+	if len(userAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
 			}
 		}
 	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
 	if singular {
-		object.R.TemplateTemplateParts = resultSlice
-		for _, foreign := range resultSlice {
-			if foreign.R == nil {
-				foreign.R = &templatePartR{}
-			}
-			foreign.R.Template = object
+		foreign := resultSlice[0]
+		object.R.OwnerUser = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
 		}
+		foreign.R.OwnerUserCollagesTemplates = append(foreign.R.OwnerUserCollagesTemplates, object)
 		return nil
 	}
 
-	for _, foreign := range resultSlice {
-		for _, local := range slice {
-			if local.TemplateID == foreign.TemplateID {
-				local.R.TemplateTemplateParts = append(local.R.TemplateTemplateParts, foreign)
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.OwnerUserID, foreign.ID) {
+				local.R.OwnerUser = foreign
 				if foreign.R == nil {
-					foreign.R = &templatePartR{}
+					foreign.R = &userR{}
 				}
-				foreign.R.Template = local
+				foreign.R.OwnerUserCollagesTemplates = append(foreign.R.OwnerUserCollagesTemplates, local)
 				break
 			}
 		}
@@ -704,50 +983,1248 @@ func (collagesTemplateL) LoadTemplateTemplateParts(ctx context.Context, e boil.C
 	return nil
 }
 
-// AddTemplateCollageResults adds the given related objects to the existing relationships
-// of the collages_template, optionally inserting them as new records.
-// Appends related to o.R.TemplateCollageResults.
-// Sets related.R.Template appropriately.
-func (o *CollagesTemplate) AddTemplateCollageResults(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*CollageResult) error {
-	var err error
-	for _, rel := range related {
-		if insert {
-			rel.TemplateID = o.TemplateID
-			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
-				return errors.Wrap(err, "failed to insert into foreign table")
-			}
-		} else {
-			updateQuery := fmt.Sprintf(
-				"UPDATE `collage_results` SET %s WHERE %s",
-				strmangle.SetParamNames("`", "`", 0, []string{"template_id"}),
-				strmangle.WhereClause("`", "`", 0, collageResultPrimaryKeyColumns),
-			)
-			values := []interface{}{o.TemplateID, rel.ResultID}
+// LoadTemplateCollageResults allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (collagesTemplateL) LoadTemplateCollageResults(ctx context.Context, e boil.ContextExecutor, singular bool, maybeCollagesTemplate interface{}, mods queries.Applicator) error {
+	var slice []*CollagesTemplate
+	var object *CollagesTemplate
 
-			if boil.IsDebug(ctx) {
-				writer := boil.DebugWriterFrom(ctx)
-				fmt.Fprintln(writer, updateQuery)
-				fmt.Fprintln(writer, values)
+	if singular {
+		var ok bool
+		object, ok = maybeCollagesTemplate.(*CollagesTemplate)
+		if !ok {
+			object = new(CollagesTemplate)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeCollagesTemplate)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeCollagesTemplate))
 			}
-			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-				return errors.Wrap(err, "failed to update foreign table")
+		}
+	} else {
+		s, ok := maybeCollagesTemplate.(*[]*CollagesTemplate)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeCollagesTemplate)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeCollagesTemplate))
 			}
-
-			rel.TemplateID = o.TemplateID
 		}
 	}
 
-	if o.R == nil {
-		o.R = &collagesTemplateR{
-			TemplateCollageResults: related,
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &collagesTemplateR{}
 		}
+		args[object.TemplateID] = struct{}{}
 	} else {
-		o.R.TemplateCollageResults = append(o.R.TemplateCollageResults, related...)
-	}
-
-	for _, rel := range related {
-		if rel.R == nil {
-			rel.R = &collageResultR{
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &collagesTemplateR{}
+			}
+			args[obj.TemplateID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`collage_results`),
+		qm.WhereIn(`collage_results.template_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load collage_results")
+	}
+
+	var resultSlice []*CollageResult
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice collage_results")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on collage_results")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for collage_results")
+	}
+
+	if len(collageResultAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.TemplateCollageResults = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &collageResultR{}
+			}
+			foreign.R.Template = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.TemplateID == foreign.TemplateID {
+				local.R.TemplateCollageResults = append(local.R.TemplateCollageResults, foreign)
+				if foreign.R == nil {
+					foreign.R = &collageResultR{}
+				}
+				foreign.R.Template = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadForkedFromCollagesTemplates allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (collagesTemplateL) LoadForkedFromCollagesTemplates(ctx context.Context, e boil.ContextExecutor, singular bool, maybeCollagesTemplate interface{}, mods queries.Applicator) error {
+	var slice []*CollagesTemplate
+	var object *CollagesTemplate
+
+	if singular {
+		var ok bool
+		object, ok = maybeCollagesTemplate.(*CollagesTemplate)
+		if !ok {
+			object = new(CollagesTemplate)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeCollagesTemplate)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeCollagesTemplate))
+			}
+		}
+	} else {
+		s, ok := maybeCollagesTemplate.(*[]*CollagesTemplate)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeCollagesTemplate)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeCollagesTemplate))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &collagesTemplateR{}
+		}
+		args[object.TemplateID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &collagesTemplateR{}
+			}
+			args[obj.TemplateID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`collages_template`),
+		qm.WhereIn(`collages_template.forked_from in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load collages_template")
+	}
+
+	var resultSlice []*CollagesTemplate
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice collages_template")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on collages_template")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for collages_template")
+	}
+
+	if len(collagesTemplateAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ForkedFromCollagesTemplates = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &collagesTemplateR{}
+			}
+			foreign.R.ForkedFromCollagesTemplate = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.TemplateID, foreign.ForkedFrom) {
+				local.R.ForkedFromCollagesTemplates = append(local.R.ForkedFromCollagesTemplates, foreign)
+				if foreign.R == nil {
+					foreign.R = &collagesTemplateR{}
+				}
+				foreign.R.ForkedFromCollagesTemplate = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTemplateDailyCollages allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (collagesTemplateL) LoadTemplateDailyCollages(ctx context.Context, e boil.ContextExecutor, singular bool, maybeCollagesTemplate interface{}, mods queries.Applicator) error {
+	var slice []*CollagesTemplate
+	var object *CollagesTemplate
+
+	if singular {
+		var ok bool
+		object, ok = maybeCollagesTemplate.(*CollagesTemplate)
+		if !ok {
+			object = new(CollagesTemplate)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeCollagesTemplate)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeCollagesTemplate))
+			}
+		}
+	} else {
+		s, ok := maybeCollagesTemplate.(*[]*CollagesTemplate)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeCollagesTemplate)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeCollagesTemplate))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &collagesTemplateR{}
+		}
+		args[object.TemplateID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &collagesTemplateR{}
+			}
+			args[obj.TemplateID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`daily_collages`),
+		qm.WhereIn(`daily_collages.template_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load daily_collages")
+	}
+
+	var resultSlice []*DailyCollage
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice daily_collages")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on daily_collages")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for daily_collages")
+	}
+
+	if len(dailyCollageAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.TemplateDailyCollages = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &dailyCollageR{}
+			}
+			foreign.R.Template = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.TemplateID == foreign.TemplateID {
+				local.R.TemplateDailyCollages = append(local.R.TemplateDailyCollages, foreign)
+				if foreign.R == nil {
+					foreign.R = &dailyCollageR{}
+				}
+				foreign.R.Template = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTemplateGroups allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (collagesTemplateL) LoadTemplateGroups(ctx context.Context, e boil.ContextExecutor, singular bool, maybeCollagesTemplate interface{}, mods queries.Applicator) error {
+	var slice []*CollagesTemplate
+	var object *CollagesTemplate
+
+	if singular {
+		var ok bool
+		object, ok = maybeCollagesTemplate.(*CollagesTemplate)
+		if !ok {
+			object = new(CollagesTemplate)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeCollagesTemplate)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeCollagesTemplate))
+			}
+		}
+	} else {
+		s, ok := maybeCollagesTemplate.(*[]*CollagesTemplate)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeCollagesTemplate)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeCollagesTemplate))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &collagesTemplateR{}
+		}
+		args[object.TemplateID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &collagesTemplateR{}
+			}
+			args[obj.TemplateID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`groups`),
+		qm.WhereIn(`groups.template_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load groups")
+	}
+
+	var resultSlice []*Group
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice groups")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on groups")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for groups")
+	}
+
+	if len(groupAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.TemplateGroups = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &groupR{}
+			}
+			foreign.R.Template = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.TemplateID, foreign.TemplateID) {
+				local.R.TemplateGroups = append(local.R.TemplateGroups, foreign)
+				if foreign.R == nil {
+					foreign.R = &groupR{}
+				}
+				foreign.R.Template = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTemplateSessionRounds allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (collagesTemplateL) LoadTemplateSessionRounds(ctx context.Context, e boil.ContextExecutor, singular bool, maybeCollagesTemplate interface{}, mods queries.Applicator) error {
+	var slice []*CollagesTemplate
+	var object *CollagesTemplate
+
+	if singular {
+		var ok bool
+		object, ok = maybeCollagesTemplate.(*CollagesTemplate)
+		if !ok {
+			object = new(CollagesTemplate)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeCollagesTemplate)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeCollagesTemplate))
+			}
+		}
+	} else {
+		s, ok := maybeCollagesTemplate.(*[]*CollagesTemplate)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeCollagesTemplate)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeCollagesTemplate))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &collagesTemplateR{}
+		}
+		args[object.TemplateID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &collagesTemplateR{}
+			}
+			args[obj.TemplateID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`session_rounds`),
+		qm.WhereIn(`session_rounds.template_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load session_rounds")
+	}
+
+	var resultSlice []*SessionRound
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice session_rounds")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on session_rounds")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for session_rounds")
+	}
+
+	if len(sessionRoundAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.TemplateSessionRounds = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &sessionRoundR{}
+			}
+			foreign.R.Template = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.TemplateID == foreign.TemplateID {
+				local.R.TemplateSessionRounds = append(local.R.TemplateSessionRounds, foreign)
+				if foreign.R == nil {
+					foreign.R = &sessionRoundR{}
+				}
+				foreign.R.Template = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTemplateTemplateParts allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (collagesTemplateL) LoadTemplateTemplateParts(ctx context.Context, e boil.ContextExecutor, singular bool, maybeCollagesTemplate interface{}, mods queries.Applicator) error {
+	var slice []*CollagesTemplate
+	var object *CollagesTemplate
+
+	if singular {
+		var ok bool
+		object, ok = maybeCollagesTemplate.(*CollagesTemplate)
+		if !ok {
+			object = new(CollagesTemplate)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeCollagesTemplate)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeCollagesTemplate))
+			}
+		}
+	} else {
+		s, ok := maybeCollagesTemplate.(*[]*CollagesTemplate)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeCollagesTemplate)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeCollagesTemplate))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &collagesTemplateR{}
+		}
+		args[object.TemplateID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &collagesTemplateR{}
+			}
+			args[obj.TemplateID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`template_parts`),
+		qm.WhereIn(`template_parts.template_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load template_parts")
+	}
+
+	var resultSlice []*TemplatePart
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice template_parts")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on template_parts")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for template_parts")
+	}
+
+	if len(templatePartAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.TemplateTemplateParts = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &templatePartR{}
+			}
+			foreign.R.Template = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.TemplateID == foreign.TemplateID {
+				local.R.TemplateTemplateParts = append(local.R.TemplateTemplateParts, foreign)
+				if foreign.R == nil {
+					foreign.R = &templatePartR{}
+				}
+				foreign.R.Template = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetForkedFromCollagesTemplate of the collagesTemplate to the related item.
+// Sets o.R.ForkedFromCollagesTemplate to related.
+// Adds o to related.R.ForkedFromCollagesTemplates.
+func (o *CollagesTemplate) SetForkedFromCollagesTemplate(ctx context.Context, exec boil.ContextExecutor, insert bool, related *CollagesTemplate) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `collages_template` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"forked_from"}),
+		strmangle.WhereClause("`", "`", 0, collagesTemplatePrimaryKeyColumns),
+	)
+	values := []interface{}{related.TemplateID, o.TemplateID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.ForkedFrom, related.TemplateID)
+	if o.R == nil {
+		o.R = &collagesTemplateR{
+			ForkedFromCollagesTemplate: related,
+		}
+	} else {
+		o.R.ForkedFromCollagesTemplate = related
+	}
+
+	if related.R == nil {
+		related.R = &collagesTemplateR{
+			ForkedFromCollagesTemplates: CollagesTemplateSlice{o},
+		}
+	} else {
+		related.R.ForkedFromCollagesTemplates = append(related.R.ForkedFromCollagesTemplates, o)
+	}
+
+	return nil
+}
+
+// RemoveForkedFromCollagesTemplate relationship.
+// Sets o.R.ForkedFromCollagesTemplate to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *CollagesTemplate) RemoveForkedFromCollagesTemplate(ctx context.Context, exec boil.ContextExecutor, related *CollagesTemplate) error {
+	var err error
+
+	queries.SetScanner(&o.ForkedFrom, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("forked_from")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.ForkedFromCollagesTemplate = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.ForkedFromCollagesTemplates {
+		if queries.Equal(o.ForkedFrom, ri.ForkedFrom) {
+			continue
+		}
+
+		ln := len(related.R.ForkedFromCollagesTemplates)
+		if ln > 1 && i < ln-1 {
+			related.R.ForkedFromCollagesTemplates[i] = related.R.ForkedFromCollagesTemplates[ln-1]
+		}
+		related.R.ForkedFromCollagesTemplates = related.R.ForkedFromCollagesTemplates[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetOwnerUser of the collagesTemplate to the related item.
+// Sets o.R.OwnerUser to related.
+// Adds o to related.R.OwnerUserCollagesTemplates.
+func (o *CollagesTemplate) SetOwnerUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `collages_template` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"owner_user_id"}),
+		strmangle.WhereClause("`", "`", 0, collagesTemplatePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.TemplateID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.OwnerUserID, related.ID)
+	if o.R == nil {
+		o.R = &collagesTemplateR{
+			OwnerUser: related,
+		}
+	} else {
+		o.R.OwnerUser = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			OwnerUserCollagesTemplates: CollagesTemplateSlice{o},
+		}
+	} else {
+		related.R.OwnerUserCollagesTemplates = append(related.R.OwnerUserCollagesTemplates, o)
+	}
+
+	return nil
+}
+
+// RemoveOwnerUser relationship.
+// Sets o.R.OwnerUser to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *CollagesTemplate) RemoveOwnerUser(ctx context.Context, exec boil.ContextExecutor, related *User) error {
+	var err error
+
+	queries.SetScanner(&o.OwnerUserID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("owner_user_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.OwnerUser = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.OwnerUserCollagesTemplates {
+		if queries.Equal(o.OwnerUserID, ri.OwnerUserID) {
+			continue
+		}
+
+		ln := len(related.R.OwnerUserCollagesTemplates)
+		if ln > 1 && i < ln-1 {
+			related.R.OwnerUserCollagesTemplates[i] = related.R.OwnerUserCollagesTemplates[ln-1]
+		}
+		related.R.OwnerUserCollagesTemplates = related.R.OwnerUserCollagesTemplates[:ln-1]
+		break
+	}
+	return nil
+}
+
+// AddTemplateCollageResults adds the given related objects to the existing relationships
+// of the collages_template, optionally inserting them as new records.
+// Appends related to o.R.TemplateCollageResults.
+// Sets related.R.Template appropriately.
+func (o *CollagesTemplate) AddTemplateCollageResults(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*CollageResult) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.TemplateID = o.TemplateID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `collage_results` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"template_id"}),
+				strmangle.WhereClause("`", "`", 0, collageResultPrimaryKeyColumns),
+			)
+			values := []interface{}{o.TemplateID, rel.ResultID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.TemplateID = o.TemplateID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &collagesTemplateR{
+			TemplateCollageResults: related,
+		}
+	} else {
+		o.R.TemplateCollageResults = append(o.R.TemplateCollageResults, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &collageResultR{
+				Template: o,
+			}
+		} else {
+			rel.R.Template = o
+		}
+	}
+	return nil
+}
+
+// AddForkedFromCollagesTemplates adds the given related objects to the existing relationships
+// of the collages_template, optionally inserting them as new records.
+// Appends related to o.R.ForkedFromCollagesTemplates.
+// Sets related.R.ForkedFromCollagesTemplate appropriately.
+func (o *CollagesTemplate) AddForkedFromCollagesTemplates(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*CollagesTemplate) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.ForkedFrom, o.TemplateID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `collages_template` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"forked_from"}),
+				strmangle.WhereClause("`", "`", 0, collagesTemplatePrimaryKeyColumns),
+			)
+			values := []interface{}{o.TemplateID, rel.TemplateID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.ForkedFrom, o.TemplateID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &collagesTemplateR{
+			ForkedFromCollagesTemplates: related,
+		}
+	} else {
+		o.R.ForkedFromCollagesTemplates = append(o.R.ForkedFromCollagesTemplates, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &collagesTemplateR{
+				ForkedFromCollagesTemplate: o,
+			}
+		} else {
+			rel.R.ForkedFromCollagesTemplate = o
+		}
+	}
+	return nil
+}
+
+// SetForkedFromCollagesTemplates removes all previously related items of the
+// collages_template replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.ForkedFromCollagesTemplate's ForkedFromCollagesTemplates accordingly.
+// Replaces o.R.ForkedFromCollagesTemplates with related.
+// Sets related.R.ForkedFromCollagesTemplate's ForkedFromCollagesTemplates accordingly.
+func (o *CollagesTemplate) SetForkedFromCollagesTemplates(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*CollagesTemplate) error {
+	query := "update `collages_template` set `forked_from` = null where `forked_from` = ?"
+	values := []interface{}{o.TemplateID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.ForkedFromCollagesTemplates {
+			queries.SetScanner(&rel.ForkedFrom, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.ForkedFromCollagesTemplate = nil
+		}
+		o.R.ForkedFromCollagesTemplates = nil
+	}
+
+	return o.AddForkedFromCollagesTemplates(ctx, exec, insert, related...)
+}
+
+// RemoveForkedFromCollagesTemplates relationships from objects passed in.
+// Removes related items from R.ForkedFromCollagesTemplates (uses pointer comparison, removal does not keep order)
+// Sets related.R.ForkedFromCollagesTemplate.
+func (o *CollagesTemplate) RemoveForkedFromCollagesTemplates(ctx context.Context, exec boil.ContextExecutor, related ...*CollagesTemplate) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.ForkedFrom, nil)
+		if rel.R != nil {
+			rel.R.ForkedFromCollagesTemplate = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("forked_from")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.ForkedFromCollagesTemplates {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.ForkedFromCollagesTemplates)
+			if ln > 1 && i < ln-1 {
+				o.R.ForkedFromCollagesTemplates[i] = o.R.ForkedFromCollagesTemplates[ln-1]
+			}
+			o.R.ForkedFromCollagesTemplates = o.R.ForkedFromCollagesTemplates[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddTemplateDailyCollages adds the given related objects to the existing relationships
+// of the collages_template, optionally inserting them as new records.
+// Appends related to o.R.TemplateDailyCollages.
+// Sets related.R.Template appropriately.
+func (o *CollagesTemplate) AddTemplateDailyCollages(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*DailyCollage) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.TemplateID = o.TemplateID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `daily_collages` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"template_id"}),
+				strmangle.WhereClause("`", "`", 0, dailyCollagePrimaryKeyColumns),
+			)
+			values := []interface{}{o.TemplateID, rel.DailyID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.TemplateID = o.TemplateID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &collagesTemplateR{
+			TemplateDailyCollages: related,
+		}
+	} else {
+		o.R.TemplateDailyCollages = append(o.R.TemplateDailyCollages, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &dailyCollageR{
+				Template: o,
+			}
+		} else {
+			rel.R.Template = o
+		}
+	}
+	return nil
+}
+
+// AddTemplateGroups adds the given related objects to the existing relationships
+// of the collages_template, optionally inserting them as new records.
+// Appends related to o.R.TemplateGroups.
+// Sets related.R.Template appropriately.
+func (o *CollagesTemplate) AddTemplateGroups(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Group) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.TemplateID, o.TemplateID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `groups` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"template_id"}),
+				strmangle.WhereClause("`", "`", 0, groupPrimaryKeyColumns),
+			)
+			values := []interface{}{o.TemplateID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.TemplateID, o.TemplateID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &collagesTemplateR{
+			TemplateGroups: related,
+		}
+	} else {
+		o.R.TemplateGroups = append(o.R.TemplateGroups, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &groupR{
+				Template: o,
+			}
+		} else {
+			rel.R.Template = o
+		}
+	}
+	return nil
+}
+
+// SetTemplateGroups removes all previously related items of the
+// collages_template replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Template's TemplateGroups accordingly.
+// Replaces o.R.TemplateGroups with related.
+// Sets related.R.Template's TemplateGroups accordingly.
+func (o *CollagesTemplate) SetTemplateGroups(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Group) error {
+	query := "update `groups` set `template_id` = null where `template_id` = ?"
+	values := []interface{}{o.TemplateID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.TemplateGroups {
+			queries.SetScanner(&rel.TemplateID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Template = nil
+		}
+		o.R.TemplateGroups = nil
+	}
+
+	return o.AddTemplateGroups(ctx, exec, insert, related...)
+}
+
+// RemoveTemplateGroups relationships from objects passed in.
+// Removes related items from R.TemplateGroups (uses pointer comparison, removal does not keep order)
+// Sets related.R.Template.
+func (o *CollagesTemplate) RemoveTemplateGroups(ctx context.Context, exec boil.ContextExecutor, related ...*Group) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.TemplateID, nil)
+		if rel.R != nil {
+			rel.R.Template = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("template_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.TemplateGroups {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.TemplateGroups)
+			if ln > 1 && i < ln-1 {
+				o.R.TemplateGroups[i] = o.R.TemplateGroups[ln-1]
+			}
+			o.R.TemplateGroups = o.R.TemplateGroups[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddTemplateSessionRounds adds the given related objects to the existing relationships
+// of the collages_template, optionally inserting them as new records.
+// Appends related to o.R.TemplateSessionRounds.
+// Sets related.R.Template appropriately.
+func (o *CollagesTemplate) AddTemplateSessionRounds(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*SessionRound) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.TemplateID = o.TemplateID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `session_rounds` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"template_id"}),
+				strmangle.WhereClause("`", "`", 0, sessionRoundPrimaryKeyColumns),
+			)
+			values := []interface{}{o.TemplateID, rel.RoundID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.TemplateID = o.TemplateID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &collagesTemplateR{
+			TemplateSessionRounds: related,
+		}
+	} else {
+		o.R.TemplateSessionRounds = append(o.R.TemplateSessionRounds, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &sessionRoundR{
 				Template: o,
 			}
 		} else {
@@ -1092,6 +2569,7 @@ func (o CollagesTemplateSlice) UpdateAll(ctx context.Context, exec boil.ContextE
 
 var mySQLCollagesTemplateUniqueColumns = []string{
 	"template_id",
+	"source_name",
 }
 
 // Upsert attempts an insert using an executor, and does an update or ignore on conflict.
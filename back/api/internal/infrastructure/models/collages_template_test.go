@@ -572,14 +572,14 @@ func testCollagesTemplateToManyTemplateCollageResults(t *testing.T) {
 	}
 }
 
-func testCollagesTemplateToManyTemplateTemplateParts(t *testing.T) {
+func testCollagesTemplateToManyForkedFromCollagesTemplates(t *testing.T) {
 	var err error
 	ctx := context.Background()
 	tx := MustTx(boil.BeginTx(ctx, nil))
 	defer func() { _ = tx.Rollback() }()
 
 	var a CollagesTemplate
-	var b, c TemplatePart
+	var b, c CollagesTemplate
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, collagesTemplateDBTypes, true, collagesTemplateColumnsWithDefault...); err != nil {
@@ -590,10 +590,87 @@ func testCollagesTemplateToManyTemplateTemplateParts(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if err = randomize.Struct(seed, &b, templatePartDBTypes, false, templatePartColumnsWithDefault...); err != nil {
+	if err = randomize.Struct(seed, &b, collagesTemplateDBTypes, false, collagesTemplateColumnsWithDefault...); err != nil {
 		t.Fatal(err)
 	}
-	if err = randomize.Struct(seed, &c, templatePartDBTypes, false, templatePartColumnsWithDefault...); err != nil {
+	if err = randomize.Struct(seed, &c, collagesTemplateDBTypes, false, collagesTemplateColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&b.ForkedFrom, a.TemplateID)
+	queries.Assign(&c.ForkedFrom, a.TemplateID)
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.ForkedFromCollagesTemplates().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if queries.Equal(v.ForkedFrom, b.ForkedFrom) {
+			bFound = true
+		}
+		if queries.Equal(v.ForkedFrom, c.ForkedFrom) {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := CollagesTemplateSlice{&a}
+	if err = a.L.LoadForkedFromCollagesTemplates(ctx, tx, false, (*[]*CollagesTemplate)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.ForkedFromCollagesTemplates); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.ForkedFromCollagesTemplates = nil
+	if err = a.L.LoadForkedFromCollagesTemplates(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.ForkedFromCollagesTemplates); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testCollagesTemplateToManyTemplateDailyCollages(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a CollagesTemplate
+	var b, c DailyCollage
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, collagesTemplateDBTypes, true, collagesTemplateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CollagesTemplate struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, dailyCollageDBTypes, false, dailyCollageColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, dailyCollageDBTypes, false, dailyCollageColumnsWithDefault...); err != nil {
 		t.Fatal(err)
 	}
 
@@ -607,7 +684,162 @@ func testCollagesTemplateToManyTemplateTemplateParts(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	check, err := a.TemplateTemplateParts().All(ctx, tx)
+	check, err := a.TemplateDailyCollages().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.TemplateID == b.TemplateID {
+			bFound = true
+		}
+		if v.TemplateID == c.TemplateID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := CollagesTemplateSlice{&a}
+	if err = a.L.LoadTemplateDailyCollages(ctx, tx, false, (*[]*CollagesTemplate)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.TemplateDailyCollages); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.TemplateDailyCollages = nil
+	if err = a.L.LoadTemplateDailyCollages(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.TemplateDailyCollages); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testCollagesTemplateToManyTemplateGroups(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a CollagesTemplate
+	var b, c Group
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, collagesTemplateDBTypes, true, collagesTemplateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CollagesTemplate struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, groupDBTypes, false, groupColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, groupDBTypes, false, groupColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&b.TemplateID, a.TemplateID)
+	queries.Assign(&c.TemplateID, a.TemplateID)
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.TemplateGroups().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if queries.Equal(v.TemplateID, b.TemplateID) {
+			bFound = true
+		}
+		if queries.Equal(v.TemplateID, c.TemplateID) {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := CollagesTemplateSlice{&a}
+	if err = a.L.LoadTemplateGroups(ctx, tx, false, (*[]*CollagesTemplate)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.TemplateGroups); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.TemplateGroups = nil
+	if err = a.L.LoadTemplateGroups(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.TemplateGroups); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testCollagesTemplateToManyTemplateSessionRounds(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a CollagesTemplate
+	var b, c SessionRound
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, collagesTemplateDBTypes, true, collagesTemplateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CollagesTemplate struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, sessionRoundDBTypes, false, sessionRoundColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, sessionRoundDBTypes, false, sessionRoundColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.TemplateID = a.TemplateID
+	c.TemplateID = a.TemplateID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.TemplateSessionRounds().All(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -622,35 +854,765 @@ func testCollagesTemplateToManyTemplateTemplateParts(t *testing.T) {
 		}
 	}
 
-	if !bFound {
-		t.Error("expected to find b")
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := CollagesTemplateSlice{&a}
+	if err = a.L.LoadTemplateSessionRounds(ctx, tx, false, (*[]*CollagesTemplate)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.TemplateSessionRounds); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.TemplateSessionRounds = nil
+	if err = a.L.LoadTemplateSessionRounds(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.TemplateSessionRounds); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testCollagesTemplateToManyTemplateTemplateParts(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a CollagesTemplate
+	var b, c TemplatePart
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, collagesTemplateDBTypes, true, collagesTemplateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CollagesTemplate struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, templatePartDBTypes, false, templatePartColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, templatePartDBTypes, false, templatePartColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.TemplateID = a.TemplateID
+	c.TemplateID = a.TemplateID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.TemplateTemplateParts().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.TemplateID == b.TemplateID {
+			bFound = true
+		}
+		if v.TemplateID == c.TemplateID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := CollagesTemplateSlice{&a}
+	if err = a.L.LoadTemplateTemplateParts(ctx, tx, false, (*[]*CollagesTemplate)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.TemplateTemplateParts); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.TemplateTemplateParts = nil
+	if err = a.L.LoadTemplateTemplateParts(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.TemplateTemplateParts); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testCollagesTemplateToManyAddOpTemplateCollageResults(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a CollagesTemplate
+	var b, c, d, e CollageResult
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, collagesTemplateDBTypes, false, strmangle.SetComplement(collagesTemplatePrimaryKeyColumns, collagesTemplateColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*CollageResult{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, collageResultDBTypes, false, strmangle.SetComplement(collageResultPrimaryKeyColumns, collageResultColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*CollageResult{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddTemplateCollageResults(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.TemplateID != first.TemplateID {
+			t.Error("foreign key was wrong value", a.TemplateID, first.TemplateID)
+		}
+		if a.TemplateID != second.TemplateID {
+			t.Error("foreign key was wrong value", a.TemplateID, second.TemplateID)
+		}
+
+		if first.R.Template != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Template != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.TemplateCollageResults[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.TemplateCollageResults[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.TemplateCollageResults().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testCollagesTemplateToManyAddOpForkedFromCollagesTemplates(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a CollagesTemplate
+	var b, c, d, e CollagesTemplate
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, collagesTemplateDBTypes, false, strmangle.SetComplement(collagesTemplatePrimaryKeyColumns, collagesTemplateColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*CollagesTemplate{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, collagesTemplateDBTypes, false, strmangle.SetComplement(collagesTemplatePrimaryKeyColumns, collagesTemplateColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*CollagesTemplate{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddForkedFromCollagesTemplates(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if !queries.Equal(a.TemplateID, first.ForkedFrom) {
+			t.Error("foreign key was wrong value", a.TemplateID, first.ForkedFrom)
+		}
+		if !queries.Equal(a.TemplateID, second.ForkedFrom) {
+			t.Error("foreign key was wrong value", a.TemplateID, second.ForkedFrom)
+		}
+
+		if first.R.ForkedFromCollagesTemplate != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.ForkedFromCollagesTemplate != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.ForkedFromCollagesTemplates[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.ForkedFromCollagesTemplates[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.ForkedFromCollagesTemplates().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testCollagesTemplateToManySetOpForkedFromCollagesTemplates(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a CollagesTemplate
+	var b, c, d, e CollagesTemplate
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, collagesTemplateDBTypes, false, strmangle.SetComplement(collagesTemplatePrimaryKeyColumns, collagesTemplateColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*CollagesTemplate{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, collagesTemplateDBTypes, false, strmangle.SetComplement(collagesTemplatePrimaryKeyColumns, collagesTemplateColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetForkedFromCollagesTemplates(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.ForkedFromCollagesTemplates().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetForkedFromCollagesTemplates(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.ForkedFromCollagesTemplates().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.ForkedFrom) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.ForkedFrom) {
+		t.Error("want c's foreign key value to be nil")
+	}
+	if !queries.Equal(a.TemplateID, d.ForkedFrom) {
+		t.Error("foreign key was wrong value", a.TemplateID, d.ForkedFrom)
+	}
+	if !queries.Equal(a.TemplateID, e.ForkedFrom) {
+		t.Error("foreign key was wrong value", a.TemplateID, e.ForkedFrom)
+	}
+
+	if b.R.ForkedFromCollagesTemplate != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.ForkedFromCollagesTemplate != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.ForkedFromCollagesTemplate != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.ForkedFromCollagesTemplate != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if a.R.ForkedFromCollagesTemplates[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.ForkedFromCollagesTemplates[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testCollagesTemplateToManyRemoveOpForkedFromCollagesTemplates(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a CollagesTemplate
+	var b, c, d, e CollagesTemplate
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, collagesTemplateDBTypes, false, strmangle.SetComplement(collagesTemplatePrimaryKeyColumns, collagesTemplateColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*CollagesTemplate{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, collagesTemplateDBTypes, false, strmangle.SetComplement(collagesTemplatePrimaryKeyColumns, collagesTemplateColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddForkedFromCollagesTemplates(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.ForkedFromCollagesTemplates().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemoveForkedFromCollagesTemplates(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.ForkedFromCollagesTemplates().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.ForkedFrom) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.ForkedFrom) {
+		t.Error("want c's foreign key value to be nil")
+	}
+
+	if b.R.ForkedFromCollagesTemplate != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.ForkedFromCollagesTemplate != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.ForkedFromCollagesTemplate != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+	if e.R.ForkedFromCollagesTemplate != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+
+	if len(a.R.ForkedFromCollagesTemplates) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.ForkedFromCollagesTemplates[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.ForkedFromCollagesTemplates[0] != &e {
+		t.Error("relationship to e should have been preserved")
+	}
+}
+
+func testCollagesTemplateToManyAddOpTemplateDailyCollages(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a CollagesTemplate
+	var b, c, d, e DailyCollage
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, collagesTemplateDBTypes, false, strmangle.SetComplement(collagesTemplatePrimaryKeyColumns, collagesTemplateColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*DailyCollage{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, dailyCollageDBTypes, false, strmangle.SetComplement(dailyCollagePrimaryKeyColumns, dailyCollageColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*DailyCollage{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddTemplateDailyCollages(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.TemplateID != first.TemplateID {
+			t.Error("foreign key was wrong value", a.TemplateID, first.TemplateID)
+		}
+		if a.TemplateID != second.TemplateID {
+			t.Error("foreign key was wrong value", a.TemplateID, second.TemplateID)
+		}
+
+		if first.R.Template != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Template != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.TemplateDailyCollages[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.TemplateDailyCollages[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.TemplateDailyCollages().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testCollagesTemplateToManyAddOpTemplateGroups(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a CollagesTemplate
+	var b, c, d, e Group
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, collagesTemplateDBTypes, false, strmangle.SetComplement(collagesTemplatePrimaryKeyColumns, collagesTemplateColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Group{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, groupDBTypes, false, strmangle.SetComplement(groupPrimaryKeyColumns, groupColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*Group{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddTemplateGroups(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if !queries.Equal(a.TemplateID, first.TemplateID) {
+			t.Error("foreign key was wrong value", a.TemplateID, first.TemplateID)
+		}
+		if !queries.Equal(a.TemplateID, second.TemplateID) {
+			t.Error("foreign key was wrong value", a.TemplateID, second.TemplateID)
+		}
+
+		if first.R.Template != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Template != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.TemplateGroups[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.TemplateGroups[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.TemplateGroups().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testCollagesTemplateToManySetOpTemplateGroups(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a CollagesTemplate
+	var b, c, d, e Group
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, collagesTemplateDBTypes, false, strmangle.SetComplement(collagesTemplatePrimaryKeyColumns, collagesTemplateColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Group{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, groupDBTypes, false, strmangle.SetComplement(groupPrimaryKeyColumns, groupColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetTemplateGroups(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.TemplateGroups().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetTemplateGroups(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.TemplateGroups().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.TemplateID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.TemplateID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+	if !queries.Equal(a.TemplateID, d.TemplateID) {
+		t.Error("foreign key was wrong value", a.TemplateID, d.TemplateID)
+	}
+	if !queries.Equal(a.TemplateID, e.TemplateID) {
+		t.Error("foreign key was wrong value", a.TemplateID, e.TemplateID)
+	}
+
+	if b.R.Template != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.Template != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.Template != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.Template != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if a.R.TemplateGroups[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.TemplateGroups[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testCollagesTemplateToManyRemoveOpTemplateGroups(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a CollagesTemplate
+	var b, c, d, e Group
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, collagesTemplateDBTypes, false, strmangle.SetComplement(collagesTemplatePrimaryKeyColumns, collagesTemplateColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Group{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, groupDBTypes, false, strmangle.SetComplement(groupPrimaryKeyColumns, groupColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddTemplateGroups(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.TemplateGroups().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemoveTemplateGroups(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.TemplateGroups().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
 	}
-	if !cFound {
-		t.Error("expected to find c")
+	if count != 2 {
+		t.Error("count was wrong:", count)
 	}
 
-	slice := CollagesTemplateSlice{&a}
-	if err = a.L.LoadTemplateTemplateParts(ctx, tx, false, (*[]*CollagesTemplate)(&slice), nil); err != nil {
-		t.Fatal(err)
+	if !queries.IsValuerNil(b.TemplateID) {
+		t.Error("want b's foreign key value to be nil")
 	}
-	if got := len(a.R.TemplateTemplateParts); got != 2 {
-		t.Error("number of eager loaded records wrong, got:", got)
+	if !queries.IsValuerNil(c.TemplateID) {
+		t.Error("want c's foreign key value to be nil")
 	}
 
-	a.R.TemplateTemplateParts = nil
-	if err = a.L.LoadTemplateTemplateParts(ctx, tx, true, &a, nil); err != nil {
-		t.Fatal(err)
+	if b.R.Template != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
 	}
-	if got := len(a.R.TemplateTemplateParts); got != 2 {
-		t.Error("number of eager loaded records wrong, got:", got)
+	if c.R.Template != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.Template != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+	if e.R.Template != &a {
+		t.Error("relationship to a should have been preserved")
 	}
 
-	if t.Failed() {
-		t.Logf("%#v", check)
+	if len(a.R.TemplateGroups) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.TemplateGroups[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.TemplateGroups[0] != &e {
+		t.Error("relationship to e should have been preserved")
 	}
 }
 
-func testCollagesTemplateToManyAddOpTemplateCollageResults(t *testing.T) {
+func testCollagesTemplateToManyAddOpTemplateSessionRounds(t *testing.T) {
 	var err error
 
 	ctx := context.Background()
@@ -658,15 +1620,15 @@ func testCollagesTemplateToManyAddOpTemplateCollageResults(t *testing.T) {
 	defer func() { _ = tx.Rollback() }()
 
 	var a CollagesTemplate
-	var b, c, d, e CollageResult
+	var b, c, d, e SessionRound
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, collagesTemplateDBTypes, false, strmangle.SetComplement(collagesTemplatePrimaryKeyColumns, collagesTemplateColumnsWithoutDefault)...); err != nil {
 		t.Fatal(err)
 	}
-	foreigners := []*CollageResult{&b, &c, &d, &e}
+	foreigners := []*SessionRound{&b, &c, &d, &e}
 	for _, x := range foreigners {
-		if err = randomize.Struct(seed, x, collageResultDBTypes, false, strmangle.SetComplement(collageResultPrimaryKeyColumns, collageResultColumnsWithoutDefault)...); err != nil {
+		if err = randomize.Struct(seed, x, sessionRoundDBTypes, false, strmangle.SetComplement(sessionRoundPrimaryKeyColumns, sessionRoundColumnsWithoutDefault)...); err != nil {
 			t.Fatal(err)
 		}
 	}
@@ -681,13 +1643,13 @@ func testCollagesTemplateToManyAddOpTemplateCollageResults(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	foreignersSplitByInsertion := [][]*CollageResult{
+	foreignersSplitByInsertion := [][]*SessionRound{
 		{&b, &c},
 		{&d, &e},
 	}
 
 	for i, x := range foreignersSplitByInsertion {
-		err = a.AddTemplateCollageResults(ctx, tx, i != 0, x...)
+		err = a.AddTemplateSessionRounds(ctx, tx, i != 0, x...)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -709,14 +1671,14 @@ func testCollagesTemplateToManyAddOpTemplateCollageResults(t *testing.T) {
 			t.Error("relationship was not added properly to the foreign slice")
 		}
 
-		if a.R.TemplateCollageResults[i*2] != first {
+		if a.R.TemplateSessionRounds[i*2] != first {
 			t.Error("relationship struct slice not set to correct value")
 		}
-		if a.R.TemplateCollageResults[i*2+1] != second {
+		if a.R.TemplateSessionRounds[i*2+1] != second {
 			t.Error("relationship struct slice not set to correct value")
 		}
 
-		count, err := a.TemplateCollageResults().Count(ctx, tx)
+		count, err := a.TemplateSessionRounds().Count(ctx, tx)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -800,6 +1762,345 @@ func testCollagesTemplateToManyAddOpTemplateTemplateParts(t *testing.T) {
 		}
 	}
 }
+func testCollagesTemplateToOneCollagesTemplateUsingForkedFromCollagesTemplate(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local CollagesTemplate
+	var foreign CollagesTemplate
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, collagesTemplateDBTypes, true, collagesTemplateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CollagesTemplate struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, collagesTemplateDBTypes, false, collagesTemplateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CollagesTemplate struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.ForkedFrom, foreign.TemplateID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.ForkedFromCollagesTemplate().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.TemplateID, foreign.TemplateID) {
+		t.Errorf("want: %v, got %v", foreign.TemplateID, check.TemplateID)
+	}
+
+	ranAfterSelectHook := false
+	AddCollagesTemplateHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *CollagesTemplate) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := CollagesTemplateSlice{&local}
+	if err = local.L.LoadForkedFromCollagesTemplate(ctx, tx, false, (*[]*CollagesTemplate)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.ForkedFromCollagesTemplate == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.ForkedFromCollagesTemplate = nil
+	if err = local.L.LoadForkedFromCollagesTemplate(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.ForkedFromCollagesTemplate == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testCollagesTemplateToOneUserUsingOwnerUser(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local CollagesTemplate
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, collagesTemplateDBTypes, true, collagesTemplateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CollagesTemplate struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.OwnerUserID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.OwnerUser().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	ranAfterSelectHook := false
+	AddUserHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *User) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := CollagesTemplateSlice{&local}
+	if err = local.L.LoadOwnerUser(ctx, tx, false, (*[]*CollagesTemplate)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.OwnerUser == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.OwnerUser = nil
+	if err = local.L.LoadOwnerUser(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.OwnerUser == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testCollagesTemplateToOneSetOpCollagesTemplateUsingForkedFromCollagesTemplate(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a CollagesTemplate
+	var b, c CollagesTemplate
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, collagesTemplateDBTypes, false, strmangle.SetComplement(collagesTemplatePrimaryKeyColumns, collagesTemplateColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, collagesTemplateDBTypes, false, strmangle.SetComplement(collagesTemplatePrimaryKeyColumns, collagesTemplateColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, collagesTemplateDBTypes, false, strmangle.SetComplement(collagesTemplatePrimaryKeyColumns, collagesTemplateColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*CollagesTemplate{&b, &c} {
+		err = a.SetForkedFromCollagesTemplate(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.ForkedFromCollagesTemplate != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.ForkedFromCollagesTemplates[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.ForkedFrom, x.TemplateID) {
+			t.Error("foreign key was wrong value", a.ForkedFrom)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.ForkedFrom))
+		reflect.Indirect(reflect.ValueOf(&a.ForkedFrom)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.ForkedFrom, x.TemplateID) {
+			t.Error("foreign key was wrong value", a.ForkedFrom, x.TemplateID)
+		}
+	}
+}
+
+func testCollagesTemplateToOneRemoveOpCollagesTemplateUsingForkedFromCollagesTemplate(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a CollagesTemplate
+	var b CollagesTemplate
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, collagesTemplateDBTypes, false, strmangle.SetComplement(collagesTemplatePrimaryKeyColumns, collagesTemplateColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, collagesTemplateDBTypes, false, strmangle.SetComplement(collagesTemplatePrimaryKeyColumns, collagesTemplateColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetForkedFromCollagesTemplate(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemoveForkedFromCollagesTemplate(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.ForkedFromCollagesTemplate().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.ForkedFromCollagesTemplate != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.ForkedFrom) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.ForkedFromCollagesTemplates) != 0 {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
+
+func testCollagesTemplateToOneSetOpUserUsingOwnerUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a CollagesTemplate
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, collagesTemplateDBTypes, false, strmangle.SetComplement(collagesTemplatePrimaryKeyColumns, collagesTemplateColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetOwnerUser(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.OwnerUser != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.OwnerUserCollagesTemplates[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.OwnerUserID, x.ID) {
+			t.Error("foreign key was wrong value", a.OwnerUserID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.OwnerUserID))
+		reflect.Indirect(reflect.ValueOf(&a.OwnerUserID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.OwnerUserID, x.ID) {
+			t.Error("foreign key was wrong value", a.OwnerUserID, x.ID)
+		}
+	}
+}
+
+func testCollagesTemplateToOneRemoveOpUserUsingOwnerUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a CollagesTemplate
+	var b User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, collagesTemplateDBTypes, false, strmangle.SetComplement(collagesTemplatePrimaryKeyColumns, collagesTemplateColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetOwnerUser(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemoveOwnerUser(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.OwnerUser().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.OwnerUser != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.OwnerUserID) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.OwnerUserCollagesTemplates) != 0 {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
 
 func testCollagesTemplatesReload(t *testing.T) {
 	t.Parallel()
@@ -875,7 +2176,7 @@ func testCollagesTemplatesSelect(t *testing.T) {
 }
 
 var (
-	collagesTemplateDBTypes = map[string]string{`TemplateID`: `char`, `Name`: `varchar`, `FilePath`: `varchar`, `CreatedAt`: `timestamp`, `UpdatedAt`: `timestamp`}
+	collagesTemplateDBTypes = map[string]string{`TemplateID`: `char`, `Name`: `varchar`, `FilePath`: `varchar`, `SourceName`: `varchar`, `OwnerUserID`: `char`, `Visibility`: `varchar`, `ForkedFrom`: `char`, `PhotoCount`: `int`, `ViewBox`: `varchar`, `Width`: `int`, `Height`: `int`, `CreatedAt`: `timestamp`, `UpdatedAt`: `timestamp`}
 	_                       = bytes.MinRead
 )
 
@@ -36,6 +36,12 @@ type GroupMember struct {
 	ReadyStatus bool `boil:"ready_status" json:"ready_status" toml:"ready_status" yaml:"ready_status"`
 	// æº–å‚™å®Œäº†æ™‚åˆ»
 	ReadyAt null.Time `boil:"ready_at" json:"ready_at,omitempty" toml:"ready_at" yaml:"ready_at,omitempty"`
+	// ç«¯æœ«æ™‚è¨ˆã®ãšã‚Œï¼ˆã‚µãƒ¼ãƒãƒ¼æ™‚åˆ» - ç«¯æœ«æ™‚åˆ»ã€ãƒŸãƒªç§’ï¼‰
+	ClockOffsetMS null.Int `boil:"clock_offset_ms" json:"clock_offset_ms,omitempty" toml:"clock_offset_ms" yaml:"clock_offset_ms,omitempty"`
+	// æ™‚åˆ»åŒæœŸã®å¾€å¾©é…å»¶ï¼ˆãƒŸãƒªç§’ï¼‰
+	ClockRTTMS null.Int `boil:"clock_rtt_ms" json:"clock_rtt_ms,omitempty" toml:"clock_rtt_ms" yaml:"clock_rtt_ms,omitempty"`
+	// æœ€å¾Œã«æ™‚åˆ»åŒæœŸã—ãŸæ™‚åˆ»
+	ClockSyncedAt null.Time `boil:"clock_synced_at" json:"clock_synced_at,omitempty" toml:"clock_synced_at" yaml:"clock_synced_at,omitempty"`
 	// å‚åŠ æ™‚åˆ»
 	JoinedAt time.Time `boil:"joined_at" json:"joined_at" toml:"joined_at" yaml:"joined_at"`
 	// æ›´æ–°æ—¥æ™‚
@@ -46,65 +52,121 @@ type GroupMember struct {
 }
 
 var GroupMemberColumns = struct {
-	ID          string
-	GroupID     string
-	UserID      string
-	IsOwner     string
-	ReadyStatus string
-	ReadyAt     string
-	JoinedAt    string
-	UpdatedAt   string
+	ID            string
+	GroupID       string
+	UserID        string
+	IsOwner       string
+	ReadyStatus   string
+	ReadyAt       string
+	ClockOffsetMS string
+	ClockRTTMS    string
+	ClockSyncedAt string
+	JoinedAt      string
+	UpdatedAt     string
 }{
-	ID:          "id",
-	GroupID:     "group_id",
-	UserID:      "user_id",
-	IsOwner:     "is_owner",
-	ReadyStatus: "ready_status",
-	ReadyAt:     "ready_at",
-	JoinedAt:    "joined_at",
-	UpdatedAt:   "updated_at",
+	ID:            "id",
+	GroupID:       "group_id",
+	UserID:        "user_id",
+	IsOwner:       "is_owner",
+	ReadyStatus:   "ready_status",
+	ReadyAt:       "ready_at",
+	ClockOffsetMS: "clock_offset_ms",
+	ClockRTTMS:    "clock_rtt_ms",
+	ClockSyncedAt: "clock_synced_at",
+	JoinedAt:      "joined_at",
+	UpdatedAt:     "updated_at",
 }
 
 var GroupMemberTableColumns = struct {
-	ID          string
-	GroupID     string
-	UserID      string
-	IsOwner     string
-	ReadyStatus string
-	ReadyAt     string
-	JoinedAt    string
-	UpdatedAt   string
+	ID            string
+	GroupID       string
+	UserID        string
+	IsOwner       string
+	ReadyStatus   string
+	ReadyAt       string
+	ClockOffsetMS string
+	ClockRTTMS    string
+	ClockSyncedAt string
+	JoinedAt      string
+	UpdatedAt     string
 }{
-	ID:          "group_members.id",
-	GroupID:     "group_members.group_id",
-	UserID:      "group_members.user_id",
-	IsOwner:     "group_members.is_owner",
-	ReadyStatus: "group_members.ready_status",
-	ReadyAt:     "group_members.ready_at",
-	JoinedAt:    "group_members.joined_at",
-	UpdatedAt:   "group_members.updated_at",
+	ID:            "group_members.id",
+	GroupID:       "group_members.group_id",
+	UserID:        "group_members.user_id",
+	IsOwner:       "group_members.is_owner",
+	ReadyStatus:   "group_members.ready_status",
+	ReadyAt:       "group_members.ready_at",
+	ClockOffsetMS: "group_members.clock_offset_ms",
+	ClockRTTMS:    "group_members.clock_rtt_ms",
+	ClockSyncedAt: "group_members.clock_synced_at",
+	JoinedAt:      "group_members.joined_at",
+	UpdatedAt:     "group_members.updated_at",
 }
 
 // Generated where
 
+type whereHelpernull_Int struct{ field string }
+
+func (w whereHelpernull_Int) EQ(x null.Int) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_Int) NEQ(x null.Int) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_Int) LT(x null.Int) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_Int) LTE(x null.Int) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_Int) GT(x null.Int) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_Int) GTE(x null.Int) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelpernull_Int) IN(slice []int) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelpernull_Int) NIN(slice []int) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+func (w whereHelpernull_Int) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_Int) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+
 var GroupMemberWhere = struct {
-	ID          whereHelperstring
-	GroupID     whereHelperstring
-	UserID      whereHelperstring
-	IsOwner     whereHelperbool
-	ReadyStatus whereHelperbool
-	ReadyAt     whereHelpernull_Time
-	JoinedAt    whereHelpertime_Time
-	UpdatedAt   whereHelpertime_Time
+	ID            whereHelperstring
+	GroupID       whereHelperstring
+	UserID        whereHelperstring
+	IsOwner       whereHelperbool
+	ReadyStatus   whereHelperbool
+	ReadyAt       whereHelpernull_Time
+	ClockOffsetMS whereHelpernull_Int
+	ClockRTTMS    whereHelpernull_Int
+	ClockSyncedAt whereHelpernull_Time
+	JoinedAt      whereHelpertime_Time
+	UpdatedAt     whereHelpertime_Time
 }{
-	ID:          whereHelperstring{field: "`group_members`.`id`"},
-	GroupID:     whereHelperstring{field: "`group_members`.`group_id`"},
-	UserID:      whereHelperstring{field: "`group_members`.`user_id`"},
-	IsOwner:     whereHelperbool{field: "`group_members`.`is_owner`"},
-	ReadyStatus: whereHelperbool{field: "`group_members`.`ready_status`"},
-	ReadyAt:     whereHelpernull_Time{field: "`group_members`.`ready_at`"},
-	JoinedAt:    whereHelpertime_Time{field: "`group_members`.`joined_at`"},
-	UpdatedAt:   whereHelpertime_Time{field: "`group_members`.`updated_at`"},
+	ID:            whereHelperstring{field: "`group_members`.`id`"},
+	GroupID:       whereHelperstring{field: "`group_members`.`group_id`"},
+	UserID:        whereHelperstring{field: "`group_members`.`user_id`"},
+	IsOwner:       whereHelperbool{field: "`group_members`.`is_owner`"},
+	ReadyStatus:   whereHelperbool{field: "`group_members`.`ready_status`"},
+	ReadyAt:       whereHelpernull_Time{field: "`group_members`.`ready_at`"},
+	ClockOffsetMS: whereHelpernull_Int{field: "`group_members`.`clock_offset_ms`"},
+	ClockRTTMS:    whereHelpernull_Int{field: "`group_members`.`clock_rtt_ms`"},
+	ClockSyncedAt: whereHelpernull_Time{field: "`group_members`.`clock_synced_at`"},
+	JoinedAt:      whereHelpertime_Time{field: "`group_members`.`joined_at`"},
+	UpdatedAt:     whereHelpertime_Time{field: "`group_members`.`updated_at`"},
 }
 
 // GroupMemberRels is where relationship names are stored.
@@ -163,8 +225,8 @@ func (r *groupMemberR) GetUser() *User {
 type groupMemberL struct{}
 
 var (
-	groupMemberAllColumns            = []string{"id", "group_id", "user_id", "is_owner", "ready_status", "ready_at", "joined_at", "updated_at"}
-	groupMemberColumnsWithoutDefault = []string{"id", "group_id", "user_id", "ready_at"}
+	groupMemberAllColumns            = []string{"id", "group_id", "user_id", "is_owner", "ready_status", "ready_at", "clock_offset_ms", "clock_rtt_ms", "clock_synced_at", "joined_at", "updated_at"}
+	groupMemberColumnsWithoutDefault = []string{"id", "group_id", "user_id", "ready_at", "clock_offset_ms", "clock_rtt_ms", "clock_synced_at"}
 	groupMemberColumnsWithDefault    = []string{"is_owner", "ready_status", "joined_at", "updated_at"}
 	groupMemberPrimaryKeyColumns     = []string{"group_id", "user_id"}
 	groupMemberGeneratedColumns      = []string{}
@@ -797,7 +797,7 @@ func testGroupMembersSelect(t *testing.T) {
 }
 
 var (
-	groupMemberDBTypes = map[string]string{`ID`: `char`, `GroupID`: `char`, `UserID`: `char`, `IsOwner`: `tinyint`, `ReadyStatus`: `tinyint`, `ReadyAt`: `timestamp`, `JoinedAt`: `timestamp`, `UpdatedAt`: `timestamp`}
+	groupMemberDBTypes = map[string]string{`ID`: `char`, `GroupID`: `char`, `UserID`: `char`, `IsOwner`: `tinyint`, `ReadyStatus`: `tinyint`, `ReadyAt`: `timestamp`, `ClockOffsetMS`: `int`, `ClockRTTMS`: `int`, `ClockSyncedAt`: `timestamp`, `JoinedAt`: `timestamp`, `UpdatedAt`: `timestamp`}
 	_                  = bytes.MinRead
 )
 
@@ -46,10 +46,18 @@ type Group struct {
 	CountdownStartedAt null.Time `boil:"countdown_started_at" json:"countdown_started_at,omitempty" toml:"countdown_started_at" yaml:"countdown_started_at,omitempty"`
 	// äºˆå®šæ’®å½±æ™‚åˆ»ï¼ˆå…¨ã‚¯ãƒ©ã‚¤ã‚¢ãƒ³ãƒˆåŒæœŸç”¨ï¼‰
 	ScheduledCaptureTime null.Time `boil:"scheduled_capture_time" json:"scheduled_capture_time,omitempty" toml:"scheduled_capture_time" yaml:"scheduled_capture_time,omitempty"`
-	// é¸æŠžã•ã‚ŒãŸãƒ†ãƒ³ãƒ—ãƒ¬ãƒ¼ãƒˆID
+	// æ’®å½±ã—ãŸå†™çœŸã®å—ä»˜ç· ã‚åˆ‡ã‚Š
+	CaptureDeadline null.Time `boil:"capture_deadline" json:"capture_deadline,omitempty" toml:"capture_deadline" yaml:"capture_deadline,omitempty"`
+	// é¸æŠžã•ã‚ŒãŸãƒ†ãƒ³ãƒ—ãƒ¬ãƒ¼ãƒˆIDï¼ˆcollages_template ã®IDã€‚ã‚°ãƒ«ãƒ¼ãƒ—å†…ã§çµ±ä¸€ï¼‰
 	TemplateID null.String `boil:"template_id" json:"template_id,omitempty" toml:"template_id" yaml:"template_id,omitempty"`
 	// æœ‰åŠ¹æœŸé™ï¼ˆä¸€æ™‚ã‚°ãƒ«ãƒ¼ãƒ—ç”¨ï¼‰
 	ExpiresAt null.Time `boil:"expires_at" json:"expires_at,omitempty" toml:"expires_at" yaml:"expires_at,omitempty"`
+	// ã‚«ã‚¦ãƒ³ãƒˆãƒ€ã‚¦ãƒ³ã®ç§’æ•°
+	CountdownSeconds int `boil:"countdown_seconds" json:"countdown_seconds" toml:"countdown_seconds" yaml:"countdown_seconds"`
+	// å…¨å“¡ãŒæº–å‚™å®Œäº†ã—ãŸã‚‰è‡ªå‹•ã§ã‚«ã‚¦ãƒ³ãƒˆãƒ€ã‚¦ãƒ³ã‚’é–‹å§‹ã™ã‚‹
+	AutoStart bool `boil:"auto_start" json:"auto_start" toml:"auto_start" yaml:"auto_start"`
+	// ç¾åœ¨ã®ãƒ©ã‚¦ãƒ³ãƒ‰ç•ªå·ï¼ˆæœªæ’®å½±ã¯0ï¼‰
+	CurrentRound int `boil:"current_round" json:"current_round" toml:"current_round" yaml:"current_round"`
 	// ä½œæˆæ—¥æ™‚
 	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
 	// æ›´æ–°æ—¥æ™‚
@@ -71,8 +79,12 @@ var GroupColumns = struct {
 	FinalizedAt          string
 	CountdownStartedAt   string
 	ScheduledCaptureTime string
+	CaptureDeadline      string
 	TemplateID           string
 	ExpiresAt            string
+	CountdownSeconds     string
+	AutoStart            string
+	CurrentRound         string
 	CreatedAt            string
 	UpdatedAt            string
 }{
@@ -87,8 +99,12 @@ var GroupColumns = struct {
 	FinalizedAt:          "finalized_at",
 	CountdownStartedAt:   "countdown_started_at",
 	ScheduledCaptureTime: "scheduled_capture_time",
+	CaptureDeadline:      "capture_deadline",
 	TemplateID:           "template_id",
 	ExpiresAt:            "expires_at",
+	CountdownSeconds:     "countdown_seconds",
+	AutoStart:            "auto_start",
+	CurrentRound:         "current_round",
 	CreatedAt:            "created_at",
 	UpdatedAt:            "updated_at",
 }
@@ -105,8 +121,12 @@ var GroupTableColumns = struct {
 	FinalizedAt          string
 	CountdownStartedAt   string
 	ScheduledCaptureTime string
+	CaptureDeadline      string
 	TemplateID           string
 	ExpiresAt            string
+	CountdownSeconds     string
+	AutoStart            string
+	CurrentRound         string
 	CreatedAt            string
 	UpdatedAt            string
 }{
@@ -121,8 +141,12 @@ var GroupTableColumns = struct {
 	FinalizedAt:          "groups.finalized_at",
 	CountdownStartedAt:   "groups.countdown_started_at",
 	ScheduledCaptureTime: "groups.scheduled_capture_time",
+	CaptureDeadline:      "groups.capture_deadline",
 	TemplateID:           "groups.template_id",
 	ExpiresAt:            "groups.expires_at",
+	CountdownSeconds:     "groups.countdown_seconds",
+	AutoStart:            "groups.auto_start",
+	CurrentRound:         "groups.current_round",
 	CreatedAt:            "groups.created_at",
 	UpdatedAt:            "groups.updated_at",
 }
@@ -141,8 +165,12 @@ var GroupWhere = struct {
 	FinalizedAt          whereHelpernull_Time
 	CountdownStartedAt   whereHelpernull_Time
 	ScheduledCaptureTime whereHelpernull_Time
+	CaptureDeadline      whereHelpernull_Time
 	TemplateID           whereHelpernull_String
 	ExpiresAt            whereHelpernull_Time
+	CountdownSeconds     whereHelperint
+	AutoStart            whereHelperbool
+	CurrentRound         whereHelperint
 	CreatedAt            whereHelpertime_Time
 	UpdatedAt            whereHelpertime_Time
 }{
@@ -157,8 +185,12 @@ var GroupWhere = struct {
 	FinalizedAt:          whereHelpernull_Time{field: "`groups`.`finalized_at`"},
 	CountdownStartedAt:   whereHelpernull_Time{field: "`groups`.`countdown_started_at`"},
 	ScheduledCaptureTime: whereHelpernull_Time{field: "`groups`.`scheduled_capture_time`"},
+	CaptureDeadline:      whereHelpernull_Time{field: "`groups`.`capture_deadline`"},
 	TemplateID:           whereHelpernull_String{field: "`groups`.`template_id`"},
 	ExpiresAt:            whereHelpernull_Time{field: "`groups`.`expires_at`"},
+	CountdownSeconds:     whereHelperint{field: "`groups`.`countdown_seconds`"},
+	AutoStart:            whereHelperbool{field: "`groups`.`auto_start`"},
+	CurrentRound:         whereHelperint{field: "`groups`.`current_round`"},
 	CreatedAt:            whereHelpertime_Time{field: "`groups`.`created_at`"},
 	UpdatedAt:            whereHelpertime_Time{field: "`groups`.`updated_at`"},
 }
@@ -166,25 +198,43 @@ var GroupWhere = struct {
 // GroupRels is where relationship names are stored.
 var GroupRels = struct {
 	OwnerUser            string
+	Template             string
+	CollageJobs          string
 	CollageResults       string
+	DailyCollages        string
 	GroupMembers         string
 	GroupPartAssignments string
+	ResumableUploads     string
+	SessionRounds        string
 	UploadImages         string
+	UploadSlots          string
 }{
 	OwnerUser:            "OwnerUser",
+	Template:             "Template",
+	CollageJobs:          "CollageJobs",
 	CollageResults:       "CollageResults",
+	DailyCollages:        "DailyCollages",
 	GroupMembers:         "GroupMembers",
 	GroupPartAssignments: "GroupPartAssignments",
+	ResumableUploads:     "ResumableUploads",
+	SessionRounds:        "SessionRounds",
 	UploadImages:         "UploadImages",
+	UploadSlots:          "UploadSlots",
 }
 
 // groupR is where relationships are stored.
 type groupR struct {
 	OwnerUser            *User                    `boil:"OwnerUser" json:"OwnerUser" toml:"OwnerUser" yaml:"OwnerUser"`
+	Template             *CollagesTemplate        `boil:"Template" json:"Template" toml:"Template" yaml:"Template"`
+	CollageJobs          CollageJobSlice          `boil:"CollageJobs" json:"CollageJobs" toml:"CollageJobs" yaml:"CollageJobs"`
 	CollageResults       CollageResultSlice       `boil:"CollageResults" json:"CollageResults" toml:"CollageResults" yaml:"CollageResults"`
+	DailyCollages        DailyCollageSlice        `boil:"DailyCollages" json:"DailyCollages" toml:"DailyCollages" yaml:"DailyCollages"`
 	GroupMembers         GroupMemberSlice         `boil:"GroupMembers" json:"GroupMembers" toml:"GroupMembers" yaml:"GroupMembers"`
 	GroupPartAssignments GroupPartAssignmentSlice `boil:"GroupPartAssignments" json:"GroupPartAssignments" toml:"GroupPartAssignments" yaml:"GroupPartAssignments"`
+	ResumableUploads     ResumableUploadSlice     `boil:"ResumableUploads" json:"ResumableUploads" toml:"ResumableUploads" yaml:"ResumableUploads"`
+	SessionRounds        SessionRoundSlice        `boil:"SessionRounds" json:"SessionRounds" toml:"SessionRounds" yaml:"SessionRounds"`
 	UploadImages         UploadImageSlice         `boil:"UploadImages" json:"UploadImages" toml:"UploadImages" yaml:"UploadImages"`
+	UploadSlots          UploadSlotSlice          `boil:"UploadSlots" json:"UploadSlots" toml:"UploadSlots" yaml:"UploadSlots"`
 }
 
 // NewStruct creates a new relationship struct
@@ -208,6 +258,38 @@ func (r *groupR) GetOwnerUser() *User {
 	return r.OwnerUser
 }
 
+func (o *Group) GetTemplate() *CollagesTemplate {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetTemplate()
+}
+
+func (r *groupR) GetTemplate() *CollagesTemplate {
+	if r == nil {
+		return nil
+	}
+
+	return r.Template
+}
+
+func (o *Group) GetCollageJobs() CollageJobSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetCollageJobs()
+}
+
+func (r *groupR) GetCollageJobs() CollageJobSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.CollageJobs
+}
+
 func (o *Group) GetCollageResults() CollageResultSlice {
 	if o == nil {
 		return nil
@@ -224,6 +306,22 @@ func (r *groupR) GetCollageResults() CollageResultSlice {
 	return r.CollageResults
 }
 
+func (o *Group) GetDailyCollages() DailyCollageSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetDailyCollages()
+}
+
+func (r *groupR) GetDailyCollages() DailyCollageSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.DailyCollages
+}
+
 func (o *Group) GetGroupMembers() GroupMemberSlice {
 	if o == nil {
 		return nil
@@ -256,6 +354,38 @@ func (r *groupR) GetGroupPartAssignments() GroupPartAssignmentSlice {
 	return r.GroupPartAssignments
 }
 
+func (o *Group) GetResumableUploads() ResumableUploadSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetResumableUploads()
+}
+
+func (r *groupR) GetResumableUploads() ResumableUploadSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.ResumableUploads
+}
+
+func (o *Group) GetSessionRounds() SessionRoundSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetSessionRounds()
+}
+
+func (r *groupR) GetSessionRounds() SessionRoundSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.SessionRounds
+}
+
 func (o *Group) GetUploadImages() UploadImageSlice {
 	if o == nil {
 		return nil
@@ -272,13 +402,29 @@ func (r *groupR) GetUploadImages() UploadImageSlice {
 	return r.UploadImages
 }
 
+func (o *Group) GetUploadSlots() UploadSlotSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetUploadSlots()
+}
+
+func (r *groupR) GetUploadSlots() UploadSlotSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.UploadSlots
+}
+
 // groupL is where Load methods for each relationship are stored.
 type groupL struct{}
 
 var (
-	groupAllColumns            = []string{"id", "owner_user_id", "name", "group_type", "status", "max_member", "current_member_count", "invitation_token", "finalized_at", "countdown_started_at", "scheduled_capture_time", "template_id", "expires_at", "created_at", "updated_at"}
-	groupColumnsWithoutDefault = []string{"id", "owner_user_id", "name", "max_member", "invitation_token", "finalized_at", "countdown_started_at", "scheduled_capture_time", "template_id", "expires_at"}
-	groupColumnsWithDefault    = []string{"group_type", "status", "current_member_count", "created_at", "updated_at"}
+	groupAllColumns            = []string{"id", "owner_user_id", "name", "group_type", "status", "max_member", "current_member_count", "invitation_token", "finalized_at", "countdown_started_at", "scheduled_capture_time", "capture_deadline", "template_id", "expires_at", "countdown_seconds", "auto_start", "current_round", "created_at", "updated_at"}
+	groupColumnsWithoutDefault = []string{"id", "owner_user_id", "name", "max_member", "invitation_token", "finalized_at", "countdown_started_at", "scheduled_capture_time", "capture_deadline", "template_id", "expires_at"}
+	groupColumnsWithDefault    = []string{"group_type", "status", "current_member_count", "countdown_seconds", "auto_start", "current_round", "created_at", "updated_at"}
 	groupPrimaryKeyColumns     = []string{"id"}
 	groupGeneratedColumns      = []string{}
 )
@@ -599,6 +745,31 @@ func (o *Group) OwnerUser(mods ...qm.QueryMod) userQuery {
 	return Users(queryMods...)
 }
 
+// Template pointed to by the foreign key.
+func (o *Group) Template(mods ...qm.QueryMod) collagesTemplateQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`template_id` = ?", o.TemplateID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return CollagesTemplates(queryMods...)
+}
+
+// CollageJobs retrieves all the collage_job's CollageJobs with an executor.
+func (o *Group) CollageJobs(mods ...qm.QueryMod) collageJobQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`collage_jobs`.`group_id`=?", o.ID),
+	)
+
+	return CollageJobs(queryMods...)
+}
+
 // CollageResults retrieves all the collage_result's CollageResults with an executor.
 func (o *Group) CollageResults(mods ...qm.QueryMod) collageResultQuery {
 	var queryMods []qm.QueryMod
@@ -613,6 +784,20 @@ func (o *Group) CollageResults(mods ...qm.QueryMod) collageResultQuery {
 	return CollageResults(queryMods...)
 }
 
+// DailyCollages retrieves all the daily_collage's DailyCollages with an executor.
+func (o *Group) DailyCollages(mods ...qm.QueryMod) dailyCollageQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`daily_collages`.`group_id`=?", o.ID),
+	)
+
+	return DailyCollages(queryMods...)
+}
+
 // GroupMembers retrieves all the group_member's GroupMembers with an executor.
 func (o *Group) GroupMembers(mods ...qm.QueryMod) groupMemberQuery {
 	var queryMods []qm.QueryMod
@@ -641,6 +826,34 @@ func (o *Group) GroupPartAssignments(mods ...qm.QueryMod) groupPartAssignmentQue
 	return GroupPartAssignments(queryMods...)
 }
 
+// ResumableUploads retrieves all the resumable_upload's ResumableUploads with an executor.
+func (o *Group) ResumableUploads(mods ...qm.QueryMod) resumableUploadQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`resumable_uploads`.`group_id`=?", o.ID),
+	)
+
+	return ResumableUploads(queryMods...)
+}
+
+// SessionRounds retrieves all the session_round's SessionRounds with an executor.
+func (o *Group) SessionRounds(mods ...qm.QueryMod) sessionRoundQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`session_rounds`.`group_id`=?", o.ID),
+	)
+
+	return SessionRounds(queryMods...)
+}
+
 // UploadImages retrieves all the upload_image's UploadImages with an executor.
 func (o *Group) UploadImages(mods ...qm.QueryMod) uploadImageQuery {
 	var queryMods []qm.QueryMod
@@ -655,6 +868,20 @@ func (o *Group) UploadImages(mods ...qm.QueryMod) uploadImageQuery {
 	return UploadImages(queryMods...)
 }
 
+// UploadSlots retrieves all the upload_slot's UploadSlots with an executor.
+func (o *Group) UploadSlots(mods ...qm.QueryMod) uploadSlotQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`upload_slots`.`group_id`=?", o.ID),
+	)
+
+	return UploadSlots(queryMods...)
+}
+
 // LoadOwnerUser allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for an N-1 relationship.
 func (groupL) LoadOwnerUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeGroup interface{}, mods queries.Applicator) error {
@@ -775,9 +1002,9 @@ func (groupL) LoadOwnerUser(ctx context.Context, e boil.ContextExecutor, singula
 	return nil
 }
 
-// LoadCollageResults allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (groupL) LoadCollageResults(ctx context.Context, e boil.ContextExecutor, singular bool, maybeGroup interface{}, mods queries.Applicator) error {
+// LoadTemplate allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (groupL) LoadTemplate(ctx context.Context, e boil.ContextExecutor, singular bool, maybeGroup interface{}, mods queries.Applicator) error {
 	var slice []*Group
 	var object *Group
 
@@ -808,13 +1035,20 @@ func (groupL) LoadCollageResults(ctx context.Context, e boil.ContextExecutor, si
 		if object.R == nil {
 			object.R = &groupR{}
 		}
-		args[object.ID] = struct{}{}
+		if !queries.IsNil(object.TemplateID) {
+			args[object.TemplateID] = struct{}{}
+		}
+
 	} else {
 		for _, obj := range slice {
 			if obj.R == nil {
 				obj.R = &groupR{}
 			}
-			args[obj.ID] = struct{}{}
+
+			if !queries.IsNil(obj.TemplateID) {
+				args[obj.TemplateID] = struct{}{}
+			}
+
 		}
 	}
 
@@ -830,8 +1064,8 @@ func (groupL) LoadCollageResults(ctx context.Context, e boil.ContextExecutor, si
 	}
 
 	query := NewQuery(
-		qm.From(`collage_results`),
-		qm.WhereIn(`collage_results.group_id in ?`, argsSlice...),
+		qm.From(`collages_template`),
+		qm.WhereIn(`collages_template.template_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -839,47 +1073,51 @@ func (groupL) LoadCollageResults(ctx context.Context, e boil.ContextExecutor, si
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load collage_results")
+		return errors.Wrap(err, "failed to eager load CollagesTemplate")
 	}
 
-	var resultSlice []*CollageResult
+	var resultSlice []*CollagesTemplate
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice collage_results")
+		return errors.Wrap(err, "failed to bind eager loaded slice CollagesTemplate")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on collage_results")
+		return errors.Wrap(err, "failed to close results of eager load for collages_template")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for collage_results")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for collages_template")
 	}
 
-	if len(collageResultAfterSelectHooks) != 0 {
+	if len(collagesTemplateAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
 			}
 		}
 	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
 	if singular {
-		object.R.CollageResults = resultSlice
-		for _, foreign := range resultSlice {
-			if foreign.R == nil {
-				foreign.R = &collageResultR{}
-			}
-			foreign.R.Group = object
+		foreign := resultSlice[0]
+		object.R.Template = foreign
+		if foreign.R == nil {
+			foreign.R = &collagesTemplateR{}
 		}
+		foreign.R.TemplateGroups = append(foreign.R.TemplateGroups, object)
 		return nil
 	}
 
-	for _, foreign := range resultSlice {
-		for _, local := range slice {
-			if local.ID == foreign.GroupID {
-				local.R.CollageResults = append(local.R.CollageResults, foreign)
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.TemplateID, foreign.TemplateID) {
+				local.R.Template = foreign
 				if foreign.R == nil {
-					foreign.R = &collageResultR{}
+					foreign.R = &collagesTemplateR{}
 				}
-				foreign.R.Group = local
+				foreign.R.TemplateGroups = append(foreign.R.TemplateGroups, local)
 				break
 			}
 		}
@@ -888,9 +1126,9 @@ func (groupL) LoadCollageResults(ctx context.Context, e boil.ContextExecutor, si
 	return nil
 }
 
-// LoadGroupMembers allows an eager lookup of values, cached into the
+// LoadCollageJobs allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (groupL) LoadGroupMembers(ctx context.Context, e boil.ContextExecutor, singular bool, maybeGroup interface{}, mods queries.Applicator) error {
+func (groupL) LoadCollageJobs(ctx context.Context, e boil.ContextExecutor, singular bool, maybeGroup interface{}, mods queries.Applicator) error {
 	var slice []*Group
 	var object *Group
 
@@ -943,8 +1181,8 @@ func (groupL) LoadGroupMembers(ctx context.Context, e boil.ContextExecutor, sing
 	}
 
 	query := NewQuery(
-		qm.From(`group_members`),
-		qm.WhereIn(`group_members.group_id in ?`, argsSlice...),
+		qm.From(`collage_jobs`),
+		qm.WhereIn(`collage_jobs.group_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -952,22 +1190,22 @@ func (groupL) LoadGroupMembers(ctx context.Context, e boil.ContextExecutor, sing
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load group_members")
+		return errors.Wrap(err, "failed to eager load collage_jobs")
 	}
 
-	var resultSlice []*GroupMember
+	var resultSlice []*CollageJob
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice group_members")
+		return errors.Wrap(err, "failed to bind eager loaded slice collage_jobs")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on group_members")
+		return errors.Wrap(err, "failed to close results in eager load on collage_jobs")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for group_members")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for collage_jobs")
 	}
 
-	if len(groupMemberAfterSelectHooks) != 0 {
+	if len(collageJobAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -975,10 +1213,10 @@ func (groupL) LoadGroupMembers(ctx context.Context, e boil.ContextExecutor, sing
 		}
 	}
 	if singular {
-		object.R.GroupMembers = resultSlice
+		object.R.CollageJobs = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &groupMemberR{}
+				foreign.R = &collageJobR{}
 			}
 			foreign.R.Group = object
 		}
@@ -988,9 +1226,9 @@ func (groupL) LoadGroupMembers(ctx context.Context, e boil.ContextExecutor, sing
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
 			if local.ID == foreign.GroupID {
-				local.R.GroupMembers = append(local.R.GroupMembers, foreign)
+				local.R.CollageJobs = append(local.R.CollageJobs, foreign)
 				if foreign.R == nil {
-					foreign.R = &groupMemberR{}
+					foreign.R = &collageJobR{}
 				}
 				foreign.R.Group = local
 				break
@@ -1001,9 +1239,9 @@ func (groupL) LoadGroupMembers(ctx context.Context, e boil.ContextExecutor, sing
 	return nil
 }
 
-// LoadGroupPartAssignments allows an eager lookup of values, cached into the
+// LoadCollageResults allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (groupL) LoadGroupPartAssignments(ctx context.Context, e boil.ContextExecutor, singular bool, maybeGroup interface{}, mods queries.Applicator) error {
+func (groupL) LoadCollageResults(ctx context.Context, e boil.ContextExecutor, singular bool, maybeGroup interface{}, mods queries.Applicator) error {
 	var slice []*Group
 	var object *Group
 
@@ -1056,8 +1294,8 @@ func (groupL) LoadGroupPartAssignments(ctx context.Context, e boil.ContextExecut
 	}
 
 	query := NewQuery(
-		qm.From(`group_part_assignments`),
-		qm.WhereIn(`group_part_assignments.group_id in ?`, argsSlice...),
+		qm.From(`collage_results`),
+		qm.WhereIn(`collage_results.group_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1065,22 +1303,22 @@ func (groupL) LoadGroupPartAssignments(ctx context.Context, e boil.ContextExecut
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load group_part_assignments")
+		return errors.Wrap(err, "failed to eager load collage_results")
 	}
 
-	var resultSlice []*GroupPartAssignment
+	var resultSlice []*CollageResult
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice group_part_assignments")
+		return errors.Wrap(err, "failed to bind eager loaded slice collage_results")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on group_part_assignments")
+		return errors.Wrap(err, "failed to close results in eager load on collage_results")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for group_part_assignments")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for collage_results")
 	}
 
-	if len(groupPartAssignmentAfterSelectHooks) != 0 {
+	if len(collageResultAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -1088,10 +1326,10 @@ func (groupL) LoadGroupPartAssignments(ctx context.Context, e boil.ContextExecut
 		}
 	}
 	if singular {
-		object.R.GroupPartAssignments = resultSlice
+		object.R.CollageResults = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &groupPartAssignmentR{}
+				foreign.R = &collageResultR{}
 			}
 			foreign.R.Group = object
 		}
@@ -1101,9 +1339,9 @@ func (groupL) LoadGroupPartAssignments(ctx context.Context, e boil.ContextExecut
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
 			if local.ID == foreign.GroupID {
-				local.R.GroupPartAssignments = append(local.R.GroupPartAssignments, foreign)
+				local.R.CollageResults = append(local.R.CollageResults, foreign)
 				if foreign.R == nil {
-					foreign.R = &groupPartAssignmentR{}
+					foreign.R = &collageResultR{}
 				}
 				foreign.R.Group = local
 				break
@@ -1114,9 +1352,9 @@ func (groupL) LoadGroupPartAssignments(ctx context.Context, e boil.ContextExecut
 	return nil
 }
 
-// LoadUploadImages allows an eager lookup of values, cached into the
+// LoadDailyCollages allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (groupL) LoadUploadImages(ctx context.Context, e boil.ContextExecutor, singular bool, maybeGroup interface{}, mods queries.Applicator) error {
+func (groupL) LoadDailyCollages(ctx context.Context, e boil.ContextExecutor, singular bool, maybeGroup interface{}, mods queries.Applicator) error {
 	var slice []*Group
 	var object *Group
 
@@ -1169,8 +1407,8 @@ func (groupL) LoadUploadImages(ctx context.Context, e boil.ContextExecutor, sing
 	}
 
 	query := NewQuery(
-		qm.From(`upload_images`),
-		qm.WhereIn(`upload_images.group_id in ?`, argsSlice...),
+		qm.From(`daily_collages`),
+		qm.WhereIn(`daily_collages.group_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1178,22 +1416,22 @@ func (groupL) LoadUploadImages(ctx context.Context, e boil.ContextExecutor, sing
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load upload_images")
+		return errors.Wrap(err, "failed to eager load daily_collages")
 	}
 
-	var resultSlice []*UploadImage
+	var resultSlice []*DailyCollage
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice upload_images")
+		return errors.Wrap(err, "failed to bind eager loaded slice daily_collages")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on upload_images")
+		return errors.Wrap(err, "failed to close results in eager load on daily_collages")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for upload_images")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for daily_collages")
 	}
 
-	if len(uploadImageAfterSelectHooks) != 0 {
+	if len(dailyCollageAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -1201,10 +1439,10 @@ func (groupL) LoadUploadImages(ctx context.Context, e boil.ContextExecutor, sing
 		}
 	}
 	if singular {
-		object.R.UploadImages = resultSlice
+		object.R.DailyCollages = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &uploadImageR{}
+				foreign.R = &dailyCollageR{}
 			}
 			foreign.R.Group = object
 		}
@@ -1214,9 +1452,9 @@ func (groupL) LoadUploadImages(ctx context.Context, e boil.ContextExecutor, sing
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
 			if local.ID == foreign.GroupID {
-				local.R.UploadImages = append(local.R.UploadImages, foreign)
+				local.R.DailyCollages = append(local.R.DailyCollages, foreign)
 				if foreign.R == nil {
-					foreign.R = &uploadImageR{}
+					foreign.R = &dailyCollageR{}
 				}
 				foreign.R.Group = local
 				break
@@ -1227,50 +1465,861 @@ func (groupL) LoadUploadImages(ctx context.Context, e boil.ContextExecutor, sing
 	return nil
 }
 
-// SetOwnerUser of the group to the related item.
-// Sets o.R.OwnerUser to related.
-// Adds o to related.R.OwnerUserGroups.
-func (o *Group) SetOwnerUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
-	var err error
-	if insert {
-		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
-		}
-	}
-
-	updateQuery := fmt.Sprintf(
-		"UPDATE `groups` SET %s WHERE %s",
-		strmangle.SetParamNames("`", "`", 0, []string{"owner_user_id"}),
-		strmangle.WhereClause("`", "`", 0, groupPrimaryKeyColumns),
-	)
-	values := []interface{}{related.ID, o.ID}
-
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, updateQuery)
-		fmt.Fprintln(writer, values)
-	}
-	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-		return errors.Wrap(err, "failed to update local table")
-	}
+// LoadGroupMembers allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (groupL) LoadGroupMembers(ctx context.Context, e boil.ContextExecutor, singular bool, maybeGroup interface{}, mods queries.Applicator) error {
+	var slice []*Group
+	var object *Group
+
+	if singular {
+		var ok bool
+		object, ok = maybeGroup.(*Group)
+		if !ok {
+			object = new(Group)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeGroup)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeGroup))
+			}
+		}
+	} else {
+		s, ok := maybeGroup.(*[]*Group)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeGroup)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeGroup))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &groupR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &groupR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`group_members`),
+		qm.WhereIn(`group_members.group_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load group_members")
+	}
+
+	var resultSlice []*GroupMember
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice group_members")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on group_members")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for group_members")
+	}
+
+	if len(groupMemberAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.GroupMembers = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &groupMemberR{}
+			}
+			foreign.R.Group = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.GroupID {
+				local.R.GroupMembers = append(local.R.GroupMembers, foreign)
+				if foreign.R == nil {
+					foreign.R = &groupMemberR{}
+				}
+				foreign.R.Group = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadGroupPartAssignments allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (groupL) LoadGroupPartAssignments(ctx context.Context, e boil.ContextExecutor, singular bool, maybeGroup interface{}, mods queries.Applicator) error {
+	var slice []*Group
+	var object *Group
+
+	if singular {
+		var ok bool
+		object, ok = maybeGroup.(*Group)
+		if !ok {
+			object = new(Group)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeGroup)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeGroup))
+			}
+		}
+	} else {
+		s, ok := maybeGroup.(*[]*Group)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeGroup)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeGroup))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &groupR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &groupR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`group_part_assignments`),
+		qm.WhereIn(`group_part_assignments.group_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load group_part_assignments")
+	}
+
+	var resultSlice []*GroupPartAssignment
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice group_part_assignments")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on group_part_assignments")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for group_part_assignments")
+	}
+
+	if len(groupPartAssignmentAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.GroupPartAssignments = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &groupPartAssignmentR{}
+			}
+			foreign.R.Group = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.GroupID {
+				local.R.GroupPartAssignments = append(local.R.GroupPartAssignments, foreign)
+				if foreign.R == nil {
+					foreign.R = &groupPartAssignmentR{}
+				}
+				foreign.R.Group = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadResumableUploads allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (groupL) LoadResumableUploads(ctx context.Context, e boil.ContextExecutor, singular bool, maybeGroup interface{}, mods queries.Applicator) error {
+	var slice []*Group
+	var object *Group
+
+	if singular {
+		var ok bool
+		object, ok = maybeGroup.(*Group)
+		if !ok {
+			object = new(Group)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeGroup)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeGroup))
+			}
+		}
+	} else {
+		s, ok := maybeGroup.(*[]*Group)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeGroup)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeGroup))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &groupR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &groupR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`resumable_uploads`),
+		qm.WhereIn(`resumable_uploads.group_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load resumable_uploads")
+	}
+
+	var resultSlice []*ResumableUpload
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice resumable_uploads")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on resumable_uploads")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for resumable_uploads")
+	}
+
+	if len(resumableUploadAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ResumableUploads = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &resumableUploadR{}
+			}
+			foreign.R.Group = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.GroupID {
+				local.R.ResumableUploads = append(local.R.ResumableUploads, foreign)
+				if foreign.R == nil {
+					foreign.R = &resumableUploadR{}
+				}
+				foreign.R.Group = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadSessionRounds allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (groupL) LoadSessionRounds(ctx context.Context, e boil.ContextExecutor, singular bool, maybeGroup interface{}, mods queries.Applicator) error {
+	var slice []*Group
+	var object *Group
+
+	if singular {
+		var ok bool
+		object, ok = maybeGroup.(*Group)
+		if !ok {
+			object = new(Group)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeGroup)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeGroup))
+			}
+		}
+	} else {
+		s, ok := maybeGroup.(*[]*Group)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeGroup)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeGroup))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &groupR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &groupR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`session_rounds`),
+		qm.WhereIn(`session_rounds.group_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load session_rounds")
+	}
+
+	var resultSlice []*SessionRound
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice session_rounds")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on session_rounds")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for session_rounds")
+	}
+
+	if len(sessionRoundAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.SessionRounds = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &sessionRoundR{}
+			}
+			foreign.R.Group = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.GroupID {
+				local.R.SessionRounds = append(local.R.SessionRounds, foreign)
+				if foreign.R == nil {
+					foreign.R = &sessionRoundR{}
+				}
+				foreign.R.Group = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUploadImages allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (groupL) LoadUploadImages(ctx context.Context, e boil.ContextExecutor, singular bool, maybeGroup interface{}, mods queries.Applicator) error {
+	var slice []*Group
+	var object *Group
+
+	if singular {
+		var ok bool
+		object, ok = maybeGroup.(*Group)
+		if !ok {
+			object = new(Group)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeGroup)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeGroup))
+			}
+		}
+	} else {
+		s, ok := maybeGroup.(*[]*Group)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeGroup)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeGroup))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &groupR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &groupR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`upload_images`),
+		qm.WhereIn(`upload_images.group_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load upload_images")
+	}
+
+	var resultSlice []*UploadImage
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice upload_images")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on upload_images")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for upload_images")
+	}
+
+	if len(uploadImageAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.UploadImages = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &uploadImageR{}
+			}
+			foreign.R.Group = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.GroupID {
+				local.R.UploadImages = append(local.R.UploadImages, foreign)
+				if foreign.R == nil {
+					foreign.R = &uploadImageR{}
+				}
+				foreign.R.Group = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUploadSlots allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (groupL) LoadUploadSlots(ctx context.Context, e boil.ContextExecutor, singular bool, maybeGroup interface{}, mods queries.Applicator) error {
+	var slice []*Group
+	var object *Group
+
+	if singular {
+		var ok bool
+		object, ok = maybeGroup.(*Group)
+		if !ok {
+			object = new(Group)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeGroup)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeGroup))
+			}
+		}
+	} else {
+		s, ok := maybeGroup.(*[]*Group)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeGroup)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeGroup))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &groupR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &groupR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`upload_slots`),
+		qm.WhereIn(`upload_slots.group_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load upload_slots")
+	}
+
+	var resultSlice []*UploadSlot
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice upload_slots")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on upload_slots")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for upload_slots")
+	}
+
+	if len(uploadSlotAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.UploadSlots = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &uploadSlotR{}
+			}
+			foreign.R.Group = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.GroupID {
+				local.R.UploadSlots = append(local.R.UploadSlots, foreign)
+				if foreign.R == nil {
+					foreign.R = &uploadSlotR{}
+				}
+				foreign.R.Group = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetOwnerUser of the group to the related item.
+// Sets o.R.OwnerUser to related.
+// Adds o to related.R.OwnerUserGroups.
+func (o *Group) SetOwnerUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `groups` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"owner_user_id"}),
+		strmangle.WhereClause("`", "`", 0, groupPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
 
 	o.OwnerUserID = related.ID
 	if o.R == nil {
 		o.R = &groupR{
-			OwnerUser: related,
+			OwnerUser: related,
+		}
+	} else {
+		o.R.OwnerUser = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			OwnerUserGroups: GroupSlice{o},
+		}
+	} else {
+		related.R.OwnerUserGroups = append(related.R.OwnerUserGroups, o)
+	}
+
+	return nil
+}
+
+// SetTemplate of the group to the related item.
+// Sets o.R.Template to related.
+// Adds o to related.R.TemplateGroups.
+func (o *Group) SetTemplate(ctx context.Context, exec boil.ContextExecutor, insert bool, related *CollagesTemplate) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `groups` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"template_id"}),
+		strmangle.WhereClause("`", "`", 0, groupPrimaryKeyColumns),
+	)
+	values := []interface{}{related.TemplateID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.TemplateID, related.TemplateID)
+	if o.R == nil {
+		o.R = &groupR{
+			Template: related,
 		}
 	} else {
-		o.R.OwnerUser = related
+		o.R.Template = related
 	}
 
 	if related.R == nil {
-		related.R = &userR{
-			OwnerUserGroups: GroupSlice{o},
+		related.R = &collagesTemplateR{
+			TemplateGroups: GroupSlice{o},
 		}
 	} else {
-		related.R.OwnerUserGroups = append(related.R.OwnerUserGroups, o)
+		related.R.TemplateGroups = append(related.R.TemplateGroups, o)
+	}
+
+	return nil
+}
+
+// RemoveTemplate relationship.
+// Sets o.R.Template to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *Group) RemoveTemplate(ctx context.Context, exec boil.ContextExecutor, related *CollagesTemplate) error {
+	var err error
+
+	queries.SetScanner(&o.TemplateID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("template_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Template = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.TemplateGroups {
+		if queries.Equal(o.TemplateID, ri.TemplateID) {
+			continue
+		}
+
+		ln := len(related.R.TemplateGroups)
+		if ln > 1 && i < ln-1 {
+			related.R.TemplateGroups[i] = related.R.TemplateGroups[ln-1]
+		}
+		related.R.TemplateGroups = related.R.TemplateGroups[:ln-1]
+		break
+	}
+	return nil
+}
+
+// AddCollageJobs adds the given related objects to the existing relationships
+// of the group, optionally inserting them as new records.
+// Appends related to o.R.CollageJobs.
+// Sets related.R.Group appropriately.
+func (o *Group) AddCollageJobs(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*CollageJob) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.GroupID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `collage_jobs` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"group_id"}),
+				strmangle.WhereClause("`", "`", 0, collageJobPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.JobID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.GroupID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &groupR{
+			CollageJobs: related,
+		}
+	} else {
+		o.R.CollageJobs = append(o.R.CollageJobs, related...)
 	}
 
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &collageJobR{
+				Group: o,
+			}
+		} else {
+			rel.R.Group = o
+		}
+	}
 	return nil
 }
 
@@ -1327,6 +2376,59 @@ func (o *Group) AddCollageResults(ctx context.Context, exec boil.ContextExecutor
 	return nil
 }
 
+// AddDailyCollages adds the given related objects to the existing relationships
+// of the group, optionally inserting them as new records.
+// Appends related to o.R.DailyCollages.
+// Sets related.R.Group appropriately.
+func (o *Group) AddDailyCollages(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*DailyCollage) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.GroupID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `daily_collages` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"group_id"}),
+				strmangle.WhereClause("`", "`", 0, dailyCollagePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.DailyID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.GroupID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &groupR{
+			DailyCollages: related,
+		}
+	} else {
+		o.R.DailyCollages = append(o.R.DailyCollages, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &dailyCollageR{
+				Group: o,
+			}
+		} else {
+			rel.R.Group = o
+		}
+	}
+	return nil
+}
+
 // AddGroupMembers adds the given related objects to the existing relationships
 // of the group, optionally inserting them as new records.
 // Appends related to o.R.GroupMembers.
@@ -1433,6 +2535,112 @@ func (o *Group) AddGroupPartAssignments(ctx context.Context, exec boil.ContextEx
 	return nil
 }
 
+// AddResumableUploads adds the given related objects to the existing relationships
+// of the group, optionally inserting them as new records.
+// Appends related to o.R.ResumableUploads.
+// Sets related.R.Group appropriately.
+func (o *Group) AddResumableUploads(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ResumableUpload) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.GroupID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `resumable_uploads` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"group_id"}),
+				strmangle.WhereClause("`", "`", 0, resumableUploadPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.UploadID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.GroupID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &groupR{
+			ResumableUploads: related,
+		}
+	} else {
+		o.R.ResumableUploads = append(o.R.ResumableUploads, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &resumableUploadR{
+				Group: o,
+			}
+		} else {
+			rel.R.Group = o
+		}
+	}
+	return nil
+}
+
+// AddSessionRounds adds the given related objects to the existing relationships
+// of the group, optionally inserting them as new records.
+// Appends related to o.R.SessionRounds.
+// Sets related.R.Group appropriately.
+func (o *Group) AddSessionRounds(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*SessionRound) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.GroupID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `session_rounds` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"group_id"}),
+				strmangle.WhereClause("`", "`", 0, sessionRoundPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.RoundID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.GroupID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &groupR{
+			SessionRounds: related,
+		}
+	} else {
+		o.R.SessionRounds = append(o.R.SessionRounds, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &sessionRoundR{
+				Group: o,
+			}
+		} else {
+			rel.R.Group = o
+		}
+	}
+	return nil
+}
+
 // AddUploadImages adds the given related objects to the existing relationships
 // of the group, optionally inserting them as new records.
 // Appends related to o.R.UploadImages.
@@ -1486,6 +2694,59 @@ func (o *Group) AddUploadImages(ctx context.Context, exec boil.ContextExecutor,
 	return nil
 }
 
+// AddUploadSlots adds the given related objects to the existing relationships
+// of the group, optionally inserting them as new records.
+// Appends related to o.R.UploadSlots.
+// Sets related.R.Group appropriately.
+func (o *Group) AddUploadSlots(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*UploadSlot) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.GroupID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `upload_slots` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"group_id"}),
+				strmangle.WhereClause("`", "`", 0, uploadSlotPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.SlotID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.GroupID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &groupR{
+			UploadSlots: related,
+		}
+	} else {
+		o.R.UploadSlots = append(o.R.UploadSlots, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &uploadSlotR{
+				Group: o,
+			}
+		} else {
+			rel.R.Group = o
+		}
+	}
+	return nil
+}
+
 // Groups retrieves all the records using an executor.
 func Groups(mods ...qm.QueryMod) groupQuery {
 	mods = append(mods, qm.From("`groups`"))
@@ -494,6 +494,84 @@ func testGroupsInsertWhitelist(t *testing.T) {
 	}
 }
 
+func testGroupToManyCollageJobs(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Group
+	var b, c CollageJob
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, groupDBTypes, true, groupColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Group struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, collageJobDBTypes, false, collageJobColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, collageJobDBTypes, false, collageJobColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.GroupID = a.ID
+	c.GroupID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.CollageJobs().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.GroupID == b.GroupID {
+			bFound = true
+		}
+		if v.GroupID == c.GroupID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := GroupSlice{&a}
+	if err = a.L.LoadCollageJobs(ctx, tx, false, (*[]*Group)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.CollageJobs); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.CollageJobs = nil
+	if err = a.L.LoadCollageJobs(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.CollageJobs); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
 func testGroupToManyCollageResults(t *testing.T) {
 	var err error
 	ctx := context.Background()
@@ -572,6 +650,84 @@ func testGroupToManyCollageResults(t *testing.T) {
 	}
 }
 
+func testGroupToManyDailyCollages(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Group
+	var b, c DailyCollage
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, groupDBTypes, true, groupColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Group struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, dailyCollageDBTypes, false, dailyCollageColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, dailyCollageDBTypes, false, dailyCollageColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.GroupID = a.ID
+	c.GroupID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.DailyCollages().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.GroupID == b.GroupID {
+			bFound = true
+		}
+		if v.GroupID == c.GroupID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := GroupSlice{&a}
+	if err = a.L.LoadDailyCollages(ctx, tx, false, (*[]*Group)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.DailyCollages); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.DailyCollages = nil
+	if err = a.L.LoadDailyCollages(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.DailyCollages); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
 func testGroupToManyGroupMembers(t *testing.T) {
 	var err error
 	ctx := context.Background()
@@ -728,14 +884,92 @@ func testGroupToManyGroupPartAssignments(t *testing.T) {
 	}
 }
 
-func testGroupToManyUploadImages(t *testing.T) {
+func testGroupToManyResumableUploads(t *testing.T) {
 	var err error
 	ctx := context.Background()
 	tx := MustTx(boil.BeginTx(ctx, nil))
 	defer func() { _ = tx.Rollback() }()
 
 	var a Group
-	var b, c UploadImage
+	var b, c ResumableUpload
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, groupDBTypes, true, groupColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Group struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, resumableUploadDBTypes, false, resumableUploadColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, resumableUploadDBTypes, false, resumableUploadColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.GroupID = a.ID
+	c.GroupID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.ResumableUploads().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.GroupID == b.GroupID {
+			bFound = true
+		}
+		if v.GroupID == c.GroupID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := GroupSlice{&a}
+	if err = a.L.LoadResumableUploads(ctx, tx, false, (*[]*Group)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.ResumableUploads); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.ResumableUploads = nil
+	if err = a.L.LoadResumableUploads(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.ResumableUploads); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testGroupToManySessionRounds(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Group
+	var b, c SessionRound
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, groupDBTypes, true, groupColumnsWithDefault...); err != nil {
@@ -746,16 +980,546 @@ func testGroupToManyUploadImages(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if err = randomize.Struct(seed, &b, uploadImageDBTypes, false, uploadImageColumnsWithDefault...); err != nil {
+	if err = randomize.Struct(seed, &b, sessionRoundDBTypes, false, sessionRoundColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, sessionRoundDBTypes, false, sessionRoundColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.GroupID = a.ID
+	c.GroupID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.SessionRounds().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.GroupID == b.GroupID {
+			bFound = true
+		}
+		if v.GroupID == c.GroupID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := GroupSlice{&a}
+	if err = a.L.LoadSessionRounds(ctx, tx, false, (*[]*Group)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.SessionRounds); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.SessionRounds = nil
+	if err = a.L.LoadSessionRounds(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.SessionRounds); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testGroupToManyUploadImages(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Group
+	var b, c UploadImage
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, groupDBTypes, true, groupColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Group struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, uploadImageDBTypes, false, uploadImageColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, uploadImageDBTypes, false, uploadImageColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.GroupID = a.ID
+	c.GroupID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.UploadImages().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.GroupID == b.GroupID {
+			bFound = true
+		}
+		if v.GroupID == c.GroupID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := GroupSlice{&a}
+	if err = a.L.LoadUploadImages(ctx, tx, false, (*[]*Group)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.UploadImages); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.UploadImages = nil
+	if err = a.L.LoadUploadImages(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.UploadImages); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testGroupToManyUploadSlots(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Group
+	var b, c UploadSlot
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, groupDBTypes, true, groupColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Group struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, uploadSlotDBTypes, false, uploadSlotColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, uploadSlotDBTypes, false, uploadSlotColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.GroupID = a.ID
+	c.GroupID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.UploadSlots().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.GroupID == b.GroupID {
+			bFound = true
+		}
+		if v.GroupID == c.GroupID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := GroupSlice{&a}
+	if err = a.L.LoadUploadSlots(ctx, tx, false, (*[]*Group)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.UploadSlots); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.UploadSlots = nil
+	if err = a.L.LoadUploadSlots(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.UploadSlots); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testGroupToManyAddOpCollageJobs(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Group
+	var b, c, d, e CollageJob
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, groupDBTypes, false, strmangle.SetComplement(groupPrimaryKeyColumns, groupColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*CollageJob{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, collageJobDBTypes, false, strmangle.SetComplement(collageJobPrimaryKeyColumns, collageJobColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*CollageJob{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddCollageJobs(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.GroupID {
+			t.Error("foreign key was wrong value", a.ID, first.GroupID)
+		}
+		if a.ID != second.GroupID {
+			t.Error("foreign key was wrong value", a.ID, second.GroupID)
+		}
+
+		if first.R.Group != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Group != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.CollageJobs[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.CollageJobs[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.CollageJobs().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testGroupToManyAddOpCollageResults(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Group
+	var b, c, d, e CollageResult
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, groupDBTypes, false, strmangle.SetComplement(groupPrimaryKeyColumns, groupColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*CollageResult{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, collageResultDBTypes, false, strmangle.SetComplement(collageResultPrimaryKeyColumns, collageResultColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*CollageResult{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddCollageResults(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.GroupID {
+			t.Error("foreign key was wrong value", a.ID, first.GroupID)
+		}
+		if a.ID != second.GroupID {
+			t.Error("foreign key was wrong value", a.ID, second.GroupID)
+		}
+
+		if first.R.Group != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Group != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.CollageResults[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.CollageResults[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.CollageResults().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testGroupToManyAddOpDailyCollages(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Group
+	var b, c, d, e DailyCollage
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, groupDBTypes, false, strmangle.SetComplement(groupPrimaryKeyColumns, groupColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*DailyCollage{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, dailyCollageDBTypes, false, strmangle.SetComplement(dailyCollagePrimaryKeyColumns, dailyCollageColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*DailyCollage{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddDailyCollages(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.GroupID {
+			t.Error("foreign key was wrong value", a.ID, first.GroupID)
+		}
+		if a.ID != second.GroupID {
+			t.Error("foreign key was wrong value", a.ID, second.GroupID)
+		}
+
+		if first.R.Group != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Group != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.DailyCollages[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.DailyCollages[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.DailyCollages().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testGroupToManyAddOpGroupMembers(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Group
+	var b, c, d, e GroupMember
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, groupDBTypes, false, strmangle.SetComplement(groupPrimaryKeyColumns, groupColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*GroupMember{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, groupMemberDBTypes, false, strmangle.SetComplement(groupMemberPrimaryKeyColumns, groupMemberColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*GroupMember{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddGroupMembers(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.GroupID {
+			t.Error("foreign key was wrong value", a.ID, first.GroupID)
+		}
+		if a.ID != second.GroupID {
+			t.Error("foreign key was wrong value", a.ID, second.GroupID)
+		}
+
+		if first.R.Group != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Group != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.GroupMembers[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.GroupMembers[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.GroupMembers().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testGroupToManyAddOpGroupPartAssignments(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Group
+	var b, c, d, e GroupPartAssignment
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, groupDBTypes, false, strmangle.SetComplement(groupPrimaryKeyColumns, groupColumnsWithoutDefault)...); err != nil {
 		t.Fatal(err)
 	}
-	if err = randomize.Struct(seed, &c, uploadImageDBTypes, false, uploadImageColumnsWithDefault...); err != nil {
-		t.Fatal(err)
+	foreigners := []*GroupPartAssignment{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, groupPartAssignmentDBTypes, false, strmangle.SetComplement(groupPartAssignmentPrimaryKeyColumns, groupPartAssignmentColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
 	}
 
-	b.GroupID = a.ID
-	c.GroupID = a.ID
-
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
 	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
@@ -763,50 +1527,51 @@ func testGroupToManyUploadImages(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	check, err := a.UploadImages().All(ctx, tx)
-	if err != nil {
-		t.Fatal(err)
+	foreignersSplitByInsertion := [][]*GroupPartAssignment{
+		{&b, &c},
+		{&d, &e},
 	}
 
-	bFound, cFound := false, false
-	for _, v := range check {
-		if v.GroupID == b.GroupID {
-			bFound = true
-		}
-		if v.GroupID == c.GroupID {
-			cFound = true
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddGroupPartAssignments(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
 		}
-	}
 
-	if !bFound {
-		t.Error("expected to find b")
-	}
-	if !cFound {
-		t.Error("expected to find c")
-	}
+		first := x[0]
+		second := x[1]
 
-	slice := GroupSlice{&a}
-	if err = a.L.LoadUploadImages(ctx, tx, false, (*[]*Group)(&slice), nil); err != nil {
-		t.Fatal(err)
-	}
-	if got := len(a.R.UploadImages); got != 2 {
-		t.Error("number of eager loaded records wrong, got:", got)
-	}
+		if a.ID != first.GroupID {
+			t.Error("foreign key was wrong value", a.ID, first.GroupID)
+		}
+		if a.ID != second.GroupID {
+			t.Error("foreign key was wrong value", a.ID, second.GroupID)
+		}
 
-	a.R.UploadImages = nil
-	if err = a.L.LoadUploadImages(ctx, tx, true, &a, nil); err != nil {
-		t.Fatal(err)
-	}
-	if got := len(a.R.UploadImages); got != 2 {
-		t.Error("number of eager loaded records wrong, got:", got)
-	}
+		if first.R.Group != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Group != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
 
-	if t.Failed() {
-		t.Logf("%#v", check)
+		if a.R.GroupPartAssignments[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.GroupPartAssignments[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.GroupPartAssignments().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
 	}
 }
-
-func testGroupToManyAddOpCollageResults(t *testing.T) {
+func testGroupToManyAddOpResumableUploads(t *testing.T) {
 	var err error
 
 	ctx := context.Background()
@@ -814,15 +1579,15 @@ func testGroupToManyAddOpCollageResults(t *testing.T) {
 	defer func() { _ = tx.Rollback() }()
 
 	var a Group
-	var b, c, d, e CollageResult
+	var b, c, d, e ResumableUpload
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, groupDBTypes, false, strmangle.SetComplement(groupPrimaryKeyColumns, groupColumnsWithoutDefault)...); err != nil {
 		t.Fatal(err)
 	}
-	foreigners := []*CollageResult{&b, &c, &d, &e}
+	foreigners := []*ResumableUpload{&b, &c, &d, &e}
 	for _, x := range foreigners {
-		if err = randomize.Struct(seed, x, collageResultDBTypes, false, strmangle.SetComplement(collageResultPrimaryKeyColumns, collageResultColumnsWithoutDefault)...); err != nil {
+		if err = randomize.Struct(seed, x, resumableUploadDBTypes, false, strmangle.SetComplement(resumableUploadPrimaryKeyColumns, resumableUploadColumnsWithoutDefault)...); err != nil {
 			t.Fatal(err)
 		}
 	}
@@ -837,13 +1602,13 @@ func testGroupToManyAddOpCollageResults(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	foreignersSplitByInsertion := [][]*CollageResult{
+	foreignersSplitByInsertion := [][]*ResumableUpload{
 		{&b, &c},
 		{&d, &e},
 	}
 
 	for i, x := range foreignersSplitByInsertion {
-		err = a.AddCollageResults(ctx, tx, i != 0, x...)
+		err = a.AddResumableUploads(ctx, tx, i != 0, x...)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -865,14 +1630,14 @@ func testGroupToManyAddOpCollageResults(t *testing.T) {
 			t.Error("relationship was not added properly to the foreign slice")
 		}
 
-		if a.R.CollageResults[i*2] != first {
+		if a.R.ResumableUploads[i*2] != first {
 			t.Error("relationship struct slice not set to correct value")
 		}
-		if a.R.CollageResults[i*2+1] != second {
+		if a.R.ResumableUploads[i*2+1] != second {
 			t.Error("relationship struct slice not set to correct value")
 		}
 
-		count, err := a.CollageResults().Count(ctx, tx)
+		count, err := a.ResumableUploads().Count(ctx, tx)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -881,7 +1646,7 @@ func testGroupToManyAddOpCollageResults(t *testing.T) {
 		}
 	}
 }
-func testGroupToManyAddOpGroupMembers(t *testing.T) {
+func testGroupToManyAddOpSessionRounds(t *testing.T) {
 	var err error
 
 	ctx := context.Background()
@@ -889,15 +1654,15 @@ func testGroupToManyAddOpGroupMembers(t *testing.T) {
 	defer func() { _ = tx.Rollback() }()
 
 	var a Group
-	var b, c, d, e GroupMember
+	var b, c, d, e SessionRound
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, groupDBTypes, false, strmangle.SetComplement(groupPrimaryKeyColumns, groupColumnsWithoutDefault)...); err != nil {
 		t.Fatal(err)
 	}
-	foreigners := []*GroupMember{&b, &c, &d, &e}
+	foreigners := []*SessionRound{&b, &c, &d, &e}
 	for _, x := range foreigners {
-		if err = randomize.Struct(seed, x, groupMemberDBTypes, false, strmangle.SetComplement(groupMemberPrimaryKeyColumns, groupMemberColumnsWithoutDefault)...); err != nil {
+		if err = randomize.Struct(seed, x, sessionRoundDBTypes, false, strmangle.SetComplement(sessionRoundPrimaryKeyColumns, sessionRoundColumnsWithoutDefault)...); err != nil {
 			t.Fatal(err)
 		}
 	}
@@ -912,13 +1677,13 @@ func testGroupToManyAddOpGroupMembers(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	foreignersSplitByInsertion := [][]*GroupMember{
+	foreignersSplitByInsertion := [][]*SessionRound{
 		{&b, &c},
 		{&d, &e},
 	}
 
 	for i, x := range foreignersSplitByInsertion {
-		err = a.AddGroupMembers(ctx, tx, i != 0, x...)
+		err = a.AddSessionRounds(ctx, tx, i != 0, x...)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -940,14 +1705,14 @@ func testGroupToManyAddOpGroupMembers(t *testing.T) {
 			t.Error("relationship was not added properly to the foreign slice")
 		}
 
-		if a.R.GroupMembers[i*2] != first {
+		if a.R.SessionRounds[i*2] != first {
 			t.Error("relationship struct slice not set to correct value")
 		}
-		if a.R.GroupMembers[i*2+1] != second {
+		if a.R.SessionRounds[i*2+1] != second {
 			t.Error("relationship struct slice not set to correct value")
 		}
 
-		count, err := a.GroupMembers().Count(ctx, tx)
+		count, err := a.SessionRounds().Count(ctx, tx)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -956,7 +1721,7 @@ func testGroupToManyAddOpGroupMembers(t *testing.T) {
 		}
 	}
 }
-func testGroupToManyAddOpGroupPartAssignments(t *testing.T) {
+func testGroupToManyAddOpUploadImages(t *testing.T) {
 	var err error
 
 	ctx := context.Background()
@@ -964,15 +1729,15 @@ func testGroupToManyAddOpGroupPartAssignments(t *testing.T) {
 	defer func() { _ = tx.Rollback() }()
 
 	var a Group
-	var b, c, d, e GroupPartAssignment
+	var b, c, d, e UploadImage
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, groupDBTypes, false, strmangle.SetComplement(groupPrimaryKeyColumns, groupColumnsWithoutDefault)...); err != nil {
 		t.Fatal(err)
 	}
-	foreigners := []*GroupPartAssignment{&b, &c, &d, &e}
+	foreigners := []*UploadImage{&b, &c, &d, &e}
 	for _, x := range foreigners {
-		if err = randomize.Struct(seed, x, groupPartAssignmentDBTypes, false, strmangle.SetComplement(groupPartAssignmentPrimaryKeyColumns, groupPartAssignmentColumnsWithoutDefault)...); err != nil {
+		if err = randomize.Struct(seed, x, uploadImageDBTypes, false, strmangle.SetComplement(uploadImagePrimaryKeyColumns, uploadImageColumnsWithoutDefault)...); err != nil {
 			t.Fatal(err)
 		}
 	}
@@ -987,13 +1752,13 @@ func testGroupToManyAddOpGroupPartAssignments(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	foreignersSplitByInsertion := [][]*GroupPartAssignment{
+	foreignersSplitByInsertion := [][]*UploadImage{
 		{&b, &c},
 		{&d, &e},
 	}
 
 	for i, x := range foreignersSplitByInsertion {
-		err = a.AddGroupPartAssignments(ctx, tx, i != 0, x...)
+		err = a.AddUploadImages(ctx, tx, i != 0, x...)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -1015,14 +1780,14 @@ func testGroupToManyAddOpGroupPartAssignments(t *testing.T) {
 			t.Error("relationship was not added properly to the foreign slice")
 		}
 
-		if a.R.GroupPartAssignments[i*2] != first {
+		if a.R.UploadImages[i*2] != first {
 			t.Error("relationship struct slice not set to correct value")
 		}
-		if a.R.GroupPartAssignments[i*2+1] != second {
+		if a.R.UploadImages[i*2+1] != second {
 			t.Error("relationship struct slice not set to correct value")
 		}
 
-		count, err := a.GroupPartAssignments().Count(ctx, tx)
+		count, err := a.UploadImages().Count(ctx, tx)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -1031,7 +1796,7 @@ func testGroupToManyAddOpGroupPartAssignments(t *testing.T) {
 		}
 	}
 }
-func testGroupToManyAddOpUploadImages(t *testing.T) {
+func testGroupToManyAddOpUploadSlots(t *testing.T) {
 	var err error
 
 	ctx := context.Background()
@@ -1039,15 +1804,15 @@ func testGroupToManyAddOpUploadImages(t *testing.T) {
 	defer func() { _ = tx.Rollback() }()
 
 	var a Group
-	var b, c, d, e UploadImage
+	var b, c, d, e UploadSlot
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, groupDBTypes, false, strmangle.SetComplement(groupPrimaryKeyColumns, groupColumnsWithoutDefault)...); err != nil {
 		t.Fatal(err)
 	}
-	foreigners := []*UploadImage{&b, &c, &d, &e}
+	foreigners := []*UploadSlot{&b, &c, &d, &e}
 	for _, x := range foreigners {
-		if err = randomize.Struct(seed, x, uploadImageDBTypes, false, strmangle.SetComplement(uploadImagePrimaryKeyColumns, uploadImageColumnsWithoutDefault)...); err != nil {
+		if err = randomize.Struct(seed, x, uploadSlotDBTypes, false, strmangle.SetComplement(uploadSlotPrimaryKeyColumns, uploadSlotColumnsWithoutDefault)...); err != nil {
 			t.Fatal(err)
 		}
 	}
@@ -1062,13 +1827,13 @@ func testGroupToManyAddOpUploadImages(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	foreignersSplitByInsertion := [][]*UploadImage{
+	foreignersSplitByInsertion := [][]*UploadSlot{
 		{&b, &c},
 		{&d, &e},
 	}
 
 	for i, x := range foreignersSplitByInsertion {
-		err = a.AddUploadImages(ctx, tx, i != 0, x...)
+		err = a.AddUploadSlots(ctx, tx, i != 0, x...)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -1090,14 +1855,14 @@ func testGroupToManyAddOpUploadImages(t *testing.T) {
 			t.Error("relationship was not added properly to the foreign slice")
 		}
 
-		if a.R.UploadImages[i*2] != first {
+		if a.R.UploadSlots[i*2] != first {
 			t.Error("relationship struct slice not set to correct value")
 		}
-		if a.R.UploadImages[i*2+1] != second {
+		if a.R.UploadSlots[i*2+1] != second {
 			t.Error("relationship struct slice not set to correct value")
 		}
 
-		count, err := a.UploadImages().Count(ctx, tx)
+		count, err := a.UploadSlots().Count(ctx, tx)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -1167,6 +1932,67 @@ func testGroupToOneUserUsingOwnerUser(t *testing.T) {
 	}
 }
 
+func testGroupToOneCollagesTemplateUsingTemplate(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local Group
+	var foreign CollagesTemplate
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, groupDBTypes, true, groupColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Group struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, collagesTemplateDBTypes, false, collagesTemplateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CollagesTemplate struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.TemplateID, foreign.TemplateID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Template().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.TemplateID, foreign.TemplateID) {
+		t.Errorf("want: %v, got %v", foreign.TemplateID, check.TemplateID)
+	}
+
+	ranAfterSelectHook := false
+	AddCollagesTemplateHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *CollagesTemplate) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := GroupSlice{&local}
+	if err = local.L.LoadTemplate(ctx, tx, false, (*[]*Group)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Template == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Template = nil
+	if err = local.L.LoadTemplate(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Template == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
 func testGroupToOneSetOpUserUsingOwnerUser(t *testing.T) {
 	var err error
 
@@ -1224,6 +2050,114 @@ func testGroupToOneSetOpUserUsingOwnerUser(t *testing.T) {
 		}
 	}
 }
+func testGroupToOneSetOpCollagesTemplateUsingTemplate(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Group
+	var b, c CollagesTemplate
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, groupDBTypes, false, strmangle.SetComplement(groupPrimaryKeyColumns, groupColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, collagesTemplateDBTypes, false, strmangle.SetComplement(collagesTemplatePrimaryKeyColumns, collagesTemplateColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, collagesTemplateDBTypes, false, strmangle.SetComplement(collagesTemplatePrimaryKeyColumns, collagesTemplateColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*CollagesTemplate{&b, &c} {
+		err = a.SetTemplate(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Template != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.TemplateGroups[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.TemplateID, x.TemplateID) {
+			t.Error("foreign key was wrong value", a.TemplateID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.TemplateID))
+		reflect.Indirect(reflect.ValueOf(&a.TemplateID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.TemplateID, x.TemplateID) {
+			t.Error("foreign key was wrong value", a.TemplateID, x.TemplateID)
+		}
+	}
+}
+
+func testGroupToOneRemoveOpCollagesTemplateUsingTemplate(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Group
+	var b CollagesTemplate
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, groupDBTypes, false, strmangle.SetComplement(groupPrimaryKeyColumns, groupColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, collagesTemplateDBTypes, false, strmangle.SetComplement(collagesTemplatePrimaryKeyColumns, collagesTemplateColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetTemplate(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemoveTemplate(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.Template().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.Template != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.TemplateID) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.TemplateGroups) != 0 {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
 
 func testGroupsReload(t *testing.T) {
 	t.Parallel()
@@ -1299,7 +2233,7 @@ func testGroupsSelect(t *testing.T) {
 }
 
 var (
-	groupDBTypes = map[string]string{`ID`: `char`, `OwnerUserID`: `char`, `Name`: `varchar`, `GroupType`: `enum('local_temporary','global_temporary','permanent')`, `Status`: `enum('recruiting','ready_check','countdown','photo_taking','completed','expired')`, `MaxMember`: `int`, `CurrentMemberCount`: `int`, `InvitationToken`: `char`, `FinalizedAt`: `timestamp`, `CountdownStartedAt`: `timestamp`, `ScheduledCaptureTime`: `timestamp`, `TemplateID`: `char`, `ExpiresAt`: `timestamp`, `CreatedAt`: `timestamp`, `UpdatedAt`: `timestamp`}
+	groupDBTypes = map[string]string{`ID`: `char`, `OwnerUserID`: `char`, `Name`: `varchar`, `GroupType`: `enum('local_temporary','global_temporary','permanent')`, `Status`: `enum('recruiting','ready_check','countdown','photo_taking','completed','failed','expired')`, `MaxMember`: `int`, `CurrentMemberCount`: `int`, `InvitationToken`: `char`, `FinalizedAt`: `timestamp`, `CountdownStartedAt`: `timestamp`, `ScheduledCaptureTime`: `timestamp`, `CaptureDeadline`: `timestamp`, `TemplateID`: `char`, `ExpiresAt`: `timestamp`, `CountdownSeconds`: `int`, `AutoStart`: `tinyint`, `CurrentRound`: `int`, `CreatedAt`: `timestamp`, `UpdatedAt`: `timestamp`}
 	_            = bytes.MinRead
 )
 
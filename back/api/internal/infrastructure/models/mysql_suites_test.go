@@ -6,10 +6,14 @@ package models
 import "testing"
 
 func TestUpsert(t *testing.T) {
+	t.Run("CollageJobs", testCollageJobsUpsert)
+
 	t.Run("CollageResults", testCollageResultsUpsert)
 
 	t.Run("CollagesTemplates", testCollagesTemplatesUpsert)
 
+	t.Run("DailyCollages", testDailyCollagesUpsert)
+
 	t.Run("DeviceTokens", testDeviceTokensUpsert)
 
 	t.Run("Friends", testFriendsUpsert)
@@ -22,11 +26,17 @@ func TestUpsert(t *testing.T) {
 
 	t.Run("ResultDownloads", testResultDownloadsUpsert)
 
+	t.Run("ResumableUploads", testResumableUploadsUpsert)
+
+	t.Run("SessionRounds", testSessionRoundsUpsert)
+
 	t.Run("TemplateParts", testTemplatePartsUpsert)
 
 	t.Run("UploadImages", testUploadImagesUpsert)
 
 	t.Run("UploadImagesCollageResults", testUploadImagesCollageResultsUpsert)
 
+	t.Run("UploadSlots", testUploadSlotsUpsert)
+
 	t.Run("Users", testUsersUpsert)
 }
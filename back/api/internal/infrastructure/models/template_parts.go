@@ -40,6 +40,8 @@ type TemplatePart struct {
 	Width int `boil:"width" json:"width" toml:"width" yaml:"width"`
 	// é«˜ã•
 	Height int `boil:"height" json:"height" toml:"height" yaml:"height"`
+	// ãƒ•ãƒ¬ãƒ¼ãƒ ã®å½¢ï¼ˆãƒ†ãƒ³ãƒ—ãƒ¬ãƒ¼ãƒˆã®viewBoxåº§æ¨™ç³»ã®SVGãƒ‘ã‚¹ã€‚NULLãªã‚‰çŸ©å½¢ï¼‰
+	Path null.String `boil:"path" json:"path,omitempty" toml:"path" yaml:"path,omitempty"`
 	// ãƒ‘ãƒ¼ãƒ„ã®èª¬æ˜Žï¼ˆã©ã‚“ãªå†™çœŸã‚’æ’®ã‚‹ã‹ç­‰ï¼‰
 	Description null.String `boil:"description" json:"description,omitempty" toml:"description" yaml:"description,omitempty"`
 	// ä½œæˆæ—¥æ™‚
@@ -60,6 +62,7 @@ var TemplatePartColumns = struct {
 	PositionY   string
 	Width       string
 	Height      string
+	Path        string
 	Description string
 	CreatedAt   string
 	UpdatedAt   string
@@ -72,6 +75,7 @@ var TemplatePartColumns = struct {
 	PositionY:   "position_y",
 	Width:       "width",
 	Height:      "height",
+	Path:        "path",
 	Description: "description",
 	CreatedAt:   "created_at",
 	UpdatedAt:   "updated_at",
@@ -86,6 +90,7 @@ var TemplatePartTableColumns = struct {
 	PositionY   string
 	Width       string
 	Height      string
+	Path        string
 	Description string
 	CreatedAt   string
 	UpdatedAt   string
@@ -98,6 +103,7 @@ var TemplatePartTableColumns = struct {
 	PositionY:   "template_parts.position_y",
 	Width:       "template_parts.width",
 	Height:      "template_parts.height",
+	Path:        "template_parts.path",
 	Description: "template_parts.description",
 	CreatedAt:   "template_parts.created_at",
 	UpdatedAt:   "template_parts.updated_at",
@@ -114,6 +120,7 @@ var TemplatePartWhere = struct {
 	PositionY   whereHelperint
 	Width       whereHelperint
 	Height      whereHelperint
+	Path        whereHelpernull_String
 	Description whereHelpernull_String
 	CreatedAt   whereHelpertime_Time
 	UpdatedAt   whereHelpertime_Time
@@ -126,6 +133,7 @@ var TemplatePartWhere = struct {
 	PositionY:   whereHelperint{field: "`template_parts`.`position_y`"},
 	Width:       whereHelperint{field: "`template_parts`.`width`"},
 	Height:      whereHelperint{field: "`template_parts`.`height`"},
+	Path:        whereHelpernull_String{field: "`template_parts`.`path`"},
 	Description: whereHelpernull_String{field: "`template_parts`.`description`"},
 	CreatedAt:   whereHelpertime_Time{field: "`template_parts`.`created_at`"},
 	UpdatedAt:   whereHelpertime_Time{field: "`template_parts`.`updated_at`"},
@@ -206,8 +214,8 @@ func (r *templatePartR) GetPartUploadImages() UploadImageSlice {
 type templatePartL struct{}
 
 var (
-	templatePartAllColumns            = []string{"part_id", "template_id", "part_number", "part_name", "position_x", "position_y", "width", "height", "description", "created_at", "updated_at"}
-	templatePartColumnsWithoutDefault = []string{"part_id", "template_id", "part_number", "part_name", "position_x", "position_y", "width", "height", "description"}
+	templatePartAllColumns            = []string{"part_id", "template_id", "part_number", "part_name", "position_x", "position_y", "width", "height", "path", "description", "created_at", "updated_at"}
+	templatePartColumnsWithoutDefault = []string{"part_id", "template_id", "part_number", "part_name", "position_x", "position_y", "width", "height", "path", "description"}
 	templatePartColumnsWithDefault    = []string{"created_at", "updated_at"}
 	templatePartPrimaryKeyColumns     = []string{"part_id"}
 	templatePartGeneratedColumns      = []string{}
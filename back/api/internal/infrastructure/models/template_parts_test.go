@@ -1168,7 +1168,7 @@ func testTemplatePartsSelect(t *testing.T) {
 }
 
 var (
-	templatePartDBTypes = map[string]string{`PartID`: `char`, `TemplateID`: `char`, `PartNumber`: `int`, `PartName`: `varchar`, `PositionX`: `int`, `PositionY`: `int`, `Width`: `int`, `Height`: `int`, `Description`: `text`, `CreatedAt`: `timestamp`, `UpdatedAt`: `timestamp`}
+	templatePartDBTypes = map[string]string{`PartID`: `char`, `TemplateID`: `char`, `PartNumber`: `int`, `PartName`: `varchar`, `PositionX`: `int`, `PositionY`: `int`, `Width`: `int`, `Height`: `int`, `Path`: `text`, `Description`: `text`, `CreatedAt`: `timestamp`, `UpdatedAt`: `timestamp`}
 	_                   = bytes.MinRead
 )
 
@@ -34,6 +34,16 @@ type UploadImage struct {
 	UserID string `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
 	// ãƒ‘ãƒ¼ãƒ„IDï¼ˆã©ã®ãƒ‘ãƒ¼ãƒ„ç”¨ã®ç”»åƒã‹ï¼‰
 	PartID null.String `boil:"part_id" json:"part_id,omitempty" toml:"part_id" yaml:"part_id,omitempty"`
+	// ãƒ†ãƒ³ãƒ—ãƒ¬ãƒ¼ãƒˆã®ãƒ•ãƒ¬ãƒ¼ãƒ ç•ªå·ï¼ˆframesé…åˆ—ã®0å§‹ã¾ã‚Šã®ã‚¤ãƒ³ãƒ‡ãƒƒã‚¯ã‚¹ï¼‰
+	FrameIndex null.Int `boil:"frame_index" json:"frame_index,omitempty" toml:"frame_index" yaml:"frame_index,omitempty"`
+	// ãƒ©ã‚¦ãƒ³ãƒ‰ç•ªå·
+	RoundNumber int `boil:"round_number" json:"round_number" toml:"round_number" yaml:"round_number"`
+	// æ’®å½±æ—¥æ™‚ï¼ˆEXIFã‹ã‚‰å–å¾—ï¼‰
+	CapturedAt null.Time `boil:"captured_at" json:"captured_at,omitempty" toml:"captured_at" yaml:"captured_at,omitempty"`
+	// å…ƒç”»åƒã®å¹…ï¼ˆå‘ãè£œæ­£å¾Œã®ãƒ”ã‚¯ã‚»ãƒ«æ•°ï¼‰
+	OriginalWidth null.Int `boil:"original_width" json:"original_width,omitempty" toml:"original_width" yaml:"original_width,omitempty"`
+	// å…ƒç”»åƒã®é«˜ã•ï¼ˆå‘ãè£œæ­£å¾Œã®ãƒ”ã‚¯ã‚»ãƒ«æ•°ï¼‰
+	OriginalHeight null.Int `boil:"original_height" json:"original_height,omitempty" toml:"original_height" yaml:"original_height,omitempty"`
 	// ã‚³ãƒ©ãƒ¼ã‚¸ãƒ¥å¯¾è±¡æ—¥
 	CollageDay time.Time `boil:"collage_day" json:"collage_day" toml:"collage_day" yaml:"collage_day"`
 	// ã‚¢ãƒƒãƒ—ãƒ­ãƒ¼ãƒ‰æ—¥æ™‚
@@ -44,59 +54,89 @@ type UploadImage struct {
 }
 
 var UploadImageColumns = struct {
-	ImageID    string
-	FileURL    string
-	GroupID    string
-	UserID     string
-	PartID     string
-	CollageDay string
-	CreatedAt  string
+	ImageID        string
+	FileURL        string
+	GroupID        string
+	UserID         string
+	PartID         string
+	FrameIndex     string
+	RoundNumber    string
+	CapturedAt     string
+	OriginalWidth  string
+	OriginalHeight string
+	CollageDay     string
+	CreatedAt      string
 }{
-	ImageID:    "image_id",
-	FileURL:    "file_url",
-	GroupID:    "group_id",
-	UserID:     "user_id",
-	PartID:     "part_id",
-	CollageDay: "collage_day",
-	CreatedAt:  "created_at",
+	ImageID:        "image_id",
+	FileURL:        "file_url",
+	GroupID:        "group_id",
+	UserID:         "user_id",
+	PartID:         "part_id",
+	FrameIndex:     "frame_index",
+	RoundNumber:    "round_number",
+	CapturedAt:     "captured_at",
+	OriginalWidth:  "original_width",
+	OriginalHeight: "original_height",
+	CollageDay:     "collage_day",
+	CreatedAt:      "created_at",
 }
 
 var UploadImageTableColumns = struct {
-	ImageID    string
-	FileURL    string
-	GroupID    string
-	UserID     string
-	PartID     string
-	CollageDay string
-	CreatedAt  string
+	ImageID        string
+	FileURL        string
+	GroupID        string
+	UserID         string
+	PartID         string
+	FrameIndex     string
+	RoundNumber    string
+	CapturedAt     string
+	OriginalWidth  string
+	OriginalHeight string
+	CollageDay     string
+	CreatedAt      string
 }{
-	ImageID:    "upload_images.image_id",
-	FileURL:    "upload_images.file_url",
-	GroupID:    "upload_images.group_id",
-	UserID:     "upload_images.user_id",
-	PartID:     "upload_images.part_id",
-	CollageDay: "upload_images.collage_day",
-	CreatedAt:  "upload_images.created_at",
+	ImageID:        "upload_images.image_id",
+	FileURL:        "upload_images.file_url",
+	GroupID:        "upload_images.group_id",
+	UserID:         "upload_images.user_id",
+	PartID:         "upload_images.part_id",
+	FrameIndex:     "upload_images.frame_index",
+	RoundNumber:    "upload_images.round_number",
+	CapturedAt:     "upload_images.captured_at",
+	OriginalWidth:  "upload_images.original_width",
+	OriginalHeight: "upload_images.original_height",
+	CollageDay:     "upload_images.collage_day",
+	CreatedAt:      "upload_images.created_at",
 }
 
 // Generated where
 
 var UploadImageWhere = struct {
-	ImageID    whereHelperstring
-	FileURL    whereHelperstring
-	GroupID    whereHelperstring
-	UserID     whereHelperstring
-	PartID     whereHelpernull_String
-	CollageDay whereHelpertime_Time
-	CreatedAt  whereHelpertime_Time
+	ImageID        whereHelperstring
+	FileURL        whereHelperstring
+	GroupID        whereHelperstring
+	UserID         whereHelperstring
+	PartID         whereHelpernull_String
+	FrameIndex     whereHelpernull_Int
+	RoundNumber    whereHelperint
+	CapturedAt     whereHelpernull_Time
+	OriginalWidth  whereHelpernull_Int
+	OriginalHeight whereHelpernull_Int
+	CollageDay     whereHelpertime_Time
+	CreatedAt      whereHelpertime_Time
 }{
-	ImageID:    whereHelperstring{field: "`upload_images`.`image_id`"},
-	FileURL:    whereHelperstring{field: "`upload_images`.`file_url`"},
-	GroupID:    whereHelperstring{field: "`upload_images`.`group_id`"},
-	UserID:     whereHelperstring{field: "`upload_images`.`user_id`"},
-	PartID:     whereHelpernull_String{field: "`upload_images`.`part_id`"},
-	CollageDay: whereHelpertime_Time{field: "`upload_images`.`collage_day`"},
-	CreatedAt:  whereHelpertime_Time{field: "`upload_images`.`created_at`"},
+	ImageID:        whereHelperstring{field: "`upload_images`.`image_id`"},
+	FileURL:        whereHelperstring{field: "`upload_images`.`file_url`"},
+	GroupID:        whereHelperstring{field: "`upload_images`.`group_id`"},
+	UserID:         whereHelperstring{field: "`upload_images`.`user_id`"},
+	PartID:         whereHelpernull_String{field: "`upload_images`.`part_id`"},
+	FrameIndex:     whereHelpernull_Int{field: "`upload_images`.`frame_index`"},
+	RoundNumber:    whereHelperint{field: "`upload_images`.`round_number`"},
+	CapturedAt:     whereHelpernull_Time{field: "`upload_images`.`captured_at`"},
+	OriginalWidth:  whereHelpernull_Int{field: "`upload_images`.`original_width`"},
+	OriginalHeight: whereHelpernull_Int{field: "`upload_images`.`original_height`"},
+	CollageDay:     whereHelpertime_Time{field: "`upload_images`.`collage_day`"},
+	CreatedAt:      whereHelpertime_Time{field: "`upload_images`.`created_at`"},
 }
 
 // UploadImageRels is where relationship names are stored.
@@ -104,12 +144,16 @@ var UploadImageRels = struct {
 	Group                           string
 	Part                            string
 	User                            string
+	ImageResumableUploads           string
 	ImageUploadImagesCollageResults string
+	ImageUploadSlots                string
 }{
 	Group:                           "Group",
 	Part:                            "Part",
 	User:                            "User",
+	ImageResumableUploads:           "ImageResumableUploads",
 	ImageUploadImagesCollageResults: "ImageUploadImagesCollageResults",
+	ImageUploadSlots:                "ImageUploadSlots",
 }
 
 // uploadImageR is where relationships are stored.
@@ -117,7 +161,9 @@ type uploadImageR struct {
 	Group                           *Group                         `boil:"Group" json:"Group" toml:"Group" yaml:"Group"`
 	Part                            *TemplatePart                  `boil:"Part" json:"Part" toml:"Part" yaml:"Part"`
 	User                            *User                          `boil:"User" json:"User" toml:"User" yaml:"User"`
+	ImageResumableUploads           ResumableUploadSlice           `boil:"ImageResumableUploads" json:"ImageResumableUploads" toml:"ImageResumableUploads" yaml:"ImageResumableUploads"`
 	ImageUploadImagesCollageResults UploadImagesCollageResultSlice `boil:"ImageUploadImagesCollageResults" json:"ImageUploadImagesCollageResults" toml:"ImageUploadImagesCollageResults" yaml:"ImageUploadImagesCollageResults"`
+	ImageUploadSlots                UploadSlotSlice                `boil:"ImageUploadSlots" json:"ImageUploadSlots" toml:"ImageUploadSlots" yaml:"ImageUploadSlots"`
 }
 
 // NewStruct creates a new relationship struct
@@ -173,6 +219,22 @@ func (r *uploadImageR) GetUser() *User {
 	return r.User
 }
 
+func (o *UploadImage) GetImageResumableUploads() ResumableUploadSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetImageResumableUploads()
+}
+
+func (r *uploadImageR) GetImageResumableUploads() ResumableUploadSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.ImageResumableUploads
+}
+
 func (o *UploadImage) GetImageUploadImagesCollageResults() UploadImagesCollageResultSlice {
 	if o == nil {
 		return nil
@@ -189,13 +251,29 @@ func (r *uploadImageR) GetImageUploadImagesCollageResults() UploadImagesCollageR
 	return r.ImageUploadImagesCollageResults
 }
 
+func (o *UploadImage) GetImageUploadSlots() UploadSlotSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetImageUploadSlots()
+}
+
+func (r *uploadImageR) GetImageUploadSlots() UploadSlotSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.ImageUploadSlots
+}
+
 // uploadImageL is where Load methods for each relationship are stored.
 type uploadImageL struct{}
 
 var (
-	uploadImageAllColumns            = []string{"image_id", "file_url", "group_id", "user_id", "part_id", "collage_day", "created_at"}
-	uploadImageColumnsWithoutDefault = []string{"image_id", "file_url", "group_id", "user_id", "part_id", "collage_day"}
-	uploadImageColumnsWithDefault    = []string{"created_at"}
+	uploadImageAllColumns            = []string{"image_id", "file_url", "group_id", "user_id", "part_id", "frame_index", "round_number", "captured_at", "original_width", "original_height", "collage_day", "created_at"}
+	uploadImageColumnsWithoutDefault = []string{"image_id", "file_url", "group_id", "user_id", "part_id", "frame_index", "captured_at", "original_width", "original_height", "collage_day"}
+	uploadImageColumnsWithDefault    = []string{"round_number", "created_at"}
 	uploadImagePrimaryKeyColumns     = []string{"image_id"}
 	uploadImageGeneratedColumns      = []string{}
 )
@@ -538,6 +616,20 @@ func (o *UploadImage) User(mods ...qm.QueryMod) userQuery {
 	return Users(queryMods...)
 }
 
+// ImageResumableUploads retrieves all the resumable_upload's ResumableUploads with an executor via image_id column.
+func (o *UploadImage) ImageResumableUploads(mods ...qm.QueryMod) resumableUploadQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`resumable_uploads`.`image_id`=?", o.ImageID),
+	)
+
+	return ResumableUploads(queryMods...)
+}
+
 // ImageUploadImagesCollageResults retrieves all the upload_images_collage_result's UploadImagesCollageResults with an executor via image_id column.
 func (o *UploadImage) ImageUploadImagesCollageResults(mods ...qm.QueryMod) uploadImagesCollageResultQuery {
 	var queryMods []qm.QueryMod
@@ -552,6 +644,20 @@ func (o *UploadImage) ImageUploadImagesCollageResults(mods ...qm.QueryMod) uploa
 	return UploadImagesCollageResults(queryMods...)
 }
 
+// ImageUploadSlots retrieves all the upload_slot's UploadSlots with an executor via image_id column.
+func (o *UploadImage) ImageUploadSlots(mods ...qm.QueryMod) uploadSlotQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`upload_slots`.`image_id`=?", o.ImageID),
+	)
+
+	return UploadSlots(queryMods...)
+}
+
 // LoadGroup allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for an N-1 relationship.
 func (uploadImageL) LoadGroup(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUploadImage interface{}, mods queries.Applicator) error {
@@ -916,6 +1022,119 @@ func (uploadImageL) LoadUser(ctx context.Context, e boil.ContextExecutor, singul
 	return nil
 }
 
+// LoadImageResumableUploads allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (uploadImageL) LoadImageResumableUploads(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUploadImage interface{}, mods queries.Applicator) error {
+	var slice []*UploadImage
+	var object *UploadImage
+
+	if singular {
+		var ok bool
+		object, ok = maybeUploadImage.(*UploadImage)
+		if !ok {
+			object = new(UploadImage)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUploadImage)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUploadImage))
+			}
+		}
+	} else {
+		s, ok := maybeUploadImage.(*[]*UploadImage)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUploadImage)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUploadImage))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &uploadImageR{}
+		}
+		args[object.ImageID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &uploadImageR{}
+			}
+			args[obj.ImageID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`resumable_uploads`),
+		qm.WhereIn(`resumable_uploads.image_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load resumable_uploads")
+	}
+
+	var resultSlice []*ResumableUpload
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice resumable_uploads")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on resumable_uploads")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for resumable_uploads")
+	}
+
+	if len(resumableUploadAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ImageResumableUploads = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &resumableUploadR{}
+			}
+			foreign.R.Image = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ImageID, foreign.ImageID) {
+				local.R.ImageResumableUploads = append(local.R.ImageResumableUploads, foreign)
+				if foreign.R == nil {
+					foreign.R = &resumableUploadR{}
+				}
+				foreign.R.Image = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadImageUploadImagesCollageResults allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
 func (uploadImageL) LoadImageUploadImagesCollageResults(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUploadImage interface{}, mods queries.Applicator) error {
@@ -1029,6 +1248,119 @@ func (uploadImageL) LoadImageUploadImagesCollageResults(ctx context.Context, e b
 	return nil
 }
 
+// LoadImageUploadSlots allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (uploadImageL) LoadImageUploadSlots(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUploadImage interface{}, mods queries.Applicator) error {
+	var slice []*UploadImage
+	var object *UploadImage
+
+	if singular {
+		var ok bool
+		object, ok = maybeUploadImage.(*UploadImage)
+		if !ok {
+			object = new(UploadImage)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUploadImage)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUploadImage))
+			}
+		}
+	} else {
+		s, ok := maybeUploadImage.(*[]*UploadImage)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUploadImage)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUploadImage))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &uploadImageR{}
+		}
+		args[object.ImageID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &uploadImageR{}
+			}
+			args[obj.ImageID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`upload_slots`),
+		qm.WhereIn(`upload_slots.image_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load upload_slots")
+	}
+
+	var resultSlice []*UploadSlot
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice upload_slots")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on upload_slots")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for upload_slots")
+	}
+
+	if len(uploadSlotAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ImageUploadSlots = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &uploadSlotR{}
+			}
+			foreign.R.Image = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ImageID, foreign.ImageID) {
+				local.R.ImageUploadSlots = append(local.R.ImageUploadSlots, foreign)
+				if foreign.R == nil {
+					foreign.R = &uploadSlotR{}
+				}
+				foreign.R.Image = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // SetGroup of the uploadImage to the related item.
 // Sets o.R.Group to related.
 // Adds o to related.R.UploadImages.
@@ -1203,6 +1535,133 @@ func (o *UploadImage) SetUser(ctx context.Context, exec boil.ContextExecutor, in
 	return nil
 }
 
+// AddImageResumableUploads adds the given related objects to the existing relationships
+// of the upload_image, optionally inserting them as new records.
+// Appends related to o.R.ImageResumableUploads.
+// Sets related.R.Image appropriately.
+func (o *UploadImage) AddImageResumableUploads(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ResumableUpload) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.ImageID, o.ImageID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `resumable_uploads` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"image_id"}),
+				strmangle.WhereClause("`", "`", 0, resumableUploadPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ImageID, rel.UploadID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.ImageID, o.ImageID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &uploadImageR{
+			ImageResumableUploads: related,
+		}
+	} else {
+		o.R.ImageResumableUploads = append(o.R.ImageResumableUploads, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &resumableUploadR{
+				Image: o,
+			}
+		} else {
+			rel.R.Image = o
+		}
+	}
+	return nil
+}
+
+// SetImageResumableUploads removes all previously related items of the
+// upload_image replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Image's ImageResumableUploads accordingly.
+// Replaces o.R.ImageResumableUploads with related.
+// Sets related.R.Image's ImageResumableUploads accordingly.
+func (o *UploadImage) SetImageResumableUploads(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ResumableUpload) error {
+	query := "update `resumable_uploads` set `image_id` = null where `image_id` = ?"
+	values := []interface{}{o.ImageID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.ImageResumableUploads {
+			queries.SetScanner(&rel.ImageID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Image = nil
+		}
+		o.R.ImageResumableUploads = nil
+	}
+
+	return o.AddImageResumableUploads(ctx, exec, insert, related...)
+}
+
+// RemoveImageResumableUploads relationships from objects passed in.
+// Removes related items from R.ImageResumableUploads (uses pointer comparison, removal does not keep order)
+// Sets related.R.Image.
+func (o *UploadImage) RemoveImageResumableUploads(ctx context.Context, exec boil.ContextExecutor, related ...*ResumableUpload) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.ImageID, nil)
+		if rel.R != nil {
+			rel.R.Image = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("image_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.ImageResumableUploads {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.ImageResumableUploads)
+			if ln > 1 && i < ln-1 {
+				o.R.ImageResumableUploads[i] = o.R.ImageResumableUploads[ln-1]
+			}
+			o.R.ImageResumableUploads = o.R.ImageResumableUploads[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
 // AddImageUploadImagesCollageResults adds the given related objects to the existing relationships
 // of the upload_image, optionally inserting them as new records.
 // Appends related to o.R.ImageUploadImagesCollageResults.
@@ -1256,6 +1715,133 @@ func (o *UploadImage) AddImageUploadImagesCollageResults(ctx context.Context, ex
 	return nil
 }
 
+// AddImageUploadSlots adds the given related objects to the existing relationships
+// of the upload_image, optionally inserting them as new records.
+// Appends related to o.R.ImageUploadSlots.
+// Sets related.R.Image appropriately.
+func (o *UploadImage) AddImageUploadSlots(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*UploadSlot) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.ImageID, o.ImageID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `upload_slots` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"image_id"}),
+				strmangle.WhereClause("`", "`", 0, uploadSlotPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ImageID, rel.SlotID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.ImageID, o.ImageID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &uploadImageR{
+			ImageUploadSlots: related,
+		}
+	} else {
+		o.R.ImageUploadSlots = append(o.R.ImageUploadSlots, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &uploadSlotR{
+				Image: o,
+			}
+		} else {
+			rel.R.Image = o
+		}
+	}
+	return nil
+}
+
+// SetImageUploadSlots removes all previously related items of the
+// upload_image replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Image's ImageUploadSlots accordingly.
+// Replaces o.R.ImageUploadSlots with related.
+// Sets related.R.Image's ImageUploadSlots accordingly.
+func (o *UploadImage) SetImageUploadSlots(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*UploadSlot) error {
+	query := "update `upload_slots` set `image_id` = null where `image_id` = ?"
+	values := []interface{}{o.ImageID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.ImageUploadSlots {
+			queries.SetScanner(&rel.ImageID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Image = nil
+		}
+		o.R.ImageUploadSlots = nil
+	}
+
+	return o.AddImageUploadSlots(ctx, exec, insert, related...)
+}
+
+// RemoveImageUploadSlots relationships from objects passed in.
+// Removes related items from R.ImageUploadSlots (uses pointer comparison, removal does not keep order)
+// Sets related.R.Image.
+func (o *UploadImage) RemoveImageUploadSlots(ctx context.Context, exec boil.ContextExecutor, related ...*UploadSlot) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.ImageID, nil)
+		if rel.R != nil {
+			rel.R.Image = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("image_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.ImageUploadSlots {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.ImageUploadSlots)
+			if ln > 1 && i < ln-1 {
+				o.R.ImageUploadSlots[i] = o.R.ImageUploadSlots[ln-1]
+			}
+			o.R.ImageUploadSlots = o.R.ImageUploadSlots[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
 // UploadImages retrieves all the records using an executor.
 func UploadImages(mods ...qm.QueryMod) uploadImageQuery {
 	mods = append(mods, qm.From("`upload_images`"))
@@ -494,6 +494,83 @@ func testUploadImagesInsertWhitelist(t *testing.T) {
 	}
 }
 
+func testUploadImageToManyImageResumableUploads(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a UploadImage
+	var b, c ResumableUpload
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, uploadImageDBTypes, true, uploadImageColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize UploadImage struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, resumableUploadDBTypes, false, resumableUploadColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, resumableUploadDBTypes, false, resumableUploadColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&b.ImageID, a.ImageID)
+	queries.Assign(&c.ImageID, a.ImageID)
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.ImageResumableUploads().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if queries.Equal(v.ImageID, b.ImageID) {
+			bFound = true
+		}
+		if queries.Equal(v.ImageID, c.ImageID) {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := UploadImageSlice{&a}
+	if err = a.L.LoadImageResumableUploads(ctx, tx, false, (*[]*UploadImage)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.ImageResumableUploads); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.ImageResumableUploads = nil
+	if err = a.L.LoadImageResumableUploads(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.ImageResumableUploads); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
 func testUploadImageToManyImageUploadImagesCollageResults(t *testing.T) {
 	var err error
 	ctx := context.Background()
@@ -572,6 +649,334 @@ func testUploadImageToManyImageUploadImagesCollageResults(t *testing.T) {
 	}
 }
 
+func testUploadImageToManyImageUploadSlots(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a UploadImage
+	var b, c UploadSlot
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, uploadImageDBTypes, true, uploadImageColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize UploadImage struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, uploadSlotDBTypes, false, uploadSlotColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, uploadSlotDBTypes, false, uploadSlotColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&b.ImageID, a.ImageID)
+	queries.Assign(&c.ImageID, a.ImageID)
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.ImageUploadSlots().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if queries.Equal(v.ImageID, b.ImageID) {
+			bFound = true
+		}
+		if queries.Equal(v.ImageID, c.ImageID) {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := UploadImageSlice{&a}
+	if err = a.L.LoadImageUploadSlots(ctx, tx, false, (*[]*UploadImage)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.ImageUploadSlots); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.ImageUploadSlots = nil
+	if err = a.L.LoadImageUploadSlots(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.ImageUploadSlots); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testUploadImageToManyAddOpImageResumableUploads(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a UploadImage
+	var b, c, d, e ResumableUpload
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, uploadImageDBTypes, false, strmangle.SetComplement(uploadImagePrimaryKeyColumns, uploadImageColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*ResumableUpload{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, resumableUploadDBTypes, false, strmangle.SetComplement(resumableUploadPrimaryKeyColumns, resumableUploadColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*ResumableUpload{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddImageResumableUploads(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if !queries.Equal(a.ImageID, first.ImageID) {
+			t.Error("foreign key was wrong value", a.ImageID, first.ImageID)
+		}
+		if !queries.Equal(a.ImageID, second.ImageID) {
+			t.Error("foreign key was wrong value", a.ImageID, second.ImageID)
+		}
+
+		if first.R.Image != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Image != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.ImageResumableUploads[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.ImageResumableUploads[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.ImageResumableUploads().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testUploadImageToManySetOpImageResumableUploads(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a UploadImage
+	var b, c, d, e ResumableUpload
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, uploadImageDBTypes, false, strmangle.SetComplement(uploadImagePrimaryKeyColumns, uploadImageColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*ResumableUpload{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, resumableUploadDBTypes, false, strmangle.SetComplement(resumableUploadPrimaryKeyColumns, resumableUploadColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetImageResumableUploads(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.ImageResumableUploads().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetImageResumableUploads(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.ImageResumableUploads().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.ImageID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.ImageID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+	if !queries.Equal(a.ImageID, d.ImageID) {
+		t.Error("foreign key was wrong value", a.ImageID, d.ImageID)
+	}
+	if !queries.Equal(a.ImageID, e.ImageID) {
+		t.Error("foreign key was wrong value", a.ImageID, e.ImageID)
+	}
+
+	if b.R.Image != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.Image != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.Image != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.Image != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if a.R.ImageResumableUploads[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.ImageResumableUploads[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testUploadImageToManyRemoveOpImageResumableUploads(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a UploadImage
+	var b, c, d, e ResumableUpload
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, uploadImageDBTypes, false, strmangle.SetComplement(uploadImagePrimaryKeyColumns, uploadImageColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*ResumableUpload{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, resumableUploadDBTypes, false, strmangle.SetComplement(resumableUploadPrimaryKeyColumns, resumableUploadColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddImageResumableUploads(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.ImageResumableUploads().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemoveImageResumableUploads(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.ImageResumableUploads().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.ImageID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.ImageID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+
+	if b.R.Image != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.Image != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.Image != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+	if e.R.Image != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+
+	if len(a.R.ImageResumableUploads) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.ImageResumableUploads[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.ImageResumableUploads[0] != &e {
+		t.Error("relationship to e should have been preserved")
+	}
+}
+
 func testUploadImageToManyAddOpImageUploadImagesCollageResults(t *testing.T) {
 	var err error
 
@@ -647,6 +1052,257 @@ func testUploadImageToManyAddOpImageUploadImagesCollageResults(t *testing.T) {
 		}
 	}
 }
+func testUploadImageToManyAddOpImageUploadSlots(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a UploadImage
+	var b, c, d, e UploadSlot
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, uploadImageDBTypes, false, strmangle.SetComplement(uploadImagePrimaryKeyColumns, uploadImageColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*UploadSlot{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, uploadSlotDBTypes, false, strmangle.SetComplement(uploadSlotPrimaryKeyColumns, uploadSlotColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*UploadSlot{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddImageUploadSlots(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if !queries.Equal(a.ImageID, first.ImageID) {
+			t.Error("foreign key was wrong value", a.ImageID, first.ImageID)
+		}
+		if !queries.Equal(a.ImageID, second.ImageID) {
+			t.Error("foreign key was wrong value", a.ImageID, second.ImageID)
+		}
+
+		if first.R.Image != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Image != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.ImageUploadSlots[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.ImageUploadSlots[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.ImageUploadSlots().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testUploadImageToManySetOpImageUploadSlots(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a UploadImage
+	var b, c, d, e UploadSlot
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, uploadImageDBTypes, false, strmangle.SetComplement(uploadImagePrimaryKeyColumns, uploadImageColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*UploadSlot{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, uploadSlotDBTypes, false, strmangle.SetComplement(uploadSlotPrimaryKeyColumns, uploadSlotColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetImageUploadSlots(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.ImageUploadSlots().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetImageUploadSlots(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.ImageUploadSlots().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.ImageID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.ImageID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+	if !queries.Equal(a.ImageID, d.ImageID) {
+		t.Error("foreign key was wrong value", a.ImageID, d.ImageID)
+	}
+	if !queries.Equal(a.ImageID, e.ImageID) {
+		t.Error("foreign key was wrong value", a.ImageID, e.ImageID)
+	}
+
+	if b.R.Image != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.Image != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.Image != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.Image != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if a.R.ImageUploadSlots[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.ImageUploadSlots[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testUploadImageToManyRemoveOpImageUploadSlots(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a UploadImage
+	var b, c, d, e UploadSlot
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, uploadImageDBTypes, false, strmangle.SetComplement(uploadImagePrimaryKeyColumns, uploadImageColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*UploadSlot{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, uploadSlotDBTypes, false, strmangle.SetComplement(uploadSlotPrimaryKeyColumns, uploadSlotColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddImageUploadSlots(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.ImageUploadSlots().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemoveImageUploadSlots(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.ImageUploadSlots().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.ImageID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.ImageID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+
+	if b.R.Image != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.Image != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.Image != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+	if e.R.Image != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+
+	if len(a.R.ImageUploadSlots) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.ImageUploadSlots[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.ImageUploadSlots[0] != &e {
+		t.Error("relationship to e should have been preserved")
+	}
+}
+
 func testUploadImageToOneGroupUsingGroup(t *testing.T) {
 	ctx := context.Background()
 	tx := MustTx(boil.BeginTx(ctx, nil))
@@ -1128,7 +1784,7 @@ func testUploadImagesSelect(t *testing.T) {
 }
 
 var (
-	uploadImageDBTypes = map[string]string{`ImageID`: `char`, `FileURL`: `varchar`, `GroupID`: `char`, `UserID`: `char`, `PartID`: `char`, `CollageDay`: `date`, `CreatedAt`: `timestamp`}
+	uploadImageDBTypes = map[string]string{`ImageID`: `char`, `FileURL`: `varchar`, `GroupID`: `char`, `UserID`: `char`, `PartID`: `char`, `FrameIndex`: `int`, `RoundNumber`: `int`, `CapturedAt`: `datetime`, `OriginalWidth`: `int`, `OriginalHeight`: `int`, `CollageDay`: `date`, `CreatedAt`: `timestamp`}
 	_                  = bytes.MinRead
 )
 
@@ -93,35 +93,44 @@ var UserWhere = struct {
 
 // UserRels is where relationship names are stored.
 var UserRels = struct {
-	DeviceTokens         string
-	AddresseeFriends     string
-	RequesterFriends     string
-	GroupMembers         string
-	GroupPartAssignments string
-	OwnerUserGroups      string
-	ResultDownloads      string
-	UploadImages         string
+	OwnerUserCollagesTemplates string
+	DeviceTokens               string
+	AddresseeFriends           string
+	RequesterFriends           string
+	GroupMembers               string
+	GroupPartAssignments       string
+	OwnerUserGroups            string
+	ResultDownloads            string
+	ResumableUploads           string
+	UploadImages               string
+	UploadSlots                string
 }{
-	DeviceTokens:         "DeviceTokens",
-	AddresseeFriends:     "AddresseeFriends",
-	RequesterFriends:     "RequesterFriends",
-	GroupMembers:         "GroupMembers",
-	GroupPartAssignments: "GroupPartAssignments",
-	OwnerUserGroups:      "OwnerUserGroups",
-	ResultDownloads:      "ResultDownloads",
-	UploadImages:         "UploadImages",
+	OwnerUserCollagesTemplates: "OwnerUserCollagesTemplates",
+	DeviceTokens:               "DeviceTokens",
+	AddresseeFriends:           "AddresseeFriends",
+	RequesterFriends:           "RequesterFriends",
+	GroupMembers:               "GroupMembers",
+	GroupPartAssignments:       "GroupPartAssignments",
+	OwnerUserGroups:            "OwnerUserGroups",
+	ResultDownloads:            "ResultDownloads",
+	ResumableUploads:           "ResumableUploads",
+	UploadImages:               "UploadImages",
+	UploadSlots:                "UploadSlots",
 }
 
 // userR is where relationships are stored.
 type userR struct {
-	DeviceTokens         DeviceTokenSlice         `boil:"DeviceTokens" json:"DeviceTokens" toml:"DeviceTokens" yaml:"DeviceTokens"`
-	AddresseeFriends     FriendSlice              `boil:"AddresseeFriends" json:"AddresseeFriends" toml:"AddresseeFriends" yaml:"AddresseeFriends"`
-	RequesterFriends     FriendSlice              `boil:"RequesterFriends" json:"RequesterFriends" toml:"RequesterFriends" yaml:"RequesterFriends"`
-	GroupMembers         GroupMemberSlice         `boil:"GroupMembers" json:"GroupMembers" toml:"GroupMembers" yaml:"GroupMembers"`
-	GroupPartAssignments GroupPartAssignmentSlice `boil:"GroupPartAssignments" json:"GroupPartAssignments" toml:"GroupPartAssignments" yaml:"GroupPartAssignments"`
-	OwnerUserGroups      GroupSlice               `boil:"OwnerUserGroups" json:"OwnerUserGroups" toml:"OwnerUserGroups" yaml:"OwnerUserGroups"`
-	ResultDownloads      ResultDownloadSlice      `boil:"ResultDownloads" json:"ResultDownloads" toml:"ResultDownloads" yaml:"ResultDownloads"`
-	UploadImages         UploadImageSlice         `boil:"UploadImages" json:"UploadImages" toml:"UploadImages" yaml:"UploadImages"`
+	OwnerUserCollagesTemplates CollagesTemplateSlice    `boil:"OwnerUserCollagesTemplates" json:"OwnerUserCollagesTemplates" toml:"OwnerUserCollagesTemplates" yaml:"OwnerUserCollagesTemplates"`
+	DeviceTokens               DeviceTokenSlice         `boil:"DeviceTokens" json:"DeviceTokens" toml:"DeviceTokens" yaml:"DeviceTokens"`
+	AddresseeFriends           FriendSlice              `boil:"AddresseeFriends" json:"AddresseeFriends" toml:"AddresseeFriends" yaml:"AddresseeFriends"`
+	RequesterFriends           FriendSlice              `boil:"RequesterFriends" json:"RequesterFriends" toml:"RequesterFriends" yaml:"RequesterFriends"`
+	GroupMembers               GroupMemberSlice         `boil:"GroupMembers" json:"GroupMembers" toml:"GroupMembers" yaml:"GroupMembers"`
+	GroupPartAssignments       GroupPartAssignmentSlice `boil:"GroupPartAssignments" json:"GroupPartAssignments" toml:"GroupPartAssignments" yaml:"GroupPartAssignments"`
+	OwnerUserGroups            GroupSlice               `boil:"OwnerUserGroups" json:"OwnerUserGroups" toml:"OwnerUserGroups" yaml:"OwnerUserGroups"`
+	ResultDownloads            ResultDownloadSlice      `boil:"ResultDownloads" json:"ResultDownloads" toml:"ResultDownloads" yaml:"ResultDownloads"`
+	ResumableUploads           ResumableUploadSlice     `boil:"ResumableUploads" json:"ResumableUploads" toml:"ResumableUploads" yaml:"ResumableUploads"`
+	UploadImages               UploadImageSlice         `boil:"UploadImages" json:"UploadImages" toml:"UploadImages" yaml:"UploadImages"`
+	UploadSlots                UploadSlotSlice          `boil:"UploadSlots" json:"UploadSlots" toml:"UploadSlots" yaml:"UploadSlots"`
 }
 
 // NewStruct creates a new relationship struct
@@ -129,6 +138,22 @@ func (*userR) NewStruct() *userR {
 	return &userR{}
 }
 
+func (o *User) GetOwnerUserCollagesTemplates() CollagesTemplateSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetOwnerUserCollagesTemplates()
+}
+
+func (r *userR) GetOwnerUserCollagesTemplates() CollagesTemplateSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.OwnerUserCollagesTemplates
+}
+
 func (o *User) GetDeviceTokens() DeviceTokenSlice {
 	if o == nil {
 		return nil
@@ -241,6 +266,22 @@ func (r *userR) GetResultDownloads() ResultDownloadSlice {
 	return r.ResultDownloads
 }
 
+func (o *User) GetResumableUploads() ResumableUploadSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetResumableUploads()
+}
+
+func (r *userR) GetResumableUploads() ResumableUploadSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.ResumableUploads
+}
+
 func (o *User) GetUploadImages() UploadImageSlice {
 	if o == nil {
 		return nil
@@ -257,6 +298,22 @@ func (r *userR) GetUploadImages() UploadImageSlice {
 	return r.UploadImages
 }
 
+func (o *User) GetUploadSlots() UploadSlotSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetUploadSlots()
+}
+
+func (r *userR) GetUploadSlots() UploadSlotSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.UploadSlots
+}
+
 // userL is where Load methods for each relationship are stored.
 type userL struct{}
 
@@ -573,6 +630,20 @@ func (q userQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool,
 	return count > 0, nil
 }
 
+// OwnerUserCollagesTemplates retrieves all the collages_template's CollagesTemplates with an executor via owner_user_id column.
+func (o *User) OwnerUserCollagesTemplates(mods ...qm.QueryMod) collagesTemplateQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`collages_template`.`owner_user_id`=?", o.ID),
+	)
+
+	return CollagesTemplates(queryMods...)
+}
+
 // DeviceTokens retrieves all the device_token's DeviceTokens with an executor.
 func (o *User) DeviceTokens(mods ...qm.QueryMod) deviceTokenQuery {
 	var queryMods []qm.QueryMod
@@ -671,6 +742,20 @@ func (o *User) ResultDownloads(mods ...qm.QueryMod) resultDownloadQuery {
 	return ResultDownloads(queryMods...)
 }
 
+// ResumableUploads retrieves all the resumable_upload's ResumableUploads with an executor.
+func (o *User) ResumableUploads(mods ...qm.QueryMod) resumableUploadQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`resumable_uploads`.`user_id`=?", o.ID),
+	)
+
+	return ResumableUploads(queryMods...)
+}
+
 // UploadImages retrieves all the upload_image's UploadImages with an executor.
 func (o *User) UploadImages(mods ...qm.QueryMod) uploadImageQuery {
 	var queryMods []qm.QueryMod
@@ -685,6 +770,133 @@ func (o *User) UploadImages(mods ...qm.QueryMod) uploadImageQuery {
 	return UploadImages(queryMods...)
 }
 
+// UploadSlots retrieves all the upload_slot's UploadSlots with an executor.
+func (o *User) UploadSlots(mods ...qm.QueryMod) uploadSlotQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`upload_slots`.`user_id`=?", o.ID),
+	)
+
+	return UploadSlots(queryMods...)
+}
+
+// LoadOwnerUserCollagesTemplates allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadOwnerUserCollagesTemplates(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`collages_template`),
+		qm.WhereIn(`collages_template.owner_user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load collages_template")
+	}
+
+	var resultSlice []*CollagesTemplate
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice collages_template")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on collages_template")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for collages_template")
+	}
+
+	if len(collagesTemplateAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.OwnerUserCollagesTemplates = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &collagesTemplateR{}
+			}
+			foreign.R.OwnerUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.OwnerUserID) {
+				local.R.OwnerUserCollagesTemplates = append(local.R.OwnerUserCollagesTemplates, foreign)
+				if foreign.R == nil {
+					foreign.R = &collagesTemplateR{}
+				}
+				foreign.R.OwnerUser = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadDeviceTokens allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
 func (userL) LoadDeviceTokens(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
@@ -1476,9 +1688,9 @@ func (userL) LoadResultDownloads(ctx context.Context, e boil.ContextExecutor, si
 	return nil
 }
 
-// LoadUploadImages allows an eager lookup of values, cached into the
+// LoadResumableUploads allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadUploadImages(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadResumableUploads(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -1531,8 +1743,8 @@ func (userL) LoadUploadImages(ctx context.Context, e boil.ContextExecutor, singu
 	}
 
 	query := NewQuery(
-		qm.From(`upload_images`),
-		qm.WhereIn(`upload_images.user_id in ?`, argsSlice...),
+		qm.From(`resumable_uploads`),
+		qm.WhereIn(`resumable_uploads.user_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1540,22 +1752,22 @@ func (userL) LoadUploadImages(ctx context.Context, e boil.ContextExecutor, singu
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load upload_images")
+		return errors.Wrap(err, "failed to eager load resumable_uploads")
 	}
 
-	var resultSlice []*UploadImage
+	var resultSlice []*ResumableUpload
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice upload_images")
+		return errors.Wrap(err, "failed to bind eager loaded slice resumable_uploads")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on upload_images")
+		return errors.Wrap(err, "failed to close results in eager load on resumable_uploads")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for upload_images")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for resumable_uploads")
 	}
 
-	if len(uploadImageAfterSelectHooks) != 0 {
+	if len(resumableUploadAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -1563,10 +1775,10 @@ func (userL) LoadUploadImages(ctx context.Context, e boil.ContextExecutor, singu
 		}
 	}
 	if singular {
-		object.R.UploadImages = resultSlice
+		object.R.ResumableUploads = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &uploadImageR{}
+				foreign.R = &resumableUploadR{}
 			}
 			foreign.R.User = object
 		}
@@ -1576,9 +1788,9 @@ func (userL) LoadUploadImages(ctx context.Context, e boil.ContextExecutor, singu
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
 			if local.ID == foreign.UserID {
-				local.R.UploadImages = append(local.R.UploadImages, foreign)
+				local.R.ResumableUploads = append(local.R.ResumableUploads, foreign)
 				if foreign.R == nil {
-					foreign.R = &uploadImageR{}
+					foreign.R = &resumableUploadR{}
 				}
 				foreign.R.User = local
 				break
@@ -1589,65 +1801,418 @@ func (userL) LoadUploadImages(ctx context.Context, e boil.ContextExecutor, singu
 	return nil
 }
 
-// AddDeviceTokens adds the given related objects to the existing relationships
-// of the user, optionally inserting them as new records.
-// Appends related to o.R.DeviceTokens.
-// Sets related.R.User appropriately.
-func (o *User) AddDeviceTokens(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*DeviceToken) error {
-	var err error
-	for _, rel := range related {
-		if insert {
-			rel.UserID = o.ID
-			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
-				return errors.Wrap(err, "failed to insert into foreign table")
-			}
-		} else {
-			updateQuery := fmt.Sprintf(
-				"UPDATE `device_tokens` SET %s WHERE %s",
-				strmangle.SetParamNames("`", "`", 0, []string{"user_id"}),
-				strmangle.WhereClause("`", "`", 0, deviceTokenPrimaryKeyColumns),
-			)
-			values := []interface{}{o.ID, rel.ID}
+// LoadUploadImages allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadUploadImages(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
 
-			if boil.IsDebug(ctx) {
-				writer := boil.DebugWriterFrom(ctx)
-				fmt.Fprintln(writer, updateQuery)
-				fmt.Fprintln(writer, values)
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
 			}
-			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-				return errors.Wrap(err, "failed to update foreign table")
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
 			}
-
-			rel.UserID = o.ID
 		}
 	}
 
-	if o.R == nil {
-		o.R = &userR{
-			DeviceTokens: related,
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
 		}
+		args[object.ID] = struct{}{}
 	} else {
-		o.R.DeviceTokens = append(o.R.DeviceTokens, related...)
-	}
-
-	for _, rel := range related {
-		if rel.R == nil {
-			rel.R = &deviceTokenR{
-				User: o,
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
 			}
-		} else {
-			rel.R.User = o
+			args[obj.ID] = struct{}{}
 		}
 	}
-	return nil
-}
 
-// AddAddresseeFriends adds the given related objects to the existing relationships
-// of the user, optionally inserting them as new records.
-// Appends related to o.R.AddresseeFriends.
-// Sets related.R.Addressee appropriately.
-func (o *User) AddAddresseeFriends(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Friend) error {
-	var err error
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`upload_images`),
+		qm.WhereIn(`upload_images.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load upload_images")
+	}
+
+	var resultSlice []*UploadImage
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice upload_images")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on upload_images")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for upload_images")
+	}
+
+	if len(uploadImageAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.UploadImages = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &uploadImageR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.UploadImages = append(local.R.UploadImages, foreign)
+				if foreign.R == nil {
+					foreign.R = &uploadImageR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUploadSlots allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadUploadSlots(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`upload_slots`),
+		qm.WhereIn(`upload_slots.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load upload_slots")
+	}
+
+	var resultSlice []*UploadSlot
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice upload_slots")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on upload_slots")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for upload_slots")
+	}
+
+	if len(uploadSlotAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.UploadSlots = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &uploadSlotR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.UploadSlots = append(local.R.UploadSlots, foreign)
+				if foreign.R == nil {
+					foreign.R = &uploadSlotR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// AddOwnerUserCollagesTemplates adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.OwnerUserCollagesTemplates.
+// Sets related.R.OwnerUser appropriately.
+func (o *User) AddOwnerUserCollagesTemplates(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*CollagesTemplate) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.OwnerUserID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `collages_template` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"owner_user_id"}),
+				strmangle.WhereClause("`", "`", 0, collagesTemplatePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.TemplateID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.OwnerUserID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			OwnerUserCollagesTemplates: related,
+		}
+	} else {
+		o.R.OwnerUserCollagesTemplates = append(o.R.OwnerUserCollagesTemplates, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &collagesTemplateR{
+				OwnerUser: o,
+			}
+		} else {
+			rel.R.OwnerUser = o
+		}
+	}
+	return nil
+}
+
+// SetOwnerUserCollagesTemplates removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.OwnerUser's OwnerUserCollagesTemplates accordingly.
+// Replaces o.R.OwnerUserCollagesTemplates with related.
+// Sets related.R.OwnerUser's OwnerUserCollagesTemplates accordingly.
+func (o *User) SetOwnerUserCollagesTemplates(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*CollagesTemplate) error {
+	query := "update `collages_template` set `owner_user_id` = null where `owner_user_id` = ?"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.OwnerUserCollagesTemplates {
+			queries.SetScanner(&rel.OwnerUserID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.OwnerUser = nil
+		}
+		o.R.OwnerUserCollagesTemplates = nil
+	}
+
+	return o.AddOwnerUserCollagesTemplates(ctx, exec, insert, related...)
+}
+
+// RemoveOwnerUserCollagesTemplates relationships from objects passed in.
+// Removes related items from R.OwnerUserCollagesTemplates (uses pointer comparison, removal does not keep order)
+// Sets related.R.OwnerUser.
+func (o *User) RemoveOwnerUserCollagesTemplates(ctx context.Context, exec boil.ContextExecutor, related ...*CollagesTemplate) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.OwnerUserID, nil)
+		if rel.R != nil {
+			rel.R.OwnerUser = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("owner_user_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.OwnerUserCollagesTemplates {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.OwnerUserCollagesTemplates)
+			if ln > 1 && i < ln-1 {
+				o.R.OwnerUserCollagesTemplates[i] = o.R.OwnerUserCollagesTemplates[ln-1]
+			}
+			o.R.OwnerUserCollagesTemplates = o.R.OwnerUserCollagesTemplates[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddDeviceTokens adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.DeviceTokens.
+// Sets related.R.User appropriately.
+func (o *User) AddDeviceTokens(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*DeviceToken) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `device_tokens` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"user_id"}),
+				strmangle.WhereClause("`", "`", 0, deviceTokenPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			DeviceTokens: related,
+		}
+	} else {
+		o.R.DeviceTokens = append(o.R.DeviceTokens, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &deviceTokenR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddAddresseeFriends adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.AddresseeFriends.
+// Sets related.R.Addressee appropriately.
+func (o *User) AddAddresseeFriends(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Friend) error {
+	var err error
 	for _, rel := range related {
 		if insert {
 			rel.AddresseeID = o.ID
@@ -1960,6 +2525,59 @@ func (o *User) AddResultDownloads(ctx context.Context, exec boil.ContextExecutor
 	return nil
 }
 
+// AddResumableUploads adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.ResumableUploads.
+// Sets related.R.User appropriately.
+func (o *User) AddResumableUploads(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ResumableUpload) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `resumable_uploads` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"user_id"}),
+				strmangle.WhereClause("`", "`", 0, resumableUploadPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.UploadID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			ResumableUploads: related,
+		}
+	} else {
+		o.R.ResumableUploads = append(o.R.ResumableUploads, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &resumableUploadR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
 // AddUploadImages adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
 // Appends related to o.R.UploadImages.
@@ -2013,6 +2631,59 @@ func (o *User) AddUploadImages(ctx context.Context, exec boil.ContextExecutor, i
 	return nil
 }
 
+// AddUploadSlots adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.UploadSlots.
+// Sets related.R.User appropriately.
+func (o *User) AddUploadSlots(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*UploadSlot) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `upload_slots` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"user_id"}),
+				strmangle.WhereClause("`", "`", 0, uploadSlotPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.SlotID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			UploadSlots: related,
+		}
+	} else {
+		o.R.UploadSlots = append(o.R.UploadSlots, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &uploadSlotR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
 // Users retrieves all the records using an executor.
 func Users(mods ...qm.QueryMod) userQuery {
 	mods = append(mods, qm.From("`users`"))
@@ -0,0 +1,195 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/google/uuid"
+	"github.com/jphacks/os_2502/back/api/internal/domain/collage_job"
+	"github.com/jphacks/os_2502/back/api/internal/infrastructure/db"
+	"github.com/jphacks/os_2502/back/api/internal/infrastructure/models"
+)
+
+type CollageJobRepositorySQLBoiler struct {
+	db *sql.DB
+}
+
+func NewCollageJobRepositorySQLBoiler(db *sql.DB) collage_job.Repository {
+	return &CollageJobRepositorySQLBoiler{db: db}
+}
+
+// Model to Entity conversion
+func toCollageJobEntity(m *models.CollageJob) (*collage_job.CollageJob, error) {
+	jobID, err := uuid.Parse(m.JobID)
+	if err != nil {
+		return nil, err
+	}
+
+	return collage_job.Reconstruct(
+		jobID,
+		m.GroupID,
+		collage_job.Status(m.Status),
+		m.Attempts,
+		m.MaxAttempts,
+		m.RunAt,
+		m.LockedBy.Ptr(),
+		m.LockedUntil.Ptr(),
+		m.LastError.Ptr(),
+		m.CreatedAt,
+		m.UpdatedAt,
+	)
+}
+
+// Entity to Model conversion
+func toCollageJobModel(j *collage_job.CollageJob) *models.CollageJob {
+	m := &models.CollageJob{
+		JobID:       j.JobID().String(),
+		GroupID:     j.GroupID(),
+		Status:      string(j.Status()),
+		Attempts:    j.Attempts(),
+		MaxAttempts: j.MaxAttempts(),
+		RunAt:       j.RunAt(),
+		LockedBy:    null.StringFromPtr(j.LockedBy()),
+		LockedUntil: null.TimeFromPtr(j.LockedUntil()),
+		LastError:   null.StringFromPtr(j.LastError()),
+		CreatedAt:   j.CreatedAt(),
+		UpdatedAt:   j.UpdatedAt(),
+	}
+	// 未完了のジョブはグループごとに1つだけ（一意制約で重複登録を防ぐ）
+	if !j.IsFinished() {
+		m.ActiveKey = null.StringFrom(j.GroupID())
+	}
+	return m
+}
+
+func (r *CollageJobRepositorySQLBoiler) Enqueue(ctx context.Context, j *collage_job.CollageJob) error {
+	model := toCollageJobModel(j)
+	err := model.Insert(ctx, r.db, boil.Infer())
+	if err != nil {
+		if db.IsDuplicateError(err) {
+			return collage_job.ErrJobAlreadyQueued
+		}
+		return err
+	}
+	return nil
+}
+
+// Claim 実行できるジョブを SELECT ... FOR UPDATE SKIP LOCKED で取得してリースを取る
+// 他のインスタンスがロック中の行は飛ばすので、同じジョブを複数のインスタンスが同時に実行することはない
+func (r *CollageJobRepositorySQLBoiler) Claim(ctx context.Context, workerID string, lease time.Duration, limit int) ([]*collage_job.CollageJob, error) {
+	if limit <= 0 {
+		return nil, nil
+	}
+
+	var claimed []*collage_job.CollageJob
+	err := db.WithTx(ctx, r.db, func(tx *sql.Tx) error {
+		now := time.Now()
+		modelSlice, err := models.CollageJobs(
+			qm.Where("(status = ? AND run_at <= ?) OR (status = ? AND locked_until < ?)",
+				string(collage_job.StatusPending), now, string(collage_job.StatusRunning), now),
+			qm.OrderBy("run_at ASC"),
+			qm.Limit(limit),
+			qm.For("UPDATE SKIP LOCKED"),
+		).All(ctx, tx)
+		if err != nil {
+			return err
+		}
+
+		for _, model := range modelSlice {
+			// 実行中にワーカーが落ちてリースが切れたジョブが、既に最大実行回数に達している場合
+			if model.Status == string(collage_job.StatusRunning) && model.Attempts >= model.MaxAttempts {
+				model.Status = string(collage_job.StatusDead)
+				model.ActiveKey = null.String{}
+				model.LockedBy = null.String{}
+				model.LockedUntil = null.Time{}
+				model.LastError = null.StringFrom("lease expired on final attempt")
+				if _, err := model.Update(ctx, tx, boil.Whitelist(
+					models.CollageJobColumns.Status,
+					models.CollageJobColumns.ActiveKey,
+					models.CollageJobColumns.LockedBy,
+					models.CollageJobColumns.LockedUntil,
+					models.CollageJobColumns.LastError,
+				)); err != nil {
+					return err
+				}
+				continue
+			}
+
+			model.Status = string(collage_job.StatusRunning)
+			model.Attempts++
+			model.LockedBy = null.StringFrom(workerID)
+			model.LockedUntil = null.TimeFrom(now.Add(lease))
+			if _, err := model.Update(ctx, tx, boil.Whitelist(
+				models.CollageJobColumns.Status,
+				models.CollageJobColumns.Attempts,
+				models.CollageJobColumns.LockedBy,
+				models.CollageJobColumns.LockedUntil,
+			)); err != nil {
+				return err
+			}
+
+			j, err := toCollageJobEntity(model)
+			if err != nil {
+				return err
+			}
+			claimed = append(claimed, j)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return claimed, nil
+}
+
+func (r *CollageJobRepositorySQLBoiler) Release(ctx context.Context, j *collage_job.CollageJob, workerID string) error {
+	activeKey := null.String{}
+	if !j.IsFinished() {
+		activeKey = null.StringFrom(j.GroupID())
+	}
+
+	// リースを持っている場合だけ更新する（期限切れで他のワーカーに取られていたら何もしない）
+	rows, err := models.CollageJobs(
+		qm.Where("job_id = ? AND status = ? AND locked_by = ?",
+			j.JobID().String(), string(collage_job.StatusRunning), workerID),
+	).UpdateAll(ctx, r.db, models.M{
+		models.CollageJobColumns.Status:      string(j.Status()),
+		models.CollageJobColumns.Attempts:    j.Attempts(),
+		models.CollageJobColumns.ActiveKey:   activeKey,
+		models.CollageJobColumns.RunAt:       j.RunAt(),
+		models.CollageJobColumns.LockedBy:    null.String{},
+		models.CollageJobColumns.LockedUntil: null.Time{},
+		models.CollageJobColumns.LastError:   null.StringFromPtr(j.LastError()),
+	})
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return collage_job.ErrLeaseLost
+	}
+	return nil
+}
+
+func (r *CollageJobRepositorySQLBoiler) FindByGroupID(ctx context.Context, groupID string) ([]*collage_job.CollageJob, error) {
+	modelSlice, err := models.CollageJobs(
+		qm.Where("group_id = ?", groupID),
+		qm.OrderBy("created_at DESC"),
+	).All(ctx, r.db)
+	if err != nil {
+		return nil, err
+	}
+
+	jobs := make([]*collage_job.CollageJob, len(modelSlice))
+	for i, model := range modelSlice {
+		j, err := toCollageJobEntity(model)
+		if err != nil {
+			return nil, err
+		}
+		jobs[i] = j
+	}
+	return jobs, nil
+}
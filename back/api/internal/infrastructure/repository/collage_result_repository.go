@@ -3,11 +3,15 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"errors"
+	"time"
 
 	"github.com/aarondl/sqlboiler/v4/boil"
 	"github.com/aarondl/sqlboiler/v4/queries/qm"
 	"github.com/google/uuid"
 	"github.com/jphacks/os_2502/back/api/internal/domain/collage_result"
+	"github.com/jphacks/os_2502/back/api/internal/domain/group"
+	"github.com/jphacks/os_2502/back/api/internal/domain/upload_images_collage_result"
 	"github.com/jphacks/os_2502/back/api/internal/infrastructure/db"
 	"github.com/jphacks/os_2502/back/api/internal/infrastructure/models"
 )
@@ -36,6 +40,7 @@ func toCollageResultEntity(m *models.CollageResult) (*collage_result.CollageResu
 		resultID,
 		templateID,
 		m.GroupID,
+		m.RoundNumber,
 		m.FileURL,
 		m.TargetUserNumber,
 		m.IsNotification,
@@ -49,6 +54,7 @@ func toCollageResultModel(cr *collage_result.CollageResult) *models.CollageResul
 		ResultID:         cr.ResultID().String(),
 		TemplateID:       cr.TemplateID().String(),
 		GroupID:          cr.GroupID(),
+		RoundNumber:      cr.Round(),
 		FileURL:          cr.FileURL(),
 		TargetUserNumber: cr.TargetUserNumber(),
 		IsNotification:   cr.IsNotification(),
@@ -68,6 +74,86 @@ func (r *CollageResultRepositorySQLBoiler) Create(ctx context.Context, cr *colla
 	return nil
 }
 
+// CreateWithPlacements コラージュ結果と、配置した写真ごとの upload_images_collage_results を同じトランザクションで作成する
+func (r *CollageResultRepositorySQLBoiler) CreateWithPlacements(ctx context.Context, cr *collage_result.CollageResult, placements []collage_result.Placement) error {
+	relations, err := toPlacementModels(cr, placements)
+	if err != nil {
+		return err
+	}
+	return db.WithTx(ctx, r.db, func(tx *sql.Tx) error {
+		return insertWithPlacements(ctx, tx, cr, relations)
+	})
+}
+
+// errSessionLost グループが結果のラウンドの撮影中ではなくなっていた（トランザクションを巻き戻す）
+var errSessionLost = errors.New("group is no longer taking photos for the round")
+
+// CreateForCompletedSession グループを撮影中から完了にし、同じトランザクションでコラージュ結果を作成する
+// 先にグループの行を更新するので、同じラウンドを同時に完了しようとしても結果を作成するのは1つだけ
+func (r *CollageResultRepositorySQLBoiler) CreateForCompletedSession(ctx context.Context, cr *collage_result.CollageResult, placements []collage_result.Placement, completedAt time.Time) (bool, error) {
+	relations, err := toPlacementModels(cr, placements)
+	if err != nil {
+		return false, err
+	}
+	err = db.WithTx(ctx, r.db, func(tx *sql.Tx) error {
+		affected, err := models.Groups(
+			qm.Where("id = ? AND status = ? AND current_round = ?",
+				cr.GroupID(), string(group.GroupStatusPhotoTaking), cr.Round()),
+		).UpdateAll(ctx, tx, models.M{
+			models.GroupColumns.Status:    string(group.GroupStatusCompleted),
+			models.GroupColumns.UpdatedAt: completedAt,
+		})
+		if err != nil {
+			return err
+		}
+		if affected == 0 {
+			return errSessionLost
+		}
+		return insertWithPlacements(ctx, tx, cr, relations)
+	})
+	if err == errSessionLost {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// toPlacementModels 配置した写真を upload_images_collage_results の行にする
+func toPlacementModels(cr *collage_result.CollageResult, placements []collage_result.Placement) ([]*models.UploadImagesCollageResult, error) {
+	relations := make([]*models.UploadImagesCollageResult, len(placements))
+	for i, p := range placements {
+		relation, err := upload_images_collage_result.NewUploadImagesCollageResult(
+			p.ImageID, cr.ResultID(), p.PositionX, p.PositionY, p.Width, p.Height, p.SortOrder,
+		)
+		if err != nil {
+			return nil, err
+		}
+		relations[i] = toUploadImagesCollageResultModel(relation)
+	}
+	return relations, nil
+}
+
+// insertWithPlacements トランザクション内でコラージュ結果と配置した写真を作成する
+func insertWithPlacements(ctx context.Context, tx *sql.Tx, cr *collage_result.CollageResult, relations []*models.UploadImagesCollageResult) error {
+	if err := toCollageResultModel(cr).Insert(ctx, tx, boil.Infer()); err != nil {
+		if db.IsDuplicateError(err) {
+			return collage_result.ErrResultAlreadyExists
+		}
+		return err
+	}
+	for _, m := range relations {
+		if err := m.Insert(ctx, tx, boil.Infer()); err != nil {
+			if db.IsDuplicateError(err) {
+				return upload_images_collage_result.ErrUploadImagesCollageResultAlreadyExists
+			}
+			return err
+		}
+	}
+	return nil
+}
+
 func (r *CollageResultRepositorySQLBoiler) FindByID(ctx context.Context, resultID uuid.UUID) (*collage_result.CollageResult, error) {
 	model, err := models.FindCollageResult(ctx, r.db, resultID.String())
 	if err != nil {
@@ -101,6 +187,20 @@ func (r *CollageResultRepositorySQLBoiler) FindByGroupID(ctx context.Context, gr
 	return results, nil
 }
 
+func (r *CollageResultRepositorySQLBoiler) FindLatestByGroupRound(ctx context.Context, groupID string, round int) (*collage_result.CollageResult, error) {
+	model, err := models.CollageResults(
+		qm.Where("group_id = ? AND round_number = ?", groupID, round),
+		qm.OrderBy("created_at DESC"),
+	).One(ctx, r.db)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, collage_result.ErrResultNotFound
+		}
+		return nil, err
+	}
+	return toCollageResultEntity(model)
+}
+
 func (r *CollageResultRepositorySQLBoiler) FindUnnotified(ctx context.Context, limit int) ([]*collage_result.CollageResult, error) {
 	modelSlice, err := models.CollageResults(
 		qm.Where("is_notification = ?", false),
@@ -4,10 +4,13 @@ import (
 	"context"
 	"database/sql"
 
+	"github.com/aarondl/null/v8"
 	"github.com/aarondl/sqlboiler/v4/boil"
 	"github.com/aarondl/sqlboiler/v4/queries/qm"
 	"github.com/google/uuid"
 	"github.com/jphacks/os_2502/back/api/internal/domain/collage_template"
+	"github.com/jphacks/os_2502/back/api/internal/domain/friend"
+	"github.com/jphacks/os_2502/back/api/internal/domain/template_part"
 	"github.com/jphacks/os_2502/back/api/internal/infrastructure/db"
 	"github.com/jphacks/os_2502/back/api/internal/infrastructure/models"
 )
@@ -27,10 +30,41 @@ func toCollageTemplateEntity(m *models.CollagesTemplate) (*collage_template.Coll
 		return nil, err
 	}
 
+	var sourceName *string
+	if m.SourceName.Valid {
+		sourceName = &m.SourceName.String
+	}
+
+	var ownerUserID *uuid.UUID
+	if m.OwnerUserID.Valid {
+		id, err := uuid.Parse(m.OwnerUserID.String)
+		if err != nil {
+			return nil, err
+		}
+		ownerUserID = &id
+	}
+
+	var forkedFrom *uuid.UUID
+	if m.ForkedFrom.Valid {
+		id, err := uuid.Parse(m.ForkedFrom.String)
+		if err != nil {
+			return nil, err
+		}
+		forkedFrom = &id
+	}
+
 	return collage_template.Reconstruct(
 		templateID,
 		m.Name,
-		m.FilePath,
+		m.FilePath.String,
+		sourceName,
+		m.PhotoCount,
+		m.ViewBox,
+		m.Width,
+		m.Height,
+		ownerUserID,
+		collage_template.Visibility(m.Visibility),
+		forkedFrom,
 		m.CreatedAt,
 		m.UpdatedAt,
 	)
@@ -38,13 +72,29 @@ func toCollageTemplateEntity(m *models.CollagesTemplate) (*collage_template.Coll
 
 // Entity to Model conversion
 func toCollageTemplateModel(ct *collage_template.CollageTemplate) *models.CollagesTemplate {
-	return &models.CollagesTemplate{
+	model := &models.CollagesTemplate{
 		TemplateID: ct.TemplateID().String(),
 		Name:       ct.Name(),
-		FilePath:   ct.FilePath(),
+		FilePath:   null.NewString(ct.FilePath(), ct.FilePath() != ""),
+		SourceName: null.StringFromPtr(ct.SourceName()),
+		PhotoCount: ct.PhotoCount(),
+		ViewBox:    ct.ViewBox(),
+		Width:      ct.Width(),
+		Height:     ct.Height(),
+		Visibility: string(ct.Visibility()),
 		CreatedAt:  ct.CreatedAt(),
 		UpdatedAt:  ct.UpdatedAt(),
 	}
+
+	if ownerUserID := ct.OwnerUserID(); ownerUserID != nil {
+		model.OwnerUserID = null.StringFrom(ownerUserID.String())
+	}
+
+	if forkedFrom := ct.ForkedFrom(); forkedFrom != nil {
+		model.ForkedFrom = null.StringFrom(forkedFrom.String())
+	}
+
+	return model
 }
 
 func (r *CollageTemplateRepositorySQLBoiler) Create(ctx context.Context, ct *collage_template.CollageTemplate) error {
@@ -83,8 +133,160 @@ func (r *CollageTemplateRepositorySQLBoiler) FindByName(ctx context.Context, nam
 	return toCollageTemplateEntity(model)
 }
 
+func (r *CollageTemplateRepositorySQLBoiler) FindBySourceName(ctx context.Context, sourceName string) (*collage_template.CollageTemplate, error) {
+	model, err := models.CollagesTemplates(
+		qm.Where("source_name = ?", sourceName),
+	).One(ctx, r.db)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, collage_template.ErrTemplateNotFound
+		}
+		return nil, err
+	}
+	return toCollageTemplateEntity(model)
+}
+
+func (r *CollageTemplateRepositorySQLBoiler) ListBuiltin(ctx context.Context) ([]*collage_template.CollageTemplate, error) {
+	modelSlice, err := models.CollagesTemplates(
+		qm.Where("source_name IS NOT NULL"),
+		qm.OrderBy("photo_count, source_name"),
+	).All(ctx, r.db)
+	if err != nil {
+		return nil, err
+	}
+
+	templates := make([]*collage_template.CollageTemplate, len(modelSlice))
+	for i, model := range modelSlice {
+		ct, err := toCollageTemplateEntity(model)
+		if err != nil {
+			return nil, err
+		}
+		templates[i] = ct
+	}
+	return templates, nil
+}
+
+func (r *CollageTemplateRepositorySQLBoiler) ListByOwner(ctx context.Context, ownerUserID uuid.UUID, limit, offset int) ([]*collage_template.CollageTemplate, error) {
+	return r.list(ctx,
+		qm.Where("owner_user_id = ?", ownerUserID.String()),
+		qm.OrderBy("created_at DESC"),
+		qm.Limit(limit),
+		qm.Offset(offset),
+	)
+}
+
+func (r *CollageTemplateRepositorySQLBoiler) ListSharedByFriends(ctx context.Context, userID uuid.UUID, limit, offset int) ([]*collage_template.CollageTemplate, error) {
+	id := userID.String()
+	return r.list(ctx,
+		qm.Where(`owner_user_id IN (
+			SELECT CASE WHEN requester_id = ? THEN addressee_id ELSE requester_id END
+			FROM friends
+			WHERE (requester_id = ? OR addressee_id = ?) AND status = ?
+		)`, id, id, id, string(friend.FriendStatusAccepted)),
+		qm.WhereIn("visibility IN ?", string(collage_template.VisibilityFriends), string(collage_template.VisibilityPublic)),
+		qm.OrderBy("updated_at DESC"),
+		qm.Limit(limit),
+		qm.Offset(offset),
+	)
+}
+
+func (r *CollageTemplateRepositorySQLBoiler) list(ctx context.Context, mods ...qm.QueryMod) ([]*collage_template.CollageTemplate, error) {
+	modelSlice, err := models.CollagesTemplates(mods...).All(ctx, r.db)
+	if err != nil {
+		return nil, err
+	}
+
+	templates := make([]*collage_template.CollageTemplate, len(modelSlice))
+	for i, model := range modelSlice {
+		ct, err := toCollageTemplateEntity(model)
+		if err != nil {
+			return nil, err
+		}
+		templates[i] = ct
+	}
+	return templates, nil
+}
+
+func (r *CollageTemplateRepositorySQLBoiler) SaveWithParts(ctx context.Context, ct *collage_template.CollageTemplate, parts []*template_part.TemplatePart) error {
+	return db.WithTx(ctx, r.db, func(tx *sql.Tx) error {
+		model := toCollageTemplateModel(ct)
+		exists, err := models.CollagesTemplateExists(ctx, tx, model.TemplateID)
+		if err != nil {
+			return err
+		}
+		if exists {
+			_, err = model.Update(ctx, tx, boil.Whitelist(
+				models.CollagesTemplateColumns.Name,
+				models.CollagesTemplateColumns.FilePath,
+				models.CollagesTemplateColumns.SourceName,
+				models.CollagesTemplateColumns.PhotoCount,
+				models.CollagesTemplateColumns.ViewBox,
+				models.CollagesTemplateColumns.Width,
+				models.CollagesTemplateColumns.Height,
+				models.CollagesTemplateColumns.Visibility,
+				models.CollagesTemplateColumns.UpdatedAt,
+			))
+		} else {
+			err = model.Insert(ctx, tx, boil.Infer())
+		}
+		if err != nil {
+			if db.IsDuplicateError(err) {
+				return collage_template.ErrTemplateAlreadyExists
+			}
+			return err
+		}
+
+		existing, err := models.TemplateParts(
+			qm.Where("template_id = ?", model.TemplateID),
+		).All(ctx, tx)
+		if err != nil {
+			return err
+		}
+		byNumber := make(map[int]*models.TemplatePart, len(existing))
+		for _, p := range existing {
+			byNumber[p.PartNumber] = p
+		}
+
+		// 割り当てや写真がパーツIDを参照しているので、同じ番号のパーツは行を残して更新する
+		numbers := make([]interface{}, 0, len(parts))
+		for _, tp := range parts {
+			numbers = append(numbers, tp.PartNumber())
+			partModel := toTemplatePartModel(tp)
+			old, ok := byNumber[tp.PartNumber()]
+			if !ok {
+				if err := partModel.Insert(ctx, tx, boil.Infer()); err != nil {
+					return err
+				}
+				continue
+			}
+			partModel.PartID = old.PartID
+			if _, err := partModel.Update(ctx, tx, boil.Whitelist(
+				models.TemplatePartColumns.PartName,
+				models.TemplatePartColumns.PositionX,
+				models.TemplatePartColumns.PositionY,
+				models.TemplatePartColumns.Width,
+				models.TemplatePartColumns.Height,
+				models.TemplatePartColumns.Path,
+				models.TemplatePartColumns.Description,
+				models.TemplatePartColumns.UpdatedAt,
+			)); err != nil {
+				return err
+			}
+		}
+
+		mods := []qm.QueryMod{qm.Where("template_id = ?", model.TemplateID)}
+		if len(numbers) > 0 {
+			mods = append(mods, qm.WhereNotIn("part_number NOT IN ?", numbers...))
+		}
+		_, err = models.TemplateParts(mods...).DeleteAll(ctx, tx)
+		return err
+	})
+}
+
+// List 公開範囲が public のテンプレートだけを返す（ユーザーが作成した非公開のテンプレートは含めない）
 func (r *CollageTemplateRepositorySQLBoiler) List(ctx context.Context, limit, offset int) ([]*collage_template.CollageTemplate, error) {
 	modelSlice, err := models.CollagesTemplates(
+		qm.Where("visibility = ?", string(collage_template.VisibilityPublic)),
 		qm.OrderBy("created_at DESC"),
 		qm.Limit(limit),
 		qm.Offset(offset),
@@ -114,12 +316,22 @@ func (r *CollageTemplateRepositorySQLBoiler) Update(ctx context.Context, ct *col
 	}
 
 	model.Name = ct.Name()
-	model.FilePath = ct.FilePath()
+	model.FilePath = null.NewString(ct.FilePath(), ct.FilePath() != "")
+	model.PhotoCount = ct.PhotoCount()
+	model.ViewBox = ct.ViewBox()
+	model.Width = ct.Width()
+	model.Height = ct.Height()
+	model.Visibility = string(ct.Visibility())
 	model.UpdatedAt = ct.UpdatedAt()
 
 	_, err = model.Update(ctx, r.db, boil.Whitelist(
 		models.CollagesTemplateColumns.Name,
 		models.CollagesTemplateColumns.FilePath,
+		models.CollagesTemplateColumns.PhotoCount,
+		models.CollagesTemplateColumns.ViewBox,
+		models.CollagesTemplateColumns.Width,
+		models.CollagesTemplateColumns.Height,
+		models.CollagesTemplateColumns.Visibility,
 		models.CollagesTemplateColumns.UpdatedAt,
 	))
 	return err
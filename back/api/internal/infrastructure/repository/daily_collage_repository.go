@@ -0,0 +1,204 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/google/uuid"
+	"github.com/jphacks/os_2502/back/api/internal/domain/daily_collage"
+	"github.com/jphacks/os_2502/back/api/internal/infrastructure/db"
+	"github.com/jphacks/os_2502/back/api/internal/infrastructure/models"
+)
+
+type DailyCollageRepositorySQLBoiler struct {
+	db *sql.DB
+}
+
+func NewDailyCollageRepositorySQLBoiler(db *sql.DB) daily_collage.Repository {
+	return &DailyCollageRepositorySQLBoiler{db: db}
+}
+
+// Model to Entity conversion
+func toDailyCollageEntity(m *models.DailyCollage) (*daily_collage.DailyCollage, error) {
+	dailyID, err := uuid.Parse(m.DailyID)
+	if err != nil {
+		return nil, err
+	}
+
+	templateID, err := uuid.Parse(m.TemplateID)
+	if err != nil {
+		return nil, err
+	}
+
+	var resultID *uuid.UUID
+	if m.ResultID.Valid {
+		id, err := uuid.Parse(m.ResultID.String)
+		if err != nil {
+			return nil, err
+		}
+		resultID = &id
+	}
+
+	return daily_collage.Reconstruct(
+		dailyID,
+		m.GroupID,
+		m.CollageDay,
+		templateID,
+		m.CaptureAt,
+		m.ClosesAt,
+		m.NotifiedAt.Ptr(),
+		daily_collage.Status(m.Status),
+		resultID,
+		m.LastError.Ptr(),
+		m.CreatedAt,
+		m.UpdatedAt,
+	)
+}
+
+// Entity to Model conversion
+func toDailyCollageModel(d *daily_collage.DailyCollage) *models.DailyCollage {
+	m := &models.DailyCollage{
+		DailyID:    d.DailyID().String(),
+		GroupID:    d.GroupID(),
+		CollageDay: d.CollageDay(),
+		TemplateID: d.TemplateID().String(),
+		CaptureAt:  d.CaptureAt(),
+		ClosesAt:   d.ClosesAt(),
+		NotifiedAt: null.TimeFromPtr(d.NotifiedAt()),
+		Status:     string(d.Status()),
+		LastError:  null.StringFromPtr(d.LastError()),
+		CreatedAt:  d.CreatedAt(),
+		UpdatedAt:  d.UpdatedAt(),
+	}
+	if resultID := d.ResultID(); resultID != nil {
+		m.ResultID = null.StringFrom(resultID.String())
+	}
+	return m
+}
+
+func (r *DailyCollageRepositorySQLBoiler) Create(ctx context.Context, d *daily_collage.DailyCollage) error {
+	model := toDailyCollageModel(d)
+	err := model.Insert(ctx, r.db, boil.Infer())
+	if err != nil {
+		if db.IsDuplicateError(err) {
+			return daily_collage.ErrAlreadyScheduled
+		}
+		return err
+	}
+	return nil
+}
+
+func (r *DailyCollageRepositorySQLBoiler) FindByGroupAndDay(ctx context.Context, groupID string, collageDay time.Time) (*daily_collage.DailyCollage, error) {
+	model, err := models.DailyCollages(
+		qm.Where("group_id = ? AND collage_day = ?", groupID, collageDay.Format("2006-01-02")),
+	).One(ctx, r.db)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, daily_collage.ErrDailyCollageNotFound
+		}
+		return nil, err
+	}
+	return toDailyCollageEntity(model)
+}
+
+func (r *DailyCollageRepositorySQLBoiler) FindDueForNotification(ctx context.Context, now time.Time, limit int) ([]*daily_collage.DailyCollage, error) {
+	modelSlice, err := models.DailyCollages(
+		qm.Where("notified_at IS NULL AND status = ? AND capture_at <= ? AND closes_at > ?",
+			string(daily_collage.StatusScheduled), now, now),
+		qm.OrderBy("capture_at ASC"),
+		qm.Limit(limit),
+	).All(ctx, r.db)
+	if err != nil {
+		return nil, err
+	}
+	return toDailyCollageEntities(modelSlice)
+}
+
+func (r *DailyCollageRepositorySQLBoiler) MarkNotified(ctx context.Context, dailyID uuid.UUID, now time.Time) (bool, error) {
+	// 複数のインスタンスが同じ予定を見つけても、通知するのは先に更新した1つだけ
+	affected, err := models.DailyCollages(
+		qm.Where("daily_id = ? AND notified_at IS NULL", dailyID.String()),
+	).UpdateAll(ctx, r.db, models.M{
+		models.DailyCollageColumns.NotifiedAt: now,
+		models.DailyCollageColumns.UpdatedAt:  now,
+	})
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
+
+func (r *DailyCollageRepositorySQLBoiler) ClaimClosed(ctx context.Context, now, staleBefore time.Time, limit int) ([]*daily_collage.DailyCollage, error) {
+	var claimed []*models.DailyCollage
+	err := db.WithTx(ctx, r.db, func(tx *sql.Tx) error {
+		modelSlice, err := models.DailyCollages(
+			qm.Where("(status = ? AND closes_at <= ?) OR (status = ? AND updated_at < ?)",
+				string(daily_collage.StatusScheduled), now, string(daily_collage.StatusRendering), staleBefore),
+			qm.OrderBy("closes_at ASC"),
+			qm.Limit(limit),
+			qm.For("UPDATE SKIP LOCKED"),
+		).All(ctx, tx)
+		if err != nil {
+			return err
+		}
+		if len(modelSlice) == 0 {
+			return nil
+		}
+
+		args := make([]interface{}, len(modelSlice))
+		for i, m := range modelSlice {
+			args[i] = m.DailyID
+			m.Status = string(daily_collage.StatusRendering)
+			m.UpdatedAt = now
+		}
+		_, err = models.DailyCollages(qm.WhereIn("daily_id IN ?", args...)).UpdateAll(ctx, tx, models.M{
+			models.DailyCollageColumns.Status:    string(daily_collage.StatusRendering),
+			models.DailyCollageColumns.UpdatedAt: now,
+		})
+		if err != nil {
+			return err
+		}
+		claimed = modelSlice
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toDailyCollageEntities(claimed)
+}
+
+func (r *DailyCollageRepositorySQLBoiler) Update(ctx context.Context, d *daily_collage.DailyCollage) error {
+	model, err := models.FindDailyCollage(ctx, r.db, d.DailyID().String())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return daily_collage.ErrDailyCollageNotFound
+		}
+		return err
+	}
+
+	updated := toDailyCollageModel(d)
+	model.NotifiedAt = updated.NotifiedAt
+	model.Status = updated.Status
+	model.ResultID = updated.ResultID
+	model.LastError = updated.LastError
+	model.UpdatedAt = updated.UpdatedAt
+
+	_, err = model.Update(ctx, r.db, boil.Infer())
+	return err
+}
+
+func toDailyCollageEntities(modelSlice []*models.DailyCollage) ([]*daily_collage.DailyCollage, error) {
+	dailies := make([]*daily_collage.DailyCollage, len(modelSlice))
+	for i, m := range modelSlice {
+		d, err := toDailyCollageEntity(m)
+		if err != nil {
+			return nil, err
+		}
+		dailies[i] = d
+	}
+	return dailies, nil
+}
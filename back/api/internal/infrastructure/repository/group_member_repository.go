@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"time"
 
+	"github.com/aarondl/null/v8"
 	"github.com/aarondl/sqlboiler/v4/boil"
 	"github.com/aarondl/sqlboiler/v4/queries/qm"
 	"github.com/jphacks/os_2502/back/api/internal/domain/group_member"
@@ -28,6 +29,16 @@ func toGroupMemberEntity(m *models.GroupMember) (*group_member.GroupMember, erro
 		readyAt = &t
 	}
 
+	var clockOffset, clockRTT *time.Duration
+	if m.ClockOffsetMS.Valid {
+		d := time.Duration(m.ClockOffsetMS.Int) * time.Millisecond
+		clockOffset = &d
+	}
+	if m.ClockRTTMS.Valid {
+		d := time.Duration(m.ClockRTTMS.Int) * time.Millisecond
+		clockRTT = &d
+	}
+
 	return group_member.Reconstruct(
 		m.ID,
 		m.GroupID,
@@ -35,6 +46,9 @@ func toGroupMemberEntity(m *models.GroupMember) (*group_member.GroupMember, erro
 		m.IsOwner,
 		m.ReadyStatus,
 		readyAt,
+		clockOffset,
+		clockRTT,
+		m.ClockSyncedAt.Ptr(),
 		m.JoinedAt,
 		m.UpdatedAt,
 	)
@@ -57,9 +71,24 @@ func toGroupMemberModel(gm *group_member.GroupMember) *models.GroupMember {
 		model.ReadyAt.Time = *readyAt
 	}
 
+	setClockSync(model, gm)
+
 	return model
 }
 
+// setClockSync 時刻同期の結果をミリ秒単位でモデルに反映
+func setClockSync(model *models.GroupMember, gm *group_member.GroupMember) {
+	model.ClockOffsetMS = null.Int{}
+	model.ClockRTTMS = null.Int{}
+	if offset := gm.ClockOffset(); offset != nil {
+		model.ClockOffsetMS = null.IntFrom(int(offset.Milliseconds()))
+	}
+	if rtt := gm.ClockRTT(); rtt != nil {
+		model.ClockRTTMS = null.IntFrom(int(rtt.Milliseconds()))
+	}
+	model.ClockSyncedAt = null.TimeFromPtr(gm.ClockSyncedAt())
+}
+
 func (r *GroupMemberRepositorySQLBoiler) Create(ctx context.Context, member *group_member.GroupMember) error {
 	model := toGroupMemberModel(member)
 	err := model.Insert(ctx, r.db, boil.Infer())
@@ -139,6 +168,8 @@ func (r *GroupMemberRepositorySQLBoiler) Update(ctx context.Context, member *gro
 		model.ReadyAt.Valid = false
 	}
 
+	setClockSync(model, member)
+
 	_, err = model.Update(ctx, r.db, boil.Infer())
 	return err
 }
@@ -193,6 +224,17 @@ func (r *GroupMemberRepositorySQLBoiler) CountReadyByGroupID(ctx context.Context
 	return int(count), nil
 }
 
+func (r *GroupMemberRepositorySQLBoiler) ResetReadyByGroupID(ctx context.Context, groupID string) error {
+	_, err := models.GroupMembers(
+		qm.Where("group_id = ? AND ready_status = ?", groupID, true),
+	).UpdateAll(ctx, r.db, models.M{
+		models.GroupMemberColumns.ReadyStatus: false,
+		models.GroupMemberColumns.ReadyAt:     nil,
+		models.GroupMemberColumns.UpdatedAt:   time.Now(),
+	})
+	return err
+}
+
 func (r *GroupMemberRepositorySQLBoiler) IsOwner(ctx context.Context, groupID, userID string) (bool, error) {
 	count, err := models.GroupMembers(
 		qm.Where("group_id = ? AND user_id = ? AND is_owner = ?", groupID, userID, true),
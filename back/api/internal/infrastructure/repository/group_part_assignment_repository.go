@@ -9,6 +9,7 @@ import (
 	"github.com/aarondl/sqlboiler/v4/queries/qm"
 	"github.com/google/uuid"
 	"github.com/jphacks/os_2502/back/api/internal/domain/group_part_assignment"
+	"github.com/jphacks/os_2502/back/api/internal/infrastructure/db"
 	"github.com/jphacks/os_2502/back/api/internal/infrastructure/models"
 )
 
@@ -61,7 +62,50 @@ func toGroupPartAssignmentModel(gpa *group_part_assignment.GroupPartAssignment)
 
 func (r *GroupPartAssignmentRepository) Create(ctx context.Context, assignment *group_part_assignment.GroupPartAssignment) error {
 	model := toGroupPartAssignmentModel(assignment)
-	return model.Insert(ctx, r.db, boil.Infer())
+	if err := model.Insert(ctx, r.db, boil.Infer()); err != nil {
+		if db.IsDuplicateError(err) {
+			return group_part_assignment.ErrDuplicatePartAssignment
+		}
+		return err
+	}
+	return nil
+}
+
+func (r *GroupPartAssignmentRepository) CreateBatch(ctx context.Context, assignments []*group_part_assignment.GroupPartAssignment) error {
+	err := db.WithTx(ctx, r.db, func(tx *sql.Tx) error {
+		for _, assignment := range assignments {
+			model := toGroupPartAssignmentModel(assignment)
+			if err := model.Insert(ctx, tx, boil.Infer()); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil && db.IsDuplicateError(err) {
+		return group_part_assignment.ErrDuplicatePartAssignment
+	}
+	return err
+}
+
+func (r *GroupPartAssignmentRepository) FindHistory(ctx context.Context, groupID string, before time.Time, limit int) ([]*group_part_assignment.GroupPartAssignment, error) {
+	modelSlice, err := models.GroupPartAssignments(
+		qm.Where("group_id = ? AND collage_day < DATE(?)", groupID, before),
+		qm.OrderBy("collage_day DESC"),
+		qm.Limit(limit),
+	).All(ctx, r.db)
+	if err != nil {
+		return nil, err
+	}
+
+	assignments := make([]*group_part_assignment.GroupPartAssignment, len(modelSlice))
+	for i, model := range modelSlice {
+		gpa, err := toGroupPartAssignmentEntity(model)
+		if err != nil {
+			return nil, err
+		}
+		assignments[i] = gpa
+	}
+	return assignments, nil
 }
 
 func (r *GroupPartAssignmentRepository) FindByID(ctx context.Context, assignmentID uuid.UUID) (*group_part_assignment.GroupPartAssignment, error) {
@@ -171,9 +215,11 @@ func (r *GroupPartAssignmentRepository) DeleteByGroupAndDay(ctx context.Context,
 	return err
 }
 
-func (r *GroupPartAssignmentRepository) List(ctx context.Context, limit, offset int) ([]*group_part_assignment.GroupPartAssignment, error) {
+func (r *GroupPartAssignmentRepository) FindByMemberUserID(ctx context.Context, userID string, limit, offset int) ([]*group_part_assignment.GroupPartAssignment, error) {
 	modelSlice, err := models.GroupPartAssignments(
-		qm.OrderBy("assigned_at DESC"),
+		qm.InnerJoin("group_members gm ON gm.group_id = group_part_assignments.group_id"),
+		qm.Where("gm.user_id = ?", userID),
+		qm.OrderBy("group_part_assignments.assigned_at DESC"),
 		qm.Limit(limit),
 		qm.Offset(offset),
 	).All(ctx, r.db)
@@ -3,12 +3,12 @@ package repository
 import (
 	"context"
 	"database/sql"
-	"errors"
 	"time"
 
 	"github.com/aarondl/sqlboiler/v4/boil"
 	"github.com/aarondl/sqlboiler/v4/queries/qm"
 	"github.com/jphacks/os_2502/back/api/internal/domain/group"
+	"github.com/jphacks/os_2502/back/api/internal/domain/session_round"
 	"github.com/jphacks/os_2502/back/api/internal/infrastructure/db"
 	"github.com/jphacks/os_2502/back/api/internal/infrastructure/models"
 )
@@ -32,6 +32,9 @@ func toGroupModel(g *group.Group) *models.Group {
 		MaxMember:          g.MaxMember(),
 		CurrentMemberCount: g.CurrentMemberCount(),
 		InvitationToken:    g.InvitationToken(),
+		CountdownSeconds:   g.CountdownSeconds(),
+		AutoStart:          g.AutoStart(),
+		CurrentRound:       g.CurrentRound(),
 		CreatedAt:          g.CreatedAt(),
 		UpdatedAt:          g.UpdatedAt(),
 	}
@@ -51,6 +54,11 @@ func toGroupModel(g *group.Group) *models.Group {
 		model.ScheduledCaptureTime.Time = *scheduledCaptureTime
 	}
 
+	if captureDeadline := g.CaptureDeadline(); captureDeadline != nil {
+		model.CaptureDeadline.Valid = true
+		model.CaptureDeadline.Time = *captureDeadline
+	}
+
 	if templateID := g.TemplateID(); templateID != nil {
 		model.TemplateID.Valid = true
 		model.TemplateID.String = *templateID
@@ -84,6 +92,12 @@ func toGroupEntity(m *models.Group) (*group.Group, error) {
 		scheduledCaptureTime = &t
 	}
 
+	var captureDeadline *time.Time
+	if m.CaptureDeadline.Valid {
+		t := m.CaptureDeadline.Time
+		captureDeadline = &t
+	}
+
 	var templateID *string
 	if m.TemplateID.Valid {
 		templateID = &m.TemplateID.String
@@ -107,8 +121,12 @@ func toGroupEntity(m *models.Group) (*group.Group, error) {
 		finalizedAt,
 		countdownStartedAt,
 		scheduledCaptureTime,
+		captureDeadline,
 		templateID,
 		expiresAt,
+		m.CountdownSeconds,
+		m.AutoStart,
+		m.CurrentRound,
 		m.CreatedAt,
 		m.UpdatedAt,
 	)
@@ -195,6 +213,30 @@ func (r *GroupRepositorySQLBoiler) List(ctx context.Context, limit, offset int)
 	return groups, nil
 }
 
+func (r *GroupRepositorySQLBoiler) FindByMemberUserID(ctx context.Context, userID string, limit, offset int) ([]*group.Group, error) {
+	modelSlice, err := models.Groups(
+		qm.InnerJoin("group_members gm ON gm.group_id = `groups`.id"),
+		qm.Where("gm.user_id = ?", userID),
+		qm.OrderBy("`groups`.created_at DESC"),
+		qm.Limit(limit),
+		qm.Offset(offset),
+	).All(ctx, r.db)
+
+	if err != nil {
+		return nil, err
+	}
+
+	groups := make([]*group.Group, len(modelSlice))
+	for i, m := range modelSlice {
+		g, err := toGroupEntity(m)
+		if err != nil {
+			return nil, err
+		}
+		groups[i] = g
+	}
+	return groups, nil
+}
+
 func (r *GroupRepositorySQLBoiler) Update(ctx context.Context, g *group.Group) error {
 	model, err := models.FindGroup(ctx, r.db, g.ID())
 	if err != nil {
@@ -209,6 +251,9 @@ func (r *GroupRepositorySQLBoiler) Update(ctx context.Context, g *group.Group) e
 	model.Status = string(g.Status())
 	model.MaxMember = g.MaxMember()
 	model.CurrentMemberCount = g.CurrentMemberCount()
+	model.CountdownSeconds = g.CountdownSeconds()
+	model.AutoStart = g.AutoStart()
+	model.CurrentRound = g.CurrentRound()
 	model.UpdatedAt = g.UpdatedAt()
 
 	if finalizedAt := g.FinalizedAt(); finalizedAt != nil {
@@ -232,6 +277,20 @@ func (r *GroupRepositorySQLBoiler) Update(ctx context.Context, g *group.Group) e
 		model.ScheduledCaptureTime.Valid = false
 	}
 
+	if captureDeadline := g.CaptureDeadline(); captureDeadline != nil {
+		model.CaptureDeadline.Valid = true
+		model.CaptureDeadline.Time = *captureDeadline
+	} else {
+		model.CaptureDeadline.Valid = false
+	}
+
+	if templateID := g.TemplateID(); templateID != nil {
+		model.TemplateID.Valid = true
+		model.TemplateID.String = *templateID
+	} else {
+		model.TemplateID.Valid = false
+	}
+
 	if expiresAt := g.ExpiresAt(); expiresAt != nil {
 		model.ExpiresAt.Valid = true
 		model.ExpiresAt.Time = *expiresAt
@@ -243,6 +302,65 @@ func (r *GroupRepositorySQLBoiler) Update(ctx context.Context, g *group.Group) e
 	return err
 }
 
+func (r *GroupRepositorySQLBoiler) UpdateIfStatus(ctx context.Context, g *group.Group, expected group.GroupStatus) (bool, error) {
+	model := toGroupModel(g)
+	// 作成時に決まる列以外を、ステータスが変わっていない場合だけ書き換える
+	affected, err := models.Groups(
+		qm.Where("id = ? AND status = ?", g.ID(), string(expected)),
+	).UpdateAll(ctx, r.db, models.M{
+		models.GroupColumns.Name:                 model.Name,
+		models.GroupColumns.GroupType:            model.GroupType,
+		models.GroupColumns.Status:               model.Status,
+		models.GroupColumns.MaxMember:            model.MaxMember,
+		models.GroupColumns.CurrentMemberCount:   model.CurrentMemberCount,
+		models.GroupColumns.FinalizedAt:          model.FinalizedAt,
+		models.GroupColumns.CountdownStartedAt:   model.CountdownStartedAt,
+		models.GroupColumns.ScheduledCaptureTime: model.ScheduledCaptureTime,
+		models.GroupColumns.CaptureDeadline:      model.CaptureDeadline,
+		models.GroupColumns.TemplateID:           model.TemplateID,
+		models.GroupColumns.ExpiresAt:            model.ExpiresAt,
+		models.GroupColumns.CountdownSeconds:     model.CountdownSeconds,
+		models.GroupColumns.AutoStart:            model.AutoStart,
+		models.GroupColumns.CurrentRound:         model.CurrentRound,
+		models.GroupColumns.UpdatedAt:            model.UpdatedAt,
+	})
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
+
+func (r *GroupRepositorySQLBoiler) IncrementMemberCount(ctx context.Context, id string, updatedAt time.Time) (bool, error) {
+	return r.exec(ctx, "UPDATE `groups` SET current_member_count = current_member_count + 1, updated_at = ?"+
+		" WHERE id = ? AND status = ? AND current_member_count < max_member",
+		updatedAt, id, string(group.GroupStatusRecruiting))
+}
+
+func (r *GroupRepositorySQLBoiler) DecrementMemberCount(ctx context.Context, id string, updatedAt time.Time) (bool, error) {
+	return r.exec(ctx, "UPDATE `groups` SET current_member_count = current_member_count - 1, updated_at = ?"+
+		" WHERE id = ? AND status = ? AND current_member_count > 0",
+		updatedAt, id, string(group.GroupStatusRecruiting))
+}
+
+func (r *GroupRepositorySQLBoiler) FinalizeMembers(ctx context.Context, id string, finalizedAt time.Time) (bool, error) {
+	return r.exec(ctx, "UPDATE `groups` SET status = ?, max_member = current_member_count, finalized_at = ?, updated_at = ?"+
+		" WHERE id = ? AND status = ? AND current_member_count > 0",
+		string(group.GroupStatusReadyCheck), finalizedAt, finalizedAt, id, string(group.GroupStatusRecruiting))
+}
+
+// exec 列の値を元に更新する文を実行し、行を更新したかどうかを返す
+func (r *GroupRepositorySQLBoiler) exec(ctx context.Context, query string, args ...interface{}) (bool, error) {
+	result, err := r.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
+
 func (r *GroupRepositorySQLBoiler) Delete(ctx context.Context, id string) error {
 	model, err := models.FindGroup(ctx, r.db, id)
 	if err != nil {
@@ -296,16 +414,110 @@ func (r *GroupRepositorySQLBoiler) FindByStatus(ctx context.Context, status stri
 	return groups, nil
 }
 
-func (r *GroupRepositorySQLBoiler) UpdateStatus(ctx context.Context, id string, status string) error {
-	dbGroup, err := models.FindGroup(ctx, r.db, id)
+func (r *GroupRepositorySQLBoiler) FindActiveByType(ctx context.Context, groupType group.GroupType, limit, offset int) ([]*group.Group, error) {
+	dbGroups, err := models.Groups(
+		qm.Where("group_type = ? AND status <> ?", string(groupType), string(group.GroupStatusExpired)),
+		qm.OrderBy("created_at ASC"),
+		qm.Limit(limit),
+		qm.Offset(offset),
+	).All(ctx, r.db)
+	if err != nil {
+		return nil, err
+	}
+
+	groups := make([]*group.Group, 0, len(dbGroups))
+	for _, dbGroup := range dbGroups {
+		g, err := toGroupEntity(dbGroup)
+		if err != nil {
+			return nil, err
+		}
+		groups = append(groups, g)
+	}
+	return groups, nil
+}
+
+func (r *GroupRepositorySQLBoiler) ExpireOverdue(ctx context.Context, now time.Time) ([]string, error) {
+	dbGroups, err := r.closeWhere(ctx, group.GroupStatusExpired,
+		qm.Where("status IN (?, ?) AND expires_at IS NOT NULL AND expires_at < ?",
+			string(group.GroupStatusRecruiting), string(group.GroupStatusReadyCheck), now),
+	)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return group.ErrGroupNotFound
+		return nil, err
+	}
+	ids := make([]string, len(dbGroups))
+	for i, g := range dbGroups {
+		ids[i] = g.ID
+	}
+	return ids, nil
+}
+
+func (r *GroupRepositorySQLBoiler) AbortStuckSessions(ctx context.Context, startedBefore time.Time) ([]*group.Group, error) {
+	dbGroups, err := r.closeWhere(ctx, group.GroupStatusFailed,
+		qm.Where("status IN (?, ?) AND countdown_started_at < ?",
+			string(group.GroupStatusCountdown), string(group.GroupStatusPhotoTaking), startedBefore),
+	)
+	if err != nil {
+		return nil, err
+	}
+	groups := make([]*group.Group, 0, len(dbGroups))
+	for _, dbGroup := range dbGroups {
+		g, err := toGroupEntity(dbGroup)
+		if err != nil {
+			return nil, err
 		}
-		return err
+		groups = append(groups, g)
 	}
+	return groups, nil
+}
 
-	dbGroup.Status = status
-	_, err = dbGroup.Update(ctx, r.db, boil.Whitelist("status", "updated_at"))
-	return err
+// closeWhere 条件に合うグループを行ロックしてから status にし、途中のラウンドを中断扱いにする
+// 更新後のグループを返す。他のインスタンスが同時に実行しても、同じグループを二重に返さない
+func (r *GroupRepositorySQLBoiler) closeWhere(ctx context.Context, status group.GroupStatus, where qm.QueryMod) (models.GroupSlice, error) {
+	var dbGroups models.GroupSlice
+	err := db.WithTx(ctx, r.db, func(tx *sql.Tx) error {
+		var err error
+		dbGroups, err = models.Groups(
+			where,
+			qm.For("UPDATE SKIP LOCKED"),
+		).All(ctx, tx)
+		if err != nil {
+			return err
+		}
+		if len(dbGroups) == 0 {
+			return nil
+		}
+
+		args := make([]interface{}, len(dbGroups))
+		for i, g := range dbGroups {
+			args[i] = g.ID
+		}
+
+		now := time.Now()
+		_, err = models.Groups(qm.WhereIn("id IN ?", args...)).UpdateAll(ctx, tx, models.M{
+			models.GroupColumns.Status:    string(status),
+			models.GroupColumns.UpdatedAt: now,
+		})
+		if err != nil {
+			return err
+		}
+		for _, g := range dbGroups {
+			g.Status = string(status)
+			g.UpdatedAt = now
+		}
+
+		// 途中で止まったラウンドも中断扱いにする
+		_, err = models.SessionRounds(
+			qm.WhereIn("group_id IN ?", args...),
+			qm.Where("status = ?", string(session_round.StatusInProgress)),
+		).UpdateAll(ctx, tx, models.M{
+			models.SessionRoundColumns.Status:     string(session_round.StatusAborted),
+			models.SessionRoundColumns.FinishedAt: now,
+			models.SessionRoundColumns.UpdatedAt:  now,
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return dbGroups, nil
 }
@@ -0,0 +1,168 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/google/uuid"
+	"github.com/jphacks/os_2502/back/api/internal/domain/resumable_upload"
+	"github.com/jphacks/os_2502/back/api/internal/infrastructure/models"
+)
+
+type ResumableUploadRepositorySQLBoiler struct {
+	db *sql.DB
+}
+
+func NewResumableUploadRepositorySQLBoiler(db *sql.DB) resumable_upload.Repository {
+	return &ResumableUploadRepositorySQLBoiler{db: db}
+}
+
+// Model to Entity conversion
+func toResumableUploadEntity(m *models.ResumableUpload) (*resumable_upload.ResumableUpload, error) {
+	uploadID, err := uuid.Parse(m.UploadID)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := uuid.Parse(m.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	var imageID *uuid.UUID
+	if m.ImageID.Valid {
+		id, err := uuid.Parse(m.ImageID.String)
+		if err != nil {
+			return nil, err
+		}
+		imageID = &id
+	}
+
+	return resumable_upload.Reconstruct(
+		uploadID,
+		m.GroupID,
+		userID,
+		m.FrameIndex,
+		m.UploadLength,
+		m.UploadOffset,
+		m.Metadata.Ptr(),
+		resumable_upload.Status(m.Status),
+		imageID,
+		m.ExpiresAt,
+		m.CreatedAt,
+		m.UpdatedAt,
+	)
+}
+
+// Entity to Model conversion
+func toResumableUploadModel(u *resumable_upload.ResumableUpload) *models.ResumableUpload {
+	m := &models.ResumableUpload{
+		UploadID:     u.UploadID().String(),
+		GroupID:      u.GroupID(),
+		UserID:       u.UserID().String(),
+		FrameIndex:   u.FrameIndex(),
+		UploadLength: u.Length(),
+		UploadOffset: u.Offset(),
+		Metadata:     null.StringFromPtr(u.Metadata()),
+		Status:       string(u.Status()),
+		ExpiresAt:    u.ExpiresAt(),
+		CreatedAt:    u.CreatedAt(),
+		UpdatedAt:    u.UpdatedAt(),
+	}
+	if imageID := u.ImageID(); imageID != nil {
+		m.ImageID = null.StringFrom(imageID.String())
+	}
+	return m
+}
+
+func (r *ResumableUploadRepositorySQLBoiler) Create(ctx context.Context, u *resumable_upload.ResumableUpload) error {
+	model := toResumableUploadModel(u)
+	return model.Insert(ctx, r.db, boil.Infer())
+}
+
+func (r *ResumableUploadRepositorySQLBoiler) FindByID(ctx context.Context, uploadID uuid.UUID) (*resumable_upload.ResumableUpload, error) {
+	model, err := models.FindResumableUpload(ctx, r.db, uploadID.String())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, resumable_upload.ErrUploadNotFound
+		}
+		return nil, err
+	}
+	return toResumableUploadEntity(model)
+}
+
+// UpdateOffset 受信位置が from のままの場合だけ更新する（同じ位置への追加が重なった場合は先に来た方だけが反映される）
+func (r *ResumableUploadRepositorySQLBoiler) UpdateOffset(ctx context.Context, u *resumable_upload.ResumableUpload, from int64) error {
+	rows, err := models.ResumableUploads(
+		qm.Where("upload_id = ? AND upload_offset = ?", u.UploadID().String(), from),
+	).UpdateAll(ctx, r.db, models.M{
+		models.ResumableUploadColumns.UploadOffset: u.Offset(),
+		models.ResumableUploadColumns.UpdatedAt:    u.UpdatedAt(),
+	})
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return resumable_upload.ErrOffsetMismatch
+	}
+	return nil
+}
+
+func (r *ResumableUploadRepositorySQLBoiler) Update(ctx context.Context, u *resumable_upload.ResumableUpload) error {
+	model, err := models.FindResumableUpload(ctx, r.db, u.UploadID().String())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return resumable_upload.ErrUploadNotFound
+		}
+		return err
+	}
+
+	updated := toResumableUploadModel(u)
+	model.Status = updated.Status
+	model.ImageID = updated.ImageID
+	model.UpdatedAt = updated.UpdatedAt
+
+	_, err = model.Update(ctx, r.db, boil.Infer())
+	return err
+}
+
+func (r *ResumableUploadRepositorySQLBoiler) CountInProgress(ctx context.Context, groupID string, startedBefore, now time.Time) (int, error) {
+	count, err := models.ResumableUploads(
+		qm.Where("group_id = ? AND status = ?", groupID, string(resumable_upload.StatusUploading)),
+		qm.Where("created_at <= ? AND expires_at > ?", startedBefore, now),
+	).Count(ctx, r.db)
+	if err != nil {
+		return 0, err
+	}
+	return int(count), nil
+}
+
+func (r *ResumableUploadRepositorySQLBoiler) FindExpired(ctx context.Context, before time.Time, limit int) ([]*resumable_upload.ResumableUpload, error) {
+	modelSlice, err := models.ResumableUploads(
+		qm.Where("expires_at < ?", before),
+		qm.OrderBy("expires_at ASC"),
+		qm.Limit(limit),
+	).All(ctx, r.db)
+	if err != nil {
+		return nil, err
+	}
+
+	uploads := make([]*resumable_upload.ResumableUpload, len(modelSlice))
+	for i, model := range modelSlice {
+		u, err := toResumableUploadEntity(model)
+		if err != nil {
+			return nil, err
+		}
+		uploads[i] = u
+	}
+	return uploads, nil
+}
+
+func (r *ResumableUploadRepositorySQLBoiler) Delete(ctx context.Context, uploadID uuid.UUID) error {
+	_, err := models.ResumableUploads(qm.Where("upload_id = ?", uploadID.String())).DeleteAll(ctx, r.db)
+	return err
+}
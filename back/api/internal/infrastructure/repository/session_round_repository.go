@@ -0,0 +1,121 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/google/uuid"
+	"github.com/jphacks/os_2502/back/api/internal/domain/session_round"
+	"github.com/jphacks/os_2502/back/api/internal/infrastructure/db"
+	"github.com/jphacks/os_2502/back/api/internal/infrastructure/models"
+)
+
+type SessionRoundRepositorySQLBoiler struct {
+	db *sql.DB
+}
+
+func NewSessionRoundRepositorySQLBoiler(db *sql.DB) session_round.Repository {
+	return &SessionRoundRepositorySQLBoiler{db: db}
+}
+
+// Model to Entity conversion
+func toSessionRoundEntity(m *models.SessionRound) (*session_round.SessionRound, error) {
+	roundID, err := uuid.Parse(m.RoundID)
+	if err != nil {
+		return nil, err
+	}
+
+	return session_round.Reconstruct(
+		roundID,
+		m.GroupID,
+		m.RoundNumber,
+		m.TemplateID,
+		m.ScheduledCaptureTime,
+		m.CaptureDeadline,
+		session_round.Status(m.Status),
+		m.FinishedAt.Ptr(),
+		m.CreatedAt,
+		m.UpdatedAt,
+	)
+}
+
+// Entity to Model conversion
+func toSessionRoundModel(r *session_round.SessionRound) *models.SessionRound {
+	return &models.SessionRound{
+		RoundID:              r.RoundID().String(),
+		GroupID:              r.GroupID(),
+		RoundNumber:          r.RoundNumber(),
+		TemplateID:           r.TemplateID(),
+		ScheduledCaptureTime: r.ScheduledCaptureTime(),
+		CaptureDeadline:      r.CaptureDeadline(),
+		Status:               string(r.Status()),
+		FinishedAt:           null.TimeFromPtr(r.FinishedAt()),
+		CreatedAt:            r.CreatedAt(),
+		UpdatedAt:            r.UpdatedAt(),
+	}
+}
+
+func (r *SessionRoundRepositorySQLBoiler) Create(ctx context.Context, round *session_round.SessionRound) error {
+	model := toSessionRoundModel(round)
+	err := model.Insert(ctx, r.db, boil.Infer())
+	if err != nil {
+		if db.IsDuplicateError(err) {
+			return session_round.ErrRoundAlreadyExists
+		}
+		return err
+	}
+	return nil
+}
+
+func (r *SessionRoundRepositorySQLBoiler) FindByGroupAndNumber(ctx context.Context, groupID string, roundNumber int) (*session_round.SessionRound, error) {
+	model, err := models.SessionRounds(
+		qm.Where("group_id = ? AND round_number = ?", groupID, roundNumber),
+	).One(ctx, r.db)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, session_round.ErrRoundNotFound
+		}
+		return nil, err
+	}
+	return toSessionRoundEntity(model)
+}
+
+func (r *SessionRoundRepositorySQLBoiler) FindByGroupID(ctx context.Context, groupID string) ([]*session_round.SessionRound, error) {
+	modelSlice, err := models.SessionRounds(
+		qm.Where("group_id = ?", groupID),
+		qm.OrderBy("round_number DESC"),
+	).All(ctx, r.db)
+	if err != nil {
+		return nil, err
+	}
+
+	rounds := make([]*session_round.SessionRound, len(modelSlice))
+	for i, model := range modelSlice {
+		round, err := toSessionRoundEntity(model)
+		if err != nil {
+			return nil, err
+		}
+		rounds[i] = round
+	}
+	return rounds, nil
+}
+
+func (r *SessionRoundRepositorySQLBoiler) Update(ctx context.Context, round *session_round.SessionRound) error {
+	model, err := models.FindSessionRound(ctx, r.db, round.RoundID().String())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return session_round.ErrRoundNotFound
+		}
+		return err
+	}
+
+	model.Status = string(round.Status())
+	model.FinishedAt = null.TimeFromPtr(round.FinishedAt())
+	model.UpdatedAt = round.UpdatedAt()
+
+	_, err = model.Update(ctx, r.db, boil.Infer())
+	return err
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/aarondl/sqlboiler/v4/queries/qm"
 	"github.com/aarondl/null/v8"
 	"github.com/google/uuid"
+	"github.com/jphacks/os_2502/back/api/internal/domain/collage_template"
 	"github.com/jphacks/os_2502/back/api/internal/domain/template_part"
 	"github.com/jphacks/os_2502/back/api/internal/infrastructure/models"
 )
@@ -42,6 +43,11 @@ func toTemplatePartEntity(m *models.TemplatePart) (*template_part.TemplatePart,
 		description = &m.Description.String
 	}
 
+	var path *string
+	if m.Path.Valid {
+		path = &m.Path.String
+	}
+
 	return template_part.Reconstruct(
 		partID,
 		templateID,
@@ -52,6 +58,7 @@ func toTemplatePartEntity(m *models.TemplatePart) (*template_part.TemplatePart,
 		m.Height,
 		partName,
 		description,
+		path,
 		m.CreatedAt,
 		m.UpdatedAt,
 	)
@@ -79,6 +86,10 @@ func toTemplatePartModel(tp *template_part.TemplatePart) *models.TemplatePart {
 		model.Description = null.String{String: *description, Valid: true}
 	}
 
+	if path := tp.Path(); path != nil {
+		model.Path = null.String{String: *path, Valid: true}
+	}
+
 	return model
 }
 
@@ -159,6 +170,12 @@ func (r *TemplatePartRepository) Update(ctx context.Context, tp *template_part.T
 		model.Description = null.String{Valid: false}
 	}
 
+	if path := tp.Path(); path != nil {
+		model.Path = null.String{String: *path, Valid: true}
+	} else {
+		model.Path = null.String{Valid: false}
+	}
+
 	_, err = model.Update(ctx, r.db, boil.Whitelist(
 		models.TemplatePartColumns.PartNumber,
 		models.TemplatePartColumns.PartName,
@@ -166,6 +183,7 @@ func (r *TemplatePartRepository) Update(ctx context.Context, tp *template_part.T
 		models.TemplatePartColumns.PositionY,
 		models.TemplatePartColumns.Width,
 		models.TemplatePartColumns.Height,
+		models.TemplatePartColumns.Path,
 		models.TemplatePartColumns.Description,
 		models.TemplatePartColumns.UpdatedAt,
 	))
@@ -194,7 +212,9 @@ func (r *TemplatePartRepository) DeleteByTemplateID(ctx context.Context, templat
 
 func (r *TemplatePartRepository) List(ctx context.Context, limit, offset int) ([]*template_part.TemplatePart, error) {
 	modelSlice, err := models.TemplateParts(
-		qm.OrderBy("created_at DESC"),
+		qm.InnerJoin("collages_template ct ON ct.template_id = template_parts.template_id"),
+		qm.Where("ct.visibility = ?", string(collage_template.VisibilityPublic)),
+		qm.OrderBy("template_parts.created_at DESC"),
 		qm.Limit(limit),
 		qm.Offset(offset),
 	).All(ctx, r.db)
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"time"
 
+	"github.com/aarondl/null/v8"
 	"github.com/aarondl/sqlboiler/v4/boil"
 	"github.com/aarondl/sqlboiler/v4/queries/qm"
 	"github.com/google/uuid"
@@ -33,11 +34,38 @@ func toUploadImageEntity(m *models.UploadImage) (*upload_image.UploadImage, erro
 		return nil, err
 	}
 
+	var partID *uuid.UUID
+	if m.PartID.Valid {
+		id, err := uuid.Parse(m.PartID.String)
+		if err != nil {
+			return nil, err
+		}
+		partID = &id
+	}
+
+	var frameIndex *int
+	if m.FrameIndex.Valid {
+		idx := m.FrameIndex.Int
+		frameIndex = &idx
+	}
+
+	var capturedAt *time.Time
+	if m.CapturedAt.Valid {
+		t := m.CapturedAt.Time
+		capturedAt = &t
+	}
+
 	return upload_image.Reconstruct(
 		imageID,
 		m.FileURL,
 		m.GroupID,
 		userID,
+		partID,
+		frameIndex,
+		m.RoundNumber,
+		capturedAt,
+		m.OriginalWidth.Ptr(),
+		m.OriginalHeight.Ptr(),
 		m.CollageDay,
 		m.CreatedAt,
 	)
@@ -45,14 +73,25 @@ func toUploadImageEntity(m *models.UploadImage) (*upload_image.UploadImage, erro
 
 // Entity to Model conversion
 func toUploadImageModel(ui *upload_image.UploadImage) *models.UploadImage {
-	return &models.UploadImage{
-		ImageID:    ui.ImageID().String(),
-		FileURL:    ui.FileURL(),
-		GroupID:    ui.GroupID(),
-		UserID:     ui.UserID().String(),
-		CollageDay: ui.CollageDay(),
-		CreatedAt:  ui.CreatedAt(),
+	model := &models.UploadImage{
+		ImageID:     ui.ImageID().String(),
+		FileURL:     ui.FileURL(),
+		GroupID:     ui.GroupID(),
+		UserID:      ui.UserID().String(),
+		RoundNumber: ui.Round(),
+		CollageDay:  ui.CollageDay(),
+		CreatedAt:   ui.CreatedAt(),
+	}
+	if partID := ui.PartID(); partID != nil {
+		model.PartID = null.StringFrom(partID.String())
+	}
+	if idx := ui.FrameIndex(); idx != nil {
+		model.FrameIndex = null.IntFrom(*idx)
 	}
+	model.CapturedAt = null.TimeFromPtr(ui.CapturedAt())
+	model.OriginalWidth = null.IntFromPtr(ui.OriginalWidth())
+	model.OriginalHeight = null.IntFromPtr(ui.OriginalHeight())
+	return model
 }
 
 func (r *UploadImageRepositorySQLBoiler) Create(ctx context.Context, ui *upload_image.UploadImage) error {
@@ -100,6 +139,38 @@ func (r *UploadImageRepositorySQLBoiler) FindByGroupID(ctx context.Context, grou
 	return images, nil
 }
 
+func (r *UploadImageRepositorySQLBoiler) FindLatestByGroupID(ctx context.Context, groupID string) ([]*upload_image.UploadImage, error) {
+	// 撮り直しで複数行ある場合は各メンバーの最新の1枚だけを返す
+	// 前のラウンドの写真は含めない
+	modelSlice, err := models.UploadImages(
+		qm.Where("group_id = ? AND round_number = (SELECT g.current_round FROM `groups` g WHERE g.id = upload_images.group_id)"+
+			" AND created_at = (SELECT MAX(ui2.created_at) FROM upload_images ui2"+
+			" WHERE ui2.group_id = upload_images.group_id AND ui2.round_number = upload_images.round_number"+
+			" AND ui2.user_id = upload_images.user_id)", groupID),
+		qm.OrderBy("created_at DESC, image_id DESC"),
+	).All(ctx, r.db)
+	if err != nil {
+		return nil, err
+	}
+
+	// created_at はマイクロ秒まで持つので通常は1人1行だが、同時刻の行があってもユーザーごとに1枚に絞る
+	seen := make(map[string]bool, len(modelSlice))
+	images := make([]*upload_image.UploadImage, 0, len(modelSlice))
+	for _, model := range modelSlice {
+		if seen[model.UserID] {
+			continue
+		}
+		seen[model.UserID] = true
+
+		img, err := toUploadImageEntity(model)
+		if err != nil {
+			return nil, err
+		}
+		images = append(images, img)
+	}
+	return images, nil
+}
+
 func (r *UploadImageRepositorySQLBoiler) FindByUserID(ctx context.Context, userID uuid.UUID, limit, offset int) ([]*upload_image.UploadImage, error) {
 	modelSlice, err := models.UploadImages(
 		qm.Where("user_id = ?", userID.String()),
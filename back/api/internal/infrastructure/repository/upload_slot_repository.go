@@ -0,0 +1,140 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/google/uuid"
+	"github.com/jphacks/os_2502/back/api/internal/domain/upload_slot"
+	"github.com/jphacks/os_2502/back/api/internal/infrastructure/models"
+)
+
+type UploadSlotRepositorySQLBoiler struct {
+	db *sql.DB
+}
+
+func NewUploadSlotRepositorySQLBoiler(db *sql.DB) upload_slot.Repository {
+	return &UploadSlotRepositorySQLBoiler{db: db}
+}
+
+// Model to Entity conversion
+func toUploadSlotEntity(m *models.UploadSlot) (*upload_slot.UploadSlot, error) {
+	slotID, err := uuid.Parse(m.SlotID)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := uuid.Parse(m.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	var imageID *uuid.UUID
+	if m.ImageID.Valid {
+		id, err := uuid.Parse(m.ImageID.String)
+		if err != nil {
+			return nil, err
+		}
+		imageID = &id
+	}
+
+	return upload_slot.Reconstruct(
+		slotID,
+		m.GroupID,
+		userID,
+		m.FrameIndex,
+		m.ObjectKey,
+		m.ContentType,
+		m.MaxSize,
+		upload_slot.Status(m.Status),
+		imageID,
+		m.ExpiresAt,
+		m.CreatedAt,
+		m.UpdatedAt,
+	)
+}
+
+// Entity to Model conversion
+func toUploadSlotModel(s *upload_slot.UploadSlot) *models.UploadSlot {
+	m := &models.UploadSlot{
+		SlotID:      s.SlotID().String(),
+		GroupID:     s.GroupID(),
+		UserID:      s.UserID().String(),
+		FrameIndex:  s.FrameIndex(),
+		ObjectKey:   s.ObjectKey(),
+		ContentType: s.ContentType(),
+		MaxSize:     s.MaxSize(),
+		Status:      string(s.Status()),
+		ExpiresAt:   s.ExpiresAt(),
+		CreatedAt:   s.CreatedAt(),
+		UpdatedAt:   s.UpdatedAt(),
+	}
+	if imageID := s.ImageID(); imageID != nil {
+		m.ImageID = null.StringFrom(imageID.String())
+	}
+	return m
+}
+
+func (r *UploadSlotRepositorySQLBoiler) Create(ctx context.Context, s *upload_slot.UploadSlot) error {
+	model := toUploadSlotModel(s)
+	return model.Insert(ctx, r.db, boil.Infer())
+}
+
+func (r *UploadSlotRepositorySQLBoiler) FindByID(ctx context.Context, slotID uuid.UUID) (*upload_slot.UploadSlot, error) {
+	model, err := models.FindUploadSlot(ctx, r.db, slotID.String())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, upload_slot.ErrSlotNotFound
+		}
+		return nil, err
+	}
+	return toUploadSlotEntity(model)
+}
+
+func (r *UploadSlotRepositorySQLBoiler) Update(ctx context.Context, s *upload_slot.UploadSlot) error {
+	model, err := models.FindUploadSlot(ctx, r.db, s.SlotID().String())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return upload_slot.ErrSlotNotFound
+		}
+		return err
+	}
+
+	updated := toUploadSlotModel(s)
+	model.Status = updated.Status
+	model.ImageID = updated.ImageID
+	model.UpdatedAt = updated.UpdatedAt
+
+	_, err = model.Update(ctx, r.db, boil.Infer())
+	return err
+}
+
+func (r *UploadSlotRepositorySQLBoiler) FindExpiredPending(ctx context.Context, before time.Time, limit int) ([]*upload_slot.UploadSlot, error) {
+	modelSlice, err := models.UploadSlots(
+		qm.Where("status = ? AND expires_at < ?", string(upload_slot.StatusPending), before),
+		qm.OrderBy("expires_at ASC"),
+		qm.Limit(limit),
+	).All(ctx, r.db)
+	if err != nil {
+		return nil, err
+	}
+
+	slots := make([]*upload_slot.UploadSlot, len(modelSlice))
+	for i, model := range modelSlice {
+		s, err := toUploadSlotEntity(model)
+		if err != nil {
+			return nil, err
+		}
+		slots[i] = s
+	}
+	return slots, nil
+}
+
+func (r *UploadSlotRepositorySQLBoiler) Delete(ctx context.Context, slotID uuid.UUID) error {
+	_, err := models.UploadSlots(qm.Where("slot_id = ?", slotID.String())).DeleteAll(ctx, r.db)
+	return err
+}
@@ -0,0 +1,28 @@
+package ingest
+
+// Error 写真を受け付けなかった理由。Code はクライアントに返すエラーコード
+type Error struct {
+	Code string
+	msg  string
+}
+
+func (e *Error) Error() string {
+	return "ingest: " + e.msg
+}
+
+var (
+	// ErrUnsupportedFormat JPEG / PNG / WebP 以外の画像
+	ErrUnsupportedFormat = &Error{Code: "unsupported_format", msg: "unsupported image format"}
+
+	// ErrCorruptImage 形式は正しいが、壊れているか途中で切れていてデコードできない
+	ErrCorruptImage = &Error{Code: "corrupt_image", msg: "corrupt or truncated image"}
+
+	// ErrFileTooLarge ファイルサイズが上限を超えている
+	ErrFileTooLarge = &Error{Code: "file_too_large", msg: "file too large"}
+
+	// ErrDimensionsTooLarge 幅か高さが上限を超えている
+	ErrDimensionsTooLarge = &Error{Code: "dimensions_too_large", msg: "image dimensions too large"}
+
+	// ErrTooManyPixels 画素数が上限を超えている（小さなファイルが巨大な画像に展開される decompression bomb を含む）
+	ErrTooManyPixels = &Error{Code: "too_many_pixels", msg: "too many pixels"}
+)
@@ -0,0 +1,144 @@
+// Package ingest はアップロードされた写真を検証し、保存する形に正規化する。
+//
+// 先頭のマジックバイトで形式を判定して JPEG / PNG / WebP だけを受け付け、デコード前にヘッダーの画素数を確認する。
+// EXIF Orientation を一度だけ適用して正立させ、正規の JPEG に再エンコードする（位置情報や端末情報などのメタデータは書き出さない）。
+// あわせて一覧用のサムネイルと画面表示用のプレビューを作る。
+package ingest
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/jpeg"
+	"time"
+
+	"github.com/jphacks/os_2502/back/api/internal/exif"
+)
+
+// MaxFileSize 受け付ける写真のファイルサイズの上限
+const MaxFileSize = 10 << 20
+
+// jpegQuality は正規化した JPEG の画質
+const jpegQuality = 92
+
+// Limits 受け付ける写真の上限
+type Limits struct {
+	// MaxBytes ファイルサイズ
+	MaxBytes int
+	// MaxDimension 幅・高さそれぞれの最大ピクセル数
+	MaxDimension int
+	// MaxPixels 幅×高さの上限。デコード前にヘッダーの値で確認するので、展開後のメモリ使用量もこれで抑えられる
+	MaxPixels int
+}
+
+// DefaultLimits 通常の上限（24メガピクセル、長辺8192ピクセルまで）
+var DefaultLimits = Limits{
+	MaxBytes:     MaxFileSize,
+	MaxDimension: 8192,
+	MaxPixels:    24_000_000,
+}
+
+// Result 正規化後の画像と取り込み時に分かったメタデータ
+type Result struct {
+	// Data は正規化した JPEG
+	Data        []byte
+	Ext         string
+	ContentType string
+	// SourceFormat はアップロードされた画像の形式
+	SourceFormat Format
+	// Width, Height は正立させた後のサイズ
+	Width  int
+	Height int
+	// CapturedAt は EXIF の撮影日時。不明な場合は nil
+	CapturedAt *time.Time
+	// Renditions はサムネイルとプレビュー
+	Renditions []Rendition
+}
+
+// Normalize は DefaultLimits で画像を検証・正規化する。
+// 撮影日時にタイムゾーンが無い場合は loc として扱う。
+func Normalize(data []byte, loc *time.Location) (*Result, error) {
+	return NormalizeWithLimits(data, loc, DefaultLimits)
+}
+
+// NormalizeWithLimits は limits で画像を検証し、正立させた JPEG とレンディションを返す
+func NormalizeWithLimits(data []byte, loc *time.Location, limits Limits) (*Result, error) {
+	if limits.MaxBytes > 0 && len(data) > limits.MaxBytes {
+		return nil, ErrFileTooLarge
+	}
+
+	format, ok := Sniff(data)
+	if !ok {
+		return nil, ErrUnsupportedFormat
+	}
+
+	cfg, err := format.decodeConfig(data)
+	if err != nil || cfg.Width <= 0 || cfg.Height <= 0 {
+		return nil, ErrCorruptImage
+	}
+	if err := limits.check(cfg.Width, cfg.Height); err != nil {
+		return nil, err
+	}
+
+	img, err := format.decode(data)
+	if err != nil || img.Bounds().Dx() != cfg.Width || img.Bounds().Dy() != cfg.Height {
+		return nil, ErrCorruptImage
+	}
+
+	info := format.readExif(data, loc)
+	upright := exif.Orient(flatten(img), info.Orientation)
+
+	canonical, err := encodeJPEG(upright, jpegQuality)
+	if err != nil {
+		return nil, err
+	}
+	renditions, err := makeRenditions(upright)
+	if err != nil {
+		return nil, err
+	}
+
+	b := upright.Bounds()
+	return &Result{
+		Data:         canonical,
+		Ext:          ".jpg",
+		ContentType:  "image/jpeg",
+		SourceFormat: format,
+		Width:        b.Dx(),
+		Height:       b.Dy(),
+		CapturedAt:   info.CapturedAt,
+		Renditions:   renditions,
+	}, nil
+}
+
+// check はヘッダーの幅と高さが上限以内か確認する
+func (l Limits) check(width, height int) error {
+	if l.MaxDimension > 0 && (width > l.MaxDimension || height > l.MaxDimension) {
+		return ErrDimensionsTooLarge
+	}
+	// 掛け算があふれないよう割り算で比べる
+	if l.MaxPixels > 0 && width > l.MaxPixels/height {
+		return ErrTooManyPixels
+	}
+	return nil
+}
+
+// flatten は透明部分を白で塗りつぶす（JPEG はアルファを持てず、そのままだと黒くなる）
+func flatten(img image.Image) image.Image {
+	if o, ok := img.(interface{ Opaque() bool }); ok && o.Opaque() {
+		return img
+	}
+	b := img.Bounds()
+	dst := image.NewRGBA(image.Rect(0, 0, b.Dx(), b.Dy()))
+	draw.Draw(dst, dst.Bounds(), image.NewUniform(color.White), image.Point{}, draw.Src)
+	draw.Draw(dst, dst.Bounds(), img, b.Min, draw.Over)
+	return dst
+}
+
+func encodeJPEG(img image.Image, quality int) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: quality}); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
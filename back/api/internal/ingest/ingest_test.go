@@ -0,0 +1,206 @@
+package ingest
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+	"time"
+)
+
+// exifWithOrientation は Orientation と GPS IFD ポインタだけを持つ最小の EXIF を作る
+func exifWithOrientation(orientation uint16) []byte {
+	var b bytes.Buffer
+	b.WriteString("MM")
+	binary.Write(&b, binary.BigEndian, uint16(42))
+	binary.Write(&b, binary.BigEndian, uint32(8))
+	binary.Write(&b, binary.BigEndian, uint16(1))
+	binary.Write(&b, binary.BigEndian, []uint16{0x0112, 3})
+	binary.Write(&b, binary.BigEndian, uint32(1))
+	binary.Write(&b, binary.BigEndian, []uint16{orientation, 0})
+	binary.Write(&b, binary.BigEndian, uint32(0))
+	// GPS 情報の代わりの目印
+	b.WriteString("GPSLatitude")
+	return append([]byte("Exif\x00\x00"), b.Bytes()...)
+}
+
+func jpegWithExif(t *testing.T, w, h int, orientation uint16) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, image.NewRGBA(image.Rect(0, 0, w, h)), nil); err != nil {
+		t.Fatal(err)
+	}
+	jpg := buf.Bytes()
+
+	payload := exifWithOrientation(orientation)
+	seg := []byte{0xFF, 0xE1, 0, 0}
+	binary.BigEndian.PutUint16(seg[2:], uint16(len(payload)+2))
+
+	out := append([]byte{}, jpg[:2]...)
+	out = append(out, seg...)
+	out = append(out, payload...)
+	return append(out, jpg[2:]...)
+}
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		name        string
+		orientation uint16
+		wantW       int
+		wantH       int
+	}{
+		{name: "upright keeps size", orientation: 1, wantW: 40, wantH: 20},
+		{name: "rotated 90 is re-encoded upright", orientation: 6, wantW: 20, wantH: 40},
+		{name: "rotated 180 keeps size", orientation: 3, wantW: 40, wantH: 20},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := Normalize(jpegWithExif(t, 40, 20, tt.orientation), time.UTC)
+			if err != nil {
+				t.Fatalf("Normalize() error = %v", err)
+			}
+			if res.Width != tt.wantW || res.Height != tt.wantH {
+				t.Errorf("size = %dx%d, want %dx%d", res.Width, res.Height, tt.wantW, tt.wantH)
+			}
+			if bytes.Contains(res.Data, []byte("Exif")) || bytes.Contains(res.Data, []byte("GPSLatitude")) {
+				t.Error("metadata was not stripped")
+			}
+
+			cfg, err := jpeg.DecodeConfig(bytes.NewReader(res.Data))
+			if err != nil {
+				t.Fatalf("output is not a valid JPEG: %v", err)
+			}
+			if cfg.Width != tt.wantW || cfg.Height != tt.wantH {
+				t.Errorf("stored size = %dx%d, want %dx%d", cfg.Width, cfg.Height, tt.wantW, tt.wantH)
+			}
+		})
+	}
+}
+
+func TestNormalizeUnsupported(t *testing.T) {
+	if _, err := Normalize([]byte("GIF89a....."), time.UTC); err != ErrUnsupportedFormat {
+		t.Errorf("Normalize() error = %v, want %v", err, ErrUnsupportedFormat)
+	}
+}
+
+// losslessWebP は 4x2 の可逆 WebP（右下から2番目のピクセルだけ透明）
+const losslessWebP = "524946463a000000574542505650384c2e0000002f034000103f40906dd3a39f7c1a0282a2eb962390b4f1d19f7cf31f80246386e41d040264ac4a80150522fa1fe4"
+
+func TestNormalizeFormats(t *testing.T) {
+	webp, _ := hex.DecodeString(losslessWebP)
+
+	// 半分が透明な PNG
+	transparent := image.NewNRGBA(image.Rect(0, 0, 600, 400))
+	for y := 0; y < 400; y++ {
+		for x := 0; x < 300; x++ {
+			transparent.Set(x, y, color.NRGBA{R: 255, A: 255})
+		}
+	}
+	var pngBuf bytes.Buffer
+	png.Encode(&pngBuf, transparent)
+
+	tests := []struct {
+		name   string
+		data   []byte
+		format Format
+		wantW  int
+		wantH  int
+		// 透明だった位置は白で塗りつぶされる
+		white image.Point
+	}{
+		{name: "webp", data: webp, format: FormatWebP, wantW: 4, wantH: 2, white: image.Pt(2, 1)},
+		{name: "png with alpha", data: pngBuf.Bytes(), format: FormatPNG, wantW: 600, wantH: 400, white: image.Pt(500, 200)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := Normalize(tt.data, time.UTC)
+			if err != nil {
+				t.Fatalf("Normalize() error = %v", err)
+			}
+			if res.SourceFormat != tt.format || res.ContentType != "image/jpeg" {
+				t.Errorf("format = %s -> %s, want %s -> image/jpeg", res.SourceFormat, res.ContentType, tt.format)
+			}
+
+			img, err := jpeg.Decode(bytes.NewReader(res.Data))
+			if err != nil {
+				t.Fatalf("output is not a valid JPEG: %v", err)
+			}
+			if b := img.Bounds(); b.Dx() != tt.wantW || b.Dy() != tt.wantH {
+				t.Errorf("size = %dx%d, want %dx%d", b.Dx(), b.Dy(), tt.wantW, tt.wantH)
+			}
+			if r, g, b, _ := img.At(tt.white.X, tt.white.Y).RGBA(); r>>8 < 200 || g>>8 < 200 || b>>8 < 200 {
+				t.Errorf("transparent pixel = %d,%d,%d, want white", r>>8, g>>8, b>>8)
+			}
+		})
+	}
+}
+
+func TestNormalizeRenditions(t *testing.T) {
+	res, err := Normalize(jpegWithExif(t, 2000, 1000, 6), time.UTC)
+	if err != nil {
+		t.Fatalf("Normalize() error = %v", err)
+	}
+
+	want := map[string]image.Point{
+		RenditionThumbnail: image.Pt(160, 320),
+		RenditionPreview:   image.Pt(640, 1280),
+	}
+	if len(res.Renditions) != len(want) {
+		t.Fatalf("renditions = %d, want %d", len(res.Renditions), len(want))
+	}
+	for _, r := range res.Renditions {
+		cfg, err := jpeg.DecodeConfig(bytes.NewReader(r.Data))
+		if err != nil {
+			t.Fatalf("%s is not a valid JPEG: %v", r.Name, err)
+		}
+		if got := image.Pt(cfg.Width, cfg.Height); got != want[r.Name] || got != image.Pt(r.Width, r.Height) {
+			t.Errorf("%s size = %v (reported %dx%d), want %v", r.Name, got, r.Width, r.Height, want[r.Name])
+		}
+	}
+}
+
+func TestNormalizeRejects(t *testing.T) {
+	valid := jpegWithExif(t, 64, 48, 1)
+	limits := Limits{MaxBytes: 1 << 20, MaxDimension: 100, MaxPixels: 3000}
+
+	// ヘッダーでは 60000x60000 を宣言する PNG（デコードする前に断る）
+	bomb := append([]byte{}, pngSignature...)
+	ihdr := make([]byte, 13)
+	binary.BigEndian.PutUint32(ihdr[0:], 60000)
+	binary.BigEndian.PutUint32(ihdr[4:], 60000)
+	ihdr[8], ihdr[9] = 8, 6
+	bomb = binary.BigEndian.AppendUint32(bomb, 13)
+	chunk := append([]byte("IHDR"), ihdr...)
+	bomb = binary.BigEndian.AppendUint32(append(bomb, chunk...), crc32.ChecksumIEEE(chunk))
+
+	tests := []struct {
+		name   string
+		data   []byte
+		limits Limits
+		want   *Error
+	}{
+		{name: "gif", data: []byte("GIF89a....."), limits: DefaultLimits, want: ErrUnsupportedFormat},
+		{name: "jpeg extension with text", data: []byte("not really a jpeg"), limits: DefaultLimits, want: ErrUnsupportedFormat},
+		{name: "truncated jpeg", data: valid[:len(valid)/2], limits: DefaultLimits, want: ErrCorruptImage},
+		{name: "too large file", data: valid, limits: Limits{MaxBytes: 100}, want: ErrFileTooLarge},
+		{name: "too wide", data: jpegWithExif(t, 120, 10, 1), limits: limits, want: ErrDimensionsTooLarge},
+		{name: "too many pixels", data: valid, limits: limits, want: ErrTooManyPixels},
+		{name: "decompression bomb", data: bomb, limits: DefaultLimits, want: ErrDimensionsTooLarge},
+		{name: "bomb within dimension limit", data: bomb, limits: Limits{MaxDimension: 65535, MaxPixels: DefaultLimits.MaxPixels}, want: ErrTooManyPixels},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NormalizeWithLimits(tt.data, time.UTC, tt.limits); err != tt.want {
+				t.Errorf("error = %v, want %v (%s)", err, tt.want, tt.want.Code)
+			}
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package ingest
+
+import (
+	"bytes"
+	"image"
+
+	"github.com/jphacks/os_2502/back/api/internal/rendition"
+)
+
+// レンディションの名前
+const (
+	RenditionThumbnail = string(rendition.SizeThumb)
+	RenditionPreview   = string(rendition.SizePreview)
+)
+
+// Rendition 一覧や画面表示用に縮小した JPEG
+type Rendition struct {
+	Name   string
+	Data   []byte
+	Width  int
+	Height int
+}
+
+// makeRenditions は長辺が上限に収まるように縮小したレンディションを作る（元より大きくはしない）
+// 大きさと画質はコラージュのレンディションと同じ
+func makeRenditions(img image.Image) ([]Rendition, error) {
+	sizes := []rendition.Size{rendition.SizeThumb, rendition.SizePreview}
+	renditions := make([]Rendition, 0, len(sizes))
+	for _, size := range sizes {
+		scaled := rendition.Scale(img, size)
+
+		var buf bytes.Buffer
+		if err := rendition.Encode(&buf, scaled, size, rendition.FormatJPEG); err != nil {
+			return nil, err
+		}
+		renditions = append(renditions, Rendition{
+			Name:   string(size),
+			Data:   buf.Bytes(),
+			Width:  scaled.Bounds().Dx(),
+			Height: scaled.Bounds().Dy(),
+		})
+	}
+	return renditions, nil
+}
@@ -0,0 +1,89 @@
+package ingest
+
+import (
+	"bytes"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"time"
+
+	"github.com/jphacks/os_2502/back/api/internal/exif"
+	"golang.org/x/image/webp"
+)
+
+// Format 受け付ける画像の形式
+type Format string
+
+const (
+	FormatJPEG Format = "jpeg"
+	FormatPNG  Format = "png"
+	FormatWebP Format = "webp"
+)
+
+// pngSignature PNG ファイルの先頭8バイト
+var pngSignature = []byte("\x89PNG\r\n\x1a\n")
+
+// Sniff は先頭のマジックバイトで形式を判定する（ファイル名の拡張子や Content-Type は信用しない）
+func Sniff(data []byte) (Format, bool) {
+	switch {
+	case len(data) >= 3 && data[0] == 0xFF && data[1] == 0xD8 && data[2] == 0xFF:
+		return FormatJPEG, true
+	case bytes.HasPrefix(data, pngSignature):
+		return FormatPNG, true
+	case len(data) >= 16 && string(data[0:4]) == "RIFF" && string(data[8:12]) == "WEBP" && string(data[12:15]) == "VP8":
+		// チャンクは VP8（非可逆）、VP8L（可逆）、VP8X（拡張）のいずれか
+		return FormatWebP, true
+	}
+	return "", false
+}
+
+// ContentType 形式の MIME タイプ
+func (f Format) ContentType() string {
+	return "image/" + string(f)
+}
+
+func (f Format) decodeConfig(data []byte) (image.Config, error) {
+	r := bytes.NewReader(data)
+	switch f {
+	case FormatJPEG:
+		return jpeg.DecodeConfig(r)
+	case FormatPNG:
+		return png.DecodeConfig(r)
+	case FormatWebP:
+		return webp.DecodeConfig(r)
+	}
+	return image.Config{}, ErrUnsupportedFormat
+}
+
+func (f Format) decode(data []byte) (image.Image, error) {
+	r := bytes.NewReader(data)
+	switch f {
+	case FormatJPEG:
+		return jpeg.Decode(r)
+	case FormatPNG:
+		return png.Decode(r)
+	case FormatWebP:
+		return webp.Decode(r)
+	}
+	return nil, ErrUnsupportedFormat
+}
+
+// readExif は向きと撮影日時を読む。EXIF が無い、または壊れている場合は向きの補正をしない
+func (f Format) readExif(data []byte, loc *time.Location) *exif.Info {
+	var (
+		info *exif.Info
+		err  error
+	)
+	switch f {
+	case FormatJPEG:
+		info, err = exif.ReadJPEG(data, loc)
+	case FormatWebP:
+		info, err = exif.ReadWebP(data, loc)
+	default:
+		err = exif.ErrNoExif
+	}
+	if err != nil {
+		return &exif.Info{Orientation: 1}
+	}
+	return info
+}
@@ -0,0 +1,182 @@
+package notification
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/jphacks/os_2502/back/api/internal/domain/device_token"
+)
+
+const (
+	// APNsProductionURL 本番環境の APNs
+	APNsProductionURL = "https://api.push.apple.com"
+	// APNsSandboxURL 開発ビルド用の APNs
+	APNsSandboxURL = "https://api.sandbox.push.apple.com"
+
+	// apnsTokenLifetime provider token の使い回し期間
+	// Apple は20分〜60分での更新を求めているため、余裕を持って50分で作り直す
+	apnsTokenLifetime = 50 * time.Minute
+)
+
+// apnsUnregisteredReasons このトークンには今後も届かないことを示す reason
+var apnsUnregisteredReasons = map[string]bool{
+	"BadDeviceToken":         true,
+	"Unregistered":           true,
+	"DeviceTokenNotForTopic": true,
+}
+
+// APNsConfig トークンベース認証（.p8 キー）の設定
+type APNsConfig struct {
+	KeyID    string
+	TeamID   string
+	BundleID string
+	Key      *ecdsa.PrivateKey
+	// Production false の場合は sandbox に送る
+	Production bool
+}
+
+// ParseAPNsKey Apple Developer からダウンロードした .p8 キーを読み込む
+func ParseAPNsKey(pemData []byte) (*ecdsa.PrivateKey, error) {
+	signer, err := parsePrivateKey(pemData)
+	if err != nil {
+		return nil, err
+	}
+	key, ok := signer.(*ecdsa.PrivateKey)
+	if !ok {
+		return nil, errInvalidKey
+	}
+	return key, nil
+}
+
+// APNs Apple Push Notification service への HTTP/2 プロバイダー
+type APNs struct {
+	cfg      APNsConfig
+	endpoint string
+	client   *http.Client
+	now      func() time.Time
+
+	mu       sync.Mutex
+	token    string
+	issuedAt time.Time
+}
+
+// NewAPNs APNsプロバイダーを作成。client が nil の場合は HTTP/2 に対応した既定のクライアントを使う
+func NewAPNs(cfg APNsConfig, client *http.Client) *APNs {
+	if client == nil {
+		// 既定のトランスポートは TLS 上で HTTP/2 をネゴシエートする
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+	endpoint := APNsSandboxURL
+	if cfg.Production {
+		endpoint = APNsProductionURL
+	}
+	return &APNs{cfg: cfg, endpoint: endpoint, client: client, now: time.Now}
+}
+
+// Platform iOS
+func (a *APNs) Platform() device_token.DeviceType {
+	return device_token.DeviceTypeIOS
+}
+
+type apnsAlert struct {
+	Title string `json:"title"`
+	Body  string `json:"body"`
+}
+
+type apnsAps struct {
+	Alert apnsAlert `json:"alert"`
+	Sound string    `json:"sound"`
+}
+
+// Send 1台の端末に送る
+func (a *APNs) Send(ctx context.Context, token string, msg Message) error {
+	// カスタムデータは aps と同じ階層に置く
+	body := make(map[string]interface{}, len(msg.Data)+2)
+	for k, v := range msg.payload() {
+		body[k] = v
+	}
+	body["aps"] = apnsAps{Alert: apnsAlert{Title: msg.Title, Body: msg.Body}, Sound: "default"}
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+
+	providerToken, err := a.providerToken()
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, a.endpoint+"/3/device/"+url.PathEscape(token), bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("authorization", "bearer "+providerToken)
+	req.Header.Set("apns-topic", a.cfg.BundleID)
+	req.Header.Set("apns-push-type", "alert")
+	req.Header.Set("apns-priority", "10")
+	req.Header.Set("content-type", "application/json")
+	if msg.CollapseKey != "" {
+		req.Header.Set("apns-collapse-id", msg.CollapseKey)
+	}
+
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusOK {
+		io.Copy(io.Discard, resp.Body)
+		return nil
+	}
+
+	var apnsErr struct {
+		Reason string `json:"reason"`
+	}
+	json.NewDecoder(io.LimitReader(resp.Body, 4096)).Decode(&apnsErr)
+
+	if apnsUnregisteredReasons[apnsErr.Reason] {
+		return fmt.Errorf("%w: apns %s", ErrUnregistered, apnsErr.Reason)
+	}
+	if apnsErr.Reason == "ExpiredProviderToken" || apnsErr.Reason == "InvalidProviderToken" {
+		// 次の送信で作り直す
+		a.mu.Lock()
+		a.token = ""
+		a.mu.Unlock()
+	}
+	return fmt.Errorf("notification: apns returned %d %s", resp.StatusCode, apnsErr.Reason)
+}
+
+// providerToken ES256 で署名した provider token を返す（一定時間は使い回す）
+func (a *APNs) providerToken() (string, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	now := a.now()
+	if a.token != "" && now.Sub(a.issuedAt) < apnsTokenLifetime {
+		return a.token, nil
+	}
+	if a.cfg.Key == nil {
+		return "", errInvalidKey
+	}
+
+	token, err := signJWT(
+		map[string]string{"alg": "ES256", "kid": a.cfg.KeyID},
+		map[string]interface{}{"iss": a.cfg.TeamID, "iat": now.Unix()},
+		a.cfg.Key,
+	)
+	if err != nil {
+		return "", err
+	}
+	a.token = token
+	a.issuedAt = now
+	return token, nil
+}
@@ -0,0 +1,106 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jphacks/os_2502/back/api/internal/domain/device_token"
+)
+
+// dispatchTimeout 非同期送信1回分（全宛先）の制限時間
+const dispatchTimeout = 30 * time.Second
+
+// Result 送信結果の集計
+type Result struct {
+	Sent        int
+	Failed      int
+	Deactivated int
+	// Skipped プロバイダーが設定されていないプラットフォームの端末
+	Skipped int
+}
+
+// Dispatcher ユーザーの有効なデバイストークンへ通知を振り分ける
+type Dispatcher struct {
+	tokens    device_token.Repository
+	providers map[device_token.DeviceType]Provider
+}
+
+// NewDispatcher Dispatcherを作成。providers に無いプラットフォームの端末には送らない
+func NewDispatcher(tokens device_token.Repository, providers ...Provider) *Dispatcher {
+	byPlatform := make(map[device_token.DeviceType]Provider, len(providers))
+	for _, p := range providers {
+		byPlatform[p.Platform()] = p
+	}
+	return &Dispatcher{tokens: tokens, providers: byPlatform}
+}
+
+// Notify バックグラウンドで送信し、結果はログに残す
+func (d *Dispatcher) Notify(userIDs []string, msg Message) {
+	ids := make([]uuid.UUID, 0, len(userIDs))
+	for _, s := range userIDs {
+		id, err := uuid.Parse(s)
+		if err != nil {
+			log.Printf("⚠️ Skipping notification to invalid user ID %q", s)
+			continue
+		}
+		ids = append(ids, id)
+	}
+	if len(ids) == 0 {
+		return
+	}
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), dispatchTimeout)
+		defer cancel()
+
+		res, err := d.Send(ctx, ids, msg)
+		if err != nil {
+			log.Printf("❌ Failed to send %s notification: %v", msg.Kind, err)
+			return
+		}
+		log.Printf("🔔 Sent %s notification to %d users: sent=%d failed=%d deactivated=%d skipped=%d",
+			msg.Kind, len(ids), res.Sent, res.Failed, res.Deactivated, res.Skipped)
+	}()
+}
+
+// Send ユーザーごとの有効なデバイストークンへ送信する
+// 端末単位の失敗は Result に数えて続行し、トークンの取得に失敗した場合のみエラーを返す
+func (d *Dispatcher) Send(ctx context.Context, userIDs []uuid.UUID, msg Message) (Result, error) {
+	var res Result
+	for _, userID := range userIDs {
+		tokens, err := d.tokens.FindActiveByUserID(ctx, userID)
+		if err != nil {
+			return res, err
+		}
+
+		for _, t := range tokens {
+			provider, ok := d.providers[t.DeviceType()]
+			if !ok {
+				res.Skipped++
+				continue
+			}
+
+			err := provider.Send(ctx, t.DeviceToken(), msg)
+			switch {
+			case err == nil:
+				res.Sent++
+			case errors.Is(err, ErrUnregistered):
+				// 今後も届かないトークンは無効化して配信対象から外す
+				t.Deactivate()
+				if err := d.tokens.Update(ctx, t); err != nil {
+					log.Printf("⚠️ Failed to deactivate device token %s: %v", t.ID(), err)
+					res.Failed++
+					continue
+				}
+				res.Deactivated++
+			default:
+				log.Printf("⚠️ Failed to send notification to device %s: %v", t.ID(), err)
+				res.Failed++
+			}
+		}
+	}
+	return res, nil
+}
@@ -0,0 +1,108 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jphacks/os_2502/back/api/internal/domain/device_token"
+)
+
+// fakeTokens ユーザーごとのデバイストークンを保持するだけのリポジトリ
+type fakeTokens struct {
+	device_token.Repository
+	byUser map[uuid.UUID][]*device_token.DeviceToken
+}
+
+func (f *fakeTokens) FindActiveByUserID(_ context.Context, userID uuid.UUID) ([]*device_token.DeviceToken, error) {
+	var active []*device_token.DeviceToken
+	for _, t := range f.byUser[userID] {
+		if t.IsActive() {
+			active = append(active, t)
+		}
+	}
+	return active, nil
+}
+
+func (f *fakeTokens) Update(context.Context, *device_token.DeviceToken) error {
+	return nil
+}
+
+func (f *fakeTokens) add(t *testing.T, userID uuid.UUID, token string, deviceType device_token.DeviceType) *device_token.DeviceToken {
+	t.Helper()
+	dt, err := device_token.NewDeviceToken(userID, token, deviceType, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.byUser[userID] = append(f.byUser[userID], dt)
+	return dt
+}
+
+func TestDispatcherSend(t *testing.T) {
+	alice, bob := uuid.New(), uuid.New()
+	tokens := &fakeTokens{byUser: map[uuid.UUID][]*device_token.DeviceToken{}}
+	tokens.add(t, alice, "alice-iphone", device_token.DeviceTypeIOS)
+	tokens.add(t, alice, "alice-pixel", device_token.DeviceTypeAndroid)
+	stale := tokens.add(t, bob, "bob-old-iphone", device_token.DeviceTypeIOS)
+	tokens.add(t, bob, "bob-iphone", device_token.DeviceTypeIOS)
+
+	ios := NewFake(device_token.DeviceTypeIOS)
+	android := NewFake(device_token.DeviceTypeAndroid)
+	ios.Unregister("bob-old-iphone")
+
+	d := NewDispatcher(tokens, ios, android)
+	msg := CollageReady("group-1", "旅行", "/api/groups/group-1/collage")
+
+	res, err := d.Send(context.Background(), []uuid.UUID{alice, bob}, msg)
+	if err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+	if want := (Result{Sent: 3, Deactivated: 1}); res != want {
+		t.Errorf("Send() = %+v, want %+v", res, want)
+	}
+	if stale.IsActive() {
+		t.Error("unregistered token should be deactivated")
+	}
+
+	if got := len(ios.Deliveries()); got != 2 {
+		t.Errorf("iOS deliveries = %d, want 2", got)
+	}
+	deliveries := android.Deliveries()
+	if len(deliveries) != 1 || deliveries[0].Token != "alice-pixel" || deliveries[0].Message.Kind != KindCollageReady {
+		t.Errorf("Android deliveries = %+v", deliveries)
+	}
+
+	// 無効化したトークンには二度と送らない
+	res, err = d.Send(context.Background(), []uuid.UUID{bob}, msg)
+	if err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+	if want := (Result{Sent: 1}); res != want {
+		t.Errorf("second Send() = %+v, want %+v", res, want)
+	}
+}
+
+func TestDispatcherSkipsAndCountsFailures(t *testing.T) {
+	user := uuid.New()
+	tokens := &fakeTokens{byUser: map[uuid.UUID][]*device_token.DeviceToken{}}
+	tokens.add(t, user, "iphone", device_token.DeviceTypeIOS)
+	android := tokens.add(t, user, "pixel", device_token.DeviceTypeAndroid)
+
+	// Android のプロバイダーが無く、iOS は一時的に失敗する
+	ios := NewFake(device_token.DeviceTypeIOS)
+	ios.FailWith(errors.New("apns: 503"))
+
+	res, err := NewDispatcher(tokens, ios).Send(context.Background(), []uuid.UUID{user},
+		CountdownStarting("group-1", "旅行", time.Now()))
+	if err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+	if want := (Result{Failed: 1, Skipped: 1}); res != want {
+		t.Errorf("Send() = %+v, want %+v", res, want)
+	}
+	if !android.IsActive() {
+		t.Error("token without a provider should stay active")
+	}
+}
@@ -0,0 +1,70 @@
+package notification
+
+import (
+	"context"
+	"sync"
+
+	"github.com/jphacks/os_2502/back/api/internal/domain/device_token"
+)
+
+// Delivery Fake が受け取った1件の送信
+type Delivery struct {
+	Token   string
+	Message Message
+}
+
+// Fake 送信内容をメモリに記録するだけの Provider（テスト用）
+type Fake struct {
+	platform device_token.DeviceType
+
+	mu           sync.Mutex
+	deliveries   []Delivery
+	unregistered map[string]bool
+	err          error
+}
+
+// NewFake platform の端末宛ての送信を記録する Fake を作成
+func NewFake(platform device_token.DeviceType) *Fake {
+	return &Fake{platform: platform, unregistered: make(map[string]bool)}
+}
+
+// Platform 配信できるデバイスの種類
+func (f *Fake) Platform() device_token.DeviceType {
+	return f.platform
+}
+
+// Send 送信内容を記録する。Unregister したトークンには ErrUnregistered を返す
+func (f *Fake) Send(_ context.Context, token string, msg Message) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.unregistered[token] {
+		return ErrUnregistered
+	}
+	if f.err != nil {
+		return f.err
+	}
+	f.deliveries = append(f.deliveries, Delivery{Token: token, Message: msg})
+	return nil
+}
+
+// Unregister token をアンインストール済みの端末として扱う
+func (f *Fake) Unregister(token string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.unregistered[token] = true
+}
+
+// FailWith 以降の送信を err で失敗させる（nil で元に戻す）
+func (f *Fake) FailWith(err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.err = err
+}
+
+// Deliveries これまでに記録した送信
+func (f *Fake) Deliveries() []Delivery {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]Delivery(nil), f.deliveries...)
+}
@@ -0,0 +1,265 @@
+package notification
+
+import (
+	"bytes"
+	"context"
+	"crypto/rsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jphacks/os_2502/back/api/internal/domain/device_token"
+)
+
+const (
+	// FCMEndpoint FCM HTTP v1 API
+	FCMEndpoint = "https://fcm.googleapis.com"
+
+	fcmScope = "https://www.googleapis.com/auth/firebase.messaging"
+	// googleTokenURL サービスアカウントの認証情報に token_uri が無い場合の既定値
+	googleTokenURL = "https://oauth2.googleapis.com/token"
+)
+
+// fcmUnregisteredCodes このトークンには今後も届かないことを示す FcmError.errorCode
+var fcmUnregisteredCodes = map[string]bool{
+	"UNREGISTERED":       true,
+	"SENDER_ID_MISMATCH": true,
+}
+
+// TokenSource FCM API 用の OAuth 2.0 アクセストークンを返す
+type TokenSource interface {
+	AccessToken(ctx context.Context) (string, error)
+}
+
+// ServiceAccount サービスアカウントの秘密鍵で署名したJWTをアクセストークンに交換する TokenSource
+type ServiceAccount struct {
+	projectID string
+	email     string
+	tokenURL  string
+	key       *rsa.PrivateKey
+	client    *http.Client
+	now       func() time.Time
+
+	mu     sync.Mutex
+	token  string
+	expiry time.Time
+}
+
+// NewServiceAccount Firebase コンソールからダウンロードしたサービスアカウントのJSONを読み込む
+func NewServiceAccount(credentialsJSON []byte, client *http.Client) (*ServiceAccount, error) {
+	var creds struct {
+		Type        string `json:"type"`
+		ProjectID   string `json:"project_id"`
+		PrivateKey  string `json:"private_key"`
+		ClientEmail string `json:"client_email"`
+		TokenURI    string `json:"token_uri"`
+	}
+	if err := json.Unmarshal(credentialsJSON, &creds); err != nil {
+		return nil, err
+	}
+	if creds.Type != "service_account" || creds.ClientEmail == "" {
+		return nil, errors.New("notification: not a service account credential")
+	}
+
+	signer, err := parsePrivateKey([]byte(creds.PrivateKey))
+	if err != nil {
+		return nil, err
+	}
+	key, ok := signer.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errInvalidKey
+	}
+
+	if creds.TokenURI == "" {
+		creds.TokenURI = googleTokenURL
+	}
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+	return &ServiceAccount{
+		projectID: creds.ProjectID,
+		email:     creds.ClientEmail,
+		tokenURL:  creds.TokenURI,
+		key:       key,
+		client:    client,
+		now:       time.Now,
+	}, nil
+}
+
+// ProjectID 認証情報に含まれる Firebase プロジェクトID
+func (s *ServiceAccount) ProjectID() string {
+	return s.projectID
+}
+
+// AccessToken 有効期限が1分以上残っているトークンは使い回す
+func (s *ServiceAccount) AccessToken(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	if s.token != "" && now.Add(time.Minute).Before(s.expiry) {
+		return s.token, nil
+	}
+
+	assertion, err := signJWT(
+		map[string]string{"alg": "RS256", "typ": "JWT"},
+		map[string]interface{}{
+			"iss":   s.email,
+			"scope": fcmScope,
+			"aud":   s.tokenURL,
+			"iat":   now.Unix(),
+			"exp":   now.Add(time.Hour).Unix(),
+		},
+		s.key,
+	)
+	if err != nil {
+		return "", err
+	}
+
+	form := url.Values{
+		"grant_type": {"urn:ietf:params:oauth:grant-type:jwt-bearer"},
+		"assertion":  {assertion},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.tokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		b, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return "", fmt.Errorf("notification: token exchange returned %d: %s", resp.StatusCode, b)
+	}
+
+	var tok struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int    `json:"expires_in"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&tok); err != nil {
+		return "", err
+	}
+	if tok.AccessToken == "" {
+		return "", errors.New("notification: token exchange returned no access token")
+	}
+
+	s.token = tok.AccessToken
+	s.expiry = now.Add(time.Duration(tok.ExpiresIn) * time.Second)
+	return s.token, nil
+}
+
+// FCM Firebase Cloud Messaging HTTP v1 API のプロバイダー
+type FCM struct {
+	projectID string
+	tokens    TokenSource
+	endpoint  string
+	client    *http.Client
+}
+
+// NewFCM FCMプロバイダーを作成。client が nil の場合は既定のクライアントを使う
+func NewFCM(projectID string, tokens TokenSource, client *http.Client) *FCM {
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+	return &FCM{projectID: projectID, tokens: tokens, endpoint: FCMEndpoint, client: client}
+}
+
+// Platform Android
+func (f *FCM) Platform() device_token.DeviceType {
+	return device_token.DeviceTypeAndroid
+}
+
+type fcmRequest struct {
+	Message fcmMessage `json:"message"`
+}
+
+type fcmMessage struct {
+	Token        string            `json:"token"`
+	Notification fcmNotification   `json:"notification"`
+	Data         map[string]string `json:"data,omitempty"`
+	Android      fcmAndroid        `json:"android"`
+}
+
+type fcmNotification struct {
+	Title string `json:"title"`
+	Body  string `json:"body"`
+}
+
+type fcmAndroid struct {
+	Priority    string `json:"priority"`
+	CollapseKey string `json:"collapse_key,omitempty"`
+}
+
+type fcmErrorResponse struct {
+	Error struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+		Status  string `json:"status"`
+		Details []struct {
+			Type      string `json:"@type"`
+			ErrorCode string `json:"errorCode"`
+		} `json:"details"`
+	} `json:"error"`
+}
+
+// Send 1台の端末に送る
+func (f *FCM) Send(ctx context.Context, token string, msg Message) error {
+	body, err := json.Marshal(fcmRequest{Message: fcmMessage{
+		Token:        token,
+		Notification: fcmNotification{Title: msg.Title, Body: msg.Body},
+		Data:         msg.payload(),
+		Android:      fcmAndroid{Priority: "high", CollapseKey: msg.CollapseKey},
+	}})
+	if err != nil {
+		return err
+	}
+
+	accessToken, err := f.tokens.AccessToken(ctx)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost,
+		f.endpoint+"/v1/projects/"+url.PathEscape(f.projectID)+"/messages:send", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusOK {
+		io.Copy(io.Discard, resp.Body)
+		return nil
+	}
+
+	var fcmErr fcmErrorResponse
+	json.NewDecoder(io.LimitReader(resp.Body, 8192)).Decode(&fcmErr)
+
+	for _, d := range fcmErr.Error.Details {
+		if fcmUnregisteredCodes[d.ErrorCode] {
+			return fmt.Errorf("%w: fcm %s", ErrUnregistered, d.ErrorCode)
+		}
+	}
+	// 形式が不正なトークンは INVALID_ARGUMENT で返る（APNs の BadDeviceToken に相当）
+	if fcmErr.Error.Status == "INVALID_ARGUMENT" && strings.Contains(fcmErr.Error.Message, "registration token") {
+		return fmt.Errorf("%w: fcm %s", ErrUnregistered, fcmErr.Error.Message)
+	}
+	return fmt.Errorf("notification: fcm returned %d %s: %s", resp.StatusCode, fcmErr.Error.Status, fcmErr.Error.Message)
+}
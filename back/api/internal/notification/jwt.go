@@ -0,0 +1,70 @@
+package notification
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+)
+
+var errInvalidKey = errors.New("notification: invalid private key")
+
+// signJWT header と claims をエンコードして署名したJWTを返す
+// APNs の provider token は ES256、Google のサービスアカウントは RS256 で署名する
+func signJWT(header, claims interface{}, key crypto.Signer) (string, error) {
+	h, err := json.Marshal(header)
+	if err != nil {
+		return "", err
+	}
+	c, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+	signing := base64.RawURLEncoding.EncodeToString(h) + "." + base64.RawURLEncoding.EncodeToString(c)
+	digest := sha256.Sum256([]byte(signing))
+
+	var sig []byte
+	switch k := key.(type) {
+	case *ecdsa.PrivateKey:
+		// JWS の ES256 は ASN.1 ではなく r と s を32バイトずつ連結した形式
+		r, s, err := ecdsa.Sign(rand.Reader, k, digest[:])
+		if err != nil {
+			return "", err
+		}
+		sig = make([]byte, 64)
+		r.FillBytes(sig[:32])
+		s.FillBytes(sig[32:])
+	case *rsa.PrivateKey:
+		sig, err = rsa.SignPKCS1v15(rand.Reader, k, crypto.SHA256, digest[:])
+		if err != nil {
+			return "", err
+		}
+	default:
+		return "", errInvalidKey
+	}
+
+	return signing + "." + base64.RawURLEncoding.EncodeToString(sig), nil
+}
+
+// parsePrivateKey PEM形式の PKCS#8 秘密鍵（APNs の .p8 やサービスアカウントの private_key）を読み込む
+func parsePrivateKey(pemData []byte) (crypto.Signer, error) {
+	block, _ := pem.Decode(pemData)
+	if block == nil {
+		return nil, errInvalidKey
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, errInvalidKey
+	}
+	return signer, nil
+}
@@ -0,0 +1,60 @@
+package notification
+
+import "time"
+
+// Invited グループへの招待
+func Invited(groupID, groupName, invitationToken string) Message {
+	return Message{
+		Kind:  KindInvited,
+		Title: "グループに招待されました",
+		Body:  "「" + groupName + "」に参加して一緒に撮影しましょう",
+		Data: map[string]string{
+			"group_id":         groupID,
+			"invitation_token": invitationToken,
+		},
+		CollapseKey: groupID + ":" + string(KindInvited),
+	}
+}
+
+// CountdownStarting 撮影のカウントダウン開始
+func CountdownStarting(groupID, groupName string, scheduledCaptureTime time.Time) Message {
+	return Message{
+		Kind:  KindCountdownStarting,
+		Title: "まもなく撮影です",
+		Body:  "「" + groupName + "」の撮影が始まります。アプリを開いてください",
+		Data: map[string]string{
+			"group_id":               groupID,
+			"scheduled_capture_time": scheduledCaptureTime.UTC().Format(time.RFC3339Nano),
+		},
+		CollapseKey: groupID + ":" + string(KindCountdownStarting),
+	}
+}
+
+// CollageReady コラージュの完成
+func CollageReady(groupID, groupName, collageURL string) Message {
+	return Message{
+		Kind:  KindCollageReady,
+		Title: "コラージュが完成しました",
+		Body:  "「" + groupName + "」のコラージュができあがりました",
+		Data: map[string]string{
+			"group_id":    groupID,
+			"collage_url": collageURL,
+		},
+		CollapseKey: groupID + ":" + string(KindCollageReady),
+	}
+}
+
+// DailyCapture 永続グループの今日の撮影時刻
+func DailyCapture(groupID, groupName, collageDay string, closesAt time.Time) Message {
+	return Message{
+		Kind:  KindDailyCapture,
+		Title: "今日の撮影の時間です",
+		Body:  "「" + groupName + "」の今日のパーツを撮影しましょう",
+		Data: map[string]string{
+			"group_id":    groupID,
+			"collage_day": collageDay,
+			"closes_at":   closesAt.UTC().Format(time.RFC3339Nano),
+		},
+		CollapseKey: groupID + ":" + string(KindDailyCapture),
+	}
+}
@@ -0,0 +1,71 @@
+// Package notification は登録済みのデバイストークンへプッシュ通知を送る。
+//
+// 配信先のプラットフォームごとに Provider（APNs / FCM）を用意し、Dispatcher が
+// ユーザーの有効なデバイストークンへ振り分ける。プロバイダーが「トークンが無効」と
+// 返した場合はトークンを無効化し、以降の配信対象から外す。
+package notification
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jphacks/os_2502/back/api/internal/domain/device_token"
+)
+
+// ErrUnregistered アプリが削除された、または形式が不正などで今後も届かないデバイストークン
+var ErrUnregistered = errors.New("notification: device token is no longer valid")
+
+// Kind 通知の種類。アプリはペイロードの type で画面遷移先を決める
+type Kind string
+
+const (
+	// KindInvited グループに招待された
+	KindInvited Kind = "group_invited"
+	// KindCountdownStarting 撮影のカウントダウンが始まった
+	KindCountdownStarting Kind = "countdown_starting"
+	// KindCollageReady コラージュが完成した
+	KindCollageReady Kind = "collage_ready"
+	// KindDailyCapture 永続グループの今日の撮影時刻になった
+	KindDailyCapture Kind = "daily_capture"
+)
+
+// Message 1件の通知の内容
+type Message struct {
+	Kind  Kind
+	Title string
+	Body  string
+	// Data アプリに渡す追加のキーと値。type には Kind が入る
+	Data map[string]string
+	// CollapseKey 同じキーの未読通知は端末上で最新の1件にまとめられる
+	CollapseKey string
+}
+
+// payload プロバイダーに渡すデータ（type を含む）
+func (m Message) payload() map[string]string {
+	data := make(map[string]string, len(m.Data)+1)
+	for k, v := range m.Data {
+		data[k] = v
+	}
+	data["type"] = string(m.Kind)
+	return data
+}
+
+// Provider プラットフォームごとの配信手段
+type Provider interface {
+	// Platform 配信できるデバイスの種類
+	Platform() device_token.DeviceType
+	// Send 1台の端末に送る。トークンが無効な場合は ErrUnregistered をラップしたエラーを返す
+	Send(ctx context.Context, token string, msg Message) error
+}
+
+// Notifier 通知の送信先（ユースケースやワーカーから使う）
+// 送信は非同期で行われ、呼び出し元は結果を待たない
+type Notifier interface {
+	Notify(userIDs []string, msg Message)
+}
+
+// NopNotifier 何もしない Notifier（テストや通知を設定していない環境用）
+type NopNotifier struct{}
+
+// Notify 何もしない
+func (NopNotifier) Notify([]string, Message) {}
@@ -0,0 +1,197 @@
+package notification
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+func TestAPNsSend(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.ProtoMajor != 2 {
+			t.Errorf("proto = %s, want HTTP/2", r.Proto)
+		}
+		if got := r.Header.Get("apns-topic"); got != "jp.example.app" {
+			t.Errorf("apns-topic = %q", got)
+		}
+		if got := r.Header.Get("apns-collapse-id"); got != "group-1:collage_ready" {
+			t.Errorf("apns-collapse-id = %q", got)
+		}
+		if !strings.HasPrefix(r.Header.Get("authorization"), "bearer ") {
+			t.Errorf("authorization = %q", r.Header.Get("authorization"))
+		}
+
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		if body["type"] != "collage_ready" || body["aps"] == nil {
+			t.Errorf("payload = %v", body)
+		}
+
+		switch r.URL.Path {
+		case "/3/device/good":
+			w.WriteHeader(http.StatusOK)
+		case "/3/device/gone":
+			w.WriteHeader(http.StatusGone)
+			w.Write([]byte(`{"reason":"Unregistered","timestamp":1700000000000}`))
+		case "/3/device/bad":
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"reason":"BadDeviceToken"}`))
+		default:
+			w.WriteHeader(http.StatusTooManyRequests)
+			w.Write([]byte(`{"reason":"TooManyRequests"}`))
+		}
+	}))
+	srv.EnableHTTP2 = true
+	srv.StartTLS()
+	defer srv.Close()
+
+	a := NewAPNs(APNsConfig{KeyID: "KEY123", TeamID: "TEAM123", BundleID: "jp.example.app", Key: key}, srv.Client())
+	a.endpoint = srv.URL
+	msg := CollageReady("group-1", "旅行", "/api/groups/group-1/collage")
+
+	tests := []struct {
+		token          string
+		wantErr        bool
+		wantUnregister bool
+	}{
+		{token: "good"},
+		{token: "gone", wantErr: true, wantUnregister: true},
+		{token: "bad", wantErr: true, wantUnregister: true},
+		{token: "busy", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.token, func(t *testing.T) {
+			err := a.Send(context.Background(), tt.token, msg)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Send() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got := errors.Is(err, ErrUnregistered); got != tt.wantUnregister {
+				t.Errorf("errors.Is(err, ErrUnregistered) = %v, want %v", got, tt.wantUnregister)
+			}
+		})
+	}
+
+	// provider token は使い回し、JWS 形式の ES256 署名が検証できる
+	first, _ := a.providerToken()
+	second, _ := a.providerToken()
+	if first != second {
+		t.Error("provider token should be cached")
+	}
+	parts := strings.Split(first, ".")
+	sig, _ := base64.RawURLEncoding.DecodeString(parts[2])
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	r, s := new(big.Int).SetBytes(sig[:32]), new(big.Int).SetBytes(sig[32:])
+	if len(sig) != 64 || !ecdsa.Verify(&key.PublicKey, digest[:], r, s) {
+		t.Error("provider token signature does not verify")
+	}
+}
+
+func TestFCMSend(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, _ := x509.MarshalPKCS8PrivateKey(key)
+
+	var exchanges int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/token":
+			atomic.AddInt32(&exchanges, 1)
+			r.ParseForm()
+			if r.PostForm.Get("grant_type") != "urn:ietf:params:oauth:grant-type:jwt-bearer" || r.PostForm.Get("assertion") == "" {
+				t.Errorf("token request = %v", r.PostForm)
+			}
+			json.NewEncoder(w).Encode(map[string]interface{}{"access_token": "ya29.test", "expires_in": 3600})
+
+		case "/v1/projects/test-project/messages:send":
+			if got := r.Header.Get("Authorization"); got != "Bearer ya29.test" {
+				t.Errorf("Authorization = %q", got)
+			}
+			var req fcmRequest
+			json.NewDecoder(r.Body).Decode(&req)
+			if req.Message.Data["type"] != "group_invited" || req.Message.Data["invitation_token"] != "inv-1" {
+				t.Errorf("data = %v", req.Message.Data)
+			}
+
+			switch req.Message.Token {
+			case "good":
+				w.Write([]byte(`{"name":"projects/test-project/messages/1"}`))
+			case "gone":
+				w.WriteHeader(http.StatusNotFound)
+				w.Write([]byte(`{"error":{"code":404,"message":"Requested entity was not found.","status":"NOT_FOUND","details":[{"@type":"type.googleapis.com/google.firebase.fcm.v1.FcmError","errorCode":"UNREGISTERED"}]}}`))
+			case "bad":
+				w.WriteHeader(http.StatusBadRequest)
+				w.Write([]byte(`{"error":{"code":400,"message":"The registration token is not a valid FCM registration token","status":"INVALID_ARGUMENT"}}`))
+			default:
+				w.WriteHeader(http.StatusServiceUnavailable)
+				w.Write([]byte(`{"error":{"code":503,"message":"unavailable","status":"UNAVAILABLE"}}`))
+			}
+
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	creds, _ := json.Marshal(map[string]string{
+		"type":         "service_account",
+		"project_id":   "test-project",
+		"private_key":  string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})),
+		"client_email": "fcm@test-project.iam.gserviceaccount.com",
+		"token_uri":    srv.URL + "/token",
+	})
+	sa, err := NewServiceAccount(creds, srv.Client())
+	if err != nil {
+		t.Fatalf("NewServiceAccount() error = %v", err)
+	}
+
+	f := NewFCM(sa.ProjectID(), sa, srv.Client())
+	f.endpoint = srv.URL
+	msg := Invited("group-1", "旅行", "inv-1")
+
+	tests := []struct {
+		token          string
+		wantErr        bool
+		wantUnregister bool
+	}{
+		{token: "good"},
+		{token: "gone", wantErr: true, wantUnregister: true},
+		{token: "bad", wantErr: true, wantUnregister: true},
+		{token: "busy", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.token, func(t *testing.T) {
+			err := f.Send(context.Background(), tt.token, msg)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Send() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got := errors.Is(err, ErrUnregistered); got != tt.wantUnregister {
+				t.Errorf("errors.Is(err, ErrUnregistered) = %v, want %v", got, tt.wantUnregister)
+			}
+		})
+	}
+
+	if n := atomic.LoadInt32(&exchanges); n != 1 {
+		t.Errorf("token exchanged %d times, want 1", n)
+	}
+}
@@ -0,0 +1,91 @@
+// Package policy はグループ単位のリソースに対する認可を一か所で判定する。
+//
+// 判定はグループのメンバーシップ（group_members）だけを根拠にし、
+// 権限が無い場合は操作の種類によらず ErrForbidden を返す。
+// 存在しないグループもメンバーでないグループと区別せず、グループIDの存在を外部に漏らさない。
+package policy
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jphacks/os_2502/back/api/internal/domain/group_member"
+)
+
+// ErrForbidden 呼び出し元にこの操作の権限が無い
+var ErrForbidden = errors.New("この操作を行う権限がありません")
+
+// Policy グループ単位の認可
+type Policy struct {
+	members group_member.Repository
+}
+
+// New Policyを作成
+func New(members group_member.Repository) *Policy {
+	return &Policy{members: members}
+}
+
+// CanViewGroup グループの情報とメンバー、写真を参照できるか（メンバーのみ）
+func (p *Policy) CanViewGroup(ctx context.Context, userID, groupID string) error {
+	return p.requireMember(ctx, userID, groupID)
+}
+
+// CanUploadToGroup グループに写真をアップロードできるか（メンバーのみ）
+func (p *Policy) CanUploadToGroup(ctx context.Context, userID, groupID string) error {
+	return p.requireMember(ctx, userID, groupID)
+}
+
+// CanManageGroup グループの進行や設定を操作できるか（オーナーのみ）
+func (p *Policy) CanManageGroup(ctx context.Context, userID, groupID string) error {
+	if userID == "" || groupID == "" {
+		return ErrForbidden
+	}
+	isOwner, err := p.members.IsOwner(ctx, groupID, userID)
+	if err != nil {
+		return err
+	}
+	if !isOwner {
+		return ErrForbidden
+	}
+	return nil
+}
+
+// CanViewResult グループのコラージュ結果を参照・ダウンロードできるか（メンバーのみ）
+func (p *Policy) CanViewResult(ctx context.Context, userID, groupID string) error {
+	return p.requireMember(ctx, userID, groupID)
+}
+
+// ViewableGroups groupIDs のうち userID が参照できるグループの集合を返す
+// 複数グループにまたがる一覧を呼び出し元が見られるものだけに絞り込むために使う
+func (p *Policy) ViewableGroups(ctx context.Context, userID string, groupIDs []string) (map[string]bool, error) {
+	viewable := make(map[string]bool, len(groupIDs))
+	checked := make(map[string]bool, len(groupIDs))
+	for _, id := range groupIDs {
+		if checked[id] {
+			continue
+		}
+		checked[id] = true
+
+		switch err := p.CanViewGroup(ctx, userID, id); err {
+		case nil:
+			viewable[id] = true
+		case ErrForbidden:
+		default:
+			return nil, err
+		}
+	}
+	return viewable, nil
+}
+
+func (p *Policy) requireMember(ctx context.Context, userID, groupID string) error {
+	if userID == "" || groupID == "" {
+		return ErrForbidden
+	}
+	if _, err := p.members.FindByGroupIDAndUserID(ctx, groupID, userID); err != nil {
+		if err == group_member.ErrMemberNotFound {
+			return ErrForbidden
+		}
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,128 @@
+package policy
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/jphacks/os_2502/back/api/internal/domain/group_member"
+)
+
+// fakeMembers グループID → ユーザーID → オーナーかどうか
+type fakeMembers struct {
+	group_member.Repository
+	groups map[string]map[string]bool
+	err    error
+}
+
+func (f fakeMembers) FindByGroupIDAndUserID(_ context.Context, groupID, userID string) (*group_member.GroupMember, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	isOwner, ok := f.groups[groupID][userID]
+	if !ok {
+		return nil, group_member.ErrMemberNotFound
+	}
+	return group_member.NewGroupMember(groupID, userID, isOwner)
+}
+
+func (f fakeMembers) IsOwner(_ context.Context, groupID, userID string) (bool, error) {
+	if f.err != nil {
+		return false, f.err
+	}
+	return f.groups[groupID][userID], nil
+}
+
+func TestPolicy(t *testing.T) {
+	p := New(fakeMembers{groups: map[string]map[string]bool{
+		"group-1": {"owner": true, "member": false},
+		"group-2": {"outsider": true},
+	}})
+
+	checks := map[string]func(context.Context, string, string) error{
+		"CanViewGroup":     p.CanViewGroup,
+		"CanUploadToGroup": p.CanUploadToGroup,
+		"CanManageGroup":   p.CanManageGroup,
+		"CanViewResult":    p.CanViewResult,
+	}
+
+	tests := []struct {
+		check   string
+		userID  string
+		groupID string
+		want    error
+	}{
+		{"CanViewGroup", "owner", "group-1", nil},
+		{"CanViewGroup", "member", "group-1", nil},
+		{"CanViewGroup", "outsider", "group-1", ErrForbidden},
+		{"CanViewGroup", "owner", "missing-group", ErrForbidden},
+
+		{"CanUploadToGroup", "owner", "group-1", nil},
+		{"CanUploadToGroup", "member", "group-1", nil},
+		{"CanUploadToGroup", "outsider", "group-1", ErrForbidden},
+
+		{"CanManageGroup", "owner", "group-1", nil},
+		{"CanManageGroup", "member", "group-1", ErrForbidden},
+		{"CanManageGroup", "outsider", "group-1", ErrForbidden},
+		{"CanManageGroup", "", "group-1", ErrForbidden},
+
+		{"CanViewResult", "owner", "group-1", nil},
+		{"CanViewResult", "member", "group-1", nil},
+		{"CanViewResult", "outsider", "group-1", ErrForbidden},
+		{"CanViewResult", "member", "", ErrForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.check+"/"+tt.userID+"@"+tt.groupID, func(t *testing.T) {
+			if got := checks[tt.check](context.Background(), tt.userID, tt.groupID); got != tt.want {
+				t.Errorf("%s(%q, %q) = %v, want %v", tt.check, tt.userID, tt.groupID, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestViewableGroups(t *testing.T) {
+	p := New(fakeMembers{groups: map[string]map[string]bool{
+		"group-1": {"owner": true, "member": false},
+		"group-2": {"member": true},
+		"group-3": {"owner": true},
+	}})
+
+	tests := []struct {
+		userID string
+		want   []string
+	}{
+		{"owner", []string{"group-1", "group-3"}},
+		{"member", []string{"group-1", "group-2"}},
+		{"outsider", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.userID, func(t *testing.T) {
+			got, err := p.ViewableGroups(context.Background(), tt.userID, []string{"group-1", "group-2", "group-1", "group-3"})
+			if err != nil {
+				t.Fatalf("ViewableGroups() error = %v", err)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("ViewableGroups() = %v, want %v", got, tt.want)
+			}
+			for _, id := range tt.want {
+				if !got[id] {
+					t.Errorf("ViewableGroups() = %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestPolicyPropagatesRepositoryErrors(t *testing.T) {
+	dbErr := errors.New("connection refused")
+	p := New(fakeMembers{err: dbErr})
+
+	if err := p.CanViewGroup(context.Background(), "owner", "group-1"); err != dbErr {
+		t.Errorf("CanViewGroup() = %v, want %v", err, dbErr)
+	}
+	if err := p.CanManageGroup(context.Background(), "owner", "group-1"); err != dbErr {
+		t.Errorf("CanManageGroup() = %v, want %v", err, dbErr)
+	}
+}
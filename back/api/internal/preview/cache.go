@@ -0,0 +1,100 @@
+package preview
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"image"
+	"image/png"
+	"os"
+	"path/filepath"
+)
+
+// Key キャッシュのキー。Version はテンプレートのキャンバスとフレームから作る（LayoutVersion）
+type Key struct {
+	TemplateID string
+	Version    string
+	Width      int
+	Height     int
+	Highlight  int
+}
+
+// ETag プレビュー画像の強い ETag
+func (k Key) ETag() string {
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%d|%s|%s|%dx%d|%d", RendererVersion, k.TemplateID, k.Version, k.Width, k.Height, k.Highlight)))
+	return `"` + hex.EncodeToString(sum[:16]) + `"`
+}
+
+// LayoutVersion テンプレートのキャンバスとフレームのハッシュ
+// フレームやキャンバスが変わると別のバージョンになる
+func LayoutVersion(layout Layout) string {
+	h := sha256.New()
+	fmt.Fprintf(h, "%s|%d|%d", layout.ViewBox, layout.Width, layout.Height)
+	for _, f := range layout.Frames {
+		fmt.Fprintf(h, "|%d:%s", f.Number, f.Path)
+	}
+	return hex.EncodeToString(h.Sum(nil)[:12])
+}
+
+// Cache 描画したプレビューをディスクに保存する
+// {dir}/{templateID}/{version}/{width}x{height}_{highlight}.png
+type Cache struct {
+	dir string
+}
+
+func NewCache(dir string) *Cache {
+	return &Cache{dir: dir}
+}
+
+func (c *Cache) path(k Key) string {
+	return filepath.Join(c.dir, k.TemplateID, fmt.Sprintf("v%d-%s", RendererVersion, k.Version), fmt.Sprintf("%dx%d_%d.png", k.Width, k.Height, k.Highlight))
+}
+
+// Lookup キャッシュ済みのプレビューのパス
+func (c *Cache) Lookup(k Key) (string, bool) {
+	p := c.path(k)
+	if _, err := os.Stat(p); err != nil {
+		return "", false
+	}
+	return p, true
+}
+
+// Store プレビューを PNG で保存してパスを返す
+// 同じテンプレートの古いバージョンは削除する
+func (c *Cache) Store(k Key, img image.Image) (string, error) {
+	p := c.path(k)
+	versionDir := filepath.Dir(p)
+	if err := os.MkdirAll(versionDir, 0755); err != nil {
+		return "", err
+	}
+
+	// 書きかけのファイルを読まれないよう、一時ファイルに書いてから置き換える
+	tmp, err := os.CreateTemp(versionDir, ".preview-*.png")
+	if err != nil {
+		return "", err
+	}
+	if err := png.Encode(tmp, img); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return "", err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return "", err
+	}
+	if err := os.Rename(tmp.Name(), p); err != nil {
+		os.Remove(tmp.Name())
+		return "", err
+	}
+
+	templateDir := filepath.Dir(versionDir)
+	entries, err := os.ReadDir(templateDir)
+	if err == nil {
+		for _, e := range entries {
+			if e.IsDir() && e.Name() != filepath.Base(versionDir) {
+				os.RemoveAll(filepath.Join(templateDir, e.Name()))
+			}
+		}
+	}
+	return p, nil
+}
@@ -0,0 +1,54 @@
+package preview
+
+import (
+	"image"
+	"image/color"
+	"strconv"
+)
+
+// 3x5 のビットマップ数字（フォントを持たずに番号を描くため）
+const (
+	glyphWidth  = 3
+	glyphHeight = 5
+)
+
+var digitGlyphs = [10][glyphHeight]string{
+	{"###", "#.#", "#.#", "#.#", "###"},
+	{".#.", "##.", ".#.", ".#.", "###"},
+	{"###", "..#", "###", "#..", "###"},
+	{"###", "..#", "###", "..#", "###"},
+	{"#.#", "#.#", "###", "..#", "..#"},
+	{"###", "#..", "###", "..#", "###"},
+	{"###", "#..", "###", "#.#", "###"},
+	{"###", "..#", "..#", "..#", "..#"},
+	{"###", "#.#", "###", "#.#", "###"},
+	{"###", "#.#", "###", "..#", "###"},
+}
+
+// drawNumber (cx, cy) を中心に number を描く。1ドットは scale x scale ピクセル
+func drawNumber(canvas *image.RGBA, number, cx, cy, scale int, c color.RGBA) {
+	s := strconv.Itoa(number)
+	width := (len(s)*(glyphWidth+1) - 1) * scale
+	left, top := cx-width/2, cy-glyphHeight*scale/2
+
+	for i, ch := range s {
+		if ch < '0' || ch > '9' {
+			continue
+		}
+		glyph := digitGlyphs[ch-'0']
+		ox := left + i*(glyphWidth+1)*scale
+		for gy, row := range glyph {
+			for gx := 0; gx < glyphWidth; gx++ {
+				if row[gx] != '#' {
+					continue
+				}
+				dot := image.Rect(ox+gx*scale, top+gy*scale, ox+(gx+1)*scale, top+(gy+1)*scale).Intersect(canvas.Bounds())
+				for y := dot.Min.Y; y < dot.Max.Y; y++ {
+					for x := dot.Min.X; x < dot.Max.X; x++ {
+						canvas.SetRGBA(x, y, c)
+					}
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,246 @@
+// Package preview はテンプレートのプレビュー画像（フレームを色分けして番号を振ったもの）を描画する。
+//
+// フレームはコラージュの合成と同じく svgpath でキャンバス全体の座標にラスタライズするので、
+// プレビューの形は実際のコラージュと一致する。
+package preview
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"math"
+
+	"github.com/jphacks/os_2502/back/api/internal/svgpath"
+)
+
+const (
+	// DefaultSize サイズを指定しないときのプレビューの長辺のピクセル数
+	DefaultSize = 512
+	// MinSize, MaxSize 指定できる長辺のピクセル数の範囲
+	MinSize = 32
+	MaxSize = 2048
+
+	// RendererVersion 描画内容を変えたら上げる（キャッシュと ETag が変わる）
+	RendererVersion = 1
+)
+
+// Frame プレビューに描くフレーム（パスは viewBox 座標系）
+type Frame struct {
+	Number int
+	Path   string
+}
+
+// Layout テンプレートのキャンバスとフレーム
+type Layout struct {
+	ViewBox string
+	Width   int
+	Height  int
+	Frames  []Frame
+}
+
+// Options プレビューの大きさと強調するフレーム
+type Options struct {
+	// Size 長辺のピクセル数（縦横比はテンプレートのキャンバスに合わせる）
+	Size int
+	// Highlight 強調するフレームの番号（0 なら強調しない）。他のフレームは薄く描く
+	Highlight int
+}
+
+// palette フレームの色（フレーム番号順に使う）
+var palette = []color.RGBA{
+	{0xE5, 0x73, 0x73, 0xFF}, // red
+	{0x64, 0xB5, 0xF6, 0xFF}, // blue
+	{0x81, 0xC7, 0x84, 0xFF}, // green
+	{0xFF, 0xB7, 0x4D, 0xFF}, // orange
+	{0xBA, 0x68, 0xC8, 0xFF}, // purple
+	{0x4D, 0xD0, 0xE1, 0xFF}, // cyan
+	{0xF0, 0x62, 0x92, 0xFF}, // pink
+	{0xAE, 0xD5, 0x81, 0xFF}, // lime
+	{0xFF, 0xD5, 0x4F, 0xFF}, // amber
+	{0xA1, 0x88, 0x7F, 0xFF}, // brown
+}
+
+var (
+	dimTarget    = color.RGBA{0xEE, 0xEE, 0xEE, 0xFF}
+	outlineColor = color.RGBA{0x21, 0x21, 0x21, 0xFF}
+	textColor    = color.RGBA{0x21, 0x21, 0x21, 0xFF}
+)
+
+// FrameColor フレーム番号に対応する色
+func FrameColor(number int) color.RGBA {
+	if number < 1 {
+		number = 1
+	}
+	return palette[(number-1)%len(palette)]
+}
+
+// Dimensions テンプレートの縦横比を保ったまま長辺を size にしたプレビューの大きさ
+func Dimensions(layout Layout, size int) (int, int) {
+	cw, ch := layout.Width, layout.Height
+	if cw <= 0 || ch <= 0 {
+		cw, ch = 1, 1
+	}
+	if cw >= ch {
+		return size, max(1, int(math.Round(float64(size)*float64(ch)/float64(cw))))
+	}
+	return max(1, int(math.Round(float64(size)*float64(cw)/float64(ch)))), size
+}
+
+// Render プレビューを描画する。背景はコラージュと同じく白
+func Render(layout Layout, opts Options) (*image.RGBA, error) {
+	if opts.Size < MinSize || opts.Size > MaxSize {
+		return nil, fmt.Errorf("preview: size %d out of range", opts.Size)
+	}
+	vb, err := svgpath.ParseViewBox(layout.ViewBox)
+	if err != nil {
+		return nil, err
+	}
+
+	width, height := Dimensions(layout, opts.Size)
+	canvas := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.Draw(canvas, canvas.Bounds(), image.White, image.Point{}, draw.Src)
+
+	masks := make([]*image.Alpha, len(layout.Frames))
+	for i, f := range layout.Frames {
+		p, err := svgpath.Parse(f.Path)
+		if err != nil {
+			return nil, fmt.Errorf("preview: invalid path for frame %d: %w", f.Number, err)
+		}
+		masks[i] = svgpath.Rasterize(vb.ToPixels(p, width, height), width, height)
+
+		c := FrameColor(f.Number)
+		if opts.Highlight > 0 && f.Number != opts.Highlight {
+			c = mix(c, dimTarget, 0.7)
+		}
+		draw.DrawMask(canvas, canvas.Bounds(), image.NewUniform(c), image.Point{}, masks[i], image.Point{}, draw.Over)
+	}
+
+	// 強調するフレームは縁取りする
+	thickness := max(2, min(width, height)/100)
+	for i, f := range layout.Frames {
+		if opts.Highlight > 0 && f.Number == opts.Highlight {
+			draw.DrawMask(canvas, canvas.Bounds(), image.NewUniform(outlineColor), image.Point{}, outline(masks[i], thickness), image.Point{}, draw.Over)
+		}
+	}
+
+	for i, f := range layout.Frames {
+		drawBadge(canvas, masks[i], f.Number)
+	}
+	return canvas, nil
+}
+
+// mix a と b を t (0〜1) の割合で混ぜる
+func mix(a, b color.RGBA, t float64) color.RGBA {
+	l := func(x, y uint8) uint8 { return uint8(math.Round(float64(x)*(1-t) + float64(y)*t)) }
+	return color.RGBA{l(a.R, b.R), l(a.G, b.G), l(a.B, b.B), 0xFF}
+}
+
+// outline マスクの内側の縁（幅 thickness）のマスク
+// 縦横の最小値フィルタで縮めたマスクとの差をとる
+func outline(mask *image.Alpha, thickness int) *image.Alpha {
+	b := mask.Bounds()
+	w, h := b.Dx(), b.Dy()
+
+	minRun := func(get func(int) uint8, n int, set func(int, uint8)) {
+		for i := 0; i < n; i++ {
+			m := uint8(255)
+			for k := max(0, i-thickness); k <= min(n-1, i+thickness); k++ {
+				m = min(m, get(k))
+			}
+			// キャンバスの端は外側とみなす
+			if i < thickness || i >= n-thickness {
+				m = 0
+			}
+			set(i, m)
+		}
+	}
+
+	tmp := image.NewAlpha(b)
+	for y := 0; y < h; y++ {
+		row := mask.Pix[y*mask.Stride : y*mask.Stride+w]
+		out := tmp.Pix[y*tmp.Stride : y*tmp.Stride+w]
+		minRun(func(x int) uint8 { return row[x] }, w, func(x int, v uint8) { out[x] = v })
+	}
+	eroded := image.NewAlpha(b)
+	for x := 0; x < w; x++ {
+		minRun(func(y int) uint8 { return tmp.Pix[y*tmp.Stride+x] }, h, func(y int, v uint8) { eroded.Pix[y*eroded.Stride+x] = v })
+	}
+
+	edge := image.NewAlpha(b)
+	for k, a := range mask.Pix {
+		edge.Pix[k] = a - min(a, eroded.Pix[k])
+	}
+	return edge
+}
+
+// drawBadge フレームの重心に白い円と番号を描く
+func drawBadge(canvas *image.RGBA, mask *image.Alpha, number int) {
+	cx, cy, area, bounds := centroid(mask)
+	if area == 0 {
+		return
+	}
+
+	r := math.Max(6, math.Min(float64(bounds.Dx()), float64(bounds.Dy()))*0.16)
+	r = math.Min(r, float64(min(canvas.Rect.Dx(), canvas.Rect.Dy()))/8)
+	fillCircle(canvas, cx, cy, r, color.RGBA{0xFF, 0xFF, 0xFF, 0xFF})
+
+	scale := max(1, int(math.Round(r*1.1/glyphHeight)))
+	drawNumber(canvas, number, int(math.Round(cx)), int(math.Round(cy)), scale, textColor)
+}
+
+// centroid マスクの重心と面積、不透明部分の外接矩形
+// 重心がフレームの外に出る形（L字など）では外接矩形の中心を使う
+func centroid(mask *image.Alpha) (cx, cy, area float64, bounds image.Rectangle) {
+	b := mask.Bounds()
+	minX, minY, maxX, maxY := b.Max.X, b.Max.Y, b.Min.X, b.Min.Y
+	var sx, sy float64
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		for x := b.Min.X; x < b.Max.X; x++ {
+			a := float64(mask.Pix[mask.PixOffset(x, y)]) / 255
+			if a == 0 {
+				continue
+			}
+			sx += (float64(x) + 0.5) * a
+			sy += (float64(y) + 0.5) * a
+			area += a
+			minX, minY = min(minX, x), min(minY, y)
+			maxX, maxY = max(maxX, x+1), max(maxY, y+1)
+		}
+	}
+	if area == 0 {
+		return 0, 0, 0, image.Rectangle{}
+	}
+	bounds = image.Rect(minX, minY, maxX, maxY)
+	cx, cy = sx/area, sy/area
+	if mask.AlphaAt(int(cx), int(cy)).A < 128 {
+		cx, cy = float64(minX+maxX)/2, float64(minY+maxY)/2
+	}
+	return cx, cy, area, bounds
+}
+
+// fillCircle アンチエイリアスした円を塗る
+func fillCircle(canvas *image.RGBA, cx, cy, r float64, c color.RGBA) {
+	b := canvas.Bounds()
+	x0, x1 := max(b.Min.X, int(cx-r-1)), min(b.Max.X, int(cx+r+2))
+	y0, y1 := max(b.Min.Y, int(cy-r-1)), min(b.Max.Y, int(cy+r+2))
+	for y := y0; y < y1; y++ {
+		for x := x0; x < x1; x++ {
+			d := math.Hypot(float64(x)+0.5-cx, float64(y)+0.5-cy)
+			blend(canvas, x, y, c, math.Max(0, math.Min(1, r-d+0.5)))
+		}
+	}
+}
+
+// blend (x, y) のピクセルに c を a の割合で重ねる
+func blend(canvas *image.RGBA, x, y int, c color.RGBA, a float64) {
+	if a <= 0 {
+		return
+	}
+	i := canvas.PixOffset(x, y)
+	p := canvas.Pix[i : i+4 : i+4]
+	p[0] = uint8(math.Round(float64(p[0])*(1-a) + float64(c.R)*a))
+	p[1] = uint8(math.Round(float64(p[1])*(1-a) + float64(c.G)*a))
+	p[2] = uint8(math.Round(float64(p[2])*(1-a) + float64(c.B)*a))
+	p[3] = 0xFF
+}
@@ -0,0 +1,65 @@
+package preview
+
+import (
+	"image/color"
+	"testing"
+)
+
+var halves = Layout{
+	ViewBox: "0 0 1 1",
+	Width:   1000,
+	Height:  500,
+	Frames: []Frame{
+		{Number: 1, Path: "M 0 0 H 0.5 V 1 H 0 Z"},
+		{Number: 2, Path: "M 0.5 0 H 1 V 1 H 0.5 Z"},
+	},
+}
+
+func TestRender(t *testing.T) {
+	img, err := Render(halves, Options{Size: 200})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b := img.Bounds(); b.Dx() != 200 || b.Dy() != 100 {
+		t.Fatalf("size = %v, want 200x100", b.Size())
+	}
+
+	// フレームの角はフレームの色、中央には白い番号の円
+	if got := img.RGBAAt(5, 5); got != FrameColor(1) {
+		t.Errorf("frame 1 = %v, want %v", got, FrameColor(1))
+	}
+	if got := img.RGBAAt(195, 95); got != FrameColor(2) {
+		t.Errorf("frame 2 = %v, want %v", got, FrameColor(2))
+	}
+	if got := img.RGBAAt(50, 40); got != (color.RGBA{0xFF, 0xFF, 0xFF, 0xFF}) {
+		t.Errorf("badge = %v, want white", got)
+	}
+
+	// 強調すると他のフレームは薄くなり、強調したフレームは縁取りされる
+	img, err = Render(halves, Options{Size: 200, Highlight: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := img.RGBAAt(5, 50); got == FrameColor(1) {
+		t.Errorf("frame 1 is not dimmed: %v", got)
+	}
+	if got := img.RGBAAt(190, 50); got != FrameColor(2) {
+		t.Errorf("highlighted frame = %v, want %v", got, FrameColor(2))
+	}
+	if got := img.RGBAAt(100, 50); got != outlineColor {
+		t.Errorf("outline = %v, want %v", got, outlineColor)
+	}
+}
+
+func TestKeyETag(t *testing.T) {
+	k := Key{TemplateID: "t", Version: LayoutVersion(halves), Width: 200, Height: 100}
+	if k.ETag() == (Key{TemplateID: "t", Version: k.Version, Width: 200, Height: 100, Highlight: 1}).ETag() {
+		t.Error("highlight does not change the ETag")
+	}
+
+	moved := halves
+	moved.Frames = []Frame{halves.Frames[0], {Number: 2, Path: "M 0.6 0 H 1 V 1 H 0.6 Z"}}
+	if LayoutVersion(moved) == k.Version {
+		t.Error("changing a frame does not change the version")
+	}
+}
@@ -0,0 +1,133 @@
+package realtime
+
+import "time"
+
+// EventType クライアントに配信するイベントの種類
+type EventType string
+
+const (
+	EventMemberJoined       EventType = "member_joined"
+	EventMembersFinalized   EventType = "members_finalized"
+	EventMemberReady        EventType = "member_ready"
+	EventMemberUnready      EventType = "member_unready"
+	EventCountdownStarted   EventType = "countdown_started"
+	EventPhotoTakingStarted EventType = "photo_taking_started"
+	EventPhotoUploaded      EventType = "photo_uploaded"
+	EventCollageReady       EventType = "collage_ready"
+	EventSessionFailed      EventType = "session_failed"
+	EventGroupExpired       EventType = "group_expired"
+	EventNextRound          EventType = "next_round"
+	EventDailyCapture       EventType = "daily_capture"
+)
+
+// Event グループ単位で配信されるイベント
+type Event struct {
+	Type       EventType   `json:"type"`
+	GroupID    string      `json:"group_id"`
+	OccurredAt time.Time   `json:"occurred_at"`
+	Payload    interface{} `json:"payload"`
+}
+
+// MemberJoinedPayload メンバー参加
+type MemberJoinedPayload struct {
+	UserID      string `json:"user_id"`
+	MemberCount int    `json:"member_count"`
+}
+
+// MembersFinalizedPayload メンバー確定
+type MembersFinalizedPayload struct {
+	MemberCount int `json:"member_count"`
+}
+
+// MemberReadyPayload メンバーの準備完了（member_unready でも同じ形で送る）
+type MemberReadyPayload struct {
+	UserID      string `json:"user_id"`
+	ReadyCount  int    `json:"ready_count"`
+	MemberCount int    `json:"member_count"`
+}
+
+// CountdownStartedPayload カウントダウン開始
+type CountdownStartedPayload struct {
+	RoundNumber          int       `json:"round_number"`
+	TemplateID           string    `json:"template_id"`
+	CountdownStartedAt   time.Time `json:"countdown_started_at"`
+	ScheduledCaptureTime time.Time `json:"scheduled_capture_time"`
+	// AutoStarted 全員の準備完了でサーバーが自動で開始した場合 true
+	AutoStarted bool `json:"auto_started"`
+	// Devices メンバーごとの端末時計に換算した撮影時刻
+	Devices []DeviceCapture `json:"devices"`
+}
+
+// DeviceCapture 端末時計で表した撮影時刻
+type DeviceCapture struct {
+	UserID string `json:"user_id"`
+	// CaptureAt 端末の時計がこの時刻を指したらシャッターを切る
+	CaptureAt     time.Time `json:"capture_at"`
+	ClockOffsetMS *int64    `json:"clock_offset_ms,omitempty"`
+	ClockState    string    `json:"clock_state"`
+}
+
+// PhotoTakingStartedPayload 撮影時刻になり、写真の受付を開始
+type PhotoTakingStartedPayload struct {
+	CaptureDeadline time.Time `json:"capture_deadline"`
+}
+
+// PhotoUploadedPayload 写真のアップロード
+type PhotoUploadedPayload struct {
+	UserID     string `json:"user_id"`
+	ImageID    string `json:"image_id"`
+	FrameIndex int    `json:"frame_index"`
+}
+
+// CollageReadyPayload コラージュ生成完了
+type CollageReadyPayload struct {
+	RoundNumber int    `json:"round_number"`
+	CollageURL  string `json:"collage_url"`
+	// CollageDay 毎日のコラージュの場合は対象日 (YYYY-MM-DD)
+	CollageDay string `json:"collage_day,omitempty"`
+}
+
+// SessionFailedPayload 締め切りまでに写真が揃わない、またはセッションが終わらず撮影が失敗
+type SessionFailedPayload struct {
+	RoundNumber int `json:"round_number"`
+	// UploadedCount 打ち切った (session_aborted) 場合は数えないので 0
+	UploadedCount int `json:"uploaded_count"`
+	MemberCount   int `json:"member_count"`
+	// Reason 写真が揃わなかった (deadline) か、セッションが終わらず打ち切った (session_aborted) か
+	Reason string `json:"reason"`
+}
+
+// NextRoundPayload 撮影が終わったグループで次のラウンドの準備を開始（全員の準備完了はリセットされる）
+type NextRoundPayload struct {
+	RoundNumber int `json:"round_number"`
+}
+
+// DailyCapturePayload 永続グループの今日の撮影時刻になった
+type DailyCapturePayload struct {
+	CollageDay  string                `json:"collage_day"`
+	ClosesAt    time.Time             `json:"closes_at"`
+	Assignments []DailyPartAssignment `json:"assignments"`
+}
+
+// DailyPartAssignment メンバーが撮影するパーツ
+type DailyPartAssignment struct {
+	UserID     string `json:"user_id"`
+	PartID     string `json:"part_id"`
+	PartNumber int    `json:"part_number"`
+}
+
+// GroupExpiredPayload グループの期限切れ
+type GroupExpiredPayload struct {
+	// Reason expires_at を過ぎた (expired)
+	Reason string `json:"reason"`
+}
+
+// NewEvent 現在時刻でイベントを作成
+func NewEvent(eventType EventType, groupID string, payload interface{}) Event {
+	return Event{
+		Type:       eventType,
+		GroupID:    groupID,
+		OccurredAt: time.Now(),
+		Payload:    payload,
+	}
+}
@@ -0,0 +1,117 @@
+// Package realtime はグループIDごとのプロセス内 pub/sub を提供する。
+//
+// ユースケースやワーカーが Publish したイベントを、そのグループを購読している
+// WebSocket 接続へそのまま配信する。
+package realtime
+
+import (
+	"log"
+	"sync"
+)
+
+// subscriberBuffer 購読者ごとのバッファ。これを超えて溜まった遅い購読者は切断する
+const subscriberBuffer = 32
+
+// Publisher イベントの発行先
+type Publisher interface {
+	Publish(event Event)
+}
+
+// NopPublisher 何もしない Publisher（テストや配信不要な場面用）
+type NopPublisher struct{}
+
+// Publish 何もしない
+func (NopPublisher) Publish(Event) {}
+
+// Subscription グループのイベント購読
+type Subscription struct {
+	groupID string
+	ch      chan Event
+	hub     *Hub
+	once    sync.Once
+}
+
+// Events イベントを受け取るチャネル。購読が終了すると close される
+func (s *Subscription) Events() <-chan Event {
+	return s.ch
+}
+
+// Close 購読を終了
+func (s *Subscription) Close() {
+	s.hub.unsubscribe(s)
+}
+
+// Hub グループIDごとの購読者を管理する
+type Hub struct {
+	mu   sync.RWMutex
+	subs map[string]map[*Subscription]struct{}
+}
+
+// NewHub Hubを作成
+func NewHub() *Hub {
+	return &Hub{
+		subs: make(map[string]map[*Subscription]struct{}),
+	}
+}
+
+// Subscribe グループのイベントを購読
+func (h *Hub) Subscribe(groupID string) *Subscription {
+	sub := &Subscription{
+		groupID: groupID,
+		ch:      make(chan Event, subscriberBuffer),
+		hub:     h,
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.subs[groupID] == nil {
+		h.subs[groupID] = make(map[*Subscription]struct{})
+	}
+	h.subs[groupID][sub] = struct{}{}
+
+	return sub
+}
+
+// Publish グループの全購読者にイベントを配信（ブロックしない）
+func (h *Hub) Publish(event Event) {
+	var slow []*Subscription
+
+	h.mu.RLock()
+	for sub := range h.subs[event.GroupID] {
+		select {
+		case sub.ch <- event:
+		default:
+			slow = append(slow, sub)
+		}
+	}
+	h.mu.RUnlock()
+
+	// 取りこぼしたまま配信を続けると状態がずれるため、遅い購読者は切断して再接続させる
+	for _, sub := range slow {
+		log.Printf("⚠️ Dropping slow subscriber for group %s", event.GroupID)
+		h.unsubscribe(sub)
+	}
+}
+
+// SubscriberCount グループの購読者数
+func (h *Hub) SubscriberCount(groupID string) int {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return len(h.subs[groupID])
+}
+
+func (h *Hub) unsubscribe(sub *Subscription) {
+	sub.once.Do(func() {
+		h.mu.Lock()
+		defer h.mu.Unlock()
+
+		if subs := h.subs[sub.groupID]; subs != nil {
+			delete(subs, sub)
+			if len(subs) == 0 {
+				delete(h.subs, sub.groupID)
+			}
+		}
+		close(sub.ch)
+	})
+}
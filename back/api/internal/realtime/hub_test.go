@@ -0,0 +1,79 @@
+package realtime
+
+import (
+	"testing"
+	"time"
+)
+
+func receive(t *testing.T, sub *Subscription) (Event, bool) {
+	t.Helper()
+	select {
+	case e, ok := <-sub.Events():
+		return e, ok
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for event")
+		return Event{}, false
+	}
+}
+
+func TestHubDeliversToGroupOnly(t *testing.T) {
+	hub := NewHub()
+	a := hub.Subscribe("group-a")
+	defer a.Close()
+	b := hub.Subscribe("group-b")
+	defer b.Close()
+
+	hub.Publish(NewEvent(EventMemberJoined, "group-a", MemberJoinedPayload{UserID: "u1", MemberCount: 2}))
+
+	e, ok := receive(t, a)
+	if !ok || e.Type != EventMemberJoined || e.GroupID != "group-a" {
+		t.Fatalf("got %+v (ok=%v), want member_joined for group-a", e, ok)
+	}
+
+	select {
+	case e := <-b.Events():
+		t.Fatalf("group-b received %+v", e)
+	default:
+	}
+}
+
+func TestSubscriptionClose(t *testing.T) {
+	hub := NewHub()
+	sub := hub.Subscribe("g")
+	sub.Close()
+	sub.Close() // 二重に閉じても panic しない
+
+	if _, ok := <-sub.Events(); ok {
+		t.Error("events channel should be closed")
+	}
+	if n := hub.SubscriberCount("g"); n != 0 {
+		t.Errorf("SubscriberCount = %d, want 0", n)
+	}
+
+	// 購読者がいなくても Publish はブロックしない
+	hub.Publish(NewEvent(EventCollageReady, "g", nil))
+}
+
+func TestHubDropsSlowSubscriber(t *testing.T) {
+	hub := NewHub()
+	slow := hub.Subscribe("g")
+	defer slow.Close()
+
+	for i := 0; i < subscriberBuffer+1; i++ {
+		hub.Publish(NewEvent(EventPhotoUploaded, "g", nil))
+	}
+
+	if n := hub.SubscriberCount("g"); n != 0 {
+		t.Errorf("SubscriberCount = %d, want 0", n)
+	}
+
+	// バッファ済みのイベントを読み切るとチャネルが閉じている
+	for i := 0; i < subscriberBuffer; i++ {
+		if _, ok := receive(t, slow); !ok {
+			t.Fatalf("channel closed after %d events", i)
+		}
+	}
+	if _, ok := receive(t, slow); ok {
+		t.Error("events channel should be closed")
+	}
+}
@@ -0,0 +1,77 @@
+package rendition
+
+import (
+	"strconv"
+	"strings"
+)
+
+// formatPreference 同じ優先度のときに選ぶ順番（ワイルドカードだけならこれまでどおり JPEG）
+var formatPreference = []Format{FormatJPEG, FormatWebP, FormatPNG}
+
+// Negotiate クエリの size / format と Accept ヘッダーから返すレンディションを決める
+// format が無い場合は Accept で q の最も高い形式を選び、同じ q なら明示された形式を優先する。
+// Accept が無いか、どの形式も受け付けない場合は JPEG
+func Negotiate(size, format, accept string) (Size, Format, error) {
+	s, err := ParseSize(size)
+	if err != nil {
+		return "", "", err
+	}
+	if format != "" {
+		f, err := ParseFormat(format)
+		return s, f, err
+	}
+	return s, negotiateFormat(accept), nil
+}
+
+func negotiateFormat(accept string) Format {
+	if strings.TrimSpace(accept) == "" {
+		return FormatJPEG
+	}
+
+	best, bestQ, bestExplicit := FormatJPEG, 0.0, false
+	for _, f := range formatPreference {
+		q, explicit := acceptQuality(accept, f.ContentType())
+		if q > bestQ || (q == bestQ && q > 0 && explicit && !bestExplicit) {
+			best, bestQ, bestExplicit = f, q, explicit
+		}
+	}
+	return best
+}
+
+// acceptQuality Accept で contentType に当てはまる最も具体的な範囲の q と、その範囲が contentType そのものか
+func acceptQuality(accept, contentType string) (float64, bool) {
+	mainType := contentType[:strings.IndexByte(contentType, '/')]
+
+	q, specificity := 0.0, -1
+	for _, part := range strings.Split(accept, ",") {
+		params := strings.Split(part, ";")
+		mediaRange := strings.ToLower(strings.TrimSpace(params[0]))
+
+		var s int
+		switch mediaRange {
+		case contentType:
+			s = 2
+		case mainType + "/*":
+			s = 1
+		case "*/*":
+			s = 0
+		default:
+			continue
+		}
+		if s <= specificity {
+			continue
+		}
+
+		rangeQ := 1.0
+		for _, p := range params[1:] {
+			k, v, ok := strings.Cut(strings.TrimSpace(p), "=")
+			if ok && strings.EqualFold(strings.TrimSpace(k), "q") {
+				if f, err := strconv.ParseFloat(strings.TrimSpace(v), 64); err == nil {
+					rangeQ = min(max(f, 0), 1)
+				}
+			}
+		}
+		q, specificity = rangeQ, s
+	}
+	return q, specificity == 2
+}
@@ -0,0 +1,248 @@
+// Package rendition はコラージュ画像の大きさ（thumb / preview / full）と形式（JPEG / PNG / WebP）の組み合わせを扱う。
+//
+// 描画時に JPEG の thumb / preview / full を保存し、PNG や WebP は最初に要求されたときに
+// full の JPEG から作って保存する（以前に生成したコラージュの thumb / preview も同じように作る）。
+// full の JPEG のキーは collage_results.file_url のまま変えず、ほかは {base}_{size}.{ext} に置く。
+package rendition
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"log"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/jphacks/os_2502/back/api/internal/blobstore"
+	"github.com/jphacks/os_2502/back/api/internal/resample"
+	"github.com/jphacks/os_2502/back/api/internal/webp"
+)
+
+// Size レンディションの大きさ
+type Size string
+
+const (
+	// SizeThumb 履歴一覧用（長辺320ピクセル）
+	SizeThumb Size = "thumb"
+	// SizePreview 画面表示用（長辺1280ピクセル）
+	SizePreview Size = "preview"
+	// SizeFull 描画したままの大きさ
+	SizeFull Size = "full"
+)
+
+// Sizes 描画時に作る大きさ
+var Sizes = []Size{SizeThumb, SizePreview, SizeFull}
+
+// Format レンディションの形式
+type Format string
+
+const (
+	FormatJPEG Format = "jpeg"
+	FormatPNG  Format = "png"
+	FormatWebP Format = "webp"
+)
+
+var (
+	// ErrInvalidSize thumb / preview / full 以外の大きさ
+	ErrInvalidSize = errors.New("rendition: invalid size")
+	// ErrInvalidFormat jpeg / png / webp 以外の形式
+	ErrInvalidFormat = errors.New("rendition: invalid format")
+)
+
+// ParseSize クエリの size を読む（空なら full）
+func ParseSize(s string) (Size, error) {
+	switch Size(strings.ToLower(s)) {
+	case "", SizeFull:
+		return SizeFull, nil
+	case SizeThumb:
+		return SizeThumb, nil
+	case SizePreview:
+		return SizePreview, nil
+	}
+	return "", ErrInvalidSize
+}
+
+// ParseFormat クエリの format を読む（jpg も JPEG として扱う）
+func ParseFormat(s string) (Format, error) {
+	switch strings.ToLower(s) {
+	case "jpeg", "jpg":
+		return FormatJPEG, nil
+	case "png":
+		return FormatPNG, nil
+	case "webp":
+		return FormatWebP, nil
+	}
+	return "", ErrInvalidFormat
+}
+
+// ContentType Content-Type ヘッダーの値
+func (f Format) ContentType() string {
+	return "image/" + string(f)
+}
+
+// Ext 保存するファイルの拡張子
+func (f Format) Ext() string {
+	if f == FormatJPEG {
+		return ".jpg"
+	}
+	return "." + string(f)
+}
+
+// maxEdge 長辺の最大ピクセル数（full は 0）
+func (s Size) maxEdge() int {
+	switch s {
+	case SizeThumb:
+		return 320
+	case SizePreview:
+		return 1280
+	}
+	return 0
+}
+
+// jpegQuality 大きさごとの JPEG の画質（full はこれまでの配信と同じ 90）
+func (s Size) jpegQuality() int {
+	switch s {
+	case SizeThumb:
+		return 80
+	case SizePreview:
+		return 85
+	}
+	return 90
+}
+
+// Key full の JPEG のキーから、大きさと形式に対応するキーを作る
+// collages/g_round1_collage.jpg -> collages/g_round1_collage_thumb.webp
+func Key(fullKey string, size Size, format Format) string {
+	if size == SizeFull && format == FormatJPEG {
+		return fullKey
+	}
+	return strings.TrimSuffix(fullKey, path.Ext(fullKey)) + "_" + string(size) + format.Ext()
+}
+
+// FitLongEdge 縦横比を保ったまま長辺が maxEdge 以下になるサイズ（拡大はしない）
+func FitLongEdge(w, h, maxEdge int) (int, int) {
+	if maxEdge <= 0 || (w <= maxEdge && h <= maxEdge) {
+		return w, h
+	}
+	if w >= h {
+		return maxEdge, max(1, (h*maxEdge+w/2)/w)
+	}
+	return max(1, (w*maxEdge+h/2)/h), maxEdge
+}
+
+// Scale img を size の大きさに縮小する
+func Scale(img image.Image, size Size) image.Image {
+	b := img.Bounds()
+	w, h := FitLongEdge(b.Dx(), b.Dy(), size.maxEdge())
+	if w == b.Dx() && h == b.Dy() {
+		return img
+	}
+	return resample.Resize(img, w, h, resample.CatmullRom)
+}
+
+// Encode img を format で書き込む（JPEG の画質は size で決める。WebP はロスレス）
+func Encode(w io.Writer, img image.Image, size Size, format Format) error {
+	switch format {
+	case FormatJPEG:
+		return jpeg.Encode(w, img, &jpeg.Options{Quality: size.jpegQuality()})
+	case FormatPNG:
+		return (&png.Encoder{CompressionLevel: png.BestSpeed}).Encode(w, img)
+	case FormatWebP:
+		return webp.Encode(w, img)
+	}
+	return ErrInvalidFormat
+}
+
+// Render 描画したコラージュの thumb / preview / full を JPEG で保存する
+func Render(ctx context.Context, store blobstore.Store, fullKey string, img image.Image) error {
+	for _, size := range Sizes {
+		if err := put(ctx, store, Key(fullKey, size, FormatJPEG), Scale(img, size), size, FormatJPEG); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func put(ctx context.Context, store blobstore.Store, key string, img image.Image, size Size, format Format) error {
+	var buf bytes.Buffer
+	if err := Encode(&buf, img, size, format); err != nil {
+		return fmt.Errorf("failed to encode %s: %w", key, err)
+	}
+	return store.Put(ctx, key, &buf, int64(buf.Len()), format.ContentType())
+}
+
+// Object 配信するレンディション
+type Object struct {
+	Key         string
+	Data        []byte
+	ContentType string
+	// ETag 内容から作る強い ETag（保存先によらず同じ値になる）
+	ETag    string
+	ModTime time.Time
+}
+
+// Open レンディションを読み込む。まだ無ければ full の JPEG から作って保存する
+// full の JPEG が無い場合は blobstore.ErrNotFound
+func Open(ctx context.Context, store blobstore.Store, fullKey string, size Size, format Format) (*Object, error) {
+	key := Key(fullKey, size, format)
+	obj, err := get(ctx, store, key)
+	if err != blobstore.ErrNotFound || key == fullKey {
+		return obj, err
+	}
+
+	full, err := get(ctx, store, fullKey)
+	if err != nil {
+		return nil, err
+	}
+	img, _, err := image.Decode(bytes.NewReader(full.Data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode %s: %w", fullKey, err)
+	}
+
+	var buf bytes.Buffer
+	if err := Encode(&buf, Scale(img, size), size, format); err != nil {
+		return nil, fmt.Errorf("failed to encode %s: %w", key, err)
+	}
+	// 保存に失敗しても作った画像は返す（次の要求でまた作る）
+	if err := store.Put(ctx, key, bytes.NewReader(buf.Bytes()), int64(buf.Len()), format.ContentType()); err != nil {
+		log.Printf("⚠️ Failed to save rendition %s: %v", key, err)
+	}
+	return newObject(key, buf.Bytes(), format.ContentType(), time.Now()), nil
+}
+
+func get(ctx context.Context, store blobstore.Store, key string) (*Object, error) {
+	rc, info, err := store.Get(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	data, err := io.ReadAll(rc)
+	if err != nil {
+		return nil, err
+	}
+	contentType := info.ContentType
+	if contentType == "" {
+		contentType = "image/jpeg"
+	}
+	return newObject(key, data, contentType, info.ModTime), nil
+}
+
+func newObject(key string, data []byte, contentType string, modTime time.Time) *Object {
+	sum := sha256.Sum256(data)
+	return &Object{
+		Key:         key,
+		Data:        data,
+		ContentType: contentType,
+		ETag:        `"` + hex.EncodeToString(sum[:16]) + `"`,
+		ModTime:     modTime,
+	}
+}
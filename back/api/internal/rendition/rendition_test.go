@@ -0,0 +1,113 @@
+package rendition
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"testing"
+
+	"github.com/jphacks/os_2502/back/api/internal/blobstore"
+	xwebp "golang.org/x/image/webp"
+)
+
+func TestNegotiate(t *testing.T) {
+	tests := []struct {
+		name                 string
+		size, format, accept string
+		wantSize             Size
+		wantFormat           Format
+		wantErr              error
+	}{
+		{name: "defaults", wantSize: SizeFull, wantFormat: FormatJPEG},
+		{name: "query wins over accept", size: "thumb", format: "png", accept: "image/webp", wantSize: SizeThumb, wantFormat: FormatPNG},
+		{name: "jpg alias", format: "JPG", wantSize: SizeFull, wantFormat: FormatJPEG},
+		{name: "browser accept", size: "preview", accept: "image/avif,image/webp,image/apng,image/*,*/*;q=0.8", wantSize: SizePreview, wantFormat: FormatWebP},
+		{name: "wildcard keeps jpeg", accept: "*/*", wantSize: SizeFull, wantFormat: FormatJPEG},
+		{name: "explicit png", accept: "image/png, */*;q=0.1", wantSize: SizeFull, wantFormat: FormatPNG},
+		{name: "q decides", accept: "image/webp;q=0.5, image/png;q=0.9", wantSize: SizeFull, wantFormat: FormatPNG},
+		{name: "refused webp", accept: "image/webp;q=0, image/*", wantSize: SizeFull, wantFormat: FormatJPEG},
+		{name: "nothing acceptable", accept: "application/json", wantSize: SizeFull, wantFormat: FormatJPEG},
+		{name: "invalid size", size: "huge", wantErr: ErrInvalidSize},
+		{name: "invalid format", format: "gif", wantErr: ErrInvalidFormat},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			size, format, err := Negotiate(tt.size, tt.format, tt.accept)
+			if err != tt.wantErr {
+				t.Fatalf("Negotiate() error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && (size != tt.wantSize || format != tt.wantFormat) {
+				t.Errorf("Negotiate() = %s, %s, want %s, %s", size, format, tt.wantSize, tt.wantFormat)
+			}
+		})
+	}
+}
+
+func TestKey(t *testing.T) {
+	full := "collages/g1_round2_collage.jpg"
+	if got := Key(full, SizeFull, FormatJPEG); got != full {
+		t.Errorf("Key(full, jpeg) = %q, want the original key", got)
+	}
+	if got := Key(full, SizeThumb, FormatWebP); got != "collages/g1_round2_collage_thumb.webp" {
+		t.Errorf("Key(thumb, webp) = %q", got)
+	}
+	if got := Key(full, SizeFull, FormatPNG); got != "collages/g1_round2_collage_full.png" {
+		t.Errorf("Key(full, png) = %q", got)
+	}
+}
+
+func TestRenderAndOpen(t *testing.T) {
+	ctx := context.Background()
+	store := blobstore.NewLocalStore(t.TempDir(), "", nil)
+	full := "collages/g1_round1_collage.jpg"
+
+	img := image.NewRGBA(image.Rect(0, 0, 1600, 800))
+	draw.Draw(img, img.Bounds(), image.NewUniform(color.RGBA{200, 80, 40, 255}), image.Point{}, draw.Src)
+	if err := Render(ctx, store, full, img); err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+
+	// 描画時に JPEG の3つの大きさができている
+	for size, want := range map[Size]image.Point{SizeThumb: {320, 160}, SizePreview: {1280, 640}, SizeFull: {1600, 800}} {
+		obj, err := Open(ctx, store, full, size, FormatJPEG)
+		if err != nil {
+			t.Fatalf("Open(%s): %v", size, err)
+		}
+		cfg, _, err := image.DecodeConfig(bytes.NewReader(obj.Data))
+		if err != nil || cfg.Width != want.X || cfg.Height != want.Y || obj.ContentType != "image/jpeg" {
+			t.Errorf("Open(%s) = %dx%d %s, %v, want %v", size, cfg.Width, cfg.Height, obj.ContentType, err, want)
+		}
+	}
+
+	// WebP と PNG は最初の要求で作って保存する
+	if _, err := store.Stat(ctx, Key(full, SizeThumb, FormatWebP)); err != blobstore.ErrNotFound {
+		t.Fatalf("thumb webp exists before request: %v", err)
+	}
+	obj, err := Open(ctx, store, full, SizeThumb, FormatWebP)
+	if err != nil {
+		t.Fatalf("Open(thumb, webp): %v", err)
+	}
+	decoded, err := xwebp.Decode(bytes.NewReader(obj.Data))
+	if err != nil || decoded.Bounds().Dx() != 320 || obj.ContentType != "image/webp" {
+		t.Fatalf("thumb webp = %v, %v", decoded.Bounds(), err)
+	}
+	again, err := Open(ctx, store, full, SizeThumb, FormatWebP)
+	if err != nil || again.ETag != obj.ETag {
+		t.Errorf("cached thumb webp ETag = %q, %v, want %q", again.ETag, err, obj.ETag)
+	}
+
+	obj, err = Open(ctx, store, full, SizePreview, FormatPNG)
+	if err != nil {
+		t.Fatalf("Open(preview, png): %v", err)
+	}
+	if cfg, err := png.DecodeConfig(bytes.NewReader(obj.Data)); err != nil || cfg.Width != 1280 {
+		t.Errorf("preview png = %+v, %v", cfg, err)
+	}
+
+	if _, err := Open(ctx, store, "collages/missing.jpg", SizeThumb, FormatWebP); err != blobstore.ErrNotFound {
+		t.Errorf("Open(missing) = %v, want ErrNotFound", err)
+	}
+}
@@ -0,0 +1,76 @@
+package resample
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// Kernel はリサンプリングに使うフィルタ関数
+type Kernel struct {
+	Name string
+	// Support はフィルタの半径（ソース1ピクセル単位）
+	Support float64
+	At      func(x float64) float64
+}
+
+var (
+	// Bilinear は三角フィルタ（高速だがやや甘い）
+	Bilinear = Kernel{
+		Name:    "bilinear",
+		Support: 1,
+		At: func(x float64) float64 {
+			x = math.Abs(x)
+			if x < 1 {
+				return 1 - x
+			}
+			return 0
+		},
+	}
+
+	// CatmullRom は B=0, C=0.5 の3次フィルタ（シャープで速度とのバランスが良い）
+	CatmullRom = Kernel{
+		Name:    "catmullrom",
+		Support: 2,
+		At: func(x float64) float64 {
+			x = math.Abs(x)
+			switch {
+			case x < 1:
+				return (1.5*x-2.5)*x*x + 1
+			case x < 2:
+				return ((-0.5*x+2.5)*x-4)*x + 2
+			}
+			return 0
+		},
+	}
+
+	// Lanczos3 は a=3 の Lanczos フィルタ（最も高品質だが最も重い）
+	Lanczos3 = Kernel{
+		Name:    "lanczos",
+		Support: 3,
+		At: func(x float64) float64 {
+			x = math.Abs(x)
+			if x == 0 {
+				return 1
+			}
+			if x < 3 {
+				px := math.Pi * x
+				return 3 * math.Sin(px) * math.Sin(px/3) / (px * px)
+			}
+			return 0
+		},
+	}
+)
+
+// ParseKernel は設定値の名前からカーネルを返す
+func ParseKernel(name string) (Kernel, error) {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "bilinear", "linear":
+		return Bilinear, nil
+	case "catmullrom", "catmull-rom", "bicubic":
+		return CatmullRom, nil
+	case "lanczos", "lanczos3":
+		return Lanczos3, nil
+	}
+	return Kernel{}, fmt.Errorf("resample: unknown kernel %q", name)
+}
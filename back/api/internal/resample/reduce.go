@@ -0,0 +1,194 @@
+package resample
+
+import (
+	"image"
+	"image/draw"
+)
+
+// reduceGap はボックス平均で縮めた後にフィルタへ残す最小の縮小率
+const reduceGap = 2
+
+// reduce は src の sr の範囲を fx x fy ピクセルごとのボックス平均で縮小する。
+// 端の端数ブロックは実際に含まれるピクセルだけで平均する。
+// *image.YCbCr は 4:4:4 の *image.YCbCr に、それ以外は *image.RGBA に縮小する。
+func reduce(src image.Image, sr image.Rectangle, fx, fy int) image.Image {
+	ow := (sr.Dx() + fx - 1) / fx
+	oh := (sr.Dy() + fy - 1) / fy
+
+	if s, ok := src.(*image.YCbCr); ok {
+		dst := image.NewYCbCr(image.Rect(0, 0, ow, oh), image.YCbCrSubsampleRatio444)
+		parallel(oh, func(lo, hi int) { reduceYCbCr(dst, s, sr, fx, fy, lo, hi) })
+		return dst
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, ow, oh))
+	if s, ok := src.(*image.RGBA); ok {
+		parallel(oh, func(lo, hi int) { reduceRGBA(dst, s, sr, fx, fy, lo, hi) })
+		return dst
+	}
+	tmp := image.NewRGBA(image.Rect(0, 0, sr.Dx(), sr.Dy()))
+	draw.Draw(tmp, tmp.Bounds(), src, sr.Min, draw.Src)
+	parallel(oh, func(lo, hi int) { reduceRGBA(dst, tmp, tmp.Bounds(), fx, fy, lo, hi) })
+	return dst
+}
+
+// reciprocal は n で割って丸める代わりに掛ける値を返す（average と組み合わせて使う）
+func reciprocal(n uint32) uint64 {
+	return (1 << 32) / uint64(n)
+}
+
+// average は n 個の合計 sum を reciprocal(n) を使って平均し、四捨五入する。
+// 合計は 255*n 以下なので、除算との差は丸めの境界だけに出る
+func average(sum uint32, recip uint64) uint8 {
+	return uint8((uint64(sum)*recip + 1<<31) >> 32)
+}
+
+// reduceRGBA は出力の [oyLo, oyHi) 行を計算する
+func reduceRGBA(dst, src *image.RGBA, sr image.Rectangle, fx, fy, oyLo, oyHi int) {
+	ow := dst.Rect.Dx()
+	sums := make([]uint32, ow*4)
+	counts := make([]uint32, ow)
+
+	for oy := oyLo; oy < oyHi; oy++ {
+		y0 := sr.Min.Y + oy*fy
+		y1 := min(y0+fy, sr.Max.Y)
+		clear(sums)
+		clear(counts)
+
+		for y := y0; y < y1; y++ {
+			off := src.PixOffset(sr.Min.X, y)
+			row := src.Pix[off : off+sr.Dx()*4]
+			for ox := 0; ox < ow; ox++ {
+				x0 := ox * fx
+				x1 := min(x0+fx, sr.Dx())
+				var r, g, b, a uint32
+				for p := x0 * 4; p < x1*4; p += 4 {
+					r += uint32(row[p])
+					g += uint32(row[p+1])
+					b += uint32(row[p+2])
+					a += uint32(row[p+3])
+				}
+				q := ox * 4
+				sums[q] += r
+				sums[q+1] += g
+				sums[q+2] += b
+				sums[q+3] += a
+				counts[ox] += uint32(x1 - x0)
+			}
+		}
+
+		out := dst.Pix[oy*dst.Stride:]
+		for ox := 0; ox < ow; ox++ {
+			n := counts[ox]
+			q := ox * 4
+			out[q] = uint8((sums[q] + n/2) / n)
+			out[q+1] = uint8((sums[q+1] + n/2) / n)
+			out[q+2] = uint8((sums[q+2] + n/2) / n)
+			out[q+3] = uint8((sums[q+3] + n/2) / n)
+		}
+	}
+}
+
+// reduceYCbCr は Y と Cb/Cr をそれぞれの解像度のまま平均して 4:4:4 の dst に書き込む。
+// 色差のサブサンプリング比に関係なく、ブロックに対応する色差サンプルだけを読む。
+// 出力の [oyLo, oyHi) 行を計算する。
+func reduceYCbCr(dst, src *image.YCbCr, sr image.Rectangle, fx, fy, oyLo, oyHi int) {
+	ow := dst.Rect.Dx()
+	sw := sr.Dx()
+
+	// COffset は行成分と列成分の和に分解できる
+	base := src.COffset(sr.Min.X, sr.Min.Y)
+	ccol := make([]int, sw)
+	for x := range ccol {
+		ccol[x] = src.COffset(sr.Min.X+x, sr.Min.Y) - base
+	}
+	cw := ccol[sw-1] + 1
+
+	// 出力の列ごとの Y と色差の範囲（行数を掛ける前のサンプル数）
+	type span struct{ x0, x1, c0, c1 int }
+	spans := make([]span, ow)
+	for ox := range spans {
+		x0 := ox * fx
+		x1 := min(x0+fx, sw)
+		spans[ox] = span{x0: x0, x1: x1, c0: ccol[x0], c1: ccol[x1-1] + 1}
+	}
+
+	// まず縦方向にブロック内の行を列ごとに足し込み、その後で横方向に束ねる
+	yCols := make([]uint32, sw)
+	cbCols := make([]uint32, cw)
+	crCols := make([]uint32, cw)
+
+	for oy := oyLo; oy < oyHi; oy++ {
+		y0 := sr.Min.Y + oy*fy
+		y1 := min(y0+fy, sr.Max.Y)
+		clear(yCols)
+		clear(cbCols)
+		clear(crCols)
+
+		for y := y0; y < y1; y++ {
+			off := src.YOffset(sr.Min.X, y)
+			addRow(yCols, src.Y[off:off+sw])
+		}
+
+		// ブロックの行範囲に対応する色差の行（重複なし）
+		cRows := 0
+		prev := -1
+		for y := y0; y < y1; y++ {
+			crow := src.COffset(sr.Min.X, y)
+			if crow == prev {
+				continue
+			}
+			prev = crow
+			cRows++
+			addRow(cbCols, src.Cb[crow:crow+cw])
+			addRow(crCols, src.Cr[crow:crow+cw])
+		}
+
+		// ブロックの大きさは端以外で同じなので、割り算の代わりの逆数は変わったときだけ求める
+		rows := uint32(y1 - y0)
+		var yn, cn uint32
+		var yRecip, cRecip uint64
+		yOut := dst.Y[oy*dst.YStride : oy*dst.YStride+ow]
+		cbOut := dst.Cb[oy*dst.CStride : oy*dst.CStride+ow]
+		crOut := dst.Cr[oy*dst.CStride : oy*dst.CStride+ow]
+		for ox, sp := range spans {
+			if n := uint32(sp.x1-sp.x0) * rows; n != yn {
+				yn, yRecip = n, reciprocal(n)
+			}
+			if n := uint32(sp.c1-sp.c0) * uint32(cRows); n != cn {
+				cn, cRecip = n, reciprocal(n)
+			}
+
+			var ySum uint32
+			for _, v := range yCols[sp.x0:sp.x1] {
+				ySum += v
+			}
+			var cbSum, crSum uint32
+			for c := sp.c0; c < sp.c1; c++ {
+				cbSum += cbCols[c]
+				crSum += crCols[c]
+			}
+
+			yOut[ox] = average(ySum, yRecip)
+			cbOut[ox] = average(cbSum, cRecip)
+			crOut[ox] = average(crSum, cRecip)
+		}
+	}
+}
+
+// addRow は row の各バイトを cols の同じ列に足し込む（4列ずつ展開して境界チェックを減らす）
+func addRow(cols []uint32, row []byte) {
+	cols = cols[:len(row)]
+	x := 0
+	for ; x+4 <= len(row); x += 4 {
+		c := cols[x : x+4 : x+4]
+		r := row[x : x+4 : x+4]
+		c[0] += uint32(r[0])
+		c[1] += uint32(r[1])
+		c[2] += uint32(r[2])
+		c[3] += uint32(r[3])
+	}
+	for ; x < len(row); x++ {
+		cols[x] += uint32(row[x])
+	}
+}
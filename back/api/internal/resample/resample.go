@@ -0,0 +1,394 @@
+// Package resample は画像の高品質リサイズを提供する。
+//
+// 横方向→縦方向の2パスの分離型フィルタで、*image.RGBA と *image.YCbCr は
+// ピクセルバッファを直接読み出す（image.At/Set を経由しない）。
+// *image.YCbCr は最後まで YCbCr のまま処理し、RGB への変換は出力ピクセルだけにする。
+// 大きな縮小ではボックス平均で先に縮め、各パスは行単位で並列に処理する。
+package resample
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+	"math"
+	"runtime"
+	"sync"
+)
+
+// minRowsPerWorker はゴルーチンを分ける最小の行数（小さい画像では並列化しない）
+const minRowsPerWorker = 32
+
+// FitMode は出力サイズとアスペクト比が異なる場合の合わせ方
+type FitMode int
+
+const (
+	// Cover は出力全体を覆うように拡大縮小し、はみ出た部分を中央基準で切り取る
+	Cover FitMode = iota
+	// Contain は全体が収まるように拡大縮小し、余白は透明のまま中央に配置する
+	Contain
+	// Stretch はアスペクト比を無視して出力サイズに合わせる
+	Stretch
+)
+
+// Resize は src 全体を width x height にリサイズする
+func Resize(src image.Image, width, height int, k Kernel) *image.RGBA {
+	return resizeRect(src, src.Bounds(), width, height, k)
+}
+
+// Fit は mode に従って src を width x height に収める
+func Fit(src image.Image, width, height int, mode FitMode, k Kernel) *image.RGBA {
+	if width <= 0 || height <= 0 {
+		return image.NewRGBA(image.Rect(0, 0, 0, 0))
+	}
+
+	sb := src.Bounds()
+	sw, sh := sb.Dx(), sb.Dy()
+	if sw <= 0 || sh <= 0 {
+		return image.NewRGBA(image.Rect(0, 0, width, height))
+	}
+
+	switch mode {
+	case Cover:
+		return resizeRect(src, coverCrop(sb, width, height), width, height, k)
+
+	case Contain:
+		scale := math.Min(float64(width)/float64(sw), float64(height)/float64(sh))
+		dw := clamp(int(math.Round(float64(sw)*scale)), 1, width)
+		dh := clamp(int(math.Round(float64(sh)*scale)), 1, height)
+
+		resized := resizeRect(src, sb, dw, dh, k)
+		if dw == width && dh == height {
+			return resized
+		}
+
+		dst := image.NewRGBA(image.Rect(0, 0, width, height))
+		off := image.Pt((width-dw)/2, (height-dh)/2)
+		draw.Draw(dst, resized.Bounds().Add(off), resized, image.Point{}, draw.Src)
+		return dst
+	}
+
+	return resizeRect(src, sb, width, height, k)
+}
+
+// coverCrop は出力のアスペクト比に合わせた中央の切り出し範囲を返す
+func coverCrop(sb image.Rectangle, width, height int) image.Rectangle {
+	sw, sh := sb.Dx(), sb.Dy()
+
+	// sw/sh と width/height を整数演算で比較
+	if sw*height > sh*width {
+		cw := clamp(int(math.Round(float64(sh)*float64(width)/float64(height))), 1, sw)
+		x0 := sb.Min.X + (sw-cw)/2
+		return image.Rect(x0, sb.Min.Y, x0+cw, sb.Max.Y)
+	}
+
+	ch := clamp(int(math.Round(float64(sw)*float64(height)/float64(width))), 1, sh)
+	y0 := sb.Min.Y + (sh-ch)/2
+	return image.Rect(sb.Min.X, y0, sb.Max.X, y0+ch)
+}
+
+// resizeRect は src の sr の範囲を width x height にリサンプリングする
+func resizeRect(src image.Image, sr image.Rectangle, width, height int, k Kernel) *image.RGBA {
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	sr = sr.Intersect(src.Bounds())
+	if width <= 0 || height <= 0 || sr.Empty() {
+		return dst
+	}
+
+	// 大幅な縮小では先に整数倍のボックス平均で縮めてからフィルタをかける。
+	// フィルタに残す縮小率は reduceGap 倍以上にして画質を保つ
+	fx := max(sr.Dx()/(width*reduceGap), 1)
+	fy := max(sr.Dy()/(height*reduceGap), 1)
+	if fx > 1 || fy > 1 {
+		src = reduce(src, sr, fx, fy)
+		sr = src.Bounds()
+	}
+
+	xw := weights(sr.Dx(), width, k)
+	yw := weights(sr.Dy(), height, k)
+	if s, ok := src.(*image.YCbCr); ok {
+		resizeYCbCr(dst, s, sr, xw, yw)
+	} else {
+		resizeRGBA(dst, newRowReader(src, sr), sr, xw, yw)
+	}
+	return dst
+}
+
+// resizeRGBA は乗算済み RGBA の4チャンネルで2パスのフィルタをかける
+func resizeRGBA(dst *image.RGBA, rows *rowReader, sr image.Rectangle, xw, yw []contrib) {
+	sw, sh := sr.Dx(), sr.Dy()
+
+	// パス1: 横方向。ソースの各行を width 列に縮約して8bitの中間バッファへ
+	stride := len(xw) * 4
+	tmp := make([]uint8, sh*stride)
+	// 変換は一度だけにして各ゴルーチンで共有する（行の変換先のバッファだけをゴルーチンごとに持つ）
+	parallel(sh, func(lo, hi int) {
+		buf := make([]byte, sw*4)
+		for y := lo; y < hi; y++ {
+			row := rows.row(y, buf)
+			out := tmp[y*stride : (y+1)*stride]
+			for x, c := range xw {
+				r, g, b, a := int32(half), int32(half), int32(half), int32(half)
+				px := row[c.start*4 : (c.start+len(c.weights))*4]
+				for j, w := range c.weights {
+					q := px[j*4 : j*4+4 : j*4+4]
+					r += w * int32(q[0])
+					g += w * int32(q[1])
+					b += w * int32(q[2])
+					a += w * int32(q[3])
+				}
+				storePremul(out[x*4:x*4+4:x*4+4], r, g, b, a)
+			}
+		}
+	})
+
+	resampleColumns(tmp, stride, yw, func(y int, acc []int32) {
+		out := dst.Pix[y*dst.Stride : y*dst.Stride+stride]
+		for i := 0; i < stride; i += 4 {
+			storePremul(out[i:i+4:i+4], acc[i], acc[i+1], acc[i+2], acc[i+3])
+		}
+	})
+}
+
+// resizeYCbCr は Y/Cb/Cr の3チャンネルのまま2パスのフィルタをかけ、出力ピクセルだけを RGB に変換する。
+// YCbCr から RGB への変換はアフィンなので、丸めを除けば RGB でフィルタをかけるのと同じ結果になる
+func resizeYCbCr(dst *image.RGBA, src *image.YCbCr, sr image.Rectangle, xw, yw []contrib) {
+	sw, sh := sr.Dx(), sr.Dy()
+
+	// COffset は行成分と列成分の和に分解できる。4:4:4 なら列成分は x そのもの
+	base := src.COffset(sr.Min.X, sr.Min.Y)
+	ccol := make([]int, sw)
+	fullChroma := true
+	for x := range ccol {
+		ccol[x] = src.COffset(sr.Min.X+x, sr.Min.Y) - base
+		fullChroma = fullChroma && ccol[x] == x
+	}
+
+	// パス1: 横方向。各行の Y/Cb/Cr を width 列に縮約して中間バッファへ
+	stride := len(xw) * 3
+	tmp := make([]uint8, sh*stride)
+	parallel(sh, func(lo, hi int) {
+		cbBuf := make([]byte, sw)
+		crBuf := make([]byte, sw)
+		for y := lo; y < hi; y++ {
+			yi := src.YOffset(sr.Min.X, sr.Min.Y+y)
+			ci := src.COffset(sr.Min.X, sr.Min.Y+y)
+			ys := src.Y[yi : yi+sw]
+			cbs, crs := cbBuf, crBuf
+			if fullChroma {
+				cbs, crs = src.Cb[ci:ci+sw], src.Cr[ci:ci+sw]
+			} else {
+				for x, c := range ccol {
+					cbBuf[x] = src.Cb[ci+c]
+					crBuf[x] = src.Cr[ci+c]
+				}
+			}
+
+			out := tmp[y*stride : (y+1)*stride]
+			for x, c := range xw {
+				n := len(c.weights)
+				yp, cbp, crp := ys[c.start:c.start+n], cbs[c.start:c.start+n], crs[c.start:c.start+n]
+				yv, cb, cr := int32(half), int32(half), int32(half)
+				for j, w := range c.weights {
+					yv += w * int32(yp[j])
+					cb += w * int32(cbp[j])
+					cr += w * int32(crp[j])
+				}
+				q := out[x*3 : x*3+3 : x*3+3]
+				q[0] = clampByte(yv >> precisionBits)
+				q[1] = clampByte(cb >> precisionBits)
+				q[2] = clampByte(cr >> precisionBits)
+			}
+		}
+	})
+
+	width := len(xw)
+	resampleColumns(tmp, stride, yw, func(y int, acc []int32) {
+		out := dst.Pix[y*dst.Stride : y*dst.Stride+width*4]
+		for x := 0; x < width; x++ {
+			a := acc[x*3 : x*3+3 : x*3+3]
+			r, g, b := color.YCbCrToRGB(
+				clampByte(a[0]>>precisionBits),
+				clampByte(a[1]>>precisionBits),
+				clampByte(a[2]>>precisionBits),
+			)
+			q := out[x*4 : x*4+4 : x*4+4]
+			q[0], q[1], q[2], q[3] = r, g, b, 0xff
+		}
+	})
+}
+
+// resampleColumns はパス2: 縦方向。寄与する行を順に読んで行単位で積和し、
+// 出力の y 行目の固定小数点の結果を store に渡す
+func resampleColumns(tmp []uint8, stride int, yw []contrib, store func(y int, acc []int32)) {
+	parallel(len(yw), func(lo, hi int) {
+		acc := make([]int32, stride)
+		for y := lo; y < hi; y++ {
+			c := yw[y]
+			for i := range acc {
+				acc[i] = half
+			}
+			for j, w := range c.weights {
+				in := tmp[(c.start+j)*stride : (c.start+j+1)*stride]
+				for i, v := range in {
+					acc[i] += w * int32(v)
+				}
+			}
+			store(y, acc)
+		}
+	})
+}
+
+// 重みは precisionBits ビットの固定小数点で持つ
+const (
+	precisionBits = 14
+	half          = 1 << (precisionBits - 1)
+)
+
+// storePremul は固定小数点の積和結果を8bitに丸めて書き込む。
+// 乗算済みアルファなので、リンギングで色がアルファを超えないよう抑える
+func storePremul(out []uint8, r, g, b, a int32) {
+	av := clampByte(a >> precisionBits)
+	out[0] = min(clampByte(r>>precisionBits), av)
+	out[1] = min(clampByte(g>>precisionBits), av)
+	out[2] = min(clampByte(b>>precisionBits), av)
+	out[3] = av
+}
+
+// contrib は出力1ピクセルに寄与するソース範囲と重み
+type contrib struct {
+	start   int
+	weights []int32
+}
+
+// weights は srcLen → dstLen の1次元リサンプリングの重み表を作る
+func weights(srcLen, dstLen int, k Kernel) []contrib {
+	scale := float64(srcLen) / float64(dstLen)
+	// 縮小時はフィルタを広げてエイリアシングを防ぐ
+	filterScale := math.Max(scale, 1)
+	support := k.Support * filterScale
+
+	out := make([]contrib, dstLen)
+	for i := range out {
+		center := (float64(i) + 0.5) * scale
+		lo := max(int(math.Floor(center-support)), 0)
+		hi := min(int(math.Ceil(center+support)), srcLen)
+
+		fw := make([]float64, 0, hi-lo)
+		var sum float64
+		for j := lo; j < hi; j++ {
+			w := k.At((float64(j) + 0.5 - center) / filterScale)
+			fw = append(fw, w)
+			sum += w
+		}
+
+		// 端の切り捨て分を含めて正規化し、前後のゼロ重みは詰める
+		ws := make([]int32, len(fw))
+		if sum != 0 {
+			for j, w := range fw {
+				ws[j] = int32(math.Round(w / sum * (1 << precisionBits)))
+			}
+		}
+		for len(ws) > 1 && ws[0] == 0 {
+			ws = ws[1:]
+			lo++
+		}
+		for len(ws) > 1 && ws[len(ws)-1] == 0 {
+			ws = ws[:len(ws)-1]
+		}
+
+		out[i] = contrib{start: lo, weights: ws}
+	}
+	return out
+}
+
+// rowReader はソース画像の1行を乗算済み RGBA のバイト列として返す（YCbCr は resizeYCbCr で扱う）
+// 作成後は読み取りのみなので、複数のゴルーチンから使える
+type rowReader struct {
+	r image.Rectangle
+
+	rgba  *image.RGBA
+	nrgba *image.NRGBA
+}
+
+func newRowReader(src image.Image, r image.Rectangle) *rowReader {
+	rr := &rowReader{r: r}
+	switch s := src.(type) {
+	case *image.RGBA:
+		rr.rgba = s
+	case *image.NRGBA:
+		rr.nrgba = s
+	default:
+		// その他の形式は一度だけ RGBA に変換する
+		tmp := image.NewRGBA(image.Rect(0, 0, r.Dx(), r.Dy()))
+		draw.Draw(tmp, tmp.Bounds(), src, r.Min, draw.Src)
+		rr.rgba = tmp
+		rr.r = tmp.Bounds()
+	}
+	return rr
+}
+
+// row は y 行目を乗算済み RGBA で返す。変換が必要な形式では buf（幅×4バイト）に書き込む
+func (rr *rowReader) row(y int, buf []byte) []byte {
+	sy := rr.r.Min.Y + y
+	w := rr.r.Dx()
+
+	switch {
+	case rr.rgba != nil:
+		off := rr.rgba.PixOffset(rr.r.Min.X, sy)
+		return rr.rgba.Pix[off : off+w*4]
+
+	default:
+		s := rr.nrgba
+		off := s.PixOffset(rr.r.Min.X, sy)
+		src := s.Pix[off : off+w*4]
+		for p := 0; p < len(src); p += 4 {
+			a := uint32(src[p+3])
+			buf[p] = uint8(uint32(src[p]) * a / 0xff)
+			buf[p+1] = uint8(uint32(src[p+1]) * a / 0xff)
+			buf[p+2] = uint8(uint32(src[p+2]) * a / 0xff)
+			buf[p+3] = uint8(a)
+		}
+		return buf
+	}
+}
+
+// parallel は [0, n) を GOMAXPROCS 個程度の区間に分けて fn を並列に実行する
+func parallel(n int, fn func(lo, hi int)) {
+	workers := min(runtime.GOMAXPROCS(0), n/minRowsPerWorker)
+	if workers <= 1 {
+		fn(0, n)
+		return
+	}
+
+	var wg sync.WaitGroup
+	chunk := (n + workers - 1) / workers
+	for lo := 0; lo < n; lo += chunk {
+		hi := min(lo+chunk, n)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			fn(lo, hi)
+		}()
+	}
+	wg.Wait()
+}
+
+func clampByte(v int32) uint8 {
+	if v <= 0 {
+		return 0
+	}
+	if v >= 255 {
+		return 255
+	}
+	return uint8(v)
+}
+
+func clamp(v, lo, hi int) int {
+	if v < lo {
+		return lo
+	}
+	if v > hi {
+		return hi
+	}
+	return v
+}
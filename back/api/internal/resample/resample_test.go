@@ -0,0 +1,246 @@
+package resample
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+	"testing"
+)
+
+func TestFit(t *testing.T) {
+	tests := []struct {
+		name   string
+		src    image.Rectangle
+		w, h   int
+		mode   FitMode
+		opaque image.Point // 不透明であるべき点
+		clear  image.Point // 透明であるべき点（Contain の余白）
+	}{
+		{name: "cover wide to square", src: image.Rect(0, 0, 400, 200), w: 100, h: 100, mode: Cover, opaque: image.Pt(0, 0), clear: image.Pt(-1, -1)},
+		{name: "cover tall to wide", src: image.Rect(0, 0, 200, 400), w: 160, h: 90, mode: Cover, opaque: image.Pt(159, 89), clear: image.Pt(-1, -1)},
+		{name: "contain wide to square", src: image.Rect(0, 0, 400, 200), w: 100, h: 100, mode: Contain, opaque: image.Pt(50, 50), clear: image.Pt(50, 5)},
+		{name: "contain upscale", src: image.Rect(0, 0, 10, 20), w: 100, h: 100, mode: Contain, opaque: image.Pt(50, 50), clear: image.Pt(5, 50)},
+		{name: "stretch", src: image.Rect(0, 0, 30, 10), w: 10, h: 30, mode: Stretch, opaque: image.Pt(9, 29), clear: image.Pt(-1, -1)},
+	}
+
+	for _, k := range []Kernel{Bilinear, CatmullRom, Lanczos3} {
+		for _, tt := range tests {
+			t.Run(k.Name+"/"+tt.name, func(t *testing.T) {
+				dst := Fit(solidYCbCr(tt.src, color.RGBA{R: 200, G: 100, B: 50, A: 255}), tt.w, tt.h, tt.mode, k)
+
+				if got := dst.Bounds().Size(); got != image.Pt(tt.w, tt.h) {
+					t.Fatalf("Fit() size = %v, want %dx%d", got, tt.w, tt.h)
+				}
+				if a := dst.RGBAAt(tt.opaque.X, tt.opaque.Y).A; a != 0xff {
+					t.Errorf("alpha at %v = %d, want 255", tt.opaque, a)
+				}
+				if tt.clear.X >= 0 {
+					if a := dst.RGBAAt(tt.clear.X, tt.clear.Y).A; a != 0 {
+						t.Errorf("alpha at %v = %d, want 0", tt.clear, a)
+					}
+				}
+			})
+		}
+	}
+}
+
+func TestResizePreservesFlatColor(t *testing.T) {
+	want := color.RGBA{R: 200, G: 100, B: 50, A: 255}
+	src := solidYCbCr(image.Rect(0, 0, 97, 53), want)
+	// YCbCr の変換誤差を除くため、基準色は元画像から取る
+	want.R, want.G, want.B = color.YCbCrToRGB(src.Y[0], src.Cb[0], src.Cr[0])
+
+	for _, k := range []Kernel{Bilinear, CatmullRom, Lanczos3} {
+		for _, size := range []image.Point{{31, 17}, {200, 120}} {
+			dst := Resize(src, size.X, size.Y, k)
+			for y := 0; y < size.Y; y++ {
+				for x := 0; x < size.X; x++ {
+					got := dst.RGBAAt(x, y)
+					if diff(got.R, want.R) > 1 || diff(got.G, want.G) > 1 || diff(got.B, want.B) > 1 || got.A != 0xff {
+						t.Fatalf("%s %v: pixel (%d,%d) = %v, want %v", k.Name, size, x, y, got, want)
+					}
+				}
+			}
+		}
+	}
+}
+
+// 変換が必要なソースでも、並列の各ワーカーが同じ rowReader を共有して同じ結果になる
+func TestResizeSharedRowReader(t *testing.T) {
+	r := image.Rect(0, 0, 64, 400)
+	rgba := image.NewRGBA(r)
+	nrgba := image.NewNRGBA(r)
+	gray := image.NewGray(r)
+	for y := 0; y < r.Dy(); y++ {
+		for x := 0; x < r.Dx(); x++ {
+			v := uint8(x*3 + y)
+			rgba.SetRGBA(x, y, color.RGBA{v, v, v, 0xff})
+			nrgba.SetNRGBA(x, y, color.NRGBA{v, v, v, 0xff})
+			gray.SetGray(x, y, color.Gray{v})
+		}
+	}
+
+	want := Resize(rgba, 32, 200, CatmullRom)
+	for name, src := range map[string]image.Image{"nrgba": nrgba, "gray": gray} {
+		if got := Resize(src, 32, 200, CatmullRom); string(got.Pix) != string(want.Pix) {
+			t.Errorf("%s: result differs from RGBA source", name)
+		}
+	}
+}
+
+// YCbCr のままフィルタをかけても、RGB に変換してからかけた場合とほぼ同じになる（ボックス平均での縮小を含む）
+func TestResizeYCbCrMatchesRGBA(t *testing.T) {
+	src := image.NewYCbCr(image.Rect(0, 0, 400, 300), image.YCbCrSubsampleRatio420)
+	for y := 0; y < 300; y++ {
+		for x := 0; x < 400; x++ {
+			src.Y[src.YOffset(x, y)] = uint8(40 + (x+y)/5)
+			c := src.COffset(x, y)
+			src.Cb[c] = uint8(100 + x/8)
+			src.Cr[c] = uint8(160 - y/8)
+		}
+	}
+	rgba := image.NewRGBA(src.Bounds())
+	draw.Draw(rgba, rgba.Bounds(), src, image.Point{}, draw.Src)
+
+	for _, size := range []image.Point{{350, 250}, {60, 45}} {
+		want := Resize(rgba, size.X, size.Y, Bilinear)
+		got := Resize(src, size.X, size.Y, Bilinear)
+		for i := range got.Pix {
+			if d := diff(got.Pix[i], want.Pix[i]); d > 2 {
+				t.Fatalf("%v: byte %d = %d, want %d", size, i, got.Pix[i], want.Pix[i])
+			}
+		}
+	}
+}
+
+func TestCoverCropIsCentered(t *testing.T) {
+	// 左右で色が異なる画像を正方形に Cover すると、中央の境界が中央に来る
+	src := image.NewRGBA(image.Rect(0, 0, 300, 100))
+	for y := 0; y < 100; y++ {
+		for x := 0; x < 300; x++ {
+			c := color.RGBA{A: 255}
+			if x >= 150 {
+				c.R = 255
+			}
+			src.SetRGBA(x, y, c)
+		}
+	}
+
+	dst := Fit(src, 50, 50, Cover, Bilinear)
+	if r := dst.RGBAAt(5, 25).R; r != 0 {
+		t.Errorf("left red = %d, want 0", r)
+	}
+	if r := dst.RGBAAt(44, 25).R; r != 255 {
+		t.Errorf("right red = %d, want 255", r)
+	}
+}
+
+func TestParseKernel(t *testing.T) {
+	tests := []struct {
+		name    string
+		want    string
+		wantErr bool
+	}{
+		{name: "lanczos", want: "lanczos"},
+		{name: "Catmull-Rom", want: "catmullrom"},
+		{name: " bilinear ", want: "bilinear"},
+		{name: "nearest", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k, err := ParseKernel(tt.name)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseKernel() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && k.Name != tt.want {
+				t.Errorf("ParseKernel() = %s, want %s", k.Name, tt.want)
+			}
+		})
+	}
+}
+
+// nearestNeighbor は以前 CollageGenerator で使っていた実装（ベンチマークの比較用）
+func nearestNeighbor(img image.Image, width, height int) image.Image {
+	bounds := img.Bounds()
+	srcW := bounds.Dx()
+	srcH := bounds.Dy()
+
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			srcX := x * srcW / width
+			srcY := y * srcH / height
+			dst.Set(x, y, img.At(bounds.Min.X+srcX, bounds.Min.Y+srcY))
+		}
+	}
+
+	return dst
+}
+
+// ベンチマーク用の写真サイズとフレームサイズ
+var benchmarkCases = []struct {
+	name string
+	src  image.Point
+	dst  image.Point
+}{
+	{name: "12MP_to_500", src: image.Pt(4032, 3024), dst: image.Pt(500, 500)},
+	{name: "1440x1080_to_500", src: image.Pt(1440, 1080), dst: image.Pt(500, 500)},
+	{name: "1080x1080_to_1000", src: image.Pt(1080, 1080), dst: image.Pt(1000, 1000)},
+}
+
+func benchmarkSource(size image.Point) image.Image {
+	src := image.NewYCbCr(image.Rect(0, 0, size.X, size.Y), image.YCbCrSubsampleRatio420)
+	for i := range src.Y {
+		src.Y[i] = uint8(i)
+	}
+	for i := range src.Cb {
+		src.Cb[i] = uint8(i >> 3)
+		src.Cr[i] = uint8(i >> 5)
+	}
+	return src
+}
+
+// BenchmarkResize は以前の nearestNeighbor ループと各カーネルの Cover を比較する。
+// 同程度のサイズへのリサイズ (1080x1080_to_1000) では bilinear の方が速い。
+// 大きな縮小では nearestNeighbor が出力ピクセル分しか読まないのに対し、フィルタは元画像の全ピクセルを読むので、
+// 1コアでは bilinear でも nearestNeighbor より遅い（12MP_to_500 で約2倍）
+func BenchmarkResize(b *testing.B) {
+	for _, bc := range benchmarkCases {
+		src := benchmarkSource(bc.src)
+
+		b.Run(bc.name+"/nearest-loop", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				nearestNeighbor(src, bc.dst.X, bc.dst.Y)
+			}
+		})
+		for _, k := range []Kernel{Bilinear, CatmullRom, Lanczos3} {
+			b.Run(bc.name+"/"+k.Name, func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					Fit(src, bc.dst.X, bc.dst.Y, Cover, k)
+				}
+			})
+		}
+	}
+}
+
+func solidYCbCr(r image.Rectangle, c color.RGBA) *image.YCbCr {
+	img := image.NewYCbCr(r, image.YCbCrSubsampleRatio420)
+	y, cb, cr := color.RGBToYCbCr(c.R, c.G, c.B)
+	for i := range img.Y {
+		img.Y[i] = y
+	}
+	for i := range img.Cb {
+		img.Cb[i] = cb
+		img.Cr[i] = cr
+	}
+	return img
+}
+
+func diff(a, b uint8) int {
+	if a > b {
+		return int(a - b)
+	}
+	return int(b - a)
+}
@@ -4,21 +4,34 @@ import (
 	"database/sql"
 	"net/http"
 	"strings"
+	"time"
 
+	"github.com/jphacks/os_2502/back/api/internal/auth"
+	"github.com/jphacks/os_2502/back/api/internal/blobstore"
 	"github.com/jphacks/os_2502/back/api/internal/handler"
 	"github.com/jphacks/os_2502/back/api/internal/infrastructure/repository"
+	"github.com/jphacks/os_2502/back/api/internal/notification"
+	"github.com/jphacks/os_2502/back/api/internal/policy"
+	"github.com/jphacks/os_2502/back/api/internal/preview"
+	"github.com/jphacks/os_2502/back/api/internal/realtime"
 	"github.com/jphacks/os_2502/back/api/internal/usecase"
 	"github.com/jphacks/os_2502/back/api/internal/worker"
 	"github.com/jphacks/os_2502/back/api/middleware"
 )
 
 type Router struct {
-	db *sql.DB
+	db            *sql.DB
+	hub           *realtime.Hub
+	verifier      *auth.Verifier
+	notifier      notification.Notifier
+	captureWindow time.Duration
+	dailyLocation *time.Location
+	store         blobstore.Store
 }
 
 // 新しいルーターを作成
-func NewRouter(db *sql.DB) *Router {
-	return &Router{db: db}
+func NewRouter(db *sql.DB, hub *realtime.Hub, verifier *auth.Verifier, notifier notification.Notifier, captureWindow time.Duration, dailyLocation *time.Location, store blobstore.Store) *Router {
+	return &Router{db: db, hub: hub, verifier: verifier, notifier: notifier, captureWindow: captureWindow, dailyLocation: dailyLocation, store: store}
 }
 
 func (r *Router) SetupRoutes() http.Handler {
@@ -37,37 +50,57 @@ func (r *Router) SetupRoutes() http.Handler {
 	templatePartRepo := repository.NewTemplatePartRepository(r.db)
 	groupPartAssignmentRepo := repository.NewGroupPartAssignmentRepository(r.db)
 	uploadImagesCollageResultRepo := repository.NewUploadImagesCollageResultRepository(r.db)
+	collageJobRepo := repository.NewCollageJobRepositorySQLBoiler(r.db)
+	sessionRoundRepo := repository.NewSessionRoundRepositorySQLBoiler(r.db)
+	dailyCollageRepo := repository.NewDailyCollageRepositorySQLBoiler(r.db)
+	uploadSlotRepo := repository.NewUploadSlotRepositorySQLBoiler(r.db)
+	resumableUploadRepo := repository.NewResumableUploadRepositorySQLBoiler(r.db)
+
+	// 認可ポリシー
+	authz := policy.New(groupMemberRepo)
 
 	// UseCase 初期化
 	userUC := usecase.NewUserUseCase(userRepo)
-	groupUC := usecase.NewGroupUseCase(groupRepo, groupMemberRepo)
+	groupUC := usecase.NewGroupUseCase(groupRepo, groupMemberRepo, sessionRoundRepo, collageTemplateRepo, friendRepo, r.hub, r.notifier, r.captureWindow, authz)
 	friendUC := usecase.NewFriendUseCase(friendRepo)
 	deviceTokenUC := usecase.NewDeviceTokenUseCase(deviceTokenRepo)
-	collageTemplateUC := usecase.NewCollageTemplateUseCase(collageTemplateRepo)
-	collageResultUC := usecase.NewCollageResultUseCase(collageResultRepo)
-	uploadImageUC := usecase.NewUploadImageUseCase(uploadImageRepo)
-	resultDownloadUC := usecase.NewResultDownloadUseCase(resultDownloadRepo)
-	templatePartUC := usecase.NewTemplatePartUseCase(templatePartRepo)
-	groupPartAssignmentUC := usecase.NewGroupPartAssignmentUseCase(groupPartAssignmentRepo)
-	uploadImagesCollageResultUC := usecase.NewUploadImagesCollageResultUseCase(uploadImagesCollageResultRepo)
+	collageTemplateUC := usecase.NewCollageTemplateUseCase(collageTemplateRepo, templatePartRepo, friendRepo)
+	customTemplateUC := usecase.NewCustomTemplateUseCase(collageTemplateRepo, templatePartRepo, friendRepo)
+	templatePreviewUC := usecase.NewTemplatePreviewUseCase(collageTemplateRepo, templatePartRepo, friendRepo, preview.NewCache(usecase.TemplatePreviewDir))
+	collageResultUC := usecase.NewCollageResultUseCase(collageResultRepo, authz)
+	uploadImageUC := usecase.NewUploadImageUseCase(uploadImageRepo, groupRepo, groupMemberRepo, collageJobRepo, collageTemplateRepo, r.hub, authz)
+	photoUploadUC := usecase.NewPhotoUploadUseCase(uploadSlotRepo, uploadImageUC, authz, r.store)
+	resumableUploadUC := usecase.NewResumableUploadUseCase(resumableUploadRepo, photoUploadUC, r.store)
+	resultDownloadUC := usecase.NewResultDownloadUseCase(resultDownloadRepo, collageResultRepo, authz)
+	templatePartUC := usecase.NewTemplatePartUseCase(templatePartRepo, collageTemplateRepo, friendRepo)
+	groupPartAssignmentUC := usecase.NewGroupPartAssignmentUseCase(groupPartAssignmentRepo, groupRepo, groupMemberRepo, collageTemplateRepo, templatePartRepo, friendRepo, authz)
+	uploadImagesCollageResultUC := usecase.NewUploadImagesCollageResultUseCase(uploadImagesCollageResultRepo, collageResultRepo, authz)
+	sessionRoundUC := usecase.NewSessionRoundUseCase(sessionRoundRepo, collageResultRepo, authz)
+	dailyCollageUC := usecase.NewDailyCollageUseCase(dailyCollageRepo, groupPartAssignmentRepo, templatePartRepo, uploadImageRepo, collageResultRepo, r.dailyLocation, authz)
 
 	// Worker 初期化
 	uploadMonitor := worker.NewUploadMonitor(uploadImageRepo)
 
 	// Handler 初期化
 	userHandler := handler.NewUserHandler(userUC)
-	groupHandler := handler.NewGroupHandler(groupUC)
+	groupHandler := handler.NewGroupHandler(groupUC, uploadImageUC, sessionRoundUC, photoUploadUC, authz, r.store)
 	friendHandler := handler.NewFriendHandler(friendUC)
 	deviceTokenHandler := handler.NewDeviceTokenHandler(deviceTokenUC)
 	collageTemplateHandler := handler.NewCollageTemplateHandler(collageTemplateUC)
+	customTemplateHandler := handler.NewCustomTemplateHandler(customTemplateUC)
 	collageResultHandler := handler.NewCollageResultHandler(collageResultUC)
 	uploadImageHandler := handler.NewUploadImageHandler(uploadImageUC)
+	photoUploadHandler := handler.NewPhotoUploadHandler(photoUploadUC)
+	tusHandler := handler.NewTusHandler(resumableUploadUC)
 	resultDownloadHandler := handler.NewResultDownloadHandler(resultDownloadUC)
 	templatePartHandler := handler.NewTemplatePartHandler(templatePartUC)
 	groupPartAssignmentHandler := handler.NewGroupPartAssignmentHandler(groupPartAssignmentUC)
 	uploadImagesCollageResultHandler := handler.NewUploadImagesCollageResultHandler(uploadImagesCollageResultUC)
-	websocketHandler := handler.NewWebSocketHandler(uploadMonitor)
-	templateDataHandler := handler.NewTemplateDataHandler()
+	websocketHandler := handler.NewWebSocketHandler(uploadMonitor, r.hub, groupUC, authz)
+	templateDataHandler := handler.NewTemplateDataHandler(collageTemplateUC)
+	templatePreviewHandler := handler.NewTemplatePreviewHandler(templatePreviewUC)
+	timeSyncHandler := handler.NewTimeSyncHandler()
+	dailyCollageHandler := handler.NewDailyCollageHandler(dailyCollageUC, r.store)
 
 	// User エンドポイント
 	mux.HandleFunc("/api/users", func(w http.ResponseWriter, r *http.Request) {
@@ -160,6 +193,12 @@ func (r *Router) SetupRoutes() http.Handler {
 			} else {
 				http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
 			}
+		case strings.HasSuffix(path, "/invite"):
+			if r.Method == http.MethodPost {
+				groupHandler.InviteUsers(w, r)
+			} else {
+				http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			}
 		case strings.HasSuffix(path, "/finalize"):
 			if r.Method == http.MethodPost {
 				groupHandler.FinalizeGroupMembers(w, r)
@@ -167,8 +206,17 @@ func (r *Router) SetupRoutes() http.Handler {
 				http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
 			}
 		case strings.HasSuffix(path, "/ready"):
-			if r.Method == http.MethodPost {
+			switch r.Method {
+			case http.MethodPost:
 				groupHandler.MarkMemberReady(w, r)
+			case http.MethodDelete:
+				groupHandler.CancelMemberReady(w, r)
+			default:
+				http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			}
+		case strings.HasSuffix(path, "/settings"):
+			if r.Method == http.MethodPut || r.Method == http.MethodPatch {
+				groupHandler.UpdateSessionSettings(w, r)
 			} else {
 				http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
 			}
@@ -178,20 +226,77 @@ func (r *Router) SetupRoutes() http.Handler {
 			} else {
 				http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
 			}
+		case strings.HasSuffix(path, "/clock-sync"):
+			if r.Method == http.MethodPost {
+				groupHandler.RecordClockSync(w, r)
+			} else {
+				http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			}
+		case strings.HasSuffix(path, "/clock-status"):
+			if r.Method == http.MethodGet {
+				groupHandler.GetClockStatus(w, r)
+			} else {
+				http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			}
 		case strings.HasSuffix(path, "/leave"):
 			if r.Method == http.MethodDelete {
 				groupHandler.LeaveGroup(w, r)
 			} else {
 				http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
 			}
+		case strings.HasSuffix(path, "/part-assignments"):
+			if r.Method == http.MethodPost {
+				groupPartAssignmentHandler.AutoAssignParts(w, r)
+			} else {
+				http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			}
+		case strings.HasSuffix(path, "/daily"):
+			if r.Method == http.MethodGet {
+				dailyCollageHandler.GetDaily(w, r)
+			} else {
+				http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			}
+		case strings.HasSuffix(path, "/daily/photos"):
+			if r.Method == http.MethodPost {
+				dailyCollageHandler.UploadDailyPhoto(w, r)
+			} else {
+				http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			}
+		case strings.HasSuffix(path, "/daily/collage"):
+			if r.Method == http.MethodGet || r.Method == http.MethodHead {
+				dailyCollageHandler.GetDailyCollageImage(w, r)
+			} else {
+				http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			}
+		case strings.HasSuffix(path, "/uploads"):
+			if r.Method == http.MethodPost {
+				photoUploadHandler.RequestUploadSlot(w, r)
+			} else {
+				http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			}
+		case strings.Contains(path, "/uploads/") && strings.HasSuffix(path, "/confirm"):
+			if r.Method == http.MethodPost {
+				photoUploadHandler.ConfirmUploadSlot(w, r)
+			} else {
+				http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			}
 		case strings.HasSuffix(path, "/photos"):
 			if r.Method == http.MethodPost {
 				groupHandler.UploadPhoto(w, r)
 			} else {
 				http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
 			}
+		case strings.HasSuffix(path, "/rounds"):
+			switch r.Method {
+			case http.MethodGet:
+				groupHandler.ListRounds(w, r)
+			case http.MethodPost:
+				groupHandler.StartNextRound(w, r)
+			default:
+				http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			}
 		case strings.HasSuffix(path, "/collage"):
-			if r.Method == http.MethodGet {
+			if r.Method == http.MethodGet || r.Method == http.MethodHead {
 				groupHandler.GetCollageImage(w, r)
 			} else {
 				http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
@@ -244,9 +349,42 @@ func (r *Router) SetupRoutes() http.Handler {
 			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
 		}
 	})
-	mux.HandleFunc("/api/templates/", collageTemplateHandler.GetTemplate)
+	mux.HandleFunc("/api/templates/", func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/preview") {
+			templatePreviewHandler.GetPreview(w, r)
+			return
+		}
+		collageTemplateHandler.GetTemplate(w, r)
+	})
+
+	// Custom Template エンドポイント (ユーザーが作成するテンプレート)
+	mux.HandleFunc("/api/custom-templates", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			customTemplateHandler.CreateTemplate(w, r)
+		case http.MethodGet:
+			customTemplateHandler.ListMyTemplates(w, r)
+		default:
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		}
+	})
+	mux.HandleFunc("/api/custom-templates/", func(w http.ResponseWriter, r *http.Request) {
+		path := r.URL.Path
+		switch {
+		case path == "/api/custom-templates/shared" && r.Method == http.MethodGet:
+			customTemplateHandler.ListSharedTemplates(w, r)
+		case strings.HasSuffix(path, "/publish") && r.Method == http.MethodPost:
+			customTemplateHandler.PublishTemplate(w, r)
+		case strings.HasSuffix(path, "/fork") && r.Method == http.MethodPost:
+			customTemplateHandler.ForkTemplate(w, r)
+		case r.Method == http.MethodGet:
+			customTemplateHandler.GetTemplate(w, r)
+		default:
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		}
+	})
 
-	// Template Data エンドポイント (JSONテンプレート)
+	// Template Data エンドポイント (組み込みテンプレートとフレーム)
 	mux.HandleFunc("/api/template-data", templateDataHandler.GetTemplates)
 	mux.HandleFunc("/api/template-data/filter", templateDataHandler.GetTemplateByPhotoCount)
 
@@ -294,6 +432,10 @@ func (r *Router) SetupRoutes() http.Handler {
 		case http.MethodPost:
 			templatePartHandler.CreateTemplatePart(w, r)
 		case http.MethodGet:
+			if r.URL.Query().Get("template_id") != "" {
+				templatePartHandler.GetTemplatePartsByTemplateID(w, r)
+				return
+			}
 			templatePartHandler.ListTemplateParts(w, r)
 		default:
 			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
@@ -326,14 +468,56 @@ func (r *Router) SetupRoutes() http.Handler {
 		}
 	})
 
+	// 時刻同期エンドポイント
+	mux.HandleFunc("/api/time/sync", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			timeSyncHandler.Sync(w, r)
+		} else {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		}
+	})
+
+	// 再開可能なアップロード（tus 1.0）
+	mux.HandleFunc("/api/uploads", func(w http.ResponseWriter, r *http.Request) {
+		switch handler.TusMethod(r) {
+		case http.MethodOptions:
+			tusHandler.Options(w, r)
+		case http.MethodPost:
+			tusHandler.CreateUpload(w, r)
+		default:
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		}
+	})
+	mux.HandleFunc("/api/uploads/", func(w http.ResponseWriter, r *http.Request) {
+		switch handler.TusMethod(r) {
+		case http.MethodOptions:
+			tusHandler.Options(w, r)
+		case http.MethodHead:
+			tusHandler.HeadUpload(w, r)
+		case http.MethodPatch:
+			tusHandler.PatchUpload(w, r)
+		case http.MethodDelete:
+			tusHandler.TerminateUpload(w, r)
+		default:
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		}
+	})
+
 	// WebSocket エンドポイント
-	mux.HandleFunc("/api/ws/upload-status", websocketHandler.HandleUploadStatus)
+	mux.HandleFunc("/api/ws/group-events", websocketHandler.HandleGroupEvents)
+	mux.HandleFunc("/api/ws/upload-status", websocketHandler.HandleGroupEvents)
 	mux.HandleFunc("/api/status", websocketHandler.HandleStatus)
 
+	// 署名付きURLの受け口（ローカルディスクに保存する場合。S3 では端末がストレージに直接アクセスする）
+	if local, ok := r.store.(*blobstore.LocalStore); ok {
+		mux.Handle("/api/blobs/", http.StripPrefix("/api/blobs", local))
+	}
+
 	mux.HandleFunc("/api/health", func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusOK)
 		w.Write([]byte("OK"))
 	})
 
-	return middleware.CORSMiddleware(mux)
+	// CORS のプリフライトは認証なしで通す
+	return middleware.CORSMiddleware(middleware.AuthMiddleware(r.verifier, userRepo)(mux))
 }
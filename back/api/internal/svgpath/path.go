@@ -0,0 +1,335 @@
+package svgpath
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Point はパス上の座標
+type Point struct {
+	X float64
+	Y float64
+}
+
+// Segment は絶対座標に正規化されたパスのセグメント
+//
+//	'M': Pts[0] へ移動
+//	'L': Pts[0] へ直線
+//	'Q': Pts[0] を制御点、Pts[1] を終点とする2次ベジェ曲線
+//	'C': Pts[0], Pts[1] を制御点、Pts[2] を終点とする3次ベジェ曲線
+//	'Z': サブパスを閉じる
+type Segment struct {
+	Op  byte
+	Pts []Point
+}
+
+// Path は SVG の d 属性をパースした結果
+type Path []Segment
+
+// Parse は SVG パス文字列 (M/L/H/V/Z/C/Q と小文字の相対指定) をパースする
+func Parse(d string) (Path, error) {
+	s := &scanner{src: d}
+	var (
+		path    Path
+		cur     Point
+		start   Point
+		cmd     byte
+		hasMove bool
+	)
+
+	for {
+		s.skipSeparators()
+		if s.eof() {
+			break
+		}
+
+		if c := s.peek(); isCommand(c) {
+			cmd = c
+			s.pos++
+		} else if cmd == 0 {
+			return nil, fmt.Errorf("svgpath: expected command at offset %d", s.pos)
+		}
+
+		rel := cmd >= 'a' && cmd <= 'z'
+		op := cmd
+		if rel {
+			op = cmd - 'a' + 'A'
+		}
+
+		if op != 'M' && op != 'Z' && !hasMove {
+			return nil, fmt.Errorf("svgpath: path must start with a moveto")
+		}
+
+		switch op {
+		case 'Z':
+			path = append(path, Segment{Op: 'Z'})
+			cur = start
+			// Z の後に数値は続かないため、次は必ずコマンドが来る
+			cmd = 0
+			continue
+
+		case 'M':
+			p, err := s.point()
+			if err != nil {
+				return nil, err
+			}
+			if rel {
+				p = add(cur, p)
+			}
+			path = append(path, Segment{Op: 'M', Pts: []Point{p}})
+			cur, start = p, p
+			hasMove = true
+			// M の後に続く座標は暗黙の L として扱う
+			if rel {
+				cmd = 'l'
+			} else {
+				cmd = 'L'
+			}
+
+		case 'L':
+			p, err := s.point()
+			if err != nil {
+				return nil, err
+			}
+			if rel {
+				p = add(cur, p)
+			}
+			path = append(path, Segment{Op: 'L', Pts: []Point{p}})
+			cur = p
+
+		case 'H':
+			x, err := s.number()
+			if err != nil {
+				return nil, err
+			}
+			if rel {
+				x += cur.X
+			}
+			p := Point{X: x, Y: cur.Y}
+			path = append(path, Segment{Op: 'L', Pts: []Point{p}})
+			cur = p
+
+		case 'V':
+			y, err := s.number()
+			if err != nil {
+				return nil, err
+			}
+			if rel {
+				y += cur.Y
+			}
+			p := Point{X: cur.X, Y: y}
+			path = append(path, Segment{Op: 'L', Pts: []Point{p}})
+			cur = p
+
+		case 'Q':
+			pts, err := s.points(2)
+			if err != nil {
+				return nil, err
+			}
+			if rel {
+				for i := range pts {
+					pts[i] = add(cur, pts[i])
+				}
+			}
+			path = append(path, Segment{Op: 'Q', Pts: pts})
+			cur = pts[1]
+
+		case 'C':
+			pts, err := s.points(3)
+			if err != nil {
+				return nil, err
+			}
+			if rel {
+				for i := range pts {
+					pts[i] = add(cur, pts[i])
+				}
+			}
+			path = append(path, Segment{Op: 'C', Pts: pts})
+			cur = pts[2]
+
+		default:
+			return nil, fmt.Errorf("svgpath: unsupported command %q", cmd)
+		}
+	}
+
+	if len(path) == 0 {
+		return nil, fmt.Errorf("svgpath: empty path")
+	}
+	return path, nil
+}
+
+// Transform は各座標に (x*sx+tx, y*sy+ty) を適用した新しいパスを返す
+func (p Path) Transform(sx, sy, tx, ty float64) Path {
+	out := make(Path, len(p))
+	for i, seg := range p {
+		pts := make([]Point, len(seg.Pts))
+		for j, pt := range seg.Pts {
+			pts[j] = Point{X: pt.X*sx + tx, Y: pt.Y*sy + ty}
+		}
+		out[i] = Segment{Op: seg.Op, Pts: pts}
+	}
+	return out
+}
+
+// Bounds は制御点を含む外接矩形を返す
+func (p Path) Bounds() (min, max Point) {
+	min = Point{X: math.Inf(1), Y: math.Inf(1)}
+	max = Point{X: math.Inf(-1), Y: math.Inf(-1)}
+	for _, seg := range p {
+		for _, pt := range seg.Pts {
+			min.X = math.Min(min.X, pt.X)
+			min.Y = math.Min(min.Y, pt.Y)
+			max.X = math.Max(max.X, pt.X)
+			max.Y = math.Max(max.Y, pt.Y)
+		}
+	}
+	return min, max
+}
+
+// ViewBox は SVG の viewBox 属性
+type ViewBox struct {
+	MinX   float64
+	MinY   float64
+	Width  float64
+	Height float64
+}
+
+// ParseViewBox は "minX minY width height" 形式の viewBox をパースする
+func ParseViewBox(s string) (ViewBox, error) {
+	fields := strings.FieldsFunc(s, func(r rune) bool {
+		return r == ' ' || r == ',' || r == '\t' || r == '\n'
+	})
+	if len(fields) != 4 {
+		return ViewBox{}, fmt.Errorf("svgpath: invalid viewBox %q", s)
+	}
+
+	var v [4]float64
+	for i, f := range fields {
+		n, err := strconv.ParseFloat(f, 64)
+		if err != nil {
+			return ViewBox{}, fmt.Errorf("svgpath: invalid viewBox %q: %w", s, err)
+		}
+		v[i] = n
+	}
+	if v[2] <= 0 || v[3] <= 0 {
+		return ViewBox{}, fmt.Errorf("svgpath: viewBox must have positive size: %q", s)
+	}
+
+	return ViewBox{MinX: v[0], MinY: v[1], Width: v[2], Height: v[3]}, nil
+}
+
+// ToPixels は viewBox 座標系のパスを width x height のピクセル座標系に変換する
+func (vb ViewBox) ToPixels(p Path, width, height int) Path {
+	sx := float64(width) / vb.Width
+	sy := float64(height) / vb.Height
+	return p.Transform(sx, sy, -vb.MinX*sx, -vb.MinY*sy)
+}
+
+func add(a, b Point) Point {
+	return Point{X: a.X + b.X, Y: a.Y + b.Y}
+}
+
+func isCommand(c byte) bool {
+	switch c {
+	case 'M', 'm', 'L', 'l', 'H', 'h', 'V', 'v', 'Z', 'z', 'C', 'c', 'Q', 'q':
+		return true
+	}
+	return false
+}
+
+// scanner はパス文字列から数値を読み出す
+type scanner struct {
+	src string
+	pos int
+}
+
+func (s *scanner) eof() bool {
+	return s.pos >= len(s.src)
+}
+
+func (s *scanner) peek() byte {
+	return s.src[s.pos]
+}
+
+func (s *scanner) skipSeparators() {
+	for !s.eof() {
+		switch s.peek() {
+		case ' ', '\t', '\n', '\r', ',':
+			s.pos++
+		default:
+			return
+		}
+	}
+}
+
+func (s *scanner) number() (float64, error) {
+	s.skipSeparators()
+	start := s.pos
+
+	if !s.eof() && (s.peek() == '+' || s.peek() == '-') {
+		s.pos++
+	}
+	digits := 0
+	for !s.eof() && isDigit(s.peek()) {
+		s.pos++
+		digits++
+	}
+	if !s.eof() && s.peek() == '.' {
+		s.pos++
+		for !s.eof() && isDigit(s.peek()) {
+			s.pos++
+			digits++
+		}
+	}
+	if digits == 0 {
+		return 0, fmt.Errorf("svgpath: expected number at offset %d", start)
+	}
+	if !s.eof() && (s.peek() == 'e' || s.peek() == 'E') {
+		// 指数部。"1e" のように数字が続かない場合は exponent として扱わない
+		save := s.pos
+		s.pos++
+		if !s.eof() && (s.peek() == '+' || s.peek() == '-') {
+			s.pos++
+		}
+		expDigits := 0
+		for !s.eof() && isDigit(s.peek()) {
+			s.pos++
+			expDigits++
+		}
+		if expDigits == 0 {
+			s.pos = save
+		}
+	}
+
+	return strconv.ParseFloat(s.src[start:s.pos], 64)
+}
+
+func (s *scanner) point() (Point, error) {
+	x, err := s.number()
+	if err != nil {
+		return Point{}, err
+	}
+	y, err := s.number()
+	if err != nil {
+		return Point{}, err
+	}
+	return Point{X: x, Y: y}, nil
+}
+
+func (s *scanner) points(n int) ([]Point, error) {
+	pts := make([]Point, n)
+	for i := range pts {
+		p, err := s.point()
+		if err != nil {
+			return nil, err
+		}
+		pts[i] = p
+	}
+	return pts, nil
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
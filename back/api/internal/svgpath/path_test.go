@@ -0,0 +1,149 @@
+package svgpath
+
+import (
+	"math"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name     string
+		d        string
+		wantOps  string
+		wantLast Point
+		wantErr  bool
+	}{
+		{
+			name:     "compact absolute rect",
+			d:        "M0.02 0.02H0.49V0.98H0.02V0.02Z",
+			wantOps:  "MLLLLZ",
+			wantLast: Point{X: 0.02, Y: 0.02},
+		},
+		{
+			name:     "spaced absolute rect",
+			d:        "M0.34 0.02 H0.66 V0.34 H0.34 Z",
+			wantOps:  "MLLLZ",
+			wantLast: Point{X: 0.34, Y: 0.34},
+		},
+		{
+			name:     "relative with implicit lineto",
+			d:        "m1,1 2,0 0,2 -2,0z",
+			wantOps:  "MLLLZ",
+			wantLast: Point{X: 1, Y: 3},
+		},
+		{
+			name:     "curves",
+			d:        "M0 0 Q5 0 5 5 c0 5 -5 5 -5 0 Z",
+			wantOps:  "MQCZ",
+			wantLast: Point{X: 0, Y: 5},
+		},
+		{
+			name:     "negative numbers without separators",
+			d:        "M10-5L-3.5-.5",
+			wantOps:  "ML",
+			wantLast: Point{X: -3.5, Y: -0.5},
+		},
+		{
+			name:    "empty",
+			d:       "",
+			wantErr: true,
+		},
+		{
+			name:    "missing moveto",
+			d:       "L1 1",
+			wantErr: true,
+		},
+		{
+			name:    "unsupported arc",
+			d:       "M0 0 A1 1 0 0 1 1 1",
+			wantErr: true,
+		},
+		{
+			name:    "missing coordinate",
+			d:       "M0 0 L1",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := Parse(tt.d)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Parse() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			ops := ""
+			var last Point
+			for _, seg := range p {
+				ops += string(seg.Op)
+				if len(seg.Pts) > 0 {
+					last = seg.Pts[len(seg.Pts)-1]
+				}
+			}
+			if ops != tt.wantOps {
+				t.Errorf("Parse() ops = %q, want %q", ops, tt.wantOps)
+			}
+			if math.Abs(last.X-tt.wantLast.X) > 1e-9 || math.Abs(last.Y-tt.wantLast.Y) > 1e-9 {
+				t.Errorf("Parse() last point = %v, want %v", last, tt.wantLast)
+			}
+		})
+	}
+}
+
+func TestRasterize(t *testing.T) {
+	vb, err := ParseViewBox("0 0 1 1")
+	if err != nil {
+		t.Fatalf("ParseViewBox() error = %v", err)
+	}
+
+	t.Run("half-pixel edges are anti-aliased", func(t *testing.T) {
+		p, _ := Parse("M0.25 0.25H0.75V0.75H0.25Z")
+		// 10x10 では 2.5〜7.5 ピクセルの矩形になる
+		mask := Rasterize(vb.ToPixels(p, 10, 10), 10, 10)
+
+		if got := mask.AlphaAt(5, 5).A; got != 0xff {
+			t.Errorf("inside alpha = %d, want 255", got)
+		}
+		if got := mask.AlphaAt(0, 0).A; got != 0 {
+			t.Errorf("outside alpha = %d, want 0", got)
+		}
+		if got := mask.AlphaAt(2, 5).A; got < 120 || got > 135 {
+			t.Errorf("edge alpha = %d, want ~128", got)
+		}
+		if got := mask.AlphaAt(2, 2).A; got < 56 || got > 72 {
+			t.Errorf("corner alpha = %d, want ~64", got)
+		}
+	})
+
+	t.Run("diagonal split covers half", func(t *testing.T) {
+		p, _ := Parse("M0 0L1 0L0 1Z")
+		mask := Rasterize(vb.ToPixels(p, 100, 100), 100, 100)
+
+		total := 0
+		for _, a := range mask.Pix {
+			total += int(a)
+		}
+		got := float64(total) / 255 / (100 * 100)
+		if math.Abs(got-0.5) > 0.005 {
+			t.Errorf("coverage = %f, want 0.5", got)
+		}
+	})
+
+	t.Run("curve is filled", func(t *testing.T) {
+		// 円を4本の3次ベジェで近似
+		p, _ := Parse("M1 0.5C1 0.776 0.776 1 0.5 1C0.224 1 0 0.776 0 0.5C0 0.224 0.224 0 0.5 0C0.776 0 1 0.224 1 0.5Z")
+		mask := Rasterize(vb.ToPixels(p, 200, 200), 200, 200)
+
+		total := 0
+		for _, a := range mask.Pix {
+			total += int(a)
+		}
+		got := float64(total) / 255 / (200 * 200)
+		if math.Abs(got-math.Pi/4) > 0.01 {
+			t.Errorf("coverage = %f, want %f", got, math.Pi/4)
+		}
+	})
+}
@@ -0,0 +1,201 @@
+package svgpath
+
+import (
+	"image"
+	"math"
+	"sort"
+)
+
+// subSamples は1ピクセル行あたりのサブスキャンライン数（縦方向のアンチエイリアス精度）
+const subSamples = 16
+
+// edge は多角形化したパスの1辺
+type edge struct {
+	x0, y0, x1, y1 float64
+	dir            int // 下向き +1 / 上向き -1
+}
+
+// Rasterize はピクセル座標系のパスを nonzero ルールで塗りつぶし、
+// width x height のアンチエイリアス済みアルファマスクを返す。
+// 縦方向はサブスキャンラインで、横方向はスパン端の被覆率で補間する。
+func Rasterize(p Path, width, height int) *image.Alpha {
+	mask := image.NewAlpha(image.Rect(0, 0, width, height))
+	if width <= 0 || height <= 0 {
+		return mask
+	}
+
+	edges := flatten(p)
+	if len(edges) == 0 {
+		return mask
+	}
+
+	minY, maxY := math.Inf(1), math.Inf(-1)
+	for _, e := range edges {
+		minY = math.Min(minY, math.Min(e.y0, e.y1))
+		maxY = math.Max(maxY, math.Max(e.y0, e.y1))
+	}
+	rowStart := clampInt(int(math.Floor(minY)), 0, height)
+	rowEnd := clampInt(int(math.Ceil(maxY)), 0, height)
+
+	cov := make([]float64, width+1)
+	var xs []crossing
+
+	for row := rowStart; row < rowEnd; row++ {
+		for i := range cov {
+			cov[i] = 0
+		}
+
+		for s := 0; s < subSamples; s++ {
+			y := float64(row) + (float64(s)+0.5)/subSamples
+
+			xs = xs[:0]
+			for _, e := range edges {
+				// 半開区間 [top, bottom) で頂点の二重カウントを防ぐ
+				top, bottom := e.y0, e.y1
+				if top > bottom {
+					top, bottom = bottom, top
+				}
+				if y < top || y >= bottom {
+					continue
+				}
+				t := (y - e.y0) / (e.y1 - e.y0)
+				xs = append(xs, crossing{x: e.x0 + t*(e.x1-e.x0), dir: e.dir})
+			}
+			if len(xs) < 2 {
+				continue
+			}
+			sort.Slice(xs, func(i, j int) bool { return xs[i].x < xs[j].x })
+
+			winding := 0
+			for i := 0; i < len(xs)-1; i++ {
+				winding += xs[i].dir
+				if winding != 0 {
+					addSpan(cov, xs[i].x, xs[i+1].x, width)
+				}
+			}
+		}
+
+		off := mask.PixOffset(0, row)
+		for x := 0; x < width; x++ {
+			a := cov[x] / subSamples
+			if a <= 0 {
+				continue
+			}
+			if a >= 1 {
+				mask.Pix[off+x] = 0xff
+				continue
+			}
+			mask.Pix[off+x] = uint8(a*255 + 0.5)
+		}
+	}
+
+	return mask
+}
+
+type crossing struct {
+	x   float64
+	dir int
+}
+
+// addSpan は [x0, x1) のスパンを横方向の被覆率として cov に加算する
+func addSpan(cov []float64, x0, x1 float64, width int) {
+	w := float64(width)
+	x0 = math.Max(0, math.Min(x0, w))
+	x1 = math.Max(0, math.Min(x1, w))
+	if x1 <= x0 {
+		return
+	}
+
+	i0 := int(x0)
+	i1 := int(x1)
+	if i0 == i1 {
+		cov[i0] += x1 - x0
+		return
+	}
+
+	cov[i0] += float64(i0+1) - x0
+	for i := i0 + 1; i < i1; i++ {
+		cov[i]++
+	}
+	// i1 == width のときは番兵要素に加算される
+	cov[i1] += x1 - float64(i1)
+}
+
+// flatten は曲線を線分に分割し、各サブパスを閉じた辺のリストにする
+func flatten(p Path) []edge {
+	var (
+		edges []edge
+		cur   Point
+		start Point
+	)
+
+	lineTo := func(to Point) {
+		if to.Y != cur.Y {
+			dir := 1
+			if to.Y < cur.Y {
+				dir = -1
+			}
+			edges = append(edges, edge{x0: cur.X, y0: cur.Y, x1: to.X, y1: to.Y, dir: dir})
+		}
+		cur = to
+	}
+
+	for _, seg := range p {
+		switch seg.Op {
+		case 'M':
+			// 塗りつぶしでは開いたサブパスも暗黙的に閉じる
+			lineTo(start)
+			cur, start = seg.Pts[0], seg.Pts[0]
+		case 'L':
+			lineTo(seg.Pts[0])
+		case 'Q':
+			p0, c, p1 := cur, seg.Pts[0], seg.Pts[1]
+			n := curveSteps(dist(p0, c) + dist(c, p1))
+			for i := 1; i <= n; i++ {
+				t := float64(i) / float64(n)
+				mt := 1 - t
+				lineTo(Point{
+					X: mt*mt*p0.X + 2*mt*t*c.X + t*t*p1.X,
+					Y: mt*mt*p0.Y + 2*mt*t*c.Y + t*t*p1.Y,
+				})
+			}
+		case 'C':
+			p0, c0, c1, p1 := cur, seg.Pts[0], seg.Pts[1], seg.Pts[2]
+			n := curveSteps(dist(p0, c0) + dist(c0, c1) + dist(c1, p1))
+			for i := 1; i <= n; i++ {
+				t := float64(i) / float64(n)
+				mt := 1 - t
+				a, b, c, d := mt*mt*mt, 3*mt*mt*t, 3*mt*t*t, t*t*t
+				lineTo(Point{
+					X: a*p0.X + b*c0.X + c*c1.X + d*p1.X,
+					Y: a*p0.Y + b*c0.Y + c*c1.Y + d*p1.Y,
+				})
+			}
+		case 'Z':
+			lineTo(start)
+		}
+	}
+	lineTo(start)
+
+	return edges
+}
+
+// curveSteps は制御点ポリゴンの長さ（ピクセル）から分割数を決める
+func curveSteps(length float64) int {
+	n := int(math.Ceil(length / 2))
+	return clampInt(n, 4, 256)
+}
+
+func dist(a, b Point) float64 {
+	return math.Hypot(b.X-a.X, b.Y-a.Y)
+}
+
+func clampInt(v, lo, hi int) int {
+	if v < lo {
+		return lo
+	}
+	if v > hi {
+		return hi
+	}
+	return v
+}
@@ -0,0 +1,82 @@
+// Package timesync はクライアント端末とサーバーの時計のずれを NTP と同じ方式で推定する。
+//
+// 1回の交換で4つの時刻を使う。時刻はすべて Unix エポックからのマイクロ秒。
+//
+//	t0: クライアントが送信した時刻（クライアントの時計）
+//	t1: サーバーが受信した時刻（サーバーの時計）
+//	t2: サーバーが返信した時刻（サーバーの時計）
+//	t3: クライアントが受信した時刻（クライアントの時計）
+//
+// オフセット θ = ((t1-t0) + (t2-t3)) / 2 はサーバーの時計からクライアントの時計を引いた値で、
+// 往復遅延 δ = (t3-t0) - (t2-t1) の半分が推定誤差の上限になる。
+package timesync
+
+import "time"
+
+// Micros 時刻を Unix エポックからのマイクロ秒に変換
+func Micros(t time.Time) int64 {
+	return t.UnixMicro()
+}
+
+// Reply サーバーが返す時刻同期の応答
+type Reply struct {
+	ClientTransmit int64 `json:"client_transmit_us"`
+	ServerReceive  int64 `json:"server_receive_us"`
+	ServerTransmit int64 `json:"server_transmit_us"`
+}
+
+// NewReply 受信時刻 received を記録した応答を作成し、送信時刻は呼び出した時点とする。
+// 送信直前に呼ぶほど精度が上がる。
+func NewReply(clientTransmit int64, received time.Time) Reply {
+	return Reply{
+		ClientTransmit: clientTransmit,
+		ServerReceive:  Micros(received),
+		ServerTransmit: Micros(time.Now()),
+	}
+}
+
+// Sample クライアントが受信時刻まで埋めた1回分の交換結果
+type Sample struct {
+	ClientTransmit int64 `json:"client_transmit_us"`
+	ServerReceive  int64 `json:"server_receive_us"`
+	ServerTransmit int64 `json:"server_transmit_us"`
+	ClientReceive  int64 `json:"client_receive_us"`
+}
+
+// Offset サーバーの時計 - クライアントの時計
+func (s Sample) Offset() time.Duration {
+	us := ((s.ServerReceive - s.ClientTransmit) + (s.ServerTransmit - s.ClientReceive)) / 2
+	return time.Duration(us) * time.Microsecond
+}
+
+// RTT サーバーでの処理時間を除いた往復遅延
+func (s Sample) RTT() time.Duration {
+	us := (s.ClientReceive - s.ClientTransmit) - (s.ServerTransmit - s.ServerReceive)
+	return time.Duration(us) * time.Microsecond
+}
+
+// Valid 各時計で時刻が逆行しておらず、往復遅延が負でないか
+func (s Sample) Valid() bool {
+	return s.ClientTransmit > 0 &&
+		s.ServerReceive > 0 &&
+		s.ServerTransmit >= s.ServerReceive &&
+		s.ClientReceive >= s.ClientTransmit &&
+		s.RTT() >= 0
+}
+
+// Best 有効なサンプルのうち往復遅延が最小のものを返す。
+// 遅延の小さい交換ほど経路の非対称性の影響を受けにくく、オフセットの推定が正確になる。
+func Best(samples []Sample) (Sample, bool) {
+	var best Sample
+	found := false
+	for _, s := range samples {
+		if !s.Valid() {
+			continue
+		}
+		if !found || s.RTT() < best.RTT() {
+			best = s
+			found = true
+		}
+	}
+	return best, found
+}
@@ -0,0 +1,81 @@
+package timesync
+
+import (
+	"testing"
+	"time"
+)
+
+// exchange はクライアントの時計がサーバーより skew 遅れている環境での交換を再現する
+func exchange(start int64, skew, up, down, processing time.Duration) Sample {
+	t0 := start
+	t1 := t0 + up.Microseconds() + skew.Microseconds()
+	t2 := t1 + processing.Microseconds()
+	t3 := t2 + down.Microseconds() - skew.Microseconds()
+	return Sample{ClientTransmit: t0, ServerReceive: t1, ServerTransmit: t2, ClientReceive: t3}
+}
+
+func TestSampleOffsetAndRTT(t *testing.T) {
+	const start = 1_700_000_000_000_000
+
+	tests := []struct {
+		name       string
+		sample     Sample
+		wantOffset time.Duration
+		wantRTT    time.Duration
+	}{
+		{
+			name:       "symmetric path",
+			sample:     exchange(start, 350*time.Millisecond, 40*time.Millisecond, 40*time.Millisecond, 2*time.Millisecond),
+			wantOffset: 350 * time.Millisecond,
+			wantRTT:    80 * time.Millisecond,
+		},
+		{
+			name:       "client ahead of server",
+			sample:     exchange(start, -120*time.Millisecond, 10*time.Millisecond, 10*time.Millisecond, 0),
+			wantOffset: -120 * time.Millisecond,
+			wantRTT:    20 * time.Millisecond,
+		},
+		{
+			name:       "asymmetric path is off by half the difference",
+			sample:     exchange(start, 0, 60*time.Millisecond, 20*time.Millisecond, time.Millisecond),
+			wantOffset: 20 * time.Millisecond,
+			wantRTT:    80 * time.Millisecond,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !tt.sample.Valid() {
+				t.Fatal("sample should be valid")
+			}
+			if got := tt.sample.Offset(); got != tt.wantOffset {
+				t.Errorf("Offset() = %v, want %v", got, tt.wantOffset)
+			}
+			if got := tt.sample.RTT(); got != tt.wantRTT {
+				t.Errorf("RTT() = %v, want %v", got, tt.wantRTT)
+			}
+		})
+	}
+}
+
+func TestBest(t *testing.T) {
+	const start = 1_700_000_000_000_000
+	slow := exchange(start, 300*time.Millisecond, 200*time.Millisecond, 50*time.Millisecond, 0)
+	fast := exchange(start, 300*time.Millisecond, 15*time.Millisecond, 15*time.Millisecond, 0)
+	broken := Sample{ClientTransmit: start, ServerReceive: start, ServerTransmit: start - 1, ClientReceive: start}
+
+	best, ok := Best([]Sample{slow, broken, fast})
+	if !ok {
+		t.Fatal("Best() found no sample")
+	}
+	if best != fast {
+		t.Errorf("Best() = %+v, want the lowest RTT sample", best)
+	}
+	if best.Offset() != 300*time.Millisecond {
+		t.Errorf("Offset() = %v, want 300ms", best.Offset())
+	}
+
+	if _, ok := Best([]Sample{broken}); ok {
+		t.Error("Best() should ignore invalid samples")
+	}
+}
@@ -5,18 +5,24 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/jphacks/os_2502/back/api/internal/domain/collage_result"
+	"github.com/jphacks/os_2502/back/api/internal/policy"
 )
 
 type CollageResultUseCase struct {
-	repo collage_result.Repository
+	repo  collage_result.Repository
+	authz *policy.Policy
 }
 
-func NewCollageResultUseCase(repo collage_result.Repository) *CollageResultUseCase {
-	return &CollageResultUseCase{repo: repo}
+func NewCollageResultUseCase(repo collage_result.Repository, authz *policy.Policy) *CollageResultUseCase {
+	return &CollageResultUseCase{repo: repo, authz: authz}
 }
 
-// CreateResult creates a new collage result
-func (uc *CollageResultUseCase) CreateResult(ctx context.Context, templateID uuid.UUID, groupID, fileURL string, targetUserNumber int) (*collage_result.CollageResult, error) {
+// CreateResult creates a new collage result (owner only)
+func (uc *CollageResultUseCase) CreateResult(ctx context.Context, callerID, templateID uuid.UUID, groupID, fileURL string, targetUserNumber int) (*collage_result.CollageResult, error) {
+	if err := uc.authz.CanManageGroup(ctx, callerID.String(), groupID); err != nil {
+		return nil, err
+	}
+
 	// 新規作成
 	result, err := collage_result.NewCollageResult(templateID, groupID, fileURL, targetUserNumber)
 	if err != nil {
@@ -30,13 +36,23 @@ func (uc *CollageResultUseCase) CreateResult(ctx context.Context, templateID uui
 	return result, nil
 }
 
-// GetResult retrieves a result by ID
-func (uc *CollageResultUseCase) GetResult(ctx context.Context, resultID uuid.UUID) (*collage_result.CollageResult, error) {
-	return uc.repo.FindByID(ctx, resultID)
+// GetResult retrieves a result by ID (members only)
+func (uc *CollageResultUseCase) GetResult(ctx context.Context, resultID, callerID uuid.UUID) (*collage_result.CollageResult, error) {
+	result, err := uc.repo.FindByID(ctx, resultID)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.authz.CanViewResult(ctx, callerID.String(), result.GroupID()); err != nil {
+		return nil, err
+	}
+	return result, nil
 }
 
-// GetResultsByGroup retrieves all results by group ID
-func (uc *CollageResultUseCase) GetResultsByGroup(ctx context.Context, groupID string, limit, offset int) ([]*collage_result.CollageResult, error) {
+// GetResultsByGroup retrieves all results by group ID (members only)
+func (uc *CollageResultUseCase) GetResultsByGroup(ctx context.Context, callerID uuid.UUID, groupID string, limit, offset int) ([]*collage_result.CollageResult, error) {
+	if err := uc.authz.CanViewResult(ctx, callerID.String(), groupID); err != nil {
+		return nil, err
+	}
 	if limit <= 0 {
 		limit = 20
 	}
@@ -46,8 +62,8 @@ func (uc *CollageResultUseCase) GetResultsByGroup(ctx context.Context, groupID s
 	return uc.repo.FindByGroupID(ctx, groupID, limit, offset)
 }
 
-// MarkAsNotified marks a result as notified
-func (uc *CollageResultUseCase) MarkAsNotified(ctx context.Context, resultID uuid.UUID) error {
+// MarkAsNotified marks a result as notified (owner only)
+func (uc *CollageResultUseCase) MarkAsNotified(ctx context.Context, resultID, callerID uuid.UUID) error {
 	// 結果を取得
 	result, err := uc.repo.FindByID(ctx, resultID)
 	if err != nil {
@@ -56,6 +72,9 @@ func (uc *CollageResultUseCase) MarkAsNotified(ctx context.Context, resultID uui
 	if result == nil {
 		return collage_result.ErrResultNotFound
 	}
+	if err := uc.authz.CanManageGroup(ctx, callerID.String(), result.GroupID()); err != nil {
+		return err
+	}
 
 	// 通知済みにする
 	result.MarkAsNotified()
@@ -63,8 +82,8 @@ func (uc *CollageResultUseCase) MarkAsNotified(ctx context.Context, resultID uui
 	return uc.repo.Update(ctx, result)
 }
 
-// DeleteResult deletes a result
-func (uc *CollageResultUseCase) DeleteResult(ctx context.Context, resultID uuid.UUID) error {
+// DeleteResult deletes a result (owner only)
+func (uc *CollageResultUseCase) DeleteResult(ctx context.Context, resultID, callerID uuid.UUID) error {
 	// 結果が存在するかチェック
 	result, err := uc.repo.FindByID(ctx, resultID)
 	if err != nil {
@@ -73,6 +92,9 @@ func (uc *CollageResultUseCase) DeleteResult(ctx context.Context, resultID uuid.
 	if result == nil {
 		return collage_result.ErrResultNotFound
 	}
+	if err := uc.authz.CanManageGroup(ctx, callerID.String(), result.GroupID()); err != nil {
+		return err
+	}
 
 	return uc.repo.Delete(ctx, resultID)
 }
@@ -5,14 +5,24 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/jphacks/os_2502/back/api/internal/domain/collage_template"
+	"github.com/jphacks/os_2502/back/api/internal/domain/friend"
+	"github.com/jphacks/os_2502/back/api/internal/domain/template_part"
 )
 
 type CollageTemplateUseCase struct {
-	repo collage_template.Repository
+	repo       collage_template.Repository
+	partRepo   template_part.Repository
+	friendRepo friend.Repository
 }
 
-func NewCollageTemplateUseCase(repo collage_template.Repository) *CollageTemplateUseCase {
-	return &CollageTemplateUseCase{repo: repo}
+func NewCollageTemplateUseCase(repo collage_template.Repository, partRepo template_part.Repository, friendRepo friend.Repository) *CollageTemplateUseCase {
+	return &CollageTemplateUseCase{repo: repo, partRepo: partRepo, friendRepo: friendRepo}
+}
+
+// TemplateLayout テンプレートと、パーツ番号順のフレーム
+type TemplateLayout struct {
+	Template *collage_template.CollageTemplate
+	Frames   []collage_template.Frame
 }
 
 // CreateTemplate creates a new collage template
@@ -36,9 +46,53 @@ func (uc *CollageTemplateUseCase) CreateTemplate(ctx context.Context, name, file
 	return template, nil
 }
 
-// GetTemplate retrieves a template by ID
-func (uc *CollageTemplateUseCase) GetTemplate(ctx context.Context, templateID uuid.UUID) (*collage_template.CollageTemplate, error) {
-	return uc.repo.FindByID(ctx, templateID)
+// GetTemplate retrieves a template the caller can view
+// 参照できないテンプレートは存在しないものとして扱う
+func (uc *CollageTemplateUseCase) GetTemplate(ctx context.Context, callerID, templateID uuid.UUID) (*collage_template.CollageTemplate, error) {
+	return findViewableTemplate(ctx, uc.repo, uc.friendRepo, callerID, templateID)
+}
+
+// GetLayout retrieves a template the caller can view with its frames
+func (uc *CollageTemplateUseCase) GetLayout(ctx context.Context, callerID, templateID uuid.UUID) (*TemplateLayout, error) {
+	template, err := findViewableTemplate(ctx, uc.repo, uc.friendRepo, callerID, templateID)
+	if err != nil {
+		return nil, err
+	}
+	return uc.layout(ctx, template)
+}
+
+// ListBuiltinLayouts 組み込みテンプレートをフレーム付きで取得（photoCount が 0 より大きければその枚数のものだけ）
+func (uc *CollageTemplateUseCase) ListBuiltinLayouts(ctx context.Context, photoCount int) ([]*TemplateLayout, error) {
+	templates, err := uc.repo.ListBuiltin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	layouts := make([]*TemplateLayout, 0, len(templates))
+	for _, t := range templates {
+		if photoCount > 0 && t.PhotoCount() != photoCount {
+			continue
+		}
+		layout, err := uc.layout(ctx, t)
+		if err != nil {
+			return nil, err
+		}
+		layouts = append(layouts, layout)
+	}
+	return layouts, nil
+}
+
+func (uc *CollageTemplateUseCase) layout(ctx context.Context, template *collage_template.CollageTemplate) (*TemplateLayout, error) {
+	return loadLayout(ctx, uc.partRepo, template)
+}
+
+// loadLayout テンプレートのパーツを読み込んでフレームにする
+func loadLayout(ctx context.Context, partRepo template_part.Repository, template *collage_template.CollageTemplate) (*TemplateLayout, error) {
+	parts, err := partRepo.FindByTemplateID(ctx, template.TemplateID())
+	if err != nil {
+		return nil, err
+	}
+	return &TemplateLayout{Template: template, Frames: template.Frames(parts)}, nil
 }
 
 // ListTemplates retrieves all templates
@@ -0,0 +1,236 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jphacks/os_2502/back/api/internal/domain/collage_template"
+	"github.com/jphacks/os_2502/back/api/internal/domain/friend"
+	"github.com/jphacks/os_2502/back/api/internal/domain/template_part"
+)
+
+// CustomTemplateUseCase ユーザーが作成するテンプレートの作成・公開・複製
+type CustomTemplateUseCase struct {
+	templateRepo collage_template.Repository
+	partRepo     template_part.Repository
+	friendRepo   friend.Repository
+}
+
+func NewCustomTemplateUseCase(templateRepo collage_template.Repository, partRepo template_part.Repository, friendRepo friend.Repository) *CustomTemplateUseCase {
+	return &CustomTemplateUseCase{
+		templateRepo: templateRepo,
+		partRepo:     partRepo,
+		friendRepo:   friendRepo,
+	}
+}
+
+// FrameInput フレームの指定。Path が空なら X, Y, Width, Height の矩形（どちらも viewBox 座標系）
+type FrameInput struct {
+	Path   string
+	X      float64
+	Y      float64
+	Width  float64
+	Height float64
+}
+
+// CustomTemplateInput テンプレートの作成内容（ViewBox・Width・Height は省略時デフォルト）
+type CustomTemplateInput struct {
+	Name       string
+	ViewBox    string
+	Width      int
+	Height     int
+	PhotoCount int
+	Visibility collage_template.Visibility
+	Frames     []FrameInput
+}
+
+// CreateTemplate フレームを検証してテンプレートを作成する（フレームの順番がパーツ番号になる）
+func (uc *CustomTemplateUseCase) CreateTemplate(ctx context.Context, ownerUserID uuid.UUID, in CustomTemplateInput) (*TemplateLayout, error) {
+	tmpl, err := collage_template.NewCustomTemplate(ownerUserID, in.Name, in.Visibility)
+	if err != nil {
+		return nil, err
+	}
+
+	viewBox, width, height := in.ViewBox, in.Width, in.Height
+	if viewBox == "" {
+		viewBox = collage_template.DefaultViewBox
+	}
+	if width == 0 {
+		width = collage_template.DefaultSize
+	}
+	if height == 0 {
+		height = collage_template.DefaultSize
+	}
+	if err := tmpl.UpdateLayout(viewBox, width, height, in.PhotoCount); err != nil {
+		return nil, err
+	}
+
+	paths := make([]string, len(in.Frames))
+	for i, f := range in.Frames {
+		switch {
+		case f.Path != "":
+			paths[i] = f.Path
+		case f.Width > 0 && f.Height > 0:
+			paths[i] = collage_template.RectPath(f.X, f.Y, f.Width, f.Height)
+		default:
+			return nil, collage_template.ErrInvalidFramePath
+		}
+	}
+	if err := tmpl.ValidateFrames(paths); err != nil {
+		return nil, err
+	}
+
+	parts, err := framesToParts(tmpl, paths)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.templateRepo.SaveWithParts(ctx, tmpl, parts); err != nil {
+		return nil, err
+	}
+	return loadLayout(ctx, uc.partRepo, tmpl)
+}
+
+// GetTemplate 参照できるテンプレートをフレーム付きで取得
+// 参照できないテンプレートは存在しないものとして扱う
+func (uc *CustomTemplateUseCase) GetTemplate(ctx context.Context, callerID, templateID uuid.UUID) (*TemplateLayout, error) {
+	tmpl, err := uc.viewable(ctx, callerID, templateID)
+	if err != nil {
+		return nil, err
+	}
+	return loadLayout(ctx, uc.partRepo, tmpl)
+}
+
+// ListMyTemplates 自分が作成したテンプレート
+func (uc *CustomTemplateUseCase) ListMyTemplates(ctx context.Context, callerID uuid.UUID, limit, offset int) ([]*TemplateLayout, error) {
+	templates, err := uc.templateRepo.ListByOwner(ctx, callerID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	return uc.layouts(ctx, templates)
+}
+
+// ListSharedByFriends フレンドが共有しているテンプレート
+func (uc *CustomTemplateUseCase) ListSharedByFriends(ctx context.Context, callerID uuid.UUID, limit, offset int) ([]*TemplateLayout, error) {
+	templates, err := uc.templateRepo.ListSharedByFriends(ctx, callerID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	return uc.layouts(ctx, templates)
+}
+
+// PublishTemplate 公開範囲を変更（作成者のみ）
+func (uc *CustomTemplateUseCase) PublishTemplate(ctx context.Context, callerID, templateID uuid.UUID, visibility collage_template.Visibility) (*TemplateLayout, error) {
+	tmpl, err := uc.viewable(ctx, callerID, templateID)
+	if err != nil {
+		return nil, err
+	}
+	if tmpl.IsBuiltin() {
+		return nil, collage_template.ErrBuiltinTemplate
+	}
+	if !tmpl.IsOwnedBy(callerID) {
+		return nil, collage_template.ErrNotTemplateOwner
+	}
+
+	if err := tmpl.Publish(visibility); err != nil {
+		return nil, err
+	}
+	if err := uc.templateRepo.Update(ctx, tmpl); err != nil {
+		return nil, err
+	}
+	return loadLayout(ctx, uc.partRepo, tmpl)
+}
+
+// ForkTemplate 参照できるテンプレートを自分用の非公開テンプレートとして複製する（name が空なら元の名前）
+func (uc *CustomTemplateUseCase) ForkTemplate(ctx context.Context, callerID, templateID uuid.UUID, name string) (*TemplateLayout, error) {
+	source, err := uc.viewable(ctx, callerID, templateID)
+	if err != nil {
+		return nil, err
+	}
+	sourceLayout, err := loadLayout(ctx, uc.partRepo, source)
+	if err != nil {
+		return nil, err
+	}
+
+	forked, err := source.Fork(callerID, name)
+	if err != nil {
+		return nil, err
+	}
+	paths := make([]string, len(sourceLayout.Frames))
+	for i, f := range sourceLayout.Frames {
+		paths[i] = f.Path
+	}
+	parts, err := framesToParts(forked, paths)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.templateRepo.SaveWithParts(ctx, forked, parts); err != nil {
+		return nil, err
+	}
+	return loadLayout(ctx, uc.partRepo, forked)
+}
+
+// viewable callerID が参照できるテンプレートを返す
+func (uc *CustomTemplateUseCase) viewable(ctx context.Context, callerID, templateID uuid.UUID) (*collage_template.CollageTemplate, error) {
+	return findViewableTemplate(ctx, uc.templateRepo, uc.friendRepo, callerID, templateID)
+}
+
+// findViewableTemplate callerID が参照できるテンプレートを返す
+// 参照できないテンプレートは ErrTemplateNotFound
+func findViewableTemplate(ctx context.Context, templateRepo collage_template.Repository, friendRepo friend.Repository, callerID, templateID uuid.UUID) (*collage_template.CollageTemplate, error) {
+	tmpl, err := templateRepo.FindByID(ctx, templateID)
+	if err != nil {
+		return nil, err
+	}
+	return viewableTemplate(ctx, friendRepo, callerID, tmpl)
+}
+
+// viewableTemplate 読み込み済みのテンプレートを callerID が参照できればそのまま返す
+// 参照できないテンプレートは ErrTemplateNotFound
+func viewableTemplate(ctx context.Context, friendRepo friend.Repository, callerID uuid.UUID, tmpl *collage_template.CollageTemplate) (*collage_template.CollageTemplate, error) {
+	if tmpl.CanBeViewedBy(callerID, false) {
+		return tmpl, nil
+	}
+	if tmpl.Visibility() == collage_template.VisibilityFriends {
+		isFriend, err := friendRepo.CheckFriendship(ctx, callerID.String(), tmpl.OwnerUserID().String())
+		if err != nil {
+			return nil, err
+		}
+		if tmpl.CanBeViewedBy(callerID, isFriend) {
+			return tmpl, nil
+		}
+	}
+	return nil, collage_template.ErrTemplateNotFound
+}
+
+func (uc *CustomTemplateUseCase) layouts(ctx context.Context, templates []*collage_template.CollageTemplate) ([]*TemplateLayout, error) {
+	layouts := make([]*TemplateLayout, len(templates))
+	for i, t := range templates {
+		layout, err := loadLayout(ctx, uc.partRepo, t)
+		if err != nil {
+			return nil, err
+		}
+		layouts[i] = layout
+	}
+	return layouts, nil
+}
+
+// framesToParts フレームのパスをパーツにする。パーツの位置とサイズはフレームの外接矩形
+func framesToParts(tmpl *collage_template.CollageTemplate, paths []string) ([]*template_part.TemplatePart, error) {
+	parts := make([]*template_part.TemplatePart, len(paths))
+	for i, d := range paths {
+		rect, err := tmpl.FrameRect(d)
+		if err != nil {
+			return nil, err
+		}
+		part, err := template_part.NewTemplatePart(tmpl.TemplateID(), i+1, rect.Min.X, rect.Min.Y, rect.Dx(), rect.Dy(), nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		path := d
+		if err := part.UpdatePath(&path); err != nil {
+			return nil, err
+		}
+		parts[i] = part
+	}
+	return parts, nil
+}
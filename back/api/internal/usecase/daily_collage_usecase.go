@@ -0,0 +1,158 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jphacks/os_2502/back/api/internal/domain/collage_result"
+	"github.com/jphacks/os_2502/back/api/internal/domain/daily_collage"
+	"github.com/jphacks/os_2502/back/api/internal/domain/group_part_assignment"
+	"github.com/jphacks/os_2502/back/api/internal/domain/template_part"
+	"github.com/jphacks/os_2502/back/api/internal/domain/upload_image"
+	"github.com/jphacks/os_2502/back/api/internal/policy"
+)
+
+type DailyCollageUseCase struct {
+	dailyRepo      daily_collage.Repository
+	assignmentRepo group_part_assignment.Repository
+	partRepo       template_part.Repository
+	uploadRepo     upload_image.Repository
+	resultRepo     collage_result.Repository
+	location       *time.Location
+	authz          *policy.Policy
+}
+
+// NewDailyCollageUseCase location は日付の区切りを決めるタイムゾーン（スケジューラーと同じもの）
+func NewDailyCollageUseCase(
+	dailyRepo daily_collage.Repository,
+	assignmentRepo group_part_assignment.Repository,
+	partRepo template_part.Repository,
+	uploadRepo upload_image.Repository,
+	resultRepo collage_result.Repository,
+	location *time.Location,
+	authz *policy.Policy,
+) *DailyCollageUseCase {
+	if location == nil {
+		location = time.Local
+	}
+	return &DailyCollageUseCase{
+		dailyRepo:      dailyRepo,
+		assignmentRepo: assignmentRepo,
+		partRepo:       partRepo,
+		uploadRepo:     uploadRepo,
+		resultRepo:     resultRepo,
+		location:       location,
+		authz:          authz,
+	}
+}
+
+// DailyView 1日分の予定と、リクエストしたメンバーが撮影するパーツ（割り当てが無い日は nil）
+type DailyView struct {
+	Collage *daily_collage.DailyCollage
+	Part    *template_part.TemplatePart
+}
+
+// Today 現在の日付（スケジューラーのタイムゾーン）
+func (uc *DailyCollageUseCase) Today() time.Time {
+	return daily_collage.DayOf(time.Now(), uc.location)
+}
+
+// GetDay retrieves the schedule of a day with the member's part (members only)
+func (uc *DailyCollageUseCase) GetDay(ctx context.Context, groupID string, userID uuid.UUID, collageDay time.Time) (*DailyView, error) {
+	if err := uc.authz.CanViewGroup(ctx, userID.String(), groupID); err != nil {
+		return nil, err
+	}
+
+	daily, err := uc.dailyRepo.FindByGroupAndDay(ctx, groupID, collageDay)
+	if err != nil {
+		return nil, err
+	}
+
+	view := &DailyView{Collage: daily}
+	part, err := uc.assignedPart(ctx, groupID, userID, collageDay)
+	switch err {
+	case nil:
+		view.Part = part
+	case daily_collage.ErrNoPartAssigned:
+	default:
+		return nil, err
+	}
+	return view, nil
+}
+
+// CheckDailyOpen 今日の写真を受け付けられるかチェックし、メンバーが撮影するパーツを返す
+func (uc *DailyCollageUseCase) CheckDailyOpen(ctx context.Context, groupID string, userID uuid.UUID) (*template_part.TemplatePart, error) {
+	if err := uc.authz.CanUploadToGroup(ctx, userID.String(), groupID); err != nil {
+		return nil, err
+	}
+
+	today := uc.Today()
+	daily, err := uc.dailyRepo.FindByGroupAndDay(ctx, groupID, today)
+	if err != nil {
+		return nil, err
+	}
+	if err := daily.CanAcceptPhoto(time.Now()); err != nil {
+		return nil, err
+	}
+	return uc.assignedPart(ctx, groupID, userID, today)
+}
+
+// RecordDailyPhoto 今日の割り当てパーツの写真を記録
+// 締め切りまでは撮り直せ、コラージュにはパーツごとに最新の写真を使う。imageID は保存した写真のキーに含めた ID
+func (uc *DailyCollageUseCase) RecordDailyPhoto(ctx context.Context, imageID uuid.UUID, fileURL, groupID string, userID uuid.UUID, meta PhotoMetadata) (*upload_image.UploadImage, error) {
+	part, err := uc.CheckDailyOpen(ctx, groupID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	image, err := upload_image.NewUploadImage(imageID, fileURL, groupID, userID, uc.Today())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := image.AssignPart(part.PartID()); err != nil {
+		return nil, err
+	}
+
+	if err := image.SetCaptureMetadata(meta.CapturedAt, meta.Width, meta.Height); err != nil {
+		return nil, err
+	}
+
+	if err := uc.uploadRepo.Create(ctx, image); err != nil {
+		return nil, err
+	}
+
+	return image, nil
+}
+
+// GetCollage retrieves the generated collage of a day (members only)
+func (uc *DailyCollageUseCase) GetCollage(ctx context.Context, groupID, userID string, collageDay time.Time) (*collage_result.CollageResult, error) {
+	if err := uc.authz.CanViewResult(ctx, userID, groupID); err != nil {
+		return nil, err
+	}
+
+	daily, err := uc.dailyRepo.FindByGroupAndDay(ctx, groupID, collageDay)
+	if err != nil {
+		if err == daily_collage.ErrDailyCollageNotFound {
+			return nil, collage_result.ErrResultNotFound
+		}
+		return nil, err
+	}
+	if daily.ResultID() == nil {
+		return nil, collage_result.ErrResultNotFound
+	}
+	return uc.resultRepo.FindByID(ctx, *daily.ResultID())
+}
+
+// assignedPart その日にメンバーが撮影するパーツ
+func (uc *DailyCollageUseCase) assignedPart(ctx context.Context, groupID string, userID uuid.UUID, collageDay time.Time) (*template_part.TemplatePart, error) {
+	assignment, err := uc.assignmentRepo.FindByUserGroupAndDay(ctx, userID, groupID, collageDay)
+	if err != nil {
+		if err == group_part_assignment.ErrGroupPartAssignmentNotFound {
+			return nil, daily_collage.ErrNoPartAssigned
+		}
+		return nil, err
+	}
+	return uc.partRepo.FindByID(ctx, assignment.PartID())
+}
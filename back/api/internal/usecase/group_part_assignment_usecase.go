@@ -2,26 +2,224 @@ package usecase
 
 import (
 	"context"
+	"math/rand"
+	"sort"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/jphacks/os_2502/back/api/internal/domain/collage_template"
+	"github.com/jphacks/os_2502/back/api/internal/domain/friend"
+	"github.com/jphacks/os_2502/back/api/internal/domain/group"
+	"github.com/jphacks/os_2502/back/api/internal/domain/group_member"
 	"github.com/jphacks/os_2502/back/api/internal/domain/group_part_assignment"
+	"github.com/jphacks/os_2502/back/api/internal/domain/template_part"
+	"github.com/jphacks/os_2502/back/api/internal/policy"
 )
 
 type GroupPartAssignmentUseCase struct {
-	repo group_part_assignment.Repository
+	repo         group_part_assignment.Repository
+	groupRepo    group.Repository
+	memberRepo   group_member.Repository
+	templateRepo collage_template.Repository
+	partRepo     template_part.Repository
+	friendRepo   friend.Repository
+	authz        *policy.Policy
 }
 
-func NewGroupPartAssignmentUseCase(repo group_part_assignment.Repository) *GroupPartAssignmentUseCase {
-	return &GroupPartAssignmentUseCase{repo: repo}
+func NewGroupPartAssignmentUseCase(
+	repo group_part_assignment.Repository,
+	groupRepo group.Repository,
+	memberRepo group_member.Repository,
+	templateRepo collage_template.Repository,
+	partRepo template_part.Repository,
+	friendRepo friend.Repository,
+	authz *policy.Policy,
+) *GroupPartAssignmentUseCase {
+	return &GroupPartAssignmentUseCase{
+		repo:         repo,
+		groupRepo:    groupRepo,
+		memberRepo:   memberRepo,
+		templateRepo: templateRepo,
+		partRepo:     partRepo,
+		friendRepo:   friendRepo,
+		authz:        authz,
+	}
+}
+
+// AutoAssignInput パーツの自動割り当ての指定
+type AutoAssignInput struct {
+	Strategy group_part_assignment.Strategy
+	// TemplateID 空の場合はグループのテンプレート
+	// オーナー以外が指定しても無視し、オーナーの場合もグループのテンプレートと一致する必要がある
+	TemplateID uuid.UUID
+	// Overrides メンバーごとに指定するパーツ（オーナーのみ）
+	Overrides map[uuid.UUID]uuid.UUID
+}
+
+// AutoAssignParts グループの全メンバーにその日のパーツを割り当てる
+// 既に割り当て済みの日は何も変えずにその割り当てを返す（created が false）
+func (uc *GroupPartAssignmentUseCase) AutoAssignParts(ctx context.Context, callerID uuid.UUID, groupID string, collageDay time.Time, in AutoAssignInput) (assignments []*group_part_assignment.GroupPartAssignment, created bool, err error) {
+	// 割り当てを指定するのはオーナーだけ、自動で決めるだけならメンバーなら誰でも
+	if len(in.Overrides) > 0 || in.Strategy == group_part_assignment.StrategyManual {
+		err = uc.authz.CanManageGroup(ctx, callerID.String(), groupID)
+	} else {
+		err = uc.authz.CanUploadToGroup(ctx, callerID.String(), groupID)
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	existing, err := uc.repo.FindByGroupAndDay(ctx, groupID, collageDay)
+	if err != nil {
+		return nil, false, err
+	}
+	if len(existing) > 0 {
+		return existing, false, nil
+	}
+
+	parts, err := uc.templateParts(ctx, callerID, groupID, in.TemplateID)
+	if err != nil {
+		return nil, false, err
+	}
+
+	members, err := uc.memberRepo.FindByGroupID(ctx, groupID)
+	if err != nil {
+		return nil, false, err
+	}
+	memberIDs := make([]uuid.UUID, 0, len(members))
+	for _, m := range members {
+		if id, err := uuid.Parse(m.UserID()); err == nil {
+			memberIDs = append(memberIDs, id)
+		}
+	}
+
+	history, err := uc.repo.FindHistory(ctx, groupID, collageDay, group_part_assignment.HistoryLimit)
+	if err != nil {
+		return nil, false, err
+	}
+
+	partIDs := make([]uuid.UUID, len(parts))
+	for i, p := range parts {
+		partIDs[i] = p.PartID()
+	}
+	plan, err := group_part_assignment.Assign(group_part_assignment.AssignInput{
+		Strategy:  in.Strategy,
+		Members:   memberIDs,
+		Parts:     partIDs,
+		History:   history,
+		Overrides: in.Overrides,
+		Intn:      rand.Intn,
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	// メンバーの並び順で返す
+	assignments = make([]*group_part_assignment.GroupPartAssignment, 0, len(plan))
+	for _, userID := range memberIDs {
+		partID, ok := plan[userID]
+		if !ok {
+			continue
+		}
+		gpa, err := group_part_assignment.NewGroupPartAssignment(groupID, userID, partID, collageDay)
+		if err != nil {
+			return nil, false, err
+		}
+		assignments = append(assignments, gpa)
+	}
+
+	if err := uc.repo.CreateBatch(ctx, assignments); err != nil {
+		if err != group_part_assignment.ErrDuplicatePartAssignment {
+			return nil, false, err
+		}
+		// 同時に実行された別のリクエストが先に割り当てた
+		existing, err := uc.repo.FindByGroupAndDay(ctx, groupID, collageDay)
+		if err != nil {
+			return nil, false, err
+		}
+		return existing, false, nil
+	}
+	return assignments, true, nil
+}
+
+// templateParts 割り当てに使うグループのテンプレートのパーツをパーツ番号順に返す
+// オーナーが templateID を指定した場合はグループのテンプレートと一致するかを確かめる（メンバーの指定は無視する）
+// どちらの場合も callerID が参照できるテンプレートに限る
+func (uc *GroupPartAssignmentUseCase) templateParts(ctx context.Context, callerID uuid.UUID, groupID string, templateID uuid.UUID) ([]*template_part.TemplatePart, error) {
+	g, err := uc.groupRepo.FindByID(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+	ref := g.TemplateID()
+	if ref == nil || *ref == "" {
+		return nil, group.ErrTemplateRequired
+	}
+	groupTemplateID, err := uuid.Parse(*ref)
+	if err != nil {
+		return nil, collage_template.ErrTemplateNotFound
+	}
+	if templateID != uuid.Nil && g.OwnerUserID() == callerID.String() && templateID != groupTemplateID {
+		return nil, group_part_assignment.ErrTemplateMismatch
+	}
+
+	if _, err := findViewableTemplate(ctx, uc.templateRepo, uc.friendRepo, callerID, groupTemplateID); err != nil {
+		return nil, err
+	}
+
+	parts, err := uc.partRepo.FindByTemplateID(ctx, groupTemplateID)
+	if err != nil {
+		return nil, err
+	}
+	if len(parts) == 0 {
+		return nil, group_part_assignment.ErrNoParts
+	}
+	sort.Slice(parts, func(i, j int) bool { return parts[i].PartNumber() < parts[j].PartNumber() })
+	return parts, nil
+}
+
+// containsPart parts に partID のパーツが含まれているか
+func containsPart(parts []*template_part.TemplatePart, partID uuid.UUID) bool {
+	for _, p := range parts {
+		if p.PartID() == partID {
+			return true
+		}
+	}
+	return false
 }
 
 func (uc *GroupPartAssignmentUseCase) CreateGroupPartAssignment(
 	ctx context.Context,
+	callerID uuid.UUID,
 	groupID string,
 	userID, partID uuid.UUID,
 	collageDay time.Time,
 ) (*group_part_assignment.GroupPartAssignment, error) {
+	// 自分への割り当てはメンバーなら可能、他のメンバーへの割り当てはオーナーのみ
+	if callerID == userID {
+		if err := uc.authz.CanUploadToGroup(ctx, callerID.String(), groupID); err != nil {
+			return nil, err
+		}
+	} else {
+		if err := uc.authz.CanManageGroup(ctx, callerID.String(), groupID); err != nil {
+			return nil, err
+		}
+		if err := uc.authz.CanUploadToGroup(ctx, userID.String(), groupID); err != nil {
+			if err == policy.ErrForbidden {
+				return nil, group_part_assignment.ErrInvalidUserID
+			}
+			return nil, err
+		}
+	}
+
+	// グループのテンプレートのパーツだけ割り当てられる
+	parts, err := uc.templateParts(ctx, callerID, groupID, uuid.Nil)
+	if err != nil {
+		return nil, err
+	}
+	if !containsPart(parts, partID) {
+		return nil, group_part_assignment.ErrOverridePartNotInTemplate
+	}
+
 	// 同じユーザー、グループ、日付の組み合わせが既に存在するかチェック
 	existing, err := uc.repo.FindByUserGroupAndDay(ctx, userID, groupID, collageDay)
 	if err == nil && existing != nil {
@@ -40,30 +238,78 @@ func (uc *GroupPartAssignmentUseCase) CreateGroupPartAssignment(
 	return gpa, nil
 }
 
-func (uc *GroupPartAssignmentUseCase) GetGroupPartAssignmentByID(ctx context.Context, assignmentID uuid.UUID) (*group_part_assignment.GroupPartAssignment, error) {
-	return uc.repo.FindByID(ctx, assignmentID)
+func (uc *GroupPartAssignmentUseCase) GetGroupPartAssignmentByID(ctx context.Context, assignmentID, callerID uuid.UUID) (*group_part_assignment.GroupPartAssignment, error) {
+	gpa, err := uc.repo.FindByID(ctx, assignmentID)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.authz.CanViewGroup(ctx, callerID.String(), gpa.GroupID()); err != nil {
+		return nil, err
+	}
+	return gpa, nil
 }
 
-func (uc *GroupPartAssignmentUseCase) GetGroupPartAssignmentsByGroupAndDay(ctx context.Context, groupID string, collageDay time.Time) ([]*group_part_assignment.GroupPartAssignment, error) {
+func (uc *GroupPartAssignmentUseCase) GetGroupPartAssignmentsByGroupAndDay(ctx context.Context, callerID uuid.UUID, groupID string, collageDay time.Time) ([]*group_part_assignment.GroupPartAssignment, error) {
+	if err := uc.authz.CanViewGroup(ctx, callerID.String(), groupID); err != nil {
+		return nil, err
+	}
 	return uc.repo.FindByGroupAndDay(ctx, groupID, collageDay)
 }
 
 func (uc *GroupPartAssignmentUseCase) GetGroupPartAssignmentByUserGroupAndDay(ctx context.Context, userID uuid.UUID, groupID string, collageDay time.Time) (*group_part_assignment.GroupPartAssignment, error) {
+	if err := uc.authz.CanViewGroup(ctx, userID.String(), groupID); err != nil {
+		return nil, err
+	}
 	return uc.repo.FindByUserGroupAndDay(ctx, userID, groupID, collageDay)
 }
 
-func (uc *GroupPartAssignmentUseCase) GetGroupPartAssignmentsByPartID(ctx context.Context, partID uuid.UUID) ([]*group_part_assignment.GroupPartAssignment, error) {
-	return uc.repo.FindByPartID(ctx, partID)
+// GetGroupPartAssignmentsByPartID パーツの割り当てのうち、呼び出し元が参照できるグループのものだけを返す
+func (uc *GroupPartAssignmentUseCase) GetGroupPartAssignmentsByPartID(ctx context.Context, callerID, partID uuid.UUID) ([]*group_part_assignment.GroupPartAssignment, error) {
+	assignments, err := uc.repo.FindByPartID(ctx, partID)
+	if err != nil {
+		return nil, err
+	}
+	return uc.filterViewable(ctx, callerID, assignments)
 }
 
-func (uc *GroupPartAssignmentUseCase) DeleteGroupPartAssignment(ctx context.Context, assignmentID uuid.UUID) error {
+func (uc *GroupPartAssignmentUseCase) DeleteGroupPartAssignment(ctx context.Context, assignmentID, callerID uuid.UUID) error {
+	gpa, err := uc.repo.FindByID(ctx, assignmentID)
+	if err != nil {
+		return err
+	}
+	if err := uc.authz.CanManageGroup(ctx, callerID.String(), gpa.GroupID()); err != nil {
+		return err
+	}
 	return uc.repo.Delete(ctx, assignmentID)
 }
 
-func (uc *GroupPartAssignmentUseCase) DeleteGroupPartAssignmentsByGroupAndDay(ctx context.Context, groupID string, collageDay time.Time) error {
+func (uc *GroupPartAssignmentUseCase) DeleteGroupPartAssignmentsByGroupAndDay(ctx context.Context, callerID uuid.UUID, groupID string, collageDay time.Time) error {
+	if err := uc.authz.CanManageGroup(ctx, callerID.String(), groupID); err != nil {
+		return err
+	}
 	return uc.repo.DeleteByGroupAndDay(ctx, groupID, collageDay)
 }
 
-func (uc *GroupPartAssignmentUseCase) ListGroupPartAssignments(ctx context.Context, limit, offset int) ([]*group_part_assignment.GroupPartAssignment, error) {
-	return uc.repo.List(ctx, limit, offset)
+// ListGroupPartAssignments 呼び出し元がメンバーのグループの割り当てだけを返す
+func (uc *GroupPartAssignmentUseCase) ListGroupPartAssignments(ctx context.Context, callerID uuid.UUID, limit, offset int) ([]*group_part_assignment.GroupPartAssignment, error) {
+	return uc.repo.FindByMemberUserID(ctx, callerID.String(), limit, offset)
+}
+
+func (uc *GroupPartAssignmentUseCase) filterViewable(ctx context.Context, callerID uuid.UUID, assignments []*group_part_assignment.GroupPartAssignment) ([]*group_part_assignment.GroupPartAssignment, error) {
+	groupIDs := make([]string, len(assignments))
+	for i, gpa := range assignments {
+		groupIDs[i] = gpa.GroupID()
+	}
+	viewable, err := uc.authz.ViewableGroups(ctx, callerID.String(), groupIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	filtered := make([]*group_part_assignment.GroupPartAssignment, 0, len(assignments))
+	for _, gpa := range assignments {
+		if viewable[gpa.GroupID()] {
+			filtered = append(filtered, gpa)
+		}
+	}
+	return filtered, nil
 }
@@ -2,21 +2,54 @@ package usecase
 
 import (
 	"context"
+	"log"
 	"time"
 
+	"github.com/google/uuid"
+	"github.com/jphacks/os_2502/back/api/internal/domain/collage_template"
+	"github.com/jphacks/os_2502/back/api/internal/domain/friend"
 	"github.com/jphacks/os_2502/back/api/internal/domain/group"
 	"github.com/jphacks/os_2502/back/api/internal/domain/group_member"
+	"github.com/jphacks/os_2502/back/api/internal/domain/session_round"
+	"github.com/jphacks/os_2502/back/api/internal/notification"
+	"github.com/jphacks/os_2502/back/api/internal/policy"
+	"github.com/jphacks/os_2502/back/api/internal/realtime"
+	"github.com/jphacks/os_2502/back/api/internal/timesync"
 )
 
 type GroupUseCase struct {
-	groupRepo  group.Repository
-	memberRepo group_member.Repository
+	groupRepo     group.Repository
+	memberRepo    group_member.Repository
+	roundRepo     session_round.Repository
+	templateRepo  collage_template.Repository
+	friendRepo    friend.Repository
+	publisher     realtime.Publisher
+	notifier      notification.Notifier
+	captureWindow time.Duration
+	authz         *policy.Policy
 }
 
-func NewGroupUseCase(groupRepo group.Repository, memberRepo group_member.Repository) *GroupUseCase {
+// NewGroupUseCase captureWindow は撮影時刻から写真を受け付ける時間（0 なら group.DefaultCaptureWindow）
+func NewGroupUseCase(groupRepo group.Repository, memberRepo group_member.Repository, roundRepo session_round.Repository, templateRepo collage_template.Repository, friendRepo friend.Repository, publisher realtime.Publisher, notifier notification.Notifier, captureWindow time.Duration, authz *policy.Policy) *GroupUseCase {
+	if publisher == nil {
+		publisher = realtime.NopPublisher{}
+	}
+	if notifier == nil {
+		notifier = notification.NopNotifier{}
+	}
+	if captureWindow <= 0 {
+		captureWindow = group.DefaultCaptureWindow
+	}
 	return &GroupUseCase{
-		groupRepo:  groupRepo,
-		memberRepo: memberRepo,
+		groupRepo:     groupRepo,
+		memberRepo:    memberRepo,
+		roundRepo:     roundRepo,
+		templateRepo:  templateRepo,
+		friendRepo:    friendRepo,
+		publisher:     publisher,
+		notifier:      notifier,
+		captureWindow: captureWindow,
+		authz:         authz,
 	}
 }
 
@@ -55,9 +88,17 @@ func (uc *GroupUseCase) CreateGroup(ctx context.Context, ownerUserID, name strin
 	return g, nil
 }
 
-// GetGroupByID retrieves a group by ID
-func (uc *GroupUseCase) GetGroupByID(ctx context.Context, id string) (*group.Group, error) {
-	return uc.groupRepo.FindByID(ctx, id)
+// GetGroupByID retrieves a group by ID (members only)
+// 撮影時刻を過ぎたカウントダウンは撮影中に進めてから返す
+func (uc *GroupUseCase) GetGroupByID(ctx context.Context, id, userID string) (*group.Group, error) {
+	if err := uc.authz.CanViewGroup(ctx, userID, id); err != nil {
+		return nil, err
+	}
+	g, err := uc.groupRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return advanceCapture(ctx, uc.groupRepo, uc.publisher, g, time.Now())
 }
 
 // GetGroupByInvitationToken retrieves a group by invitation token
@@ -66,7 +107,11 @@ func (uc *GroupUseCase) GetGroupByInvitationToken(ctx context.Context, token str
 }
 
 // GetGroupsByOwnerUserID retrieves groups by owner user ID
-func (uc *GroupUseCase) GetGroupsByOwnerUserID(ctx context.Context, ownerUserID string, limit, offset int) ([]*group.Group, error) {
+// 招待トークンを含むので、取得できるのは自分がオーナーのグループだけ
+func (uc *GroupUseCase) GetGroupsByOwnerUserID(ctx context.Context, ownerUserID, userID string, limit, offset int) ([]*group.Group, error) {
+	if ownerUserID != userID {
+		return nil, policy.ErrForbidden
+	}
 	if limit <= 0 {
 		limit = 20
 	}
@@ -111,45 +156,128 @@ func (uc *GroupUseCase) JoinGroup(ctx context.Context, invitationToken, userID s
 		return nil, err
 	}
 
-	// メンバー数を更新
-	if err := g.IncrementMemberCount(); err != nil {
+	// メンバー数だけを更新し、同時に参加したメンバーやステータスの変更を上書きしない
+	added, err := uc.groupRepo.IncrementMemberCount(ctx, g.ID(), time.Now())
+	if err != nil {
 		return nil, err
 	}
+	if !added {
+		// 取得した後に他のメンバーが定員を埋めたか、メンバーが確定した
+		if err := uc.memberRepo.DeleteByGroupIDAndUserID(ctx, g.ID(), userID); err != nil {
+			return nil, err
+		}
+		if g, err = uc.groupRepo.FindByID(ctx, g.ID()); err != nil {
+			return nil, err
+		}
+		if g.Status() != group.GroupStatusRecruiting {
+			return nil, group.ErrGroupNotRecruiting
+		}
+		return nil, group.ErrGroupFull
+	}
 
-	if err := uc.groupRepo.Update(ctx, g); err != nil {
+	if g, err = uc.groupRepo.FindByID(ctx, g.ID()); err != nil {
 		return nil, err
 	}
 
+	uc.publisher.Publish(realtime.NewEvent(realtime.EventMemberJoined, g.ID(), realtime.MemberJoinedPayload{
+		UserID:      userID,
+		MemberCount: g.CurrentMemberCount(),
+	}))
+
 	return g, nil
 }
 
+// InviteUsers sends an invitation notification to each user who is not yet a member (owner only)
+// 招待は通知を送るだけで、参加は従来どおり招待トークンで行う
+func (uc *GroupUseCase) InviteUsers(ctx context.Context, groupID, userID string, inviteeIDs []string) ([]string, error) {
+	if err := uc.authz.CanManageGroup(ctx, userID, groupID); err != nil {
+		return nil, err
+	}
+
+	g, err := uc.groupRepo.FindByID(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+	if !g.CanJoin() {
+		if g.IsExpired() {
+			return nil, group.ErrGroupExpired
+		}
+		if g.IsFull() {
+			return nil, group.ErrGroupFull
+		}
+		return nil, group.ErrGroupNotRecruiting
+	}
+
+	// 既にメンバーのユーザーと重複を除く
+	invited := make([]string, 0, len(inviteeIDs))
+	seen := make(map[string]bool, len(inviteeIDs))
+	for _, id := range inviteeIDs {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+
+		_, err := uc.memberRepo.FindByGroupIDAndUserID(ctx, groupID, id)
+		if err == nil {
+			continue
+		}
+		if err != group_member.ErrMemberNotFound {
+			return nil, err
+		}
+		invited = append(invited, id)
+	}
+
+	if len(invited) > 0 {
+		uc.notifier.Notify(invited, notification.Invited(g.ID(), g.Name(), g.InvitationToken()))
+	}
+
+	return invited, nil
+}
+
 // FinalizeGroupMembers finalizes group members (owner only)
 func (uc *GroupUseCase) FinalizeGroupMembers(ctx context.Context, groupID, userID string) (*group.Group, error) {
+	if err := uc.authz.CanManageGroup(ctx, userID, groupID); err != nil {
+		return nil, err
+	}
+
 	// グループを取得
 	g, err := uc.groupRepo.FindByID(ctx, groupID)
 	if err != nil {
 		return nil, err
 	}
 
-	// オーナーかどうかチェック
-	if g.OwnerUserID() != userID {
-		return nil, group.ErrInvalidOwnerUserID
-	}
-
 	// メンバーを確定
 	if err := g.FinalizeMembers(); err != nil {
 		return nil, err
 	}
 
-	if err := uc.groupRepo.Update(ctx, g); err != nil {
+	// 取得した後に参加・離脱があっても、保存されているメンバー数で定員を確定する
+	finalized, err := uc.groupRepo.FinalizeMembers(ctx, groupID, *g.FinalizedAt())
+	if err != nil {
 		return nil, err
 	}
+	if !finalized {
+		return nil, group.ErrGroupNotRecruiting
+	}
+
+	if g, err = uc.groupRepo.FindByID(ctx, groupID); err != nil {
+		return nil, err
+	}
+
+	uc.publisher.Publish(realtime.NewEvent(realtime.EventMembersFinalized, g.ID(), realtime.MembersFinalizedPayload{
+		MemberCount: g.CurrentMemberCount(),
+	}))
 
 	return g, nil
 }
 
 // MarkMemberReady marks a member as ready
 func (uc *GroupUseCase) MarkMemberReady(ctx context.Context, groupID, userID string) error {
+	// 撮影に参加できるのはメンバーのみ
+	if err := uc.authz.CanUploadToGroup(ctx, userID, groupID); err != nil {
+		return err
+	}
+
 	// メンバーを取得
 	member, err := uc.memberRepo.FindByGroupIDAndUserID(ctx, groupID, userID)
 	if err != nil {
@@ -168,42 +296,386 @@ func (uc *GroupUseCase) MarkMemberReady(ctx context.Context, groupID, userID str
 		return err
 	}
 
-	// 全員準備完了かどうかはクライアント側で判定
-	// オーナーが撮影ボタンを押すまでカウントダウンは開始しない
+	readyCount, err := uc.memberRepo.CountReadyByGroupID(ctx, groupID)
+	if err != nil {
+		return err
+	}
+	memberCount, err := uc.memberRepo.CountByGroupID(ctx, groupID)
+	if err != nil {
+		return err
+	}
+
+	uc.publisher.Publish(realtime.NewEvent(realtime.EventMemberReady, groupID, realtime.MemberReadyPayload{
+		UserID:      userID,
+		ReadyCount:  readyCount,
+		MemberCount: memberCount,
+	}))
+
+	// 自動開始が有効なら、確定したメンバー全員が揃った時点でサーバーがカウントダウンを始める
+	// 無効な場合はオーナーが撮影ボタンを押すまで開始しない
+	return uc.autoStartIfEveryoneReady(ctx, groupID, readyCount)
+}
+
+// CancelMemberReady cancels the ready status of the member before the countdown starts
+func (uc *GroupUseCase) CancelMemberReady(ctx context.Context, groupID, userID string) error {
+	if err := uc.authz.CanUploadToGroup(ctx, userID, groupID); err != nil {
+		return err
+	}
+
+	g, err := uc.groupRepo.FindByID(ctx, groupID)
+	if err != nil {
+		return err
+	}
+	// カウントダウンが始まった後は取り消せない
+	if g.Status() != group.GroupStatusRecruiting && g.Status() != group.GroupStatusReadyCheck {
+		return group.ErrSessionAlreadyStarted
+	}
+
+	member, err := uc.memberRepo.FindByGroupIDAndUserID(ctx, groupID, userID)
+	if err != nil {
+		return err
+	}
+	if member == nil {
+		return group_member.ErrMemberNotFound
+	}
+
+	if err := member.CancelReady(); err != nil {
+		return err
+	}
+
+	if err := uc.memberRepo.Update(ctx, member); err != nil {
+		return err
+	}
+
+	readyCount, err := uc.memberRepo.CountReadyByGroupID(ctx, groupID)
+	if err != nil {
+		return err
+	}
+	memberCount, err := uc.memberRepo.CountByGroupID(ctx, groupID)
+	if err != nil {
+		return err
+	}
+
+	uc.publisher.Publish(realtime.NewEvent(realtime.EventMemberUnready, groupID, realtime.MemberReadyPayload{
+		UserID:      userID,
+		ReadyCount:  readyCount,
+		MemberCount: memberCount,
+	}))
 
 	return nil
 }
 
-// GetGroupMembers retrieves all members of a group
-func (uc *GroupUseCase) GetGroupMembers(ctx context.Context, groupID string) ([]*group_member.GroupMember, error) {
+// UpdateSessionSettings updates the countdown length and the auto start flag (owner only)
+func (uc *GroupUseCase) UpdateSessionSettings(ctx context.Context, groupID, userID string, countdownSeconds int, autoStart bool, templateID *string) (*group.Group, error) {
+	if err := uc.authz.CanManageGroup(ctx, userID, groupID); err != nil {
+		return nil, err
+	}
+
+	g, err := uc.groupRepo.FindByID(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	if templateID != nil && *templateID != "" {
+		resolved, err := uc.resolveTemplateID(ctx, userID, *templateID)
+		if err != nil {
+			return nil, err
+		}
+		// 募集中はメンバー数が変わるので、枚数の確認はメンバーが確定してから（開始時にも確認する）
+		if g.Status() != group.GroupStatusRecruiting {
+			if err := uc.checkTemplateMembers(ctx, groupID, resolved); err != nil {
+				return nil, err
+			}
+		}
+		templateID = &resolved
+	}
+
+	status := g.Status()
+	if err := g.UpdateSessionSettings(countdownSeconds, autoStart, templateID); err != nil {
+		return nil, err
+	}
+
+	updated, err := uc.groupRepo.UpdateIfStatus(ctx, g, status)
+	if err != nil {
+		return nil, err
+	}
+	if !updated {
+		return nil, group.ErrSessionAlreadyStarted
+	}
+
+	// 全員が準備完了した後に自動開始を有効にした場合は、そのまま開始する
+	if g.AutoStart() {
+		readyCount, err := uc.memberRepo.CountReadyByGroupID(ctx, groupID)
+		if err != nil {
+			return nil, err
+		}
+		if err := uc.autoStartIfEveryoneReady(ctx, groupID, readyCount); err != nil {
+			return nil, err
+		}
+		if g, err = uc.groupRepo.FindByID(ctx, groupID); err != nil {
+			return nil, err
+		}
+	}
+
+	return g, nil
+}
+
+// autoStartIfEveryoneReady 自動開始が有効で、確定したメンバー全員が準備完了していればカウントダウンを始める
+func (uc *GroupUseCase) autoStartIfEveryoneReady(ctx context.Context, groupID string, readyCount int) error {
+	g, err := uc.groupRepo.FindByID(ctx, groupID)
+	if err != nil {
+		return err
+	}
+	if !g.AutoStart() || !g.IsEveryoneReady(readyCount) {
+		return nil
+	}
+
+	// 最後の2人が同時に準備完了しても、開始するのはどちらか一方だけ
+	// テンプレートの枚数がメンバー数と合わない場合は、オーナーがテンプレートを選び直して開始する
+	err = uc.startCountdown(ctx, g, "", "", true)
+	switch err {
+	case nil, group.ErrGroupNotReadyCheck:
+		return nil
+	case group.ErrTemplateMemberMismatch:
+		log.Printf("⏭️ Group %s: template does not match the member count, skipping auto start", groupID)
+		return nil
+	}
+	return err
+}
+
+// GetGroupMembers retrieves all members of a group (members only)
+func (uc *GroupUseCase) GetGroupMembers(ctx context.Context, groupID, userID string) ([]*group_member.GroupMember, error) {
+	if err := uc.authz.CanViewGroup(ctx, userID, groupID); err != nil {
+		return nil, err
+	}
 	return uc.memberRepo.FindByGroupID(ctx, groupID)
 }
 
 // StartCountdown starts the countdown for photo session
+// templateID が空の場合は設定で選んでおいたテンプレートを使う
 func (uc *GroupUseCase) StartCountdown(ctx context.Context, groupID, userID, templateID string) (*group.Group, error) {
+	if err := uc.authz.CanManageGroup(ctx, userID, groupID); err != nil {
+		return nil, err
+	}
+
 	g, err := uc.groupRepo.FindByID(ctx, groupID)
 	if err != nil {
 		return nil, err
 	}
 
-	// オーナーチェック
-	if g.OwnerUserID() != userID {
-		return nil, group.ErrInvalidOwnerUserID
+	if templateID != "" {
+		if templateID, err = uc.resolveTemplateID(ctx, userID, templateID); err != nil {
+			return nil, err
+		}
 	}
 
-	// カウントダウン開始（10秒後に撮影）
-	if err := g.StartCountdown(10, templateID); err != nil {
+	if err := uc.startCountdown(ctx, g, userID, templateID, false); err != nil {
 		return nil, err
 	}
 
-	// 更新
-	if err := uc.groupRepo.Update(ctx, g); err != nil {
+	return g, nil
+}
+
+// resolveTemplateID 指定されたテンプレートの collages_template のIDを返す
+// 以前のクライアントが送ってくる templates.json のテンプレート名も受け付ける
+// userID が参照できないテンプレート（他人の非公開テンプレートなど）は ErrTemplateNotFound
+func (uc *GroupUseCase) resolveTemplateID(ctx context.Context, userID, ref string) (string, error) {
+	callerID, err := uuid.Parse(userID)
+	if err != nil {
+		return "", collage_template.ErrTemplateNotFound
+	}
+
+	var t *collage_template.CollageTemplate
+	if id, parseErr := uuid.Parse(ref); parseErr == nil {
+		t, err = findViewableTemplate(ctx, uc.templateRepo, uc.friendRepo, callerID, id)
+	} else if t, err = uc.templateRepo.FindBySourceName(ctx, ref); err == nil {
+		t, err = viewableTemplate(ctx, uc.friendRepo, callerID, t)
+	}
+	if err != nil {
+		return "", err
+	}
+	return t.TemplateID().String(), nil
+}
+
+// checkTemplateMembers テンプレートの写真の枚数がグループのメンバー数と一致するかを確かめる
+// 一致しないとフレームが余るか足りず、コラージュを生成できない
+func (uc *GroupUseCase) checkTemplateMembers(ctx context.Context, groupID, templateID string) error {
+	id, err := uuid.Parse(templateID)
+	if err != nil {
+		return collage_template.ErrTemplateNotFound
+	}
+	t, err := uc.templateRepo.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	memberCount, err := uc.memberRepo.CountByGroupID(ctx, groupID)
+	if err != nil {
+		return err
+	}
+	if t.PhotoCount() != memberCount {
+		return group.ErrTemplateMemberMismatch
+	}
+	return nil
+}
+
+// startCountdown カウントダウンを開始してメンバーに知らせる
+// ステータスが準備確認中のままの場合だけ保存するので、オーナーの操作と自動開始が重なっても開始は1回になる
+func (uc *GroupUseCase) startCountdown(ctx context.Context, g *group.Group, initiatorID, templateID string, autoStarted bool) error {
+	if templateID == "" && g.TemplateID() != nil {
+		templateID = *g.TemplateID()
+	}
+	if templateID == "" {
+		return group.ErrTemplateRequired
+	}
+	if err := uc.checkTemplateMembers(ctx, g.ID(), templateID); err != nil {
+		return err
+	}
+
+	// 設定した秒数後に撮影、そこから captureWindow の間写真を受け付ける
+	if err := g.StartCountdown(g.CountdownSeconds(), templateID, uc.captureWindow); err != nil {
+		return err
+	}
+
+	updated, err := uc.groupRepo.UpdateIfStatus(ctx, g, group.GroupStatusReadyCheck)
+	if err != nil {
+		return err
+	}
+	if !updated {
+		return group.ErrGroupNotReadyCheck
+	}
+
+	// ラウンドの履歴を記録（カウントダウンは始まっているので、失敗しても撮影は続ける）
+	round, err := session_round.NewSessionRound(g.ID(), g.CurrentRound(), templateID, *g.ScheduledCaptureTime(), *g.CaptureDeadline())
+	if err == nil {
+		err = uc.roundRepo.Create(ctx, round)
+	}
+	if err != nil {
+		log.Printf("⚠️ Failed to record round %d of group %s: %v", g.CurrentRound(), g.ID(), err)
+	}
+
+	// 端末ごとの時計のずれを補正した撮影時刻を配る
+	members, err := uc.memberRepo.FindByGroupID(ctx, g.ID())
+	if err != nil {
+		return err
+	}
+
+	scheduled := *g.ScheduledCaptureTime()
+	now := time.Now()
+	devices := make([]realtime.DeviceCapture, len(members))
+	for i, m := range members {
+		devices[i] = realtime.DeviceCapture{
+			UserID:     m.UserID(),
+			CaptureAt:  m.DeviceTime(scheduled),
+			ClockState: string(m.ClockState(now)),
+		}
+		if offset := m.ClockOffset(); offset != nil {
+			ms := offset.Milliseconds()
+			devices[i].ClockOffsetMS = &ms
+		}
+	}
+
+	uc.publisher.Publish(realtime.NewEvent(realtime.EventCountdownStarted, g.ID(), realtime.CountdownStartedPayload{
+		RoundNumber:          g.CurrentRound(),
+		TemplateID:           templateID,
+		CountdownStartedAt:   *g.CountdownStartedAt(),
+		ScheduledCaptureTime: scheduled,
+		AutoStarted:          autoStarted,
+		Devices:              devices,
+	}))
+
+	// アプリを閉じているメンバーにも撮影開始を知らせる（押したオーナー本人は除く）
+	recipients := make([]string, 0, len(members))
+	for _, m := range members {
+		if m.UserID() != initiatorID {
+			recipients = append(recipients, m.UserID())
+		}
+	}
+	if len(recipients) > 0 {
+		uc.notifier.Notify(recipients, notification.CountdownStarting(g.ID(), g.Name(), scheduled))
+	}
+
+	return nil
+}
+
+// StartNextRound lets the members of a finished session shoot another round (owner only)
+func (uc *GroupUseCase) StartNextRound(ctx context.Context, groupID, userID string) (*group.Group, error) {
+	if err := uc.authz.CanManageGroup(ctx, userID, groupID); err != nil {
+		return nil, err
+	}
+
+	g, err := uc.groupRepo.FindByID(ctx, groupID)
+	if err != nil {
 		return nil, err
 	}
 
+	status := g.Status()
+	if err := g.StartNextRound(); err != nil {
+		return nil, err
+	}
+
+	// 準備確認中に戻す前にリセットしておき、戻した直後の準備完了を消さないようにする
+	if err := uc.memberRepo.ResetReadyByGroupID(ctx, groupID); err != nil {
+		return nil, err
+	}
+
+	updated, err := uc.groupRepo.UpdateIfStatus(ctx, g, status)
+	if err != nil {
+		return nil, err
+	}
+	if !updated {
+		return nil, group.ErrRoundNotFinished
+	}
+
+	uc.publisher.Publish(realtime.NewEvent(realtime.EventNextRound, groupID, realtime.NextRoundPayload{
+		RoundNumber: g.CurrentRound() + 1,
+	}))
+
 	return g, nil
 }
 
+// RecordClockSync records the member's clock offset from the best of the given time sync samples
+func (uc *GroupUseCase) RecordClockSync(ctx context.Context, groupID, userID string, samples []timesync.Sample) (*group_member.GroupMember, error) {
+	if err := uc.authz.CanUploadToGroup(ctx, userID, groupID); err != nil {
+		return nil, err
+	}
+
+	member, err := uc.memberRepo.FindByGroupIDAndUserID(ctx, groupID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	best, ok := timesync.Best(samples)
+	if !ok {
+		return nil, group_member.ErrInvalidClockSample
+	}
+
+	// サーバー側の時刻が古すぎる、または未来のサンプルは使い回しや改ざんとみなす
+	received := time.UnixMicro(best.ServerReceive)
+	now := time.Now()
+	if received.After(now) || now.Sub(received) > group_member.ClockSampleMaxAge {
+		return nil, group_member.ErrInvalidClockSample
+	}
+
+	if err := member.RecordClockSync(best.Offset(), best.RTT()); err != nil {
+		return nil, err
+	}
+
+	if err := uc.memberRepo.Update(ctx, member); err != nil {
+		return nil, err
+	}
+
+	return member, nil
+}
+
+// GetClockStatus retrieves the clock sync state of every member (owner only)
+func (uc *GroupUseCase) GetClockStatus(ctx context.Context, groupID, userID string) ([]*group_member.GroupMember, error) {
+	if err := uc.authz.CanManageGroup(ctx, userID, groupID); err != nil {
+		return nil, err
+	}
+
+	return uc.memberRepo.FindByGroupID(ctx, groupID)
+}
+
 // LeaveGroup allows a member to leave a group
 func (uc *GroupUseCase) LeaveGroup(ctx context.Context, groupID, userID string) error {
 	// グループを取得
@@ -231,46 +703,59 @@ func (uc *GroupUseCase) LeaveGroup(ctx context.Context, groupID, userID string)
 		return group_member.ErrMemberNotFound
 	}
 
-	if err := uc.memberRepo.DeleteByGroupIDAndUserID(ctx, groupID, userID); err != nil {
-		return err
-	}
-
-	// メンバー数を更新
-	if err := g.DecrementMemberCount(); err != nil {
+	// メンバー数だけを更新する。取得した後にメンバーが確定していたら離脱させない
+	removed, err := uc.groupRepo.DecrementMemberCount(ctx, groupID, time.Now())
+	if err != nil {
 		return err
 	}
-
-	if err := uc.groupRepo.Update(ctx, g); err != nil {
-		return err
+	if !removed {
+		return group.ErrGroupNotRecruiting
 	}
 
-	return nil
+	return uc.memberRepo.DeleteByGroupIDAndUserID(ctx, groupID, userID)
 }
 
 // DeleteGroup deletes a group (owner only)
 func (uc *GroupUseCase) DeleteGroup(ctx context.Context, groupID, userID string) error {
-	// グループを取得
-	g, err := uc.groupRepo.FindByID(ctx, groupID)
-	if err != nil {
+	if err := uc.authz.CanManageGroup(ctx, userID, groupID); err != nil {
 		return err
 	}
 
-	// オーナーかどうかチェック
-	if g.OwnerUserID() != userID {
-		return group.ErrInvalidOwnerUserID
+	// グループを取得
+	if _, err := uc.groupRepo.FindByID(ctx, groupID); err != nil {
+		return err
 	}
 
 	// グループを削除
 	return uc.groupRepo.Delete(ctx, groupID)
 }
 
-// ListGroups retrieves all groups
-func (uc *GroupUseCase) ListGroups(ctx context.Context, limit, offset int) ([]*group.Group, error) {
+// ListGroups retrieves the groups the user is a member of
+func (uc *GroupUseCase) ListGroups(ctx context.Context, userID string, limit, offset int) ([]*group.Group, error) {
 	if limit <= 0 {
 		limit = 20
 	}
 	if offset < 0 {
 		offset = 0
 	}
-	return uc.groupRepo.List(ctx, limit, offset)
+	return uc.groupRepo.FindByMemberUserID(ctx, userID, limit, offset)
+}
+
+// advanceCapture 撮影時刻を過ぎたカウントダウンを撮影中に進めて保存し、メンバーに知らせる
+// 他のリクエストやワーカーが先にステータスを変えていた場合は、保存されているグループを返す
+func advanceCapture(ctx context.Context, groupRepo group.Repository, publisher realtime.Publisher, g *group.Group, now time.Time) (*group.Group, error) {
+	if !g.AdvanceCapture(now) {
+		return g, nil
+	}
+	updated, err := groupRepo.UpdateIfStatus(ctx, g, group.GroupStatusCountdown)
+	if err != nil {
+		return nil, err
+	}
+	if !updated {
+		return groupRepo.FindByID(ctx, g.ID())
+	}
+	publisher.Publish(realtime.NewEvent(realtime.EventPhotoTakingStarted, g.ID(), realtime.PhotoTakingStartedPayload{
+		CaptureDeadline: *g.CaptureDeadline(),
+	}))
+	return g, nil
 }
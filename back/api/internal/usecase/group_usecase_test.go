@@ -0,0 +1,81 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jphacks/os_2502/back/api/internal/domain/group"
+	"github.com/jphacks/os_2502/back/api/internal/domain/group_member"
+	"github.com/jphacks/os_2502/back/api/internal/realtime"
+)
+
+// joinRaceGroups 取得したときは空きがあるが、メンバー数を増やす前に他のメンバーが最後の枠を埋めたグループ
+type joinRaceGroups struct {
+	group.Repository
+	fetched, latest *group.Group
+}
+
+func (f *joinRaceGroups) FindByInvitationToken(ctx context.Context, token string) (*group.Group, error) {
+	return f.fetched, nil
+}
+
+func (f *joinRaceGroups) FindByID(ctx context.Context, id string) (*group.Group, error) {
+	return f.latest, nil
+}
+
+func (f *joinRaceGroups) IncrementMemberCount(ctx context.Context, id string, updatedAt time.Time) (bool, error) {
+	return false, nil
+}
+
+type recordingMembers struct {
+	group_member.Repository
+	members map[string]bool
+}
+
+func (m *recordingMembers) FindByGroupIDAndUserID(ctx context.Context, groupID, userID string) (*group_member.GroupMember, error) {
+	return nil, group_member.ErrMemberNotFound
+}
+
+func (m *recordingMembers) Create(ctx context.Context, member *group_member.GroupMember) error {
+	m.members[member.UserID()] = true
+	return nil
+}
+
+func (m *recordingMembers) DeleteByGroupIDAndUserID(ctx context.Context, groupID, userID string) error {
+	delete(m.members, userID)
+	return nil
+}
+
+type recordingPublisher struct{ events []realtime.Event }
+
+func (p *recordingPublisher) Publish(e realtime.Event) {
+	p.events = append(p.events, e)
+}
+
+func TestJoinGroupLosesLastSlot(t *testing.T) {
+	now := time.Now()
+	recruiting := func(count int) *group.Group {
+		g, err := group.Reconstruct("g", "owner", "group", group.GroupTypeLocalTemporary, group.GroupStatusRecruiting, 2, count, "token",
+			nil, nil, nil, nil, nil, nil, group.DefaultCountdownSeconds, false, 0, now, now)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return g
+	}
+	groups := &joinRaceGroups{fetched: recruiting(1), latest: recruiting(2)}
+	members := &recordingMembers{members: map[string]bool{}}
+	publisher := &recordingPublisher{}
+	uc := NewGroupUseCase(groups, members, nil, nil, nil, publisher, nil, 0, nil)
+
+	if _, err := uc.JoinGroup(context.Background(), "token", "late"); err != group.ErrGroupFull {
+		t.Fatalf("JoinGroup: got %v, want %v", err, group.ErrGroupFull)
+	}
+	// 追加したメンバーは取り消し、参加の通知も送らない
+	if members.members["late"] {
+		t.Error("member left in the group")
+	}
+	if len(publisher.events) != 0 {
+		t.Errorf("events = %+v, want none", publisher.events)
+	}
+}
@@ -0,0 +1,54 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"log"
+	"path"
+	"strings"
+
+	"github.com/jphacks/os_2502/back/api/internal/blobstore"
+	"github.com/jphacks/os_2502/back/api/internal/ingest"
+)
+
+// StoredPhoto 保存した写真と、そのレンディションのキー
+type StoredPhoto struct {
+	Key string
+	// Renditions レンディションの名前（thumb / preview）からキー
+	Renditions map[string]string
+}
+
+// RenditionKey 写真のキーからレンディションのキーを作る（groups/g/a.jpg -> groups/g/a_thumb.jpg）
+func RenditionKey(key, name string) string {
+	return strings.TrimSuffix(key, path.Ext(key)) + "_" + name + ".jpg"
+}
+
+// SavePhoto 正規化した写真とレンディションを保存する。途中で失敗した場合は保存した分を削除する
+func SavePhoto(ctx context.Context, store blobstore.Store, key string, res *ingest.Result) (*StoredPhoto, error) {
+	photo := &StoredPhoto{Key: key, Renditions: map[string]string{}}
+	if err := store.Put(ctx, key, bytes.NewReader(res.Data), int64(len(res.Data)), res.ContentType); err != nil {
+		return nil, err
+	}
+	for _, r := range res.Renditions {
+		rkey := RenditionKey(key, r.Name)
+		if err := store.Put(ctx, rkey, bytes.NewReader(r.Data), int64(len(r.Data)), "image/jpeg"); err != nil {
+			DeletePhoto(ctx, store, photo)
+			return nil, err
+		}
+		photo.Renditions[r.Name] = rkey
+	}
+	return photo, nil
+}
+
+// DeletePhoto 保存した写真とレンディションを削除する（記録に失敗した場合の後片付け）
+func DeletePhoto(ctx context.Context, store blobstore.Store, photo *StoredPhoto) {
+	keys := []string{photo.Key}
+	for _, key := range photo.Renditions {
+		keys = append(keys, key)
+	}
+	for _, key := range keys {
+		if err := store.Delete(ctx, key); err != nil {
+			log.Printf("⚠️ Failed to delete photo %s: %v", key, err)
+		}
+	}
+}
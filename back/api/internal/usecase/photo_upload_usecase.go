@@ -0,0 +1,218 @@
+package usecase
+
+import (
+	"context"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jphacks/os_2502/back/api/internal/blobstore"
+	"github.com/jphacks/os_2502/back/api/internal/domain/upload_image"
+	"github.com/jphacks/os_2502/back/api/internal/domain/upload_slot"
+	"github.com/jphacks/os_2502/back/api/internal/ingest"
+	"github.com/jphacks/os_2502/back/api/internal/policy"
+)
+
+const (
+	// PhotoUploadMaxSize 写真1枚の最大サイズ（取り込みで受け付ける上限と同じ）
+	PhotoUploadMaxSize = ingest.MaxFileSize
+	// UploadSlotTTL 直接アップロード用の署名付きURLの有効期限
+	UploadSlotTTL = 15 * time.Minute
+)
+
+// PhotoUploadUseCase 端末からストレージへ直接アップロードする写真の受け付け
+// 署名付きURLを発行し、確定時にアップロードされたオブジェクトを確認して取り込む
+type PhotoUploadUseCase struct {
+	slotRepo      upload_slot.Repository
+	uploadImageUC *UploadImageUseCase
+	authz         *policy.Policy
+	store         blobstore.Store
+}
+
+func NewPhotoUploadUseCase(slotRepo upload_slot.Repository, uploadImageUC *UploadImageUseCase, authz *policy.Policy, store blobstore.Store) *PhotoUploadUseCase {
+	return &PhotoUploadUseCase{slotRepo: slotRepo, uploadImageUC: uploadImageUC, authz: authz, store: store}
+}
+
+// UploadSlotGrant 発行したアップロード枠と、端末がアップロードに使う署名付きURL
+type UploadSlotGrant struct {
+	Slot   *upload_slot.UploadSlot
+	URL    string
+	Method string
+	// Headers アップロード時に付けるヘッダー
+	Headers map[string]string
+}
+
+// IngestedPhoto 取り込んで記録した写真
+type IngestedPhoto struct {
+	Image *upload_image.UploadImage
+	*StoredPhoto
+	Size int
+	PhotoMetadata
+}
+
+// CheckCanUpload グループのメンバーで、グループが写真を受け付けられる状態かチェック
+// フレーム番号も記録時と同じように確認し、使えないフレームの枠は作らない
+func (uc *PhotoUploadUseCase) CheckCanUpload(ctx context.Context, groupID string, userID uuid.UUID, frameIndex int) error {
+	if err := uc.authz.CanUploadToGroup(ctx, userID.String(), groupID); err != nil {
+		return err
+	}
+	return uc.uploadImageUC.CheckFrameAvailable(ctx, groupID, userID, frameIndex)
+}
+
+// RequestSlot グループ撮影の写真をアップロードする枠を作成し、署名付きPUT URLを発行
+func (uc *PhotoUploadUseCase) RequestSlot(ctx context.Context, groupID string, userID uuid.UUID, frameIndex int, contentType string) (*UploadSlotGrant, error) {
+	// 撮影時刻前や締め切り後は枠を発行しない
+	if err := uc.CheckCanUpload(ctx, groupID, userID, frameIndex); err != nil {
+		return nil, err
+	}
+
+	slot, err := upload_slot.NewUploadSlot(groupID, userID, frameIndex, contentType, PhotoUploadMaxSize, time.Now().Add(UploadSlotTTL))
+	if err != nil {
+		return nil, err
+	}
+
+	url, err := uc.store.SignedURL(ctx, http.MethodPut, slot.ObjectKey(), UploadSlotTTL)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.slotRepo.Create(ctx, slot); err != nil {
+		return nil, err
+	}
+
+	return &UploadSlotGrant{
+		Slot:    slot,
+		URL:     url,
+		Method:  http.MethodPut,
+		Headers: map[string]string{"Content-Type": slot.ContentType()},
+	}, nil
+}
+
+// ConfirmSlot アップロードされたオブジェクトのサイズと形式を確認し、写真として取り込んで記録する
+// 確認に失敗したオブジェクトは削除するので、有効期限内ならアップロードし直して再度確定できる
+func (uc *PhotoUploadUseCase) ConfirmSlot(ctx context.Context, groupID string, slotID, userID uuid.UUID) (*IngestedPhoto, error) {
+	slot, err := uc.slotRepo.FindByID(ctx, slotID)
+	if err != nil {
+		return nil, err
+	}
+	// 他人の枠や別のグループの枠は存在しないものとして扱う
+	if slot.UserID() != userID || slot.GroupID() != groupID {
+		return nil, upload_slot.ErrSlotNotFound
+	}
+	if err := slot.CanConfirm(time.Now()); err != nil {
+		return nil, err
+	}
+
+	data, err := uc.readUploadedObject(ctx, slot)
+	if err != nil {
+		return nil, err
+	}
+
+	// 締め切り前に発行した枠は、締め切り後も group.UploadGracePeriod の間は確定できる
+	photo, err := uc.IngestPhoto(ctx, slot.GroupID(), userID, slot.FrameIndex(), slot.CreatedAt(), data)
+	if err != nil {
+		return nil, err
+	}
+
+	// 取り込み後は元のオブジェクトは不要
+	if err := uc.store.Delete(ctx, slot.ObjectKey()); err != nil {
+		log.Printf("⚠️ Failed to delete uploaded object %s: %v", slot.ObjectKey(), err)
+	}
+
+	// 写真は記録済みなので、ここで失敗しても確定自体は成功として返す
+	if err := slot.Confirm(photo.Image.ImageID(), time.Now()); err == nil {
+		if err := uc.slotRepo.Update(ctx, slot); err != nil {
+			log.Printf("❌ Failed to mark upload slot %s as confirmed: %v", slot.SlotID(), err)
+		}
+	}
+
+	return photo, nil
+}
+
+// readUploadedObject 枠にアップロードされたオブジェクトを確認して読み込む
+func (uc *PhotoUploadUseCase) readUploadedObject(ctx context.Context, slot *upload_slot.UploadSlot) ([]byte, error) {
+	key := slot.ObjectKey()
+
+	info, err := uc.store.Stat(ctx, key)
+	if err != nil {
+		if err == blobstore.ErrNotFound {
+			return nil, upload_slot.ErrObjectNotUploaded
+		}
+		return nil, err
+	}
+	if info.Size == 0 {
+		return nil, upload_slot.ErrObjectNotUploaded
+	}
+	if info.Size > slot.MaxSize() {
+		uc.discard(ctx, key)
+		return nil, upload_slot.ErrObjectTooLarge
+	}
+
+	rc, _, err := uc.store.Get(ctx, key)
+	if err != nil {
+		if err == blobstore.ErrNotFound {
+			return nil, upload_slot.ErrObjectNotUploaded
+		}
+		return nil, err
+	}
+	defer rc.Close()
+
+	// Stat の後に上書きされても上限以上は読まない
+	data, err := io.ReadAll(io.LimitReader(rc, slot.MaxSize()+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > slot.MaxSize() {
+		uc.discard(ctx, key)
+		return nil, upload_slot.ErrObjectTooLarge
+	}
+
+	// 形式はアップロード時のヘッダーではなく中身で判定する
+	if format, ok := ingest.Sniff(data); !ok || format.ContentType() != slot.ContentType() {
+		uc.discard(ctx, key)
+		return nil, upload_slot.ErrContentTypeMismatch
+	}
+
+	return data, nil
+}
+
+// discard 確認に失敗したオブジェクトを削除
+func (uc *PhotoUploadUseCase) discard(ctx context.Context, key string) {
+	if err := uc.store.Delete(ctx, key); err != nil {
+		log.Printf("⚠️ Failed to delete rejected upload %s: %v", key, err)
+	}
+}
+
+// IngestPhoto 写真を検証・正規化して保存し、グループ撮影の写真として記録
+// 壊れた写真や受け付けない形式はここで *ingest.Error として断るので、コラージュ生成時に欠けることはない
+// startedAt はアップロードを始めた時刻（撮影の受付期間の判定に使う）
+func (uc *PhotoUploadUseCase) IngestPhoto(ctx context.Context, groupID string, userID uuid.UUID, frameIndex int, startedAt time.Time, data []byte) (*IngestedPhoto, error) {
+	normalized, err := ingest.Normalize(data, time.Local)
+	if err != nil {
+		return nil, err
+	}
+
+	// 同じ秒に撮り直しても上書きし合わないよう、キーには画像IDを含める
+	imageID := uuid.New()
+	key := "groups/" + groupID + "/" + userID.String() + "_frame" + strconv.Itoa(frameIndex) + "_" + imageID.String() + normalized.Ext
+	stored, err := SavePhoto(ctx, uc.store, key, normalized)
+	if err != nil {
+		return nil, err
+	}
+
+	meta := PhotoMetadata{
+		CapturedAt: normalized.CapturedAt,
+		Width:      normalized.Width,
+		Height:     normalized.Height,
+	}
+	image, err := uc.uploadImageUC.RecordGroupPhoto(ctx, imageID, key, groupID, userID, frameIndex, startedAt, meta)
+	if err != nil {
+		DeletePhoto(ctx, uc.store, stored)
+		return nil, err
+	}
+
+	return &IngestedPhoto{Image: image, StoredPhoto: stored, Size: len(normalized.Data), PhotoMetadata: meta}, nil
+}
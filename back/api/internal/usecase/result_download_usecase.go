@@ -4,19 +4,36 @@ import (
 	"context"
 
 	"github.com/google/uuid"
+	"github.com/jphacks/os_2502/back/api/internal/domain/collage_result"
 	"github.com/jphacks/os_2502/back/api/internal/domain/result_download"
+	"github.com/jphacks/os_2502/back/api/internal/policy"
 )
 
 type ResultDownloadUseCase struct {
-	repo result_download.Repository
+	repo       result_download.Repository
+	resultRepo collage_result.Repository
+	authz      *policy.Policy
 }
 
-func NewResultDownloadUseCase(repo result_download.Repository) *ResultDownloadUseCase {
-	return &ResultDownloadUseCase{repo: repo}
+func NewResultDownloadUseCase(repo result_download.Repository, resultRepo collage_result.Repository, authz *policy.Policy) *ResultDownloadUseCase {
+	return &ResultDownloadUseCase{repo: repo, resultRepo: resultRepo, authz: authz}
 }
 
-// RecordDownload records a download
+// canViewResult コラージュ結果が属するグループのメンバーかチェック
+func (uc *ResultDownloadUseCase) canViewResult(ctx context.Context, resultID, userID uuid.UUID) error {
+	result, err := uc.resultRepo.FindByID(ctx, resultID)
+	if err != nil {
+		return err
+	}
+	return uc.authz.CanViewResult(ctx, userID.String(), result.GroupID())
+}
+
+// RecordDownload records a download (members only)
 func (uc *ResultDownloadUseCase) RecordDownload(ctx context.Context, resultID, userID uuid.UUID) (*result_download.ResultDownload, error) {
+	if err := uc.canViewResult(ctx, resultID, userID); err != nil {
+		return nil, err
+	}
+
 	// 既に記録されているかチェック
 	existing, err := uc.repo.FindByResultAndUser(ctx, resultID, userID)
 	if err == nil && existing != nil {
@@ -37,8 +54,11 @@ func (uc *ResultDownloadUseCase) RecordDownload(ctx context.Context, resultID, u
 	return download, nil
 }
 
-// GetDownloadsByResult retrieves all downloads by result ID
-func (uc *ResultDownloadUseCase) GetDownloadsByResult(ctx context.Context, resultID uuid.UUID, limit, offset int) ([]*result_download.ResultDownload, error) {
+// GetDownloadsByResult retrieves all downloads by result ID (members only)
+func (uc *ResultDownloadUseCase) GetDownloadsByResult(ctx context.Context, resultID, callerID uuid.UUID, limit, offset int) ([]*result_download.ResultDownload, error) {
+	if err := uc.canViewResult(ctx, resultID, callerID); err != nil {
+		return nil, err
+	}
 	if limit <= 0 {
 		limit = 50
 	}
@@ -48,7 +68,10 @@ func (uc *ResultDownloadUseCase) GetDownloadsByResult(ctx context.Context, resul
 	return uc.repo.FindByResultID(ctx, resultID, limit, offset)
 }
 
-// GetDownloadCount retrieves the download count for a result
-func (uc *ResultDownloadUseCase) GetDownloadCount(ctx context.Context, resultID uuid.UUID) (int, error) {
+// GetDownloadCount retrieves the download count for a result (members only)
+func (uc *ResultDownloadUseCase) GetDownloadCount(ctx context.Context, resultID, callerID uuid.UUID) (int, error) {
+	if err := uc.canViewResult(ctx, resultID, callerID); err != nil {
+		return 0, err
+	}
 	return uc.repo.CountByResultID(ctx, resultID)
 }
@@ -0,0 +1,200 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jphacks/os_2502/back/api/internal/blobstore"
+	"github.com/jphacks/os_2502/back/api/internal/domain/group"
+	"github.com/jphacks/os_2502/back/api/internal/domain/resumable_upload"
+)
+
+// ResumableUploadTTL 再開可能なアップロードの有効期限（作成から）
+const ResumableUploadTTL = time.Hour
+
+// ResumableUploadUseCase 分割して送られる写真のアップロード（tus プロトコル）
+// 受信したデータはストレージにパートとして保存し、全て揃ったら通常の写真の取り込みに渡す
+type ResumableUploadUseCase struct {
+	repo          resumable_upload.Repository
+	photoUploadUC *PhotoUploadUseCase
+	store         blobstore.Store
+}
+
+func NewResumableUploadUseCase(repo resumable_upload.Repository, photoUploadUC *PhotoUploadUseCase, store blobstore.Store) *ResumableUploadUseCase {
+	return &ResumableUploadUseCase{repo: repo, photoUploadUC: photoUploadUC, store: store}
+}
+
+// CreateUpload グループ撮影の写真のアップロードを作成
+// metadata は HEAD でそのまま返すためのクライアントの Upload-Metadata
+func (uc *ResumableUploadUseCase) CreateUpload(ctx context.Context, groupID string, userID uuid.UUID, frameIndex int, length int64, metadata *string) (*resumable_upload.ResumableUpload, error) {
+	if err := uc.photoUploadUC.CheckCanUpload(ctx, groupID, userID, frameIndex); err != nil {
+		return nil, err
+	}
+
+	upload, err := resumable_upload.NewResumableUpload(groupID, userID, frameIndex, length, PhotoUploadMaxSize, metadata, time.Now().Add(ResumableUploadTTL))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.repo.Create(ctx, upload); err != nil {
+		return nil, err
+	}
+	return upload, nil
+}
+
+// GetUpload 呼び出し元のアップロードを取得（他人のアップロードは存在しないものとして扱う）
+func (uc *ResumableUploadUseCase) GetUpload(ctx context.Context, uploadID, userID uuid.UUID) (*resumable_upload.ResumableUpload, error) {
+	upload, err := uc.repo.FindByID(ctx, uploadID)
+	if err != nil {
+		return nil, err
+	}
+	if upload.UserID() != userID {
+		return nil, resumable_upload.ErrUploadNotFound
+	}
+	if upload.IsExpired(time.Now()) {
+		return nil, resumable_upload.ErrUploadExpired
+	}
+	return upload, nil
+}
+
+// AppendData offset から body のデータを追加する
+// 途中で接続が切れた場合も受信できた分は保存するので、クライアントは HEAD で位置を確認して続きから送り直せる。
+// 全て揃ったら写真として取り込み、記録した写真を返す（取り込みに失敗した場合は空のデータを送り直せば再試行できる）。
+// 撮影の締め切り前に作成したアップロードは、締め切り後も group.UploadGracePeriod の間は完了できる
+func (uc *ResumableUploadUseCase) AppendData(ctx context.Context, uploadID, userID uuid.UUID, offset int64, body io.Reader) (*resumable_upload.ResumableUpload, *IngestedPhoto, error) {
+	upload, err := uc.GetUpload(ctx, uploadID, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	now := time.Now()
+	if err := upload.CanAppend(offset, now); err != nil {
+		return upload, nil, err
+	}
+
+	// 宣言したサイズを超えるデータは受け取らない
+	data, readErr := io.ReadAll(io.LimitReader(body, upload.Remaining()+1))
+	if int64(len(data)) > upload.Remaining() {
+		return upload, nil, resumable_upload.ErrExceedsLength
+	}
+
+	if len(data) > 0 {
+		// 同じ位置への送り直しが並行していても、パートは上書きし合わない
+		key := upload.NewPartKey(offset)
+		if err := uc.store.Put(ctx, key, bytes.NewReader(data), int64(len(data)), "application/octet-stream"); err != nil {
+			return upload, nil, err
+		}
+		if err := upload.Advance(int64(len(data)), now); err != nil {
+			return upload, nil, err
+		}
+		if err := uc.repo.UpdateOffset(ctx, upload, offset); err != nil {
+			// 他のリクエストが先に追加していたら、このパートは使わない
+			if err == resumable_upload.ErrOffsetMismatch {
+				if err := uc.store.Delete(ctx, key); err != nil {
+					log.Printf("⚠️ Failed to delete unused part %s: %v", key, err)
+				}
+			}
+			return upload, nil, err
+		}
+	}
+	if readErr != nil {
+		// 受信できた分は保存済み
+		return upload, nil, readErr
+	}
+
+	if !upload.IsReceived() {
+		return upload, nil, nil
+	}
+
+	photo, err := uc.complete(ctx, upload)
+	if err != nil {
+		// 撮影の受付が終わったアップロードは送り直しても完了できないので、ここで中止して受信済みのデータを削除する
+		if err == group.ErrCaptureWindowClosed || err == group.ErrGroupNotPhotoTaking {
+			if err := uc.remove(ctx, upload); err != nil {
+				log.Printf("⚠️ Failed to terminate upload %s after the capture window closed: %v", upload.UploadID(), err)
+			}
+		}
+		return upload, nil, err
+	}
+	return upload, photo, nil
+}
+
+// complete パートを連結して写真として取り込み、アップロードを完了する
+func (uc *ResumableUploadUseCase) complete(ctx context.Context, upload *resumable_upload.ResumableUpload) (*IngestedPhoto, error) {
+	data, err := uc.assemble(ctx, upload)
+	if err != nil {
+		return nil, err
+	}
+
+	photo, err := uc.photoUploadUC.IngestPhoto(ctx, upload.GroupID(), upload.UserID(), upload.FrameIndex(), upload.CreatedAt(), data)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := blobstore.DeletePrefix(ctx, uc.store, upload.PartPrefix()); err != nil {
+		log.Printf("⚠️ Failed to delete parts of upload %s: %v", upload.UploadID(), err)
+	}
+
+	// 写真は記録済みなので、ここで失敗しても完了として返す
+	if err := upload.Complete(photo.Image.ImageID(), time.Now()); err == nil {
+		if err := uc.repo.Update(ctx, upload); err != nil {
+			log.Printf("❌ Failed to mark upload %s as completed: %v", upload.UploadID(), err)
+		}
+	}
+	return photo, nil
+}
+
+// assemble パートを受信位置の順に連結する
+// 位置の更新に失敗して送り直されたパートは前のパートと重なる（同じ位置のこともある）ので、重なった分は読み飛ばす
+func (uc *ResumableUploadUseCase) assemble(ctx context.Context, upload *resumable_upload.ResumableUpload) ([]byte, error) {
+	prefix := upload.PartPrefix()
+	parts, err := uc.store.List(ctx, prefix)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(parts, func(i, j int) bool { return parts[i].Key < parts[j].Key })
+
+	buf := make([]byte, 0, upload.Length())
+	for _, part := range parts {
+		start, err := upload.PartOffset(part.Key)
+		if err != nil || start > int64(len(buf)) {
+			return nil, resumable_upload.ErrNotReceived
+		}
+		data, err := blobstore.ReadAll(ctx, uc.store, part.Key)
+		if err != nil {
+			return nil, err
+		}
+		if skip := int64(len(buf)) - start; skip < int64(len(data)) {
+			buf = append(buf, data[skip:]...)
+		}
+	}
+
+	if int64(len(buf)) < upload.Length() {
+		return nil, resumable_upload.ErrNotReceived
+	}
+	return buf[:upload.Length()], nil
+}
+
+// TerminateUpload アップロードを中止し、受信済みのデータを削除する
+func (uc *ResumableUploadUseCase) TerminateUpload(ctx context.Context, uploadID, userID uuid.UUID) error {
+	upload, err := uc.repo.FindByID(ctx, uploadID)
+	if err != nil {
+		return err
+	}
+	if upload.UserID() != userID {
+		return resumable_upload.ErrUploadNotFound
+	}
+	return uc.remove(ctx, upload)
+}
+
+// remove 受信済みのパートとアップロードの記録を削除する
+func (uc *ResumableUploadUseCase) remove(ctx context.Context, upload *resumable_upload.ResumableUpload) error {
+	if err := blobstore.DeletePrefix(ctx, uc.store, upload.PartPrefix()); err != nil {
+		return err
+	}
+	return uc.repo.Delete(ctx, upload.UploadID())
+}
@@ -0,0 +1,169 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/png"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jphacks/os_2502/back/api/internal/blobstore"
+	"github.com/jphacks/os_2502/back/api/internal/domain/group"
+	"github.com/jphacks/os_2502/back/api/internal/domain/group_member"
+	"github.com/jphacks/os_2502/back/api/internal/domain/resumable_upload"
+	"github.com/jphacks/os_2502/back/api/internal/policy"
+)
+
+// memUploads メモリ上の再開可能なアップロード（リクエストごとに別のコピーを返す）
+type memUploads struct {
+	resumable_upload.Repository
+	uploads map[uuid.UUID]*resumable_upload.ResumableUpload
+}
+
+func (m *memUploads) FindByID(ctx context.Context, uploadID uuid.UUID) (*resumable_upload.ResumableUpload, error) {
+	u, ok := m.uploads[uploadID]
+	if !ok {
+		return nil, resumable_upload.ErrUploadNotFound
+	}
+	return copyUpload(u), nil
+}
+
+func (m *memUploads) UpdateOffset(ctx context.Context, upload *resumable_upload.ResumableUpload, from int64) error {
+	if m.uploads[upload.UploadID()].Offset() != from {
+		return resumable_upload.ErrOffsetMismatch
+	}
+	m.uploads[upload.UploadID()] = copyUpload(upload)
+	return nil
+}
+
+func (m *memUploads) Delete(ctx context.Context, uploadID uuid.UUID) error {
+	delete(m.uploads, uploadID)
+	return nil
+}
+
+func copyUpload(u *resumable_upload.ResumableUpload) *resumable_upload.ResumableUpload {
+	c, _ := resumable_upload.Reconstruct(u.UploadID(), u.GroupID(), u.UserID(), u.FrameIndex(), u.Length(), u.Offset(),
+		u.Metadata(), u.Status(), u.ImageID(), u.ExpiresAt(), u.CreatedAt(), u.UpdatedAt())
+	return c
+}
+
+// hookedStore 次の Put の前に一度だけ beforePut を実行する
+type hookedStore struct {
+	blobstore.Store
+	beforePut func()
+}
+
+func (s *hookedStore) Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error {
+	if hook := s.beforePut; hook != nil {
+		s.beforePut = nil
+		hook()
+	}
+	return s.Store.Put(ctx, key, r, size, contentType)
+}
+
+type closedWindowGroups struct {
+	group.Repository
+	g *group.Group
+}
+
+func (f closedWindowGroups) FindByID(ctx context.Context, id string) (*group.Group, error) {
+	return f.g, nil
+}
+
+type anyMember struct{ group_member.Repository }
+
+func (anyMember) FindByGroupIDAndUserID(ctx context.Context, groupID, userID string) (*group_member.GroupMember, error) {
+	return group_member.NewGroupMember(groupID, userID, false)
+}
+
+// closedWindowPhotoUpload 締め切りから猶予も過ぎた撮影中のグループに取り込む PhotoUploadUseCase
+func closedWindowPhotoUpload(t *testing.T, store blobstore.Store) *PhotoUploadUseCase {
+	t.Helper()
+	now := time.Now()
+	scheduled := now.Add(-group.UploadGracePeriod - 2*time.Minute)
+	deadline := now.Add(-group.UploadGracePeriod - time.Minute)
+	g, err := group.Reconstruct("g", "owner", "group", group.GroupTypeLocalTemporary, group.GroupStatusPhotoTaking, 2, 2, "token",
+		&now, &now, &scheduled, &deadline, nil, nil, group.DefaultCountdownSeconds, false, 1, now, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	authz := policy.New(anyMember{})
+	uploadImageUC := NewUploadImageUseCase(nil, closedWindowGroups{g: g}, nil, nil, nil, nil, authz)
+	return NewPhotoUploadUseCase(nil, uploadImageUC, authz, store)
+}
+
+func TestResumableUploadTerminatedWhenCaptureWindowClosed(t *testing.T) {
+	ctx := context.Background()
+	store := blobstore.NewLocalStore(t.TempDir(), "", nil)
+	photoUploadUC := closedWindowPhotoUpload(t, store)
+	now := time.Now()
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, 8, 8))); err != nil {
+		t.Fatal(err)
+	}
+	userID := uuid.New()
+	upload, err := resumable_upload.NewResumableUpload("g", userID, 0, int64(buf.Len()), PhotoUploadMaxSize, nil, now.Add(ResumableUploadTTL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	repo := &memUploads{uploads: map[uuid.UUID]*resumable_upload.ResumableUpload{upload.UploadID(): upload}}
+	uc := NewResumableUploadUseCase(repo, photoUploadUC, store)
+
+	if _, _, err := uc.AppendData(ctx, upload.UploadID(), userID, 0, &buf); err != group.ErrCaptureWindowClosed {
+		t.Fatalf("AppendData: got %v, want %v", err, group.ErrCaptureWindowClosed)
+	}
+
+	// アップロードも受信済みのパートも、取り込んだ写真も残らない
+	if _, err := uc.GetUpload(ctx, upload.UploadID(), userID); err != resumable_upload.ErrUploadNotFound {
+		t.Errorf("GetUpload after termination: got %v, want %v", err, resumable_upload.ErrUploadNotFound)
+	}
+	objects, err := store.List(ctx, "groups/g/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(objects) != 0 {
+		t.Errorf("objects left in the store: %+v", objects)
+	}
+}
+
+// 同じ位置への2つの PATCH が重なっても、先に位置を進めた方のパートが残り、続きから送れば揃う
+// （負けた短い方が後から保存しても、勝った方のパートを上書きしない）
+func TestResumableUploadOverlappingAppends(t *testing.T) {
+	ctx := context.Background()
+	store := &hookedStore{Store: blobstore.NewLocalStore(t.TempDir(), "", nil)}
+	photoUploadUC := closedWindowPhotoUpload(t, store)
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, 8, 8))); err != nil {
+		t.Fatal(err)
+	}
+	data := buf.Bytes()
+	userID := uuid.New()
+	upload, err := resumable_upload.NewResumableUpload("g", userID, 0, int64(len(data)), PhotoUploadMaxSize, nil, time.Now().Add(ResumableUploadTTL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	repo := &memUploads{uploads: map[uuid.UUID]*resumable_upload.ResumableUpload{upload.UploadID(): upload}}
+	uc := NewResumableUploadUseCase(repo, photoUploadUC, store)
+
+	// 接続が切れたと思ったクライアントの送り直し (winner) が、元のリクエスト (loser) のパートの保存前に終わる
+	winner := len(data) / 2
+	store.beforePut = func() {
+		if _, _, err := uc.AppendData(ctx, upload.UploadID(), userID, 0, bytes.NewReader(data[:winner])); err != nil {
+			t.Fatalf("winner: %v", err)
+		}
+	}
+	if _, _, err := uc.AppendData(ctx, upload.UploadID(), userID, 0, bytes.NewReader(data[:winner/2])); err != resumable_upload.ErrOffsetMismatch {
+		t.Fatalf("loser: got %v, want %v", err, resumable_upload.ErrOffsetMismatch)
+	}
+
+	// 残りを送ると全て揃い、取り込み（受付終了で失敗する）まで進む
+	if _, _, err := uc.AppendData(ctx, upload.UploadID(), userID, int64(winner), bytes.NewReader(data[winner:])); err != group.ErrCaptureWindowClosed {
+		t.Fatalf("rest: got %v, want %v", err, group.ErrCaptureWindowClosed)
+	}
+}
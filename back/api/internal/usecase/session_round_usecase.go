@@ -0,0 +1,78 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/jphacks/os_2502/back/api/internal/domain/collage_result"
+	"github.com/jphacks/os_2502/back/api/internal/domain/session_round"
+	"github.com/jphacks/os_2502/back/api/internal/policy"
+)
+
+type SessionRoundUseCase struct {
+	roundRepo  session_round.Repository
+	resultRepo collage_result.Repository
+	authz      *policy.Policy
+}
+
+func NewSessionRoundUseCase(roundRepo session_round.Repository, resultRepo collage_result.Repository, authz *policy.Policy) *SessionRoundUseCase {
+	return &SessionRoundUseCase{roundRepo: roundRepo, resultRepo: resultRepo, authz: authz}
+}
+
+// RoundHistory ラウンドと、そのラウンドのコラージュ（まだ無い場合は nil）
+type RoundHistory struct {
+	Round   *session_round.SessionRound
+	Collage *collage_result.CollageResult
+}
+
+// ListRounds retrieves every round of a group with its collage, newest first (members only)
+func (uc *SessionRoundUseCase) ListRounds(ctx context.Context, groupID, userID string) ([]RoundHistory, error) {
+	if err := uc.authz.CanViewResult(ctx, userID, groupID); err != nil {
+		return nil, err
+	}
+
+	rounds, err := uc.roundRepo.FindByGroupID(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	history := make([]RoundHistory, len(rounds))
+	for i, round := range rounds {
+		history[i].Round = round
+		if round.Status() != session_round.StatusCompleted {
+			continue
+		}
+		result, err := uc.resultRepo.FindLatestByGroupRound(ctx, groupID, round.RoundNumber())
+		if err != nil {
+			if err == collage_result.ErrResultNotFound {
+				continue
+			}
+			return nil, err
+		}
+		history[i].Collage = result
+	}
+	return history, nil
+}
+
+// GetCollage retrieves the collage of a round (members only)
+// round が 0 の場合はコラージュができている最新のラウンドを返す
+func (uc *SessionRoundUseCase) GetCollage(ctx context.Context, groupID, userID string, round int) (*collage_result.CollageResult, error) {
+	if err := uc.authz.CanViewResult(ctx, userID, groupID); err != nil {
+		return nil, err
+	}
+
+	if round > 0 {
+		return uc.resultRepo.FindLatestByGroupRound(ctx, groupID, round)
+	}
+
+	rounds, err := uc.roundRepo.FindByGroupID(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range rounds {
+		if r.Status() == session_round.StatusCompleted {
+			return uc.resultRepo.FindLatestByGroupRound(ctx, groupID, r.RoundNumber())
+		}
+	}
+	// ラウンドの記録が無い古いグループは1ラウンド目として探す
+	return uc.resultRepo.FindLatestByGroupRound(ctx, groupID, 1)
+}
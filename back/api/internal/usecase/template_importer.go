@@ -0,0 +1,119 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/jphacks/os_2502/back/api/internal/domain/collage_template"
+	"github.com/jphacks/os_2502/back/api/internal/domain/template_part"
+)
+
+// BuiltinTemplatesPath 組み込みテンプレートの定義ファイル
+const BuiltinTemplatesPath = "resources/templates.json"
+
+// builtinTemplate templates.json の1件
+type builtinTemplate struct {
+	Name       string `json:"name"`
+	PhotoCount int    `json:"photo_count"`
+	ViewBox    string `json:"viewBox"`
+	Width      int    `json:"width"`
+	Height     int    `json:"height"`
+	Frames     []struct {
+		ID   int    `json:"id"`
+		Path string `json:"path"`
+	} `json:"frames"`
+}
+
+// TemplateImporter templates.json の組み込みテンプレートを collages_template / template_parts に取り込む
+type TemplateImporter struct {
+	templateRepo collage_template.Repository
+	path         string
+}
+
+func NewTemplateImporter(templateRepo collage_template.Repository, path string) *TemplateImporter {
+	if path == "" {
+		path = BuiltinTemplatesPath
+	}
+	return &TemplateImporter{
+		templateRepo: templateRepo,
+		path:         path,
+	}
+}
+
+// Import templates.json の全テンプレートを name で照合して作成・更新し、取り込んだ件数を返す
+// テンプレートIDと、同じ番号のパーツのIDは変わらないので、何度実行してもよい
+func (im *TemplateImporter) Import(ctx context.Context) (int, error) {
+	data, err := os.ReadFile(im.path)
+	if err != nil {
+		return 0, fmt.Errorf("failed to read templates file: %w", err)
+	}
+
+	var templates []builtinTemplate
+	if err := json.Unmarshal(data, &templates); err != nil {
+		return 0, fmt.Errorf("failed to parse templates file: %w", err)
+	}
+
+	for _, t := range templates {
+		err := im.importOne(ctx, t)
+		if err == collage_template.ErrTemplateAlreadyExists {
+			// 他のインスタンスが同時に作成した。作成された行を更新し直す
+			err = im.importOne(ctx, t)
+		}
+		if err != nil {
+			return 0, fmt.Errorf("failed to import template %q: %w", t.Name, err)
+		}
+	}
+	return len(templates), nil
+}
+
+func (im *TemplateImporter) importOne(ctx context.Context, t builtinTemplate) error {
+	tmpl, err := im.templateRepo.FindBySourceName(ctx, t.Name)
+	if err == collage_template.ErrTemplateNotFound {
+		tmpl, err = collage_template.NewCollageTemplate(t.Name, im.path)
+		if err == nil {
+			err = tmpl.MarkBuiltin(t.Name)
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	width, height := t.Width, t.Height
+	if width == 0 {
+		width = collage_template.DefaultSize
+	}
+	if height == 0 {
+		height = collage_template.DefaultSize
+	}
+	if err := tmpl.UpdateLayout(t.ViewBox, width, height, t.PhotoCount); err != nil {
+		return err
+	}
+	if err := tmpl.UpdateName(t.Name); err != nil {
+		return err
+	}
+	if err := tmpl.UpdateFilePath(im.path); err != nil {
+		return err
+	}
+
+	// フレームの外接矩形をパーツの位置とサイズにし、形はパスで持つ
+	parts := make([]*template_part.TemplatePart, 0, len(t.Frames))
+	for _, f := range t.Frames {
+		rect, err := tmpl.FrameRect(f.Path)
+		if err != nil {
+			return fmt.Errorf("frame %d: %w", f.ID, err)
+		}
+		part, err := template_part.NewTemplatePart(tmpl.TemplateID(), f.ID, rect.Min.X, rect.Min.Y, rect.Dx(), rect.Dy(), nil, nil)
+		if err != nil {
+			return fmt.Errorf("frame %d: %w", f.ID, err)
+		}
+		path := f.Path
+		if err := part.UpdatePath(&path); err != nil {
+			return fmt.Errorf("frame %d: %w", f.ID, err)
+		}
+		parts = append(parts, part)
+	}
+
+	return im.templateRepo.SaveWithParts(ctx, tmpl, parts)
+}
@@ -2,25 +2,37 @@ package usecase
 
 import (
 	"context"
+	"sort"
 
 	"github.com/google/uuid"
+	"github.com/jphacks/os_2502/back/api/internal/domain/collage_template"
+	"github.com/jphacks/os_2502/back/api/internal/domain/friend"
 	"github.com/jphacks/os_2502/back/api/internal/domain/template_part"
 )
 
 type TemplatePartUseCase struct {
-	repo template_part.Repository
+	repo         template_part.Repository
+	templateRepo collage_template.Repository
+	friendRepo   friend.Repository
 }
 
-func NewTemplatePartUseCase(repo template_part.Repository) *TemplatePartUseCase {
-	return &TemplatePartUseCase{repo: repo}
+func NewTemplatePartUseCase(repo template_part.Repository, templateRepo collage_template.Repository, friendRepo friend.Repository) *TemplatePartUseCase {
+	return &TemplatePartUseCase{repo: repo, templateRepo: templateRepo, friendRepo: friendRepo}
 }
 
+// CreateTemplatePart 作成者が自分のテンプレートにパーツを追加する
+// 追加後のフレームはテンプレートの検証を通る必要がある（写真の枚数とフレームの数が一致するなど）
 func (uc *TemplatePartUseCase) CreateTemplatePart(
 	ctx context.Context,
-	templateID uuid.UUID,
+	callerID, templateID uuid.UUID,
 	partNumber, positionX, positionY, width, height int,
 	partName, description *string,
 ) (*template_part.TemplatePart, error) {
+	tmpl, err := uc.editableTemplate(ctx, callerID, templateID)
+	if err != nil {
+		return nil, err
+	}
+
 	// 同じテンプレートIDとパーツ番号の組み合わせが既に存在するかチェック
 	existing, err := uc.repo.FindByTemplateIDAndPartNumber(ctx, templateID, partNumber)
 	if err == nil && existing != nil {
@@ -32,6 +44,14 @@ func (uc *TemplatePartUseCase) CreateTemplatePart(
 		return nil, err
 	}
 
+	parts, err := uc.repo.FindByTemplateID(ctx, templateID)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateParts(tmpl, append(parts, tp)); err != nil {
+		return nil, err
+	}
+
 	if err := uc.repo.Create(ctx, tp); err != nil {
 		return nil, err
 	}
@@ -39,31 +59,68 @@ func (uc *TemplatePartUseCase) CreateTemplatePart(
 	return tp, nil
 }
 
-func (uc *TemplatePartUseCase) GetTemplatePartByID(ctx context.Context, partID uuid.UUID) (*template_part.TemplatePart, error) {
-	return uc.repo.FindByID(ctx, partID)
+// GetTemplatePartByID 参照できるテンプレートのパーツを取得（参照できなければ存在しないものとして扱う）
+func (uc *TemplatePartUseCase) GetTemplatePartByID(ctx context.Context, callerID, partID uuid.UUID) (*template_part.TemplatePart, error) {
+	tp, err := uc.repo.FindByID(ctx, partID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := findViewableTemplate(ctx, uc.templateRepo, uc.friendRepo, callerID, tp.TemplateID()); err != nil {
+		if err == collage_template.ErrTemplateNotFound {
+			return nil, template_part.ErrTemplatePartNotFound
+		}
+		return nil, err
+	}
+	return tp, nil
 }
 
-func (uc *TemplatePartUseCase) GetTemplatePartsByTemplateID(ctx context.Context, templateID uuid.UUID) ([]*template_part.TemplatePart, error) {
+// GetTemplatePartsByTemplateID 参照できるテンプレートのパーツを取得（参照できなければ ErrTemplateNotFound）
+func (uc *TemplatePartUseCase) GetTemplatePartsByTemplateID(ctx context.Context, callerID, templateID uuid.UUID) ([]*template_part.TemplatePart, error) {
+	if _, err := findViewableTemplate(ctx, uc.templateRepo, uc.friendRepo, callerID, templateID); err != nil {
+		return nil, err
+	}
 	return uc.repo.FindByTemplateID(ctx, templateID)
 }
 
-func (uc *TemplatePartUseCase) GetTemplatePartByTemplateIDAndPartNumber(ctx context.Context, templateID uuid.UUID, partNumber int) (*template_part.TemplatePart, error) {
+func (uc *TemplatePartUseCase) GetTemplatePartByTemplateIDAndPartNumber(ctx context.Context, callerID, templateID uuid.UUID, partNumber int) (*template_part.TemplatePart, error) {
+	if _, err := findViewableTemplate(ctx, uc.templateRepo, uc.friendRepo, callerID, templateID); err != nil {
+		return nil, err
+	}
 	return uc.repo.FindByTemplateIDAndPartNumber(ctx, templateID, partNumber)
 }
 
+// UpdateTemplatePartPosition 作成者が自分のテンプレートのパーツを動かす
+// パーツのフレームは新しい位置の矩形になり、動かした後のフレームはテンプレートの検証を通る必要がある
 func (uc *TemplatePartUseCase) UpdateTemplatePartPosition(
 	ctx context.Context,
-	partID uuid.UUID,
+	callerID, partID uuid.UUID,
 	positionX, positionY, width, height int,
 ) (*template_part.TemplatePart, error) {
-	tp, err := uc.repo.FindByID(ctx, partID)
+	tp, tmpl, err := uc.editablePart(ctx, callerID, partID)
+	if err != nil {
+		return nil, err
+	}
+
+	parts, err := uc.repo.FindByTemplateID(ctx, tmpl.TemplateID())
 	if err != nil {
 		return nil, err
 	}
+	for i, p := range parts {
+		if p.PartID() == tp.PartID() {
+			parts[i] = tp
+		}
+	}
 
 	if err := tp.UpdatePosition(positionX, positionY, width, height); err != nil {
 		return nil, err
 	}
+	// 以前のパスではなく新しい矩形をフレームにする
+	if err := tp.UpdatePath(nil); err != nil {
+		return nil, err
+	}
+	if err := validateParts(tmpl, parts); err != nil {
+		return nil, err
+	}
 
 	if err := uc.repo.Update(ctx, tp); err != nil {
 		return nil, err
@@ -74,10 +131,10 @@ func (uc *TemplatePartUseCase) UpdateTemplatePartPosition(
 
 func (uc *TemplatePartUseCase) UpdateTemplatePartName(
 	ctx context.Context,
-	partID uuid.UUID,
+	callerID, partID uuid.UUID,
 	partName *string,
 ) (*template_part.TemplatePart, error) {
-	tp, err := uc.repo.FindByID(ctx, partID)
+	tp, _, err := uc.editablePart(ctx, callerID, partID)
 	if err != nil {
 		return nil, err
 	}
@@ -93,10 +150,10 @@ func (uc *TemplatePartUseCase) UpdateTemplatePartName(
 
 func (uc *TemplatePartUseCase) UpdateTemplatePartDescription(
 	ctx context.Context,
-	partID uuid.UUID,
+	callerID, partID uuid.UUID,
 	description *string,
 ) (*template_part.TemplatePart, error) {
-	tp, err := uc.repo.FindByID(ctx, partID)
+	tp, _, err := uc.editablePart(ctx, callerID, partID)
 	if err != nil {
 		return nil, err
 	}
@@ -110,14 +167,101 @@ func (uc *TemplatePartUseCase) UpdateTemplatePartDescription(
 	return tp, nil
 }
 
-func (uc *TemplatePartUseCase) DeleteTemplatePart(ctx context.Context, partID uuid.UUID) error {
+// DeleteTemplatePart 作成者が自分のテンプレートのパーツを削除する
+// 削除後のフレームはテンプレートの検証を通る必要がある
+func (uc *TemplatePartUseCase) DeleteTemplatePart(ctx context.Context, callerID, partID uuid.UUID) error {
+	tp, tmpl, err := uc.editablePart(ctx, callerID, partID)
+	if err != nil {
+		return err
+	}
+
+	parts, err := uc.repo.FindByTemplateID(ctx, tmpl.TemplateID())
+	if err != nil {
+		return err
+	}
+	remaining := make([]*template_part.TemplatePart, 0, len(parts))
+	for _, p := range parts {
+		if p.PartID() != tp.PartID() {
+			remaining = append(remaining, p)
+		}
+	}
+	if err := validateParts(tmpl, remaining); err != nil {
+		return err
+	}
+
 	return uc.repo.Delete(ctx, partID)
 }
 
-func (uc *TemplatePartUseCase) DeleteTemplatePartsByTemplateID(ctx context.Context, templateID uuid.UUID) error {
+// DeleteTemplatePartsByTemplateID 作成者が自分のテンプレートのパーツを全て削除する
+// フレームの無いテンプレートは検証を通らないので、実際には常に失敗する
+func (uc *TemplatePartUseCase) DeleteTemplatePartsByTemplateID(ctx context.Context, callerID, templateID uuid.UUID) error {
+	tmpl, err := uc.editableTemplate(ctx, callerID, templateID)
+	if err != nil {
+		return err
+	}
+	if err := validateParts(tmpl, nil); err != nil {
+		return err
+	}
 	return uc.repo.DeleteByTemplateID(ctx, templateID)
 }
 
+// ListTemplateParts 公開されているテンプレートのパーツを取得
 func (uc *TemplatePartUseCase) ListTemplateParts(ctx context.Context, limit, offset int) ([]*template_part.TemplatePart, error) {
 	return uc.repo.List(ctx, limit, offset)
 }
+
+// editableTemplate callerID がパーツを変更できるテンプレートを返す
+// 組み込みテンプレートは変更できず、ユーザーが作成したテンプレートは作成者だけが変更できる
+func (uc *TemplatePartUseCase) editableTemplate(ctx context.Context, callerID, templateID uuid.UUID) (*collage_template.CollageTemplate, error) {
+	tmpl, err := findViewableTemplate(ctx, uc.templateRepo, uc.friendRepo, callerID, templateID)
+	if err != nil {
+		return nil, err
+	}
+	if tmpl.IsBuiltin() {
+		return nil, collage_template.ErrBuiltinTemplate
+	}
+	if !tmpl.IsOwnedBy(callerID) {
+		return nil, collage_template.ErrNotTemplateOwner
+	}
+	return tmpl, nil
+}
+
+// editablePart callerID が変更できるパーツと、そのテンプレートを返す
+func (uc *TemplatePartUseCase) editablePart(ctx context.Context, callerID, partID uuid.UUID) (*template_part.TemplatePart, *collage_template.CollageTemplate, error) {
+	tp, err := uc.repo.FindByID(ctx, partID)
+	if err != nil {
+		return nil, nil, err
+	}
+	tmpl, err := uc.editableTemplate(ctx, callerID, tp.TemplateID())
+	if err != nil {
+		if err == collage_template.ErrTemplateNotFound {
+			return nil, nil, template_part.ErrTemplatePartNotFound
+		}
+		return nil, nil, err
+	}
+	return tp, tmpl, nil
+}
+
+// validateParts 変更後のパーツをパーツ番号順のフレームにしてテンプレートの検証にかける
+// 検証を通れば、パスを持たないパーツにはフレームのパスを設定する
+func validateParts(tmpl *collage_template.CollageTemplate, parts []*template_part.TemplatePart) error {
+	sort.Slice(parts, func(i, j int) bool { return parts[i].PartNumber() < parts[j].PartNumber() })
+
+	frames := tmpl.Frames(parts)
+	paths := make([]string, len(frames))
+	for i, f := range frames {
+		paths[i] = f.Path
+	}
+	if err := tmpl.ValidateFrames(paths); err != nil {
+		return err
+	}
+
+	for i, p := range parts {
+		if p.Path() == nil {
+			if err := p.UpdatePath(&paths[i]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,160 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jphacks/os_2502/back/api/internal/domain/collage_template"
+	"github.com/jphacks/os_2502/back/api/internal/domain/friend"
+	"github.com/jphacks/os_2502/back/api/internal/domain/template_part"
+)
+
+type memTemplates struct {
+	collage_template.Repository
+	templates map[uuid.UUID]*collage_template.CollageTemplate
+}
+
+func (m memTemplates) FindByID(ctx context.Context, templateID uuid.UUID) (*collage_template.CollageTemplate, error) {
+	t, ok := m.templates[templateID]
+	if !ok {
+		return nil, collage_template.ErrTemplateNotFound
+	}
+	return t, nil
+}
+
+type memParts struct {
+	template_part.Repository
+	parts   []*template_part.TemplatePart
+	updated int
+}
+
+func (m *memParts) FindByID(ctx context.Context, partID uuid.UUID) (*template_part.TemplatePart, error) {
+	for _, p := range m.parts {
+		if p.PartID() == partID {
+			return p, nil
+		}
+	}
+	return nil, template_part.ErrTemplatePartNotFound
+}
+
+func (m *memParts) FindByTemplateID(ctx context.Context, templateID uuid.UUID) ([]*template_part.TemplatePart, error) {
+	var found []*template_part.TemplatePart
+	for _, p := range m.parts {
+		if p.TemplateID() == templateID {
+			found = append(found, p)
+		}
+	}
+	return found, nil
+}
+
+func (m *memParts) Update(ctx context.Context, part *template_part.TemplatePart) error {
+	m.updated++
+	return nil
+}
+
+type noFriends struct{ friend.Repository }
+
+func (noFriends) CheckFriendship(ctx context.Context, userID1, userID2 string) (bool, error) {
+	return false, nil
+}
+
+// twoFrameTemplate 左右2つのフレームを持つテンプレートを作る
+func twoFrameTemplate(t *testing.T, owner uuid.UUID, visibility collage_template.Visibility) (*collage_template.CollageTemplate, []*template_part.TemplatePart) {
+	t.Helper()
+	tmpl, err := collage_template.NewCustomTemplate(owner, "two", visibility)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := tmpl.UpdateLayout(collage_template.DefaultViewBox, collage_template.DefaultSize, collage_template.DefaultSize, 2); err != nil {
+		t.Fatal(err)
+	}
+	parts, err := framesToParts(tmpl, []string{
+		collage_template.RectPath(0, 0, 0.5, 1),
+		collage_template.RectPath(0.5, 0, 0.5, 1),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tmpl, parts
+}
+
+func TestTemplatePartWrites(t *testing.T) {
+	ctx := context.Background()
+	owner, other := uuid.New(), uuid.New()
+
+	custom, customParts := twoFrameTemplate(t, owner, collage_template.VisibilityPublic)
+	private, privateParts := twoFrameTemplate(t, owner, collage_template.VisibilityPrivate)
+	builtin, builtinParts := twoFrameTemplate(t, owner, collage_template.VisibilityPublic)
+	if err := builtin.MarkBuiltin("two"); err != nil {
+		t.Fatal(err)
+	}
+
+	newUseCase := func() (*TemplatePartUseCase, *memParts) {
+		parts := &memParts{}
+		for _, ps := range [][]*template_part.TemplatePart{customParts, privateParts, builtinParts} {
+			parts.parts = append(parts.parts, ps...)
+		}
+		templates := memTemplates{templates: map[uuid.UUID]*collage_template.CollageTemplate{
+			custom.TemplateID():  custom,
+			private.TemplateID(): private,
+			builtin.TemplateID(): builtin,
+		}}
+		return NewTemplatePartUseCase(parts, templates, noFriends{}), parts
+	}
+
+	tests := []struct {
+		name   string
+		caller uuid.UUID
+		part   *template_part.TemplatePart
+		x, w   int
+		want   error
+	}{
+		{"builtin", owner, builtinParts[0], 0, 400, collage_template.ErrBuiltinTemplate},
+		{"not owner", other, customParts[0], 0, 400, collage_template.ErrNotTemplateOwner},
+		// 参照できないテンプレートのパーツは存在しないものとして扱う
+		{"private", other, privateParts[0], 0, 400, template_part.ErrTemplatePartNotFound},
+		{"out of canvas", owner, customParts[0], -100, 500, collage_template.ErrFrameOutOfBounds},
+		{"overlap", owner, customParts[0], 0, 800, collage_template.ErrFramesOverlap},
+		{"owner", owner, customParts[0], 0, 450, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc, parts := newUseCase()
+			_, err := uc.UpdateTemplatePartPosition(ctx, tt.caller, tt.part.PartID(), tt.x, 0, tt.w, 1000)
+			if err != tt.want {
+				t.Fatalf("got %v, want %v", err, tt.want)
+			}
+			if wantUpdated := tt.want == nil; (parts.updated > 0) != wantUpdated {
+				t.Errorf("updated = %d, want updated %v", parts.updated, wantUpdated)
+			}
+		})
+	}
+
+	t.Run("delete changes the frame count", func(t *testing.T) {
+		uc, _ := newUseCase()
+		if err := uc.DeleteTemplatePart(ctx, owner, customParts[1].PartID()); err != collage_template.ErrPhotoCountMismatch {
+			t.Fatalf("got %v, want %v", err, collage_template.ErrPhotoCountMismatch)
+		}
+	})
+}
+
+func TestTemplatePartReadsRequireViewableTemplate(t *testing.T) {
+	ctx := context.Background()
+	owner, other := uuid.New(), uuid.New()
+	private, parts := twoFrameTemplate(t, owner, collage_template.VisibilityPrivate)
+
+	uc := NewTemplatePartUseCase(&memParts{parts: parts}, memTemplates{templates: map[uuid.UUID]*collage_template.CollageTemplate{
+		private.TemplateID(): private,
+	}}, noFriends{})
+
+	if _, err := uc.GetTemplatePartsByTemplateID(ctx, other, private.TemplateID()); err != collage_template.ErrTemplateNotFound {
+		t.Errorf("parts by template: got %v, want %v", err, collage_template.ErrTemplateNotFound)
+	}
+	if _, err := uc.GetTemplatePartByID(ctx, other, parts[0].PartID()); err != template_part.ErrTemplatePartNotFound {
+		t.Errorf("part by ID: got %v, want %v", err, template_part.ErrTemplatePartNotFound)
+	}
+	if got, err := uc.GetTemplatePartsByTemplateID(ctx, owner, private.TemplateID()); err != nil || len(got) != 2 {
+		t.Errorf("owner: got %d parts, %v", len(got), err)
+	}
+}
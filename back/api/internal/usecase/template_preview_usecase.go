@@ -0,0 +1,103 @@
+package usecase
+
+import (
+	"context"
+	"log"
+
+	"github.com/google/uuid"
+	"github.com/jphacks/os_2502/back/api/internal/domain/collage_template"
+	"github.com/jphacks/os_2502/back/api/internal/domain/friend"
+	"github.com/jphacks/os_2502/back/api/internal/domain/template_part"
+	"github.com/jphacks/os_2502/back/api/internal/preview"
+)
+
+// TemplatePreviewDir プレビュー画像のキャッシュの保存先
+const TemplatePreviewDir = "/uploads/previews"
+
+// TemplatePreviewUseCase テンプレートのプレビュー画像を描画してキャッシュする
+type TemplatePreviewUseCase struct {
+	templateRepo collage_template.Repository
+	partRepo     template_part.Repository
+	friendRepo   friend.Repository
+	cache        *preview.Cache
+}
+
+func NewTemplatePreviewUseCase(templateRepo collage_template.Repository, partRepo template_part.Repository, friendRepo friend.Repository, cache *preview.Cache) *TemplatePreviewUseCase {
+	return &TemplatePreviewUseCase{
+		templateRepo: templateRepo,
+		partRepo:     partRepo,
+		friendRepo:   friendRepo,
+		cache:        cache,
+	}
+}
+
+// TemplatePreview キャッシュ済みのプレビュー画像
+type TemplatePreview struct {
+	Path   string
+	ETag   string
+	Width  int
+	Height int
+}
+
+// GetPreview 参照できるテンプレートのプレビュー（PNG）を返す
+// size は長辺のピクセル数（0 なら既定）、highlight は強調するフレームの番号（0 なら強調しない）
+func (uc *TemplatePreviewUseCase) GetPreview(ctx context.Context, callerID, templateID uuid.UUID, size, highlight int) (*TemplatePreview, error) {
+	if size == 0 {
+		size = preview.DefaultSize
+	}
+	if size < preview.MinSize || size > preview.MaxSize {
+		return nil, collage_template.ErrInvalidPreviewSize
+	}
+
+	tmpl, err := findViewableTemplate(ctx, uc.templateRepo, uc.friendRepo, callerID, templateID)
+	if err != nil {
+		return nil, err
+	}
+	layout, err := loadLayout(ctx, uc.partRepo, tmpl)
+	if err != nil {
+		return nil, err
+	}
+
+	pl := preview.Layout{
+		ViewBox: tmpl.ViewBox(),
+		Width:   tmpl.Width(),
+		Height:  tmpl.Height(),
+		Frames:  make([]preview.Frame, len(layout.Frames)),
+	}
+	found := highlight == 0
+	for i, f := range layout.Frames {
+		pl.Frames[i] = preview.Frame{Number: f.Number, Path: f.Path}
+		found = found || f.Number == highlight
+	}
+	if !found {
+		return nil, collage_template.ErrFrameNotFound
+	}
+
+	width, height := preview.Dimensions(pl, size)
+	key := preview.Key{
+		TemplateID: tmpl.TemplateID().String(),
+		Version:    preview.LayoutVersion(pl),
+		Width:      width,
+		Height:     height,
+		Highlight:  highlight,
+	}
+	result := &TemplatePreview{ETag: key.ETag(), Width: width, Height: height}
+
+	if path, ok := uc.cache.Lookup(key); ok {
+		result.Path = path
+		return result, nil
+	}
+
+	img, err := preview.Render(pl, preview.Options{Size: size, Highlight: highlight})
+	if err != nil {
+		return nil, err
+	}
+	path, err := uc.cache.Store(key, img)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("🖼️ Rendered preview of template %s (%dx%d, highlight %d)", key.TemplateID, width, height, highlight)
+
+	result.Path = path
+	return result, nil
+}
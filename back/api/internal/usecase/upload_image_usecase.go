@@ -2,22 +2,42 @@ package usecase
 
 import (
 	"context"
+	"log"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/jphacks/os_2502/back/api/internal/domain/collage_job"
+	"github.com/jphacks/os_2502/back/api/internal/domain/collage_template"
+	"github.com/jphacks/os_2502/back/api/internal/domain/group"
+	"github.com/jphacks/os_2502/back/api/internal/domain/group_member"
 	"github.com/jphacks/os_2502/back/api/internal/domain/upload_image"
+	"github.com/jphacks/os_2502/back/api/internal/policy"
+	"github.com/jphacks/os_2502/back/api/internal/realtime"
 )
 
 type UploadImageUseCase struct {
-	repo upload_image.Repository
+	repo         upload_image.Repository
+	groupRepo    group.Repository
+	memberRepo   group_member.Repository
+	jobRepo      collage_job.Repository
+	templateRepo collage_template.Repository
+	publisher    realtime.Publisher
+	authz        *policy.Policy
 }
 
-func NewUploadImageUseCase(repo upload_image.Repository) *UploadImageUseCase {
-	return &UploadImageUseCase{repo: repo}
+func NewUploadImageUseCase(repo upload_image.Repository, groupRepo group.Repository, memberRepo group_member.Repository, jobRepo collage_job.Repository, templateRepo collage_template.Repository, publisher realtime.Publisher, authz *policy.Policy) *UploadImageUseCase {
+	if publisher == nil {
+		publisher = realtime.NopPublisher{}
+	}
+	return &UploadImageUseCase{repo: repo, groupRepo: groupRepo, memberRepo: memberRepo, jobRepo: jobRepo, templateRepo: templateRepo, publisher: publisher, authz: authz}
 }
 
 // UploadImage uploads a new image
 func (uc *UploadImageUseCase) UploadImage(ctx context.Context, fileURL, groupID string, userID uuid.UUID, collageDay time.Time) (*upload_image.UploadImage, error) {
+	if err := uc.authz.CanUploadToGroup(ctx, userID.String(), groupID); err != nil {
+		return nil, err
+	}
+
 	// 同じグループ、ユーザー、日付の画像が既に存在するかチェック
 	existing, err := uc.repo.FindByGroupUserAndDate(ctx, groupID, userID, collageDay)
 	if err == nil && existing != nil {
@@ -28,25 +48,200 @@ func (uc *UploadImageUseCase) UploadImage(ctx context.Context, fileURL, groupID
 	}
 
 	// 新規作成
-	image, err := upload_image.NewUploadImage(fileURL, groupID, userID, collageDay)
+	image, err := upload_image.NewUploadImage(uuid.New(), fileURL, groupID, userID, collageDay)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.repo.Create(ctx, image); err != nil {
+		return nil, err
+	}
+
+	return image, nil
+}
+
+// PhotoMetadata 取り込み時に読み取った写真のメタデータ
+type PhotoMetadata struct {
+	CapturedAt *time.Time
+	Width      int
+	Height     int
+}
+
+// CheckCaptureOpen グループが撮影した写真を受け付けられる状態かチェック
+// 撮影時刻を過ぎたカウントダウンはここで撮影中に進める
+func (uc *UploadImageUseCase) CheckCaptureOpen(ctx context.Context, groupID string) error {
+	_, err := uc.openCapture(ctx, groupID, time.Now())
+	return err
+}
+
+// CheckFrameAvailable グループが写真を受け付けられる状態で、frameIndex のフレームを userID が撮影できるかチェック
+// アップロード枠や再開可能なアップロードを作る前に、記録時と同じチェックをしておく
+func (uc *UploadImageUseCase) CheckFrameAvailable(ctx context.Context, groupID string, userID uuid.UUID, frameIndex int) error {
+	g, err := uc.openCapture(ctx, groupID, time.Now())
+	if err != nil {
+		return err
+	}
+	return uc.checkFrame(ctx, g, userID, frameIndex)
+}
+
+// checkFrame フレーム番号がテンプレートのフレームの範囲内で、他のメンバーが撮影していないかチェック
+// 範囲外や重複したフレームはコラージュ生成で使えず、全員分が揃わなくなる
+func (uc *UploadImageUseCase) checkFrame(ctx context.Context, g *group.Group, userID uuid.UUID, frameIndex int) error {
+	templateID := g.TemplateID()
+	if templateID == nil {
+		return group.ErrTemplateRequired
+	}
+	id, err := uuid.Parse(*templateID)
+	if err != nil {
+		return collage_template.ErrTemplateNotFound
+	}
+	tmpl, err := uc.templateRepo.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	latest, err := uc.repo.FindLatestByGroupID(ctx, g.ID())
+	if err != nil {
+		return err
+	}
+	return upload_image.CheckFrame(frameIndex, tmpl.PhotoCount(), userID, latest)
+}
+
+// openCapture startedAt に始まったアップロードの写真を受け付けられる状態のグループを返す
+func (uc *UploadImageUseCase) openCapture(ctx context.Context, groupID string, startedAt time.Time) (*group.Group, error) {
+	g, err := uc.groupRepo.FindByID(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	if g, err = advanceCapture(ctx, uc.groupRepo, uc.publisher, g, now); err != nil {
+		return nil, err
+	}
+	if err := g.CanAcceptUpload(startedAt, now); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+// RecordGroupPhoto グループ撮影の写真を記録
+// 撮り直しの場合も既存の行は残し、コラージュ生成では各メンバーの最新の写真を使う。
+// 撮影時刻から締め切りまでの間に始まったアップロード（startedAt）だけ受け付け、写真は撮影中のラウンドのものとして記録する。
+// 締め切り前に始まったアップロードは group.UploadGracePeriod の間まで完了できる。
+// フレームはテンプレートの範囲内で、他のメンバーが撮影していないものに限る。
+// imageID は保存した写真のキーに含めた ID
+func (uc *UploadImageUseCase) RecordGroupPhoto(ctx context.Context, imageID uuid.UUID, fileURL, groupID string, userID uuid.UUID, frameIndex int, startedAt time.Time, meta PhotoMetadata) (*upload_image.UploadImage, error) {
+	if err := uc.authz.CanUploadToGroup(ctx, userID.String(), groupID); err != nil {
+		return nil, err
+	}
+
+	g, err := uc.openCapture(ctx, groupID, startedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.checkFrame(ctx, g, userID, frameIndex); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	collageDay := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+
+	image, err := upload_image.NewUploadImage(imageID, fileURL, groupID, userID, collageDay)
 	if err != nil {
 		return nil, err
 	}
 
+	if err := image.AssignFrame(frameIndex); err != nil {
+		return nil, err
+	}
+
+	if err := image.AssignRound(g.CurrentRound()); err != nil {
+		return nil, err
+	}
+
+	if err := image.SetCaptureMetadata(meta.CapturedAt, meta.Width, meta.Height); err != nil {
+		return nil, err
+	}
+
 	if err := uc.repo.Create(ctx, image); err != nil {
 		return nil, err
 	}
 
+	uc.publisher.Publish(realtime.NewEvent(realtime.EventPhotoUploaded, groupID, realtime.PhotoUploadedPayload{
+		UserID:     userID.String(),
+		ImageID:    image.ImageID().String(),
+		FrameIndex: frameIndex,
+	}))
+
+	// 最後の1枚ならコラージュ生成ジョブを登録する
+	// 写真は保存済みなので、ここで失敗してもアップロード自体は成功として返す
+	if err := uc.enqueueCollageIfComplete(ctx, groupID); err != nil {
+		log.Printf("❌ Failed to enqueue collage job for group %s: %v", groupID, err)
+	}
+
 	return image, nil
 }
 
-// GetImage retrieves an image by ID
-func (uc *UploadImageUseCase) GetImage(ctx context.Context, imageID uuid.UUID) (*upload_image.UploadImage, error) {
-	return uc.repo.FindByID(ctx, imageID)
+// enqueueCollageIfComplete 全メンバーのフレーム付きの写真が揃っていればコラージュ生成ジョブを登録
+// 同時に最後の写真が届いても、未完了のジョブはグループごとに1つしか登録されない
+func (uc *UploadImageUseCase) enqueueCollageIfComplete(ctx context.Context, groupID string) error {
+	members, err := uc.memberRepo.FindByGroupID(ctx, groupID)
+	if err != nil {
+		return err
+	}
+	if len(members) == 0 {
+		return nil
+	}
+
+	images, err := uc.repo.FindLatestByGroupID(ctx, groupID)
+	if err != nil {
+		return err
+	}
+
+	uploaded := make(map[string]bool, len(images))
+	for _, img := range images {
+		if img.FrameIndex() != nil {
+			uploaded[img.UserID().String()] = true
+		}
+	}
+	for _, m := range members {
+		if !uploaded[m.UserID()] {
+			return nil
+		}
+	}
+
+	job, err := collage_job.NewCollageJob(groupID, collage_job.DefaultMaxAttempts)
+	if err != nil {
+		return err
+	}
+	if err := uc.jobRepo.Enqueue(ctx, job); err != nil {
+		if err == collage_job.ErrJobAlreadyQueued {
+			return nil
+		}
+		return err
+	}
+
+	log.Printf("📥 Enqueued collage job %s for group %s", job.JobID(), groupID)
+	return nil
+}
+
+// GetImage retrieves an image by ID (members of the image's group only)
+func (uc *UploadImageUseCase) GetImage(ctx context.Context, imageID, userID uuid.UUID) (*upload_image.UploadImage, error) {
+	image, err := uc.repo.FindByID(ctx, imageID)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.authz.CanViewGroup(ctx, userID.String(), image.GroupID()); err != nil {
+		return nil, err
+	}
+	return image, nil
 }
 
-// GetImagesByGroup retrieves all images by group ID
-func (uc *UploadImageUseCase) GetImagesByGroup(ctx context.Context, groupID string, limit, offset int) ([]*upload_image.UploadImage, error) {
+// GetImagesByGroup retrieves all images by group ID (members only)
+func (uc *UploadImageUseCase) GetImagesByGroup(ctx context.Context, groupID string, userID uuid.UUID, limit, offset int) ([]*upload_image.UploadImage, error) {
+	if err := uc.authz.CanViewGroup(ctx, userID.String(), groupID); err != nil {
+		return nil, err
+	}
 	if limit <= 0 {
 		limit = 50
 	}
@@ -4,22 +4,55 @@ import (
 	"context"
 
 	"github.com/google/uuid"
+	"github.com/jphacks/os_2502/back/api/internal/domain/collage_result"
 	"github.com/jphacks/os_2502/back/api/internal/domain/upload_images_collage_result"
+	"github.com/jphacks/os_2502/back/api/internal/policy"
 )
 
 type UploadImagesCollageResultUseCase struct {
-	repo upload_images_collage_result.Repository
+	repo       upload_images_collage_result.Repository
+	resultRepo collage_result.Repository
+	authz      *policy.Policy
 }
 
-func NewUploadImagesCollageResultUseCase(repo upload_images_collage_result.Repository) *UploadImagesCollageResultUseCase {
-	return &UploadImagesCollageResultUseCase{repo: repo}
+func NewUploadImagesCollageResultUseCase(repo upload_images_collage_result.Repository, resultRepo collage_result.Repository, authz *policy.Policy) *UploadImagesCollageResultUseCase {
+	return &UploadImagesCollageResultUseCase{repo: repo, resultRepo: resultRepo, authz: authz}
+}
+
+// resultGroupID コラージュ結果が属するグループIDを取得
+func (uc *UploadImagesCollageResultUseCase) resultGroupID(ctx context.Context, resultID uuid.UUID) (string, error) {
+	result, err := uc.resultRepo.FindByID(ctx, resultID)
+	if err != nil {
+		return "", err
+	}
+	return result.GroupID(), nil
+}
+
+func (uc *UploadImagesCollageResultUseCase) canViewResult(ctx context.Context, callerID, resultID uuid.UUID) error {
+	groupID, err := uc.resultGroupID(ctx, resultID)
+	if err != nil {
+		return err
+	}
+	return uc.authz.CanViewResult(ctx, callerID.String(), groupID)
+}
+
+func (uc *UploadImagesCollageResultUseCase) canManageResult(ctx context.Context, callerID, resultID uuid.UUID) error {
+	groupID, err := uc.resultGroupID(ctx, resultID)
+	if err != nil {
+		return err
+	}
+	return uc.authz.CanManageGroup(ctx, callerID.String(), groupID)
 }
 
 func (uc *UploadImagesCollageResultUseCase) CreateUploadImagesCollageResult(
 	ctx context.Context,
-	imageID, resultID uuid.UUID,
+	callerID, imageID, resultID uuid.UUID,
 	positionX, positionY, width, height, sortOrder int,
 ) (*upload_images_collage_result.UploadImagesCollageResult, error) {
+	if err := uc.canManageResult(ctx, callerID, resultID); err != nil {
+		return nil, err
+	}
+
 	// 同じ画像IDとコラージュ結果IDの組み合わせが既に存在するかチェック
 	existing, err := uc.repo.FindByImageIDAndResultID(ctx, imageID, resultID)
 	if err == nil && existing != nil {
@@ -38,23 +71,38 @@ func (uc *UploadImagesCollageResultUseCase) CreateUploadImagesCollageResult(
 	return uicr, nil
 }
 
-func (uc *UploadImagesCollageResultUseCase) GetUploadImagesCollageResultByImageIDAndResultID(ctx context.Context, imageID, resultID uuid.UUID) (*upload_images_collage_result.UploadImagesCollageResult, error) {
+func (uc *UploadImagesCollageResultUseCase) GetUploadImagesCollageResultByImageIDAndResultID(ctx context.Context, callerID, imageID, resultID uuid.UUID) (*upload_images_collage_result.UploadImagesCollageResult, error) {
+	if err := uc.canViewResult(ctx, callerID, resultID); err != nil {
+		return nil, err
+	}
 	return uc.repo.FindByImageIDAndResultID(ctx, imageID, resultID)
 }
 
-func (uc *UploadImagesCollageResultUseCase) GetUploadImagesCollageResultsByImageID(ctx context.Context, imageID uuid.UUID) ([]*upload_images_collage_result.UploadImagesCollageResult, error) {
-	return uc.repo.FindByImageID(ctx, imageID)
+// GetUploadImagesCollageResultsByImageID 画像が使われたコラージュ結果のうち、呼び出し元が参照できるものだけを返す
+func (uc *UploadImagesCollageResultUseCase) GetUploadImagesCollageResultsByImageID(ctx context.Context, callerID, imageID uuid.UUID) ([]*upload_images_collage_result.UploadImagesCollageResult, error) {
+	results, err := uc.repo.FindByImageID(ctx, imageID)
+	if err != nil {
+		return nil, err
+	}
+	return uc.filterViewable(ctx, callerID, results)
 }
 
-func (uc *UploadImagesCollageResultUseCase) GetUploadImagesCollageResultsByResultID(ctx context.Context, resultID uuid.UUID) ([]*upload_images_collage_result.UploadImagesCollageResult, error) {
+func (uc *UploadImagesCollageResultUseCase) GetUploadImagesCollageResultsByResultID(ctx context.Context, callerID, resultID uuid.UUID) ([]*upload_images_collage_result.UploadImagesCollageResult, error) {
+	if err := uc.canViewResult(ctx, callerID, resultID); err != nil {
+		return nil, err
+	}
 	return uc.repo.FindByResultID(ctx, resultID)
 }
 
 func (uc *UploadImagesCollageResultUseCase) UpdateUploadImagesCollageResultPosition(
 	ctx context.Context,
-	imageID, resultID uuid.UUID,
+	callerID, imageID, resultID uuid.UUID,
 	positionX, positionY, width, height int,
 ) (*upload_images_collage_result.UploadImagesCollageResult, error) {
+	if err := uc.canManageResult(ctx, callerID, resultID); err != nil {
+		return nil, err
+	}
+
 	uicr, err := uc.repo.FindByImageIDAndResultID(ctx, imageID, resultID)
 	if err != nil {
 		return nil, err
@@ -73,9 +121,13 @@ func (uc *UploadImagesCollageResultUseCase) UpdateUploadImagesCollageResultPosit
 
 func (uc *UploadImagesCollageResultUseCase) UpdateUploadImagesCollageResultSortOrder(
 	ctx context.Context,
-	imageID, resultID uuid.UUID,
+	callerID, imageID, resultID uuid.UUID,
 	sortOrder int,
 ) (*upload_images_collage_result.UploadImagesCollageResult, error) {
+	if err := uc.canManageResult(ctx, callerID, resultID); err != nil {
+		return nil, err
+	}
+
 	uicr, err := uc.repo.FindByImageIDAndResultID(ctx, imageID, resultID)
 	if err != nil {
 		return nil, err
@@ -90,14 +142,59 @@ func (uc *UploadImagesCollageResultUseCase) UpdateUploadImagesCollageResultSortO
 	return uicr, nil
 }
 
-func (uc *UploadImagesCollageResultUseCase) DeleteUploadImagesCollageResult(ctx context.Context, imageID, resultID uuid.UUID) error {
+func (uc *UploadImagesCollageResultUseCase) DeleteUploadImagesCollageResult(ctx context.Context, callerID, imageID, resultID uuid.UUID) error {
+	if err := uc.canManageResult(ctx, callerID, resultID); err != nil {
+		return err
+	}
 	return uc.repo.Delete(ctx, imageID, resultID)
 }
 
-func (uc *UploadImagesCollageResultUseCase) DeleteUploadImagesCollageResultsByResultID(ctx context.Context, resultID uuid.UUID) error {
+func (uc *UploadImagesCollageResultUseCase) DeleteUploadImagesCollageResultsByResultID(ctx context.Context, callerID, resultID uuid.UUID) error {
+	if err := uc.canManageResult(ctx, callerID, resultID); err != nil {
+		return err
+	}
 	return uc.repo.DeleteByResultID(ctx, resultID)
 }
 
-func (uc *UploadImagesCollageResultUseCase) ListUploadImagesCollageResults(ctx context.Context, limit, offset int) ([]*upload_images_collage_result.UploadImagesCollageResult, error) {
-	return uc.repo.List(ctx, limit, offset)
+// ListUploadImagesCollageResults 呼び出し元が参照できるコラージュ結果のものだけを返す
+func (uc *UploadImagesCollageResultUseCase) ListUploadImagesCollageResults(ctx context.Context, callerID uuid.UUID, limit, offset int) ([]*upload_images_collage_result.UploadImagesCollageResult, error) {
+	results, err := uc.repo.List(ctx, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	return uc.filterViewable(ctx, callerID, results)
+}
+
+func (uc *UploadImagesCollageResultUseCase) filterViewable(ctx context.Context, callerID uuid.UUID, rows []*upload_images_collage_result.UploadImagesCollageResult) ([]*upload_images_collage_result.UploadImagesCollageResult, error) {
+	// コラージュ結果ごとにグループを引いてから、グループ単位でまとめて判定する
+	groupOf := make(map[uuid.UUID]string)
+	groupIDs := make([]string, 0, len(rows))
+	for _, row := range rows {
+		if _, ok := groupOf[row.ResultID()]; ok {
+			continue
+		}
+		groupID, err := uc.resultGroupID(ctx, row.ResultID())
+		if err != nil {
+			if err == collage_result.ErrResultNotFound {
+				groupOf[row.ResultID()] = ""
+				continue
+			}
+			return nil, err
+		}
+		groupOf[row.ResultID()] = groupID
+		groupIDs = append(groupIDs, groupID)
+	}
+
+	viewable, err := uc.authz.ViewableGroups(ctx, callerID.String(), groupIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	filtered := make([]*upload_images_collage_result.UploadImagesCollageResult, 0, len(rows))
+	for _, row := range rows {
+		if viewable[groupOf[row.ResultID()]] {
+			filtered = append(filtered, row)
+		}
+	}
+	return filtered, nil
 }
@@ -0,0 +1,284 @@
+// Package webp は画像を WebP（VP8L ロスレス）に変換する
+//
+// golang.org/x/image/webp はデコードのみなので、コラージュを WebP で配信するために
+// ロスレス形式のエンコーダーだけを実装している。使う変換は subtract green と
+// ブロックごとの予測（L / T / Average2(L, T) / Select / ClampAddSubtractFull）で、
+// 直前の画素と真上の画素の繰り返しを LZ77 の後方参照にする。
+package webp
+
+import (
+	"encoding/binary"
+	"errors"
+	"image"
+	"image/draw"
+	"io"
+)
+
+// MaxDimension VP8L で表せる幅・高さの上限
+const MaxDimension = 1 << 14
+
+// ErrTooLarge 幅か高さが VP8L の上限を超えている
+var ErrTooLarge = errors.New("webp: image is too large")
+
+const (
+	vp8lSignature = 0x2f
+
+	transformPredictor     = 0
+	transformSubtractGreen = 2
+
+	// predictorBits 予測モードを切り替えるブロックの大きさ（1<<predictorBits ピクセル四方）
+	predictorBits = 4
+
+	// 後方参照の長さの上限と、後方参照にする最短の長さ
+	maxCopyLength = 4096
+	minCopyLength = 3
+
+	numLiteralCodes = 256
+	numLengthCodes  = 24
+	numDistanceCode = 40
+)
+
+// Encode img を VP8L ロスレスの WebP として書き込む
+func Encode(w io.Writer, img image.Image) error {
+	b := img.Bounds()
+	width, height := b.Dx(), b.Dy()
+	if width <= 0 || height <= 0 {
+		return errors.New("webp: empty image")
+	}
+	if width > MaxDimension || height > MaxDimension {
+		return ErrTooLarge
+	}
+
+	argb, hasAlpha := toARGB(img)
+
+	bw := &bitWriter{}
+	bw.writeBits(vp8lSignature, 8)
+	bw.writeBits(uint32(width-1), 14)
+	bw.writeBits(uint32(height-1), 14)
+	if hasAlpha {
+		bw.writeBits(1, 1)
+	} else {
+		bw.writeBits(0, 1)
+	}
+	bw.writeBits(0, 3) // version
+
+	// デコーダーは逆順に戻すので、subtract green → 予測の順に適用して書く
+	subtractGreen(argb)
+	bw.writeBits(1, 1)
+	bw.writeBits(transformSubtractGreen, 2)
+
+	modes, residuals := predict(argb, width, height)
+	bw.writeBits(1, 1)
+	bw.writeBits(transformPredictor, 2)
+	bw.writeBits(predictorBits-2, 3)
+	writeImage(bw, modes, subSampleSize(width), false)
+
+	bw.writeBits(0, 1) // 変換はここまで
+	writeImage(bw, residuals, width, true)
+
+	data := bw.finish()
+
+	// RIFF コンテナ（チャンクは偶数長にそろえる）
+	chunk := len(data)
+	pad := chunk & 1
+	header := make([]byte, 20)
+	copy(header[0:], "RIFF")
+	binary.LittleEndian.PutUint32(header[4:], uint32(4+8+chunk+pad))
+	copy(header[8:], "WEBPVP8L")
+	binary.LittleEndian.PutUint32(header[16:], uint32(chunk))
+	if _, err := w.Write(header); err != nil {
+		return err
+	}
+	if _, err := w.Write(data); err != nil {
+		return err
+	}
+	if pad != 0 {
+		_, err := w.Write([]byte{0})
+		return err
+	}
+	return nil
+}
+
+// toARGB 画像を ARGB（アルファは乗算しない）の画素の列にする
+func toARGB(img image.Image) ([]uint32, bool) {
+	nrgba, ok := img.(*image.NRGBA)
+	if !ok || nrgba.Rect.Min != (image.Point{}) {
+		b := img.Bounds()
+		nrgba = image.NewNRGBA(image.Rect(0, 0, b.Dx(), b.Dy()))
+		draw.Draw(nrgba, nrgba.Rect, img, b.Min, draw.Src)
+	}
+
+	w, h := nrgba.Rect.Dx(), nrgba.Rect.Dy()
+	argb := make([]uint32, 0, w*h)
+	hasAlpha := false
+	for y := 0; y < h; y++ {
+		row := nrgba.Pix[y*nrgba.Stride : y*nrgba.Stride+w*4]
+		for x := 0; x < len(row); x += 4 {
+			if row[x+3] != 0xff {
+				hasAlpha = true
+			}
+			argb = append(argb, uint32(row[x+3])<<24|uint32(row[x])<<16|uint32(row[x+1])<<8|uint32(row[x+2]))
+		}
+	}
+	return argb, hasAlpha
+}
+
+// subtractGreen 赤と青から緑を引く
+func subtractGreen(argb []uint32) {
+	for i, p := range argb {
+		g := (p >> 8) & 0xff
+		r := ((p >> 16) - g) & 0xff
+		b := (p - g) & 0xff
+		argb[i] = p&0xff00ff00 | r<<16 | b
+	}
+}
+
+func subSampleSize(n int) int {
+	return (n + 1<<predictorBits - 1) >> predictorBits
+}
+
+// writeImage ARGB の画素をプレフィックス符号で書く。main の場合だけメタプレフィックス符号のビットがある
+func writeImage(bw *bitWriter, argb []uint32, width int, main bool) {
+	bw.writeBits(0, 1) // カラーキャッシュは使わない
+	if main {
+		bw.writeBits(0, 1) // プレフィックス符号は画像全体で1組
+	}
+
+	tokens := tokenize(argb, width)
+
+	green := make([]int, numLiteralCodes+numLengthCodes)
+	red := make([]int, numLiteralCodes)
+	blue := make([]int, numLiteralCodes)
+	alpha := make([]int, numLiteralCodes)
+	dist := make([]int, numDistanceCode)
+	for _, t := range tokens {
+		if t.length == 0 {
+			green[(t.pixel>>8)&0xff]++
+			red[(t.pixel>>16)&0xff]++
+			blue[t.pixel&0xff]++
+			alpha[t.pixel>>24]++
+			continue
+		}
+		lc, _, _ := prefixEncode(t.length)
+		dc, _, _ := prefixEncode(t.distCode)
+		green[numLiteralCodes+lc]++
+		dist[dc]++
+	}
+
+	codes := [5]*prefixCode{
+		newPrefixCode(green),
+		newPrefixCode(red),
+		newPrefixCode(blue),
+		newPrefixCode(alpha),
+		newPrefixCode(dist),
+	}
+	for _, c := range codes {
+		c.writeTo(bw)
+	}
+
+	for _, t := range tokens {
+		if t.length == 0 {
+			codes[0].writeSymbol(bw, int((t.pixel>>8)&0xff))
+			codes[1].writeSymbol(bw, int((t.pixel>>16)&0xff))
+			codes[2].writeSymbol(bw, int(t.pixel&0xff))
+			codes[3].writeSymbol(bw, int(t.pixel>>24))
+			continue
+		}
+		lc, lbits, lextra := prefixEncode(t.length)
+		codes[0].writeSymbol(bw, numLiteralCodes+lc)
+		bw.writeBits(lextra, lbits)
+		dc, dbits, dextra := prefixEncode(t.distCode)
+		codes[4].writeSymbol(bw, dc)
+		bw.writeBits(dextra, dbits)
+	}
+}
+
+// token 画素そのもの（length == 0）か、後方参照
+type token struct {
+	pixel    uint32
+	length   int
+	distCode int
+}
+
+// 後方参照の距離コード（1: 真上の画素、2: 直前の画素）
+const (
+	distCodeAbove = 1
+	distCodeLeft  = 2
+)
+
+// tokenize 直前の画素か真上の画素と同じ並びを後方参照にする
+func tokenize(argb []uint32, width int) []token {
+	tokens := make([]token, 0, len(argb)/2)
+	for i := 0; i < len(argb); {
+		left := matchLength(argb, i, 1)
+		above := 0
+		if i >= width {
+			above = matchLength(argb, i, width)
+		}
+
+		switch {
+		case above >= minCopyLength && above >= left:
+			tokens = append(tokens, token{length: above, distCode: distCodeAbove})
+			i += above
+		case left >= minCopyLength:
+			tokens = append(tokens, token{length: left, distCode: distCodeLeft})
+			i += left
+		default:
+			tokens = append(tokens, token{pixel: argb[i]})
+			i++
+		}
+	}
+	return tokens
+}
+
+// matchLength i から dist 前の画素と一致が続く長さ
+func matchLength(argb []uint32, i, dist int) int {
+	if i < dist {
+		return 0
+	}
+	n := 0
+	for i+n < len(argb) && n < maxCopyLength && argb[i+n] == argb[i+n-dist] {
+		n++
+	}
+	return n
+}
+
+// prefixEncode 長さ・距離（1 以上）をプレフィックスと追加ビットにする
+func prefixEncode(v int) (code, extraBits int, extra uint32) {
+	if v <= 4 {
+		return v - 1, 0, 0
+	}
+	v--
+	highest := 31
+	for v>>highest == 0 {
+		highest--
+	}
+	second := (v >> (highest - 1)) & 1
+	extraBits = highest - 1
+	return 2*highest + second, extraBits, uint32(v - (2+second)<<extraBits)
+}
+
+// bitWriter 下位ビットから詰めて書く
+type bitWriter struct {
+	buf   []byte
+	acc   uint64
+	nbits uint
+}
+
+func (w *bitWriter) writeBits(v uint32, n int) {
+	w.acc |= uint64(v) << w.nbits
+	w.nbits += uint(n)
+	for w.nbits >= 8 {
+		w.buf = append(w.buf, byte(w.acc))
+		w.acc >>= 8
+		w.nbits -= 8
+	}
+}
+
+func (w *bitWriter) finish() []byte {
+	if w.nbits > 0 {
+		w.buf = append(w.buf, byte(w.acc))
+		w.acc, w.nbits = 0, 0
+	}
+	return w.buf
+}
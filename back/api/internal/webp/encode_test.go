@@ -0,0 +1,94 @@
+package webp
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"math/rand"
+	"testing"
+
+	xwebp "golang.org/x/image/webp"
+)
+
+func TestEncodeRoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+
+	noise := image.NewNRGBA(image.Rect(0, 0, 37, 23))
+	rng.Read(noise.Pix)
+
+	// 写真に近い、なだらかに変化する画像（予測が効く）
+	gradient := image.NewNRGBA(image.Rect(0, 0, 300, 200))
+	for y := 0; y < 200; y++ {
+		for x := 0; x < 300; x++ {
+			gradient.Set(x, y, color.NRGBA{uint8(x), uint8(y), uint8((x + y) / 2), 0xff})
+		}
+	}
+
+	// 白い背景にプレースホルダーの矩形（後方参照が効く）
+	flat := image.NewRGBA(image.Rect(10, 10, 510, 410))
+	for y := 10; y < 410; y++ {
+		for x := 10; x < 510; x++ {
+			c := color.RGBA{0xff, 0xff, 0xff, 0xff}
+			if x > 100 && x < 300 && y > 50 && y < 200 {
+				c = color.RGBA{0xe0, 0xe0, 0xe0, 0xff}
+			}
+			flat.Set(x, y, c)
+		}
+	}
+
+	tests := []struct {
+		name string
+		img  image.Image
+	}{
+		{"1x1", image.NewNRGBA(image.Rect(0, 0, 1, 1))},
+		{"noise with alpha", noise},
+		{"gradient", gradient},
+		{"flat with offset bounds", flat},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := Encode(&buf, tt.img); err != nil {
+				t.Fatalf("Encode: %v", err)
+			}
+			got, err := xwebp.Decode(bytes.NewReader(buf.Bytes()))
+			if err != nil {
+				t.Fatalf("Decode: %v", err)
+			}
+
+			b := tt.img.Bounds()
+			if got.Bounds().Dx() != b.Dx() || got.Bounds().Dy() != b.Dy() {
+				t.Fatalf("size = %v, want %v", got.Bounds(), b)
+			}
+			for y := 0; y < b.Dy(); y++ {
+				for x := 0; x < b.Dx(); x++ {
+					want := color.NRGBAModel.Convert(tt.img.At(b.Min.X+x, b.Min.Y+y))
+					if c := color.NRGBAModel.Convert(got.At(x, y)); c != want {
+						t.Fatalf("pixel (%d,%d) = %v, want %v", x, y, c, want)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestEncodeCompressesFlatImage(t *testing.T) {
+	img := image.NewNRGBA(image.Rect(0, 0, 1000, 1000))
+	for i := range img.Pix {
+		img.Pix[i] = 0xff
+	}
+	var buf bytes.Buffer
+	if err := Encode(&buf, img); err != nil {
+		t.Fatal(err)
+	}
+	if buf.Len() > 4096 {
+		t.Errorf("encoded size = %d bytes, want a few hundred", buf.Len())
+	}
+}
+
+func TestEncodeTooLarge(t *testing.T) {
+	img := image.NewNRGBA(image.Rect(0, 0, MaxDimension+1, 1))
+	if err := Encode(&bytes.Buffer{}, img); err != ErrTooLarge {
+		t.Errorf("Encode = %v, want ErrTooLarge", err)
+	}
+}
@@ -0,0 +1,250 @@
+package webp
+
+import "sort"
+
+const (
+	maxCodeLength           = 15
+	maxCodeLengthCodeLength = 7
+	numCodeLengthCodes      = 19
+)
+
+// codeLengthCodeOrder 符号長の符号の長さを書く順番
+var codeLengthCodeOrder = [numCodeLengthCodes]int{17, 18, 0, 1, 2, 3, 4, 5, 16, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}
+
+// prefixCode 正規ハフマン符号。simple は 1〜2 個の 8 ビットのシンボルだけを使う短い形式
+type prefixCode struct {
+	lengths []int
+	codes   []uint32
+	simple  []int
+}
+
+// newPrefixCode 出現回数からプレフィックス符号を作る
+func newPrefixCode(freq []int) *prefixCode {
+	var used []int
+	for s, f := range freq {
+		if f > 0 {
+			used = append(used, s)
+		}
+	}
+
+	c := &prefixCode{lengths: make([]int, len(freq))}
+	switch {
+	case len(used) == 0:
+		c.simple = []int{0}
+		return c
+	case len(used) <= 2 && used[len(used)-1] < numLiteralCodes:
+		c.simple = used
+		if len(used) == 2 {
+			c.lengths[used[0]], c.lengths[used[1]] = 1, 1
+			c.codes = canonicalCodes(c.lengths)
+		}
+		return c
+	case len(used) == 1:
+		// 1 シンボルだけの符号はデコーダーが 0 ビットとして扱うので、ダミーを足して 1 ビットにする
+		freq = append([]int(nil), freq...)
+		freq[(used[0]+1)%len(freq)] = 1
+	}
+
+	c.lengths = codeLengths(freq, maxCodeLength)
+	c.codes = canonicalCodes(c.lengths)
+	return c
+}
+
+// writeTo 符号の定義を書く
+func (c *prefixCode) writeTo(bw *bitWriter) {
+	if c.simple != nil {
+		bw.writeBits(1, 1)
+		bw.writeBits(uint32(len(c.simple)-1), 1)
+		bw.writeBits(1, 1) // シンボルは 8 ビットで書く
+		for _, s := range c.simple {
+			bw.writeBits(uint32(s), 8)
+		}
+		return
+	}
+
+	// 符号長を 0〜15 と、0 の繰り返し（17: 3〜10 個、18: 11〜138 個）で表す
+	type clToken struct {
+		symbol, extraBits int
+		extra             uint32
+	}
+	var tokens []clToken
+	for i := 0; i < len(c.lengths); {
+		if c.lengths[i] != 0 {
+			tokens = append(tokens, clToken{symbol: c.lengths[i]})
+			i++
+			continue
+		}
+		run := 0
+		for i+run < len(c.lengths) && c.lengths[i+run] == 0 {
+			run++
+		}
+		i += run
+		for run > 0 {
+			switch {
+			case run >= 11:
+				n := min(run, 138)
+				tokens = append(tokens, clToken{symbol: 18, extraBits: 7, extra: uint32(n - 11)})
+				run -= n
+			case run >= 3:
+				n := min(run, 10)
+				tokens = append(tokens, clToken{symbol: 17, extraBits: 3, extra: uint32(n - 3)})
+				run -= n
+			default:
+				tokens = append(tokens, clToken{symbol: 0})
+				run--
+			}
+		}
+	}
+
+	freq := make([]int, numCodeLengthCodes)
+	for _, t := range tokens {
+		freq[t.symbol]++
+	}
+	clCode := newNormalCode(freq, maxCodeLengthCodeLength)
+
+	n := 4
+	for i, s := range codeLengthCodeOrder {
+		if clCode.lengths[s] != 0 {
+			n = max(n, i+1)
+		}
+	}
+
+	bw.writeBits(0, 1)
+	bw.writeBits(uint32(n-4), 4)
+	for _, s := range codeLengthCodeOrder[:n] {
+		bw.writeBits(uint32(clCode.lengths[s]), 3)
+	}
+	bw.writeBits(0, 1) // 全シンボルの符号長を書く
+	for _, t := range tokens {
+		clCode.writeSymbol(bw, t.symbol)
+		bw.writeBits(t.extra, t.extraBits)
+	}
+}
+
+// newNormalCode simple を使わない符号（2 シンボル以上になるようにする）
+func newNormalCode(freq []int, limit int) *prefixCode {
+	used := 0
+	for _, f := range freq {
+		if f > 0 {
+			used++
+		}
+	}
+	if used < 2 {
+		freq = append([]int(nil), freq...)
+		for s := range freq {
+			if freq[s] == 0 {
+				freq[s] = 1
+				if used++; used == 2 {
+					break
+				}
+			}
+		}
+	}
+	lengths := codeLengths(freq, limit)
+	return &prefixCode{lengths: lengths, codes: canonicalCodes(lengths)}
+}
+
+// writeSymbol シンボルを書く（1 シンボルだけの simple は 0 ビット）
+func (c *prefixCode) writeSymbol(bw *bitWriter, s int) {
+	if c.codes == nil {
+		return
+	}
+	bw.writeBits(c.codes[s], c.lengths[s])
+}
+
+// codeLengths 長さが limit 以下のハフマン符号の符号長
+// 収まらない場合は小さい出現回数を底上げして作り直す
+func codeLengths(freq []int, limit int) []int {
+	for floor := 1; ; floor *= 2 {
+		lengths := huffmanLengths(freq, floor)
+		longest := 0
+		for _, l := range lengths {
+			longest = max(longest, l)
+		}
+		if longest <= limit {
+			return lengths
+		}
+	}
+}
+
+// huffmanLengths ハフマン木の葉の深さ（出現回数は floor 以上として扱う）
+func huffmanLengths(freq []int, floor int) []int {
+	type node struct {
+		weight int
+		parent int
+	}
+	var nodes []node
+	var symbols []int
+	for s, f := range freq {
+		if f > 0 {
+			nodes = append(nodes, node{weight: max(f, floor), parent: -1})
+			symbols = append(symbols, s)
+		}
+	}
+	leaves := len(nodes)
+	order := make([]int, leaves)
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool { return nodes[order[i]].weight < nodes[order[j]].weight })
+
+	// 葉の列と、重みが単調に増える内部ノードの列から小さい方を取り出す
+	li, qi := 0, leaves
+	pop := func() int {
+		if li < leaves && (qi >= len(nodes) || nodes[order[li]].weight <= nodes[qi].weight) {
+			li++
+			return order[li-1]
+		}
+		qi++
+		return qi - 1
+	}
+	for i := 0; i < leaves-1; i++ {
+		a, b := pop(), pop()
+		nodes = append(nodes, node{weight: nodes[a].weight + nodes[b].weight, parent: -1})
+		nodes[a].parent, nodes[b].parent = len(nodes)-1, len(nodes)-1
+	}
+
+	lengths := make([]int, len(freq))
+	depth := make([]int, len(nodes))
+	for i := len(nodes) - 2; i >= 0; i-- {
+		depth[i] = depth[nodes[i].parent] + 1
+	}
+	for i, s := range symbols {
+		lengths[s] = depth[i]
+	}
+	return lengths
+}
+
+// canonicalCodes 符号長から正規ハフマン符号を作る（下位ビットから書くので反転しておく）
+func canonicalCodes(lengths []int) []uint32 {
+	var count [maxCodeLength + 1]int
+	for _, l := range lengths {
+		count[l]++
+	}
+	count[0] = 0
+	var next [maxCodeLength + 2]uint32
+	code := uint32(0)
+	for l := 1; l <= maxCodeLength; l++ {
+		code = (code + uint32(count[l-1])) << 1
+		next[l] = code
+	}
+
+	codes := make([]uint32, len(lengths))
+	for s, l := range lengths {
+		if l == 0 {
+			continue
+		}
+		codes[s] = reverseBits(next[l], l)
+		next[l]++
+	}
+	return codes
+}
+
+func reverseBits(v uint32, n int) uint32 {
+	var r uint32
+	for i := 0; i < n; i++ {
+		r = r<<1 | v&1
+		v >>= 1
+	}
+	return r
+}
@@ -0,0 +1,119 @@
+package webp
+
+// 使う予測モード（番号は VP8L の仕様のもの）
+var predictorModes = []int{1, 2, 7, 11, 12}
+
+// predict ブロックごとに残差が最も小さくなる予測モードを選び、モードの画像と残差を返す
+func predict(argb []uint32, width, height int) ([]uint32, []uint32) {
+	bw, bh := subSampleSize(width), subSampleSize(height)
+	modes := make([]uint32, bw*bh)
+	residuals := make([]uint32, len(argb))
+	size := 1 << predictorBits
+
+	for by := 0; by < bh; by++ {
+		for bx := 0; bx < bw; bx++ {
+			x0, y0 := bx*size, by*size
+			x1, y1 := min(x0+size, width), min(y0+size, height)
+
+			best, bestCost := predictorModes[0], -1
+			for _, mode := range predictorModes {
+				cost := 0
+				for y := y0; y < y1; y++ {
+					for x := x0; x < x1; x++ {
+						cost += residualCost(sub(argb[y*width+x], predictPixel(argb, width, x, y, mode)))
+					}
+				}
+				if bestCost < 0 || cost < bestCost {
+					best, bestCost = mode, cost
+				}
+			}
+
+			modes[by*bw+bx] = 0xff000000 | uint32(best)<<8
+			for y := y0; y < y1; y++ {
+				for x := x0; x < x1; x++ {
+					i := y*width + x
+					residuals[i] = sub(argb[i], predictPixel(argb, width, x, y, best))
+				}
+			}
+		}
+	}
+	return modes, residuals
+}
+
+// predictPixel (x, y) の予測値。左上の画素、1行目、1列目はモードによらず決まっている
+func predictPixel(argb []uint32, width, x, y, mode int) uint32 {
+	i := y*width + x
+	switch {
+	case x == 0 && y == 0:
+		return 0xff000000
+	case y == 0:
+		return argb[i-1]
+	case x == 0:
+		return argb[i-width]
+	}
+
+	l, t, tl := argb[i-1], argb[i-width], argb[i-width-1]
+	switch mode {
+	case 1:
+		return l
+	case 2:
+		return t
+	case 7:
+		return average2(l, t)
+	case 11:
+		return selectPixel(l, t, tl)
+	case 12:
+		return clampAddSubtractFull(l, t, tl)
+	}
+	return 0xff000000
+}
+
+func channel(p uint32, shift uint) int {
+	return int((p >> shift) & 0xff)
+}
+
+func average2(a, b uint32) uint32 {
+	return ((a^b)&0xfefefefe)>>1 + a&b
+}
+
+func selectPixel(l, t, tl uint32) uint32 {
+	pl, pt := 0, 0
+	for shift := uint(0); shift < 32; shift += 8 {
+		pl += abs(channel(tl, shift) - channel(t, shift))
+		pt += abs(channel(tl, shift) - channel(l, shift))
+	}
+	if pl < pt {
+		return l
+	}
+	return t
+}
+
+func clampAddSubtractFull(l, t, tl uint32) uint32 {
+	var p uint32
+	for shift := uint(0); shift < 32; shift += 8 {
+		v := min(max(channel(l, shift)+channel(t, shift)-channel(tl, shift), 0), 255)
+		p |= uint32(v) << shift
+	}
+	return p
+}
+
+// sub 各チャンネルごとに 256 を法として引く
+func sub(a, b uint32) uint32 {
+	return ((a|0x00ff00ff)-(b&0xff00ff00))&0xff00ff00 | ((a|0xff00ff00)-(b&0x00ff00ff))&0x00ff00ff
+}
+
+// residualCost 残差の大きさ（0 付近に集まるほど符号が短くなる）
+func residualCost(r uint32) int {
+	cost := 0
+	for shift := uint(0); shift < 32; shift += 8 {
+		cost += abs(int(int8(channel(r, shift))))
+	}
+	return cost
+}
+
+func abs(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
@@ -0,0 +1,126 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/jphacks/os_2502/back/api/internal/domain/collage_job"
+	"github.com/jphacks/os_2502/back/api/internal/domain/group"
+	"github.com/jphacks/os_2502/back/api/internal/realtime"
+)
+
+// captureSessionPageSize 1回の問い合わせで取得するグループ数
+const captureSessionPageSize = 100
+
+// CaptureSessionJob 撮影セッションを時刻に合わせて進める定期ジョブを作成
+// 撮影時刻を過ぎたカウントダウンを撮影中にし、締め切りを過ぎた撮影中のグループにコラージュ生成ジョブを登録する。
+// 締め切り後の生成ジョブは、写真が揃っていなければプレースホルダーで生成するか失敗にする
+func CaptureSessionJob(groupRepo group.Repository, jobRepo collage_job.Repository, publisher realtime.Publisher, interval time.Duration) ScheduledJob {
+	if publisher == nil {
+		publisher = realtime.NopPublisher{}
+	}
+	if interval <= 0 {
+		interval = 5 * time.Second
+	}
+
+	return ScheduledJob{
+		Name:     "resolve_capture_sessions",
+		Interval: interval,
+		Run: func(ctx context.Context) (int, error) {
+			now := time.Now()
+			changed := 0
+
+			started, err := eachGroupWithStatus(ctx, groupRepo, group.GroupStatusCountdown, func(g *group.Group) (bool, error) {
+				if !g.AdvanceCapture(now) {
+					return false, nil
+				}
+				updated, err := groupRepo.UpdateIfStatus(ctx, g, group.GroupStatusCountdown)
+				if err != nil {
+					return false, err
+				}
+				// リクエストや生成ジョブが先に撮影中に進めていたら、そちらがイベントを送っている
+				if !updated {
+					return false, nil
+				}
+				publisher.Publish(realtime.NewEvent(realtime.EventPhotoTakingStarted, g.ID(), realtime.PhotoTakingStartedPayload{
+					CaptureDeadline: *g.CaptureDeadline(),
+				}))
+				return true, nil
+			})
+			changed += started
+			if err != nil {
+				return changed, err
+			}
+
+			enqueued, err := eachGroupWithStatus(ctx, groupRepo, group.GroupStatusPhotoTaking, func(g *group.Group) (bool, error) {
+				if !g.IsCaptureDeadlinePassed(now) {
+					return false, nil
+				}
+				// このセッションのジョブが再試行を使い切っていたら登録し直さない（終わらないセッションの掃除で打ち切る）
+				dead, err := hasDeadJob(ctx, jobRepo, g)
+				if err != nil {
+					return false, err
+				}
+				if dead {
+					return false, nil
+				}
+				job, err := collage_job.NewCollageJob(g.ID(), collage_job.DefaultMaxAttempts)
+				if err != nil {
+					return false, err
+				}
+				if err := jobRepo.Enqueue(ctx, job); err != nil {
+					// 写真が揃った時点で登録済み、または前回の実行で登録済み
+					if err == collage_job.ErrJobAlreadyQueued {
+						return false, nil
+					}
+					return false, err
+				}
+				return true, nil
+			})
+			changed += enqueued
+			return changed, err
+		},
+	}
+}
+
+// hasDeadJob グループの現在のセッション（カウントダウン開始以降）の最新のジョブがデッドレターになっているか
+func hasDeadJob(ctx context.Context, jobRepo collage_job.Repository, g *group.Group) (bool, error) {
+	jobs, err := jobRepo.FindByGroupID(ctx, g.ID())
+	if err != nil {
+		return false, err
+	}
+	if len(jobs) == 0 || jobs[0].Status() != collage_job.StatusDead {
+		return false, nil
+	}
+	// 前のラウンドのジョブは関係ない
+	started := g.CountdownStartedAt()
+	return started == nil || !jobs[0].CreatedAt().Before(*started), nil
+}
+
+// eachGroupWithStatus ステータスが status のグループ全てに fn を適用し、fn が true を返した数を返す
+func eachGroupWithStatus(ctx context.Context, groupRepo group.Repository, status group.GroupStatus, fn func(g *group.Group) (bool, error)) (int, error) {
+	var groups []*group.Group
+	for offset := 0; ; offset += captureSessionPageSize {
+		page, err := groupRepo.FindByStatus(ctx, string(status), captureSessionPageSize, offset)
+		if err != nil {
+			return 0, err
+		}
+		groups = append(groups, page...)
+		if len(page) < captureSessionPageSize {
+			break
+		}
+	}
+
+	// 処理中にステータスが変わってページがずれないよう、先に全て取得してから適用する
+	count := 0
+	for _, g := range groups {
+		ok, err := fn(g)
+		if err != nil {
+			return count, err
+		}
+		if ok {
+			count++
+		}
+	}
+	return count, nil
+}
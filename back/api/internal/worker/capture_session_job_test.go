@@ -0,0 +1,126 @@
+package worker
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jphacks/os_2502/back/api/internal/domain/collage_job"
+	"github.com/jphacks/os_2502/back/api/internal/domain/group"
+	"github.com/jphacks/os_2502/back/api/internal/realtime"
+)
+
+type fakeSessionGroups struct {
+	group.Repository
+	groups  []*group.Group
+	updated []string
+	// stale ステータスが既に他で変えられているグループ
+	stale map[string]bool
+}
+
+func (f *fakeSessionGroups) FindByStatus(ctx context.Context, status string, limit, offset int) ([]*group.Group, error) {
+	var matched []*group.Group
+	for _, g := range f.groups {
+		if string(g.Status()) == status {
+			matched = append(matched, g)
+		}
+	}
+	if offset >= len(matched) {
+		return nil, nil
+	}
+	matched = matched[offset:]
+	if len(matched) > limit {
+		matched = matched[:limit]
+	}
+	return matched, nil
+}
+
+func (f *fakeSessionGroups) UpdateIfStatus(ctx context.Context, g *group.Group, expected group.GroupStatus) (bool, error) {
+	if f.stale[g.ID()] {
+		return false, nil
+	}
+	f.updated = append(f.updated, g.ID())
+	return true, nil
+}
+
+type fakeSessionJobs struct {
+	collage_job.Repository
+	queued map[string]bool
+	// deadAt デッドレターになったジョブを作成した時刻
+	deadAt map[string]time.Time
+}
+
+func (f *fakeSessionJobs) FindByGroupID(ctx context.Context, groupID string) ([]*collage_job.CollageJob, error) {
+	createdAt, ok := f.deadAt[groupID]
+	if !ok {
+		return nil, nil
+	}
+	job, err := collage_job.Reconstruct(uuid.New(), groupID, collage_job.StatusDead, collage_job.DefaultMaxAttempts, collage_job.DefaultMaxAttempts,
+		createdAt, nil, nil, nil, createdAt, createdAt)
+	if err != nil {
+		return nil, err
+	}
+	return []*collage_job.CollageJob{job}, nil
+}
+
+func (f *fakeSessionJobs) Enqueue(ctx context.Context, job *collage_job.CollageJob) error {
+	if f.queued[job.GroupID()] {
+		return collage_job.ErrJobAlreadyQueued
+	}
+	f.queued[job.GroupID()] = true
+	return nil
+}
+
+// sessionGroup 指定した撮影時刻・締め切りのグループを作る
+func sessionGroup(t *testing.T, id string, status group.GroupStatus, scheduled, deadline time.Time) *group.Group {
+	t.Helper()
+	now := time.Now()
+	g, err := group.Reconstruct(id, "owner", "group", group.GroupTypeLocalTemporary, status, 2, 2, "token",
+		&now, &now, &scheduled, &deadline, nil, nil, group.DefaultCountdownSeconds, false, 1, now, now)
+	if err != nil {
+		t.Fatalf("Reconstruct: %v", err)
+	}
+	return g
+}
+
+func TestCaptureSessionJob(t *testing.T) {
+	now := time.Now()
+	groups := &fakeSessionGroups{groups: []*group.Group{
+		sessionGroup(t, "due", group.GroupStatusCountdown, now.Add(-time.Second), now.Add(time.Minute)),
+		sessionGroup(t, "raced", group.GroupStatusCountdown, now.Add(-time.Second), now.Add(time.Minute)),
+		sessionGroup(t, "counting", group.GroupStatusCountdown, now.Add(time.Minute), now.Add(2*time.Minute)),
+		sessionGroup(t, "open", group.GroupStatusPhotoTaking, now.Add(-time.Second), now.Add(time.Minute)),
+		sessionGroup(t, "closed", group.GroupStatusPhotoTaking, now.Add(-time.Minute), now.Add(-time.Second)),
+		sessionGroup(t, "queued", group.GroupStatusPhotoTaking, now.Add(-time.Minute), now.Add(-time.Second)),
+		sessionGroup(t, "dead", group.GroupStatusPhotoTaking, now.Add(-time.Minute), now.Add(-time.Second)),
+		sessionGroup(t, "next_round", group.GroupStatusPhotoTaking, now.Add(-time.Minute), now.Add(-time.Second)),
+	}, stale: map[string]bool{"raced": true}}
+	jobs := &fakeSessionJobs{
+		queued: map[string]bool{"queued": true},
+		// dead はこのセッションのジョブ、next_round は前のラウンドのジョブがデッドレターになっている
+		deadAt: map[string]time.Time{"dead": time.Now(), "next_round": now.Add(-time.Hour)},
+	}
+	publisher := &recordingPublisher{}
+
+	job := CaptureSessionJob(groups, jobs, publisher, 0)
+	changed, err := job.Run(context.Background())
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+
+	// due が撮影中になり、closed と next_round にジョブが登録される
+	if changed != 3 {
+		t.Errorf("changed = %d, want 3", changed)
+	}
+	if len(groups.updated) != 1 || groups.updated[0] != "due" {
+		t.Errorf("updated = %v, want [due]", groups.updated)
+	}
+	if !jobs.queued["closed"] || !jobs.queued["next_round"] || jobs.queued["open"] || jobs.queued["dead"] {
+		t.Errorf("queued = %v, want closed and next_round only (plus the existing job)", jobs.queued)
+	}
+	// 他で撮影中に進められた raced のイベントは送らない
+	if len(publisher.events) != 1 || publisher.events[0].Type != realtime.EventPhotoTakingStarted || publisher.events[0].GroupID != "due" {
+		t.Errorf("events = %+v, want photo_taking_started for due", publisher.events)
+	}
+}
@@ -2,109 +2,188 @@ package worker
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
 	"fmt"
 	"image"
+	"image/color"
 	"image/draw"
-	"image/jpeg"
-	_ "image/png" // PNGデコーダーを登録
+	_ "image/jpeg" // JPEGデコーダーを登録
+	_ "image/png"  // PNGデコーダーを登録
 	"log"
-	"os"
-	"path/filepath"
+	"path"
+	"strings"
 	"time"
 
+	"github.com/google/uuid"
+	"github.com/jphacks/os_2502/back/api/internal/blobstore"
+	"github.com/jphacks/os_2502/back/api/internal/domain/collage_result"
+	"github.com/jphacks/os_2502/back/api/internal/domain/collage_template"
 	"github.com/jphacks/os_2502/back/api/internal/domain/group"
 	"github.com/jphacks/os_2502/back/api/internal/domain/group_member"
+	"github.com/jphacks/os_2502/back/api/internal/domain/resumable_upload"
+	"github.com/jphacks/os_2502/back/api/internal/domain/session_round"
+	"github.com/jphacks/os_2502/back/api/internal/domain/template_part"
+	"github.com/jphacks/os_2502/back/api/internal/domain/upload_image"
+	"github.com/jphacks/os_2502/back/api/internal/notification"
+	"github.com/jphacks/os_2502/back/api/internal/realtime"
+	"github.com/jphacks/os_2502/back/api/internal/rendition"
+	"github.com/jphacks/os_2502/back/api/internal/resample"
+	"github.com/jphacks/os_2502/back/api/internal/svgpath"
 )
 
 // TemplateFrame テンプレートのフレーム情報
 type TemplateFrame struct {
-	ID   int    `json:"id"`
-	Path string `json:"path"`
-	X    int    `json:"x"`
-	Y    int    `json:"y"`
-	W    int    `json:"width"`
-	H    int    `json:"height"`
+	ID     int       // パーツ番号
+	PartID uuid.UUID // テンプレートのパーツID
+	Path   string    // viewBox座標系のSVGパス
 }
 
 // TemplateData テンプレート情報
 type TemplateData struct {
-	Name       string          `json:"name"`
-	PhotoCount int             `json:"photo_count"`
-	ViewBox    string          `json:"viewBox"`
-	Width      int             `json:"width"`
-	Height     int             `json:"height"`
-	Frames     []TemplateFrame `json:"frames"`
+	TemplateID uuid.UUID
+	Name       string
+	PhotoCount int
+	ViewBox    string
+	Width      int
+	Height     int
+	Frames     []TemplateFrame
 }
 
+// MissingPhotoPolicy 締め切りまでに写真が揃わなかったときの扱い
+type MissingPhotoPolicy string
+
+const (
+	// MissingPhotosPlaceholder 届いた写真だけで生成し、空いたフレームはプレースホルダーで埋める
+	MissingPhotosPlaceholder MissingPhotoPolicy = "placeholder"
+	// MissingPhotosFail セッションを失敗にする
+	MissingPhotosFail MissingPhotoPolicy = "fail"
+)
+
+// ParseMissingPhotoPolicy 設定値から MissingPhotoPolicy を返す
+func ParseMissingPhotoPolicy(s string) (MissingPhotoPolicy, error) {
+	switch p := MissingPhotoPolicy(strings.ToLower(strings.TrimSpace(s))); p {
+	case MissingPhotosPlaceholder, MissingPhotosFail:
+		return p, nil
+	}
+	return "", fmt.Errorf("unknown missing photo policy %q", s)
+}
+
+// placeholderColor 写真が届かなかったフレームを埋める色
+var placeholderColor = color.RGBA{R: 0xE0, G: 0xE0, B: 0xE0, A: 0xFF}
+
 // CollageGenerator コラージュ生成ワーカー
 type CollageGenerator struct {
 	groupRepo       group.Repository
 	groupMemberRepo group_member.Repository
-	checkInterval   time.Duration
-	templatesPath   string
+	uploadImageRepo upload_image.Repository
+	templateRepo    collage_template.Repository
+	partRepo        template_part.Repository
+	resultRepo      collage_result.Repository
+	roundRepo       session_round.Repository
+	resumableRepo   resumable_upload.Repository
+	store           blobstore.Store
+	publisher       realtime.Publisher
+	notifier        notification.Notifier
+	missingPhotos   MissingPhotoPolicy
+	resampleKernel  resample.Kernel
 }
 
 // NewCollageGenerator コラージュ生成ワーカーを作成
 func NewCollageGenerator(
 	groupRepo group.Repository,
 	groupMemberRepo group_member.Repository,
-	checkInterval time.Duration,
+	uploadImageRepo upload_image.Repository,
+	templateRepo collage_template.Repository,
+	partRepo template_part.Repository,
+	resultRepo collage_result.Repository,
+	roundRepo session_round.Repository,
+	resumableRepo resumable_upload.Repository,
+	store blobstore.Store,
+	publisher realtime.Publisher,
+	notifier notification.Notifier,
+	missingPhotos MissingPhotoPolicy,
+	resampleKernel resample.Kernel,
 ) *CollageGenerator {
-	if checkInterval == 0 {
-		checkInterval = 10 * time.Second // デフォルト10秒
+	if publisher == nil {
+		publisher = realtime.NopPublisher{}
+	}
+	if notifier == nil {
+		notifier = notification.NopNotifier{}
+	}
+	if missingPhotos == "" {
+		missingPhotos = MissingPhotosPlaceholder
+	}
+	if resampleKernel.At == nil {
+		resampleKernel = resample.Bilinear // デフォルトは最も軽いカーネル（config の COLLAGE_RESAMPLE_KERNEL を参照）
 	}
 
 	return &CollageGenerator{
 		groupRepo:       groupRepo,
 		groupMemberRepo: groupMemberRepo,
-		checkInterval:   checkInterval,
-		templatesPath:   "resources/templates.json",
+		uploadImageRepo: uploadImageRepo,
+		templateRepo:    templateRepo,
+		partRepo:        partRepo,
+		resultRepo:      resultRepo,
+		roundRepo:       roundRepo,
+		resumableRepo:   resumableRepo,
+		store:           store,
+		publisher:       publisher,
+		notifier:        notifier,
+		missingPhotos:   missingPhotos,
+		resampleKernel:  resampleKernel,
 	}
 }
 
-// Start ワーカーを開始（バックグラウンドで実行）
-func (w *CollageGenerator) Start(ctx context.Context) {
-	log.Println("🎨 Collage generator worker started")
+// errPhotosIncomplete 締め切り前、または締め切り前に始まったアップロードの完了待ちで全員の写真が揃っていない
+// ジョブは失敗にせず、photoWaitInterval ごとに締め切り（猶予）まで確認し直す
+var errPhotosIncomplete = errors.New("worker: not all members have uploaded a photo")
 
-	ticker := time.NewTicker(w.checkInterval)
-	defer ticker.Stop()
+// photoWaitInterval 写真が揃うのを待つ間に確認し直す間隔
+const photoWaitInterval = 5 * time.Second
 
-	for {
-		select {
-		case <-ctx.Done():
-			log.Println("🎨 Collage generator worker stopped")
-			return
-		case <-ticker.C:
-			w.checkAndGenerateCollages(ctx)
-		}
+// waitForPhotos 写真が揃うのを待つため、limit までの間でジョブを後回しにする
+// 実行回数を使わないので、待つ時間がジョブの再試行の設定に左右されない
+func waitForPhotos(now, limit time.Time) error {
+	until := now.Add(photoWaitInterval)
+	if limit.Before(until) {
+		until = limit
 	}
+	return postpone(errPhotosIncomplete, until)
 }
 
-// checkAndGenerateCollages カウントダウン状態のグループをチェックしてコラージュを生成
-func (w *CollageGenerator) checkAndGenerateCollages(ctx context.Context) {
-	// カウントダウン状態のグループを取得
-	groups, err := w.groupRepo.FindByStatus(ctx, "countdown", 100, 0)
+// Generate グループのコラージュを生成する（CollageJobRunner のハンドラー）
+// 撮影時刻を過ぎたカウントダウンは撮影中に進める。撮影中でないグループ（生成済みなど）は何もしない
+func (w *CollageGenerator) Generate(ctx context.Context, groupID string) error {
+	g, err := w.groupRepo.FindByID(ctx, groupID)
 	if err != nil {
-		log.Printf("❌ Failed to fetch countdown groups: %v", err)
-		return
+		return fmt.Errorf("failed to get group: %w", err)
 	}
 
-	if len(groups) == 0 {
-		return
+	now := time.Now()
+	if g.AdvanceCapture(now) {
+		updated, err := w.groupRepo.UpdateIfStatus(ctx, g, group.GroupStatusCountdown)
+		if err != nil {
+			return fmt.Errorf("failed to start photo taking: %w", err)
+		}
+		// 他のワーカーやリクエストが先にステータスを変えていたら、保存されている状態で続ける
+		if !updated {
+			if g, err = w.groupRepo.FindByID(ctx, groupID); err != nil {
+				return fmt.Errorf("failed to get group: %w", err)
+			}
+		}
 	}
 
-	log.Printf("🔍 Found %d groups in countdown status", len(groups))
-
-	for _, g := range groups {
-		if err := w.processGroup(ctx, g); err != nil {
-			log.Printf("❌ Failed to process group %s: %v", g.ID(), err)
-		}
+	if g.Status() != group.GroupStatusPhotoTaking {
+		log.Printf("⏭️ Group %s is %s, skipping collage generation", groupID, g.Status())
+		return nil
 	}
+
+	return w.processGroup(ctx, g, now)
 }
 
 // processGroup グループの写真が全て揃っているかチェックし、コラージュを生成
-func (w *CollageGenerator) processGroup(ctx context.Context, g *group.Group) error {
+// 締め切りを過ぎても揃わない場合は missingPhotos に従ってプレースホルダーで生成するか失敗にする
+func (w *CollageGenerator) processGroup(ctx context.Context, g *group.Group, now time.Time) error {
 	groupID := g.ID()
 	log.Printf("🔍 Checking group %s", groupID)
 
@@ -119,160 +198,306 @@ func (w *CollageGenerator) processGroup(ctx context.Context, g *group.Group) err
 		return fmt.Errorf("no members in group")
 	}
 
-	// アップロードされた写真をチェック
-	uploadDir := "/uploads/groups/" + groupID
-	if _, err := os.Stat(uploadDir); os.IsNotExist(err) {
-		log.Printf("⏳ Upload directory not found for group %s", groupID)
-		return nil
-	}
-
-	files, err := os.ReadDir(uploadDir)
+	// 現在のラウンドの各メンバーの最新の写真をフレームごとに取得
+	photos, err := w.latestPhotosByFrame(ctx, groupID, members)
 	if err != nil {
-		return fmt.Errorf("failed to read upload directory: %w", err)
+		return fmt.Errorf("failed to get uploaded photos: %w", err)
 	}
 
-	uploadedCount := 0
-	for _, file := range files {
-		if !file.IsDir() && (filepath.Ext(file.Name()) == ".jpg" || filepath.Ext(file.Name()) == ".jpeg" || filepath.Ext(file.Name()) == ".png") {
-			uploadedCount++
+	log.Printf("📊 Group %s: %d/%d photos uploaded", groupID, len(photos), memberCount)
+
+	complete := len(photos) >= memberCount
+	if !complete {
+		// 締め切り前なら後で確認し直す
+		if !g.IsCaptureDeadlinePassed(now) {
+			limit := now.Add(photoWaitInterval)
+			if deadline := g.CaptureDeadline(); deadline != nil {
+				limit = *deadline
+			}
+			return waitForPhotos(now, limit)
+		}
+		// 締め切り前に始まった再開可能なアップロードが残っていれば、猶予の間は完了を待つ
+		if !g.IsUploadGraceOver(now) {
+			inProgress, err := w.resumableRepo.CountInProgress(ctx, groupID, *g.CaptureDeadline(), now)
+			if err != nil {
+				return fmt.Errorf("failed to count resumable uploads: %w", err)
+			}
+			if inProgress > 0 {
+				log.Printf("⏳ Group %s: waiting for %d resumable uploads started before the deadline", groupID, inProgress)
+				return waitForPhotos(now, g.CaptureDeadline().Add(group.UploadGracePeriod))
+			}
 		}
+		if len(photos) == 0 || w.missingPhotos == MissingPhotosFail {
+			return w.failSession(ctx, g, len(photos), memberCount)
+		}
+		log.Printf("⌛ Capture deadline passed for group %s with %d/%d photos, filling the rest with placeholders",
+			groupID, len(photos), memberCount)
+	} else {
+		log.Printf("✅ All photos uploaded for group %s, generating collage...", groupID)
 	}
 
-	log.Printf("📊 Group %s: %d/%d photos uploaded", groupID, uploadedCount, memberCount)
+	// コラージュを生成
+	result, placements, err := w.generateCollage(ctx, g, memberCount, photos, !complete)
+	if err != nil {
+		return fmt.Errorf("failed to generate collage: %w", err)
+	}
 
-	// 全員の写真が揃っていない場合はスキップ
-	if uploadedCount < memberCount {
+	// グループの完了とコラージュ結果の記録は同じトランザクションで行う
+	// セッションを失っていれば結果は記録されない
+	if err := g.Complete(); err != nil {
+		return err
+	}
+	updated, err := w.resultRepo.CreateForCompletedSession(ctx, result, placements, g.UpdatedAt())
+	if err != nil {
+		return fmt.Errorf("failed to record collage result: %w", err)
+	}
+	if !updated {
+		w.logLostSession(g)
+		w.discardCollage(ctx, g, result.FileURL())
 		return nil
 	}
+	log.Printf("Recorded collage result %s with %d photos", result.ResultID(), len(placements))
+	w.finishRound(ctx, g, (*session_round.SessionRound).Complete)
 
-	log.Printf("✅ All photos uploaded for group %s, generating collage...", groupID)
+	log.Printf("🎉 Collage generated successfully for group %s (round %d)", groupID, g.CurrentRound())
 
-	// コラージュを生成
-	if err := w.generateCollage(ctx, groupID, uploadDir); err != nil {
-		return fmt.Errorf("failed to generate collage: %w", err)
+	collageURL := fmt.Sprintf("/api/groups/%s/collage?round=%d", groupID, g.CurrentRound())
+	w.publisher.Publish(realtime.NewEvent(realtime.EventCollageReady, groupID, realtime.CollageReadyPayload{
+		RoundNumber: g.CurrentRound(),
+		CollageURL:  collageURL,
+	}))
+
+	// アプリを閉じているメンバーにも完成を知らせる
+	userIDs := make([]string, len(members))
+	for i, m := range members {
+		userIDs[i] = m.UserID()
 	}
+	w.notifier.Notify(userIDs, notification.CollageReady(groupID, g.Name(), collageURL))
 
-	// グループステータスを完了に更新
-	if err := w.groupRepo.UpdateStatus(ctx, groupID, "completed"); err != nil {
-		log.Printf("⚠️ Failed to update group status: %v", err)
+	result.MarkAsNotified()
+	if err := w.resultRepo.Update(ctx, result); err != nil {
+		log.Printf("⚠️ Failed to mark collage result %s as notified: %v", result.ResultID(), err)
 	}
 
-	log.Printf("🎉 Collage generated successfully for group %s", groupID)
+	return nil
+}
+
+// failSession 写真が揃わなかったセッションを失敗にしてメンバーに知らせる
+func (w *CollageGenerator) failSession(ctx context.Context, g *group.Group, uploaded, memberCount int) error {
+	if err := g.Fail(); err != nil {
+		return err
+	}
+	updated, err := w.groupRepo.UpdateIfStatus(ctx, g, group.GroupStatusPhotoTaking)
+	if err != nil {
+		return fmt.Errorf("failed to mark session as failed: %w", err)
+	}
+	if !updated {
+		w.logLostSession(g)
+		return nil
+	}
+	w.finishRound(ctx, g, (*session_round.SessionRound).Fail)
 
-	// TODO: プッシュ通知を送信
+	log.Printf("💥 Capture session for group %s (round %d) failed with %d/%d photos", g.ID(), g.CurrentRound(), uploaded, memberCount)
 
+	w.publisher.Publish(realtime.NewEvent(realtime.EventSessionFailed, g.ID(), realtime.SessionFailedPayload{
+		RoundNumber:   g.CurrentRound(),
+		UploadedCount: uploaded,
+		MemberCount:   memberCount,
+		Reason:        failedReasonDeadline,
+	}))
 	return nil
 }
 
-// generateCollage コラージュ画像を生成
-func (w *CollageGenerator) generateCollage(ctx context.Context, groupID, uploadDir string) error {
-	log.Printf("Generating collage for group %s from %s", groupID, uploadDir)
+// logLostSession 撮影中のセッションが他で終了・中止されていた（セッションを失った）ことを記録する
+// 通知やラウンドの記録は、セッションを終えた側に任せる
+func (w *CollageGenerator) logLostSession(g *group.Group) {
+	log.Printf("⏭️ Group %s is no longer photo taking, skipping round %d finalization", g.ID(), g.CurrentRound())
+}
 
-	// グループ情報を取得してテンプレートIDを確認
-	g, err := w.groupRepo.FindByID(ctx, groupID)
+// discardCollage セッションを失ったときに保存したコラージュ画像を削除する
+// 画像のキーはラウンドごとに決まっているので、他のワーカーが同じラウンドを完了していた場合は残す
+func (w *CollageGenerator) discardCollage(ctx context.Context, g *group.Group, resultPath string) {
+	current, err := w.groupRepo.FindByID(ctx, g.ID())
 	if err != nil {
-		return fmt.Errorf("failed to get group: %w", err)
+		log.Printf("⚠️ Failed to check group %s before discarding %s: %v", g.ID(), resultPath, err)
+		return
+	}
+	if current.Status() == group.GroupStatusCompleted && current.CurrentRound() == g.CurrentRound() {
+		return
+	}
+	// thumb / preview も同じキーから作られる
+	if err := blobstore.DeletePrefix(ctx, w.store, strings.TrimSuffix(resultPath, path.Ext(resultPath))); err != nil {
+		log.Printf("⚠️ Failed to discard collage %s of group %s: %v", resultPath, g.ID(), err)
+	}
+}
+
+// finishRound グループの現在のラウンドを終了として記録する
+// グループのステータスは更新済みなので、記録に失敗してもログだけ残す
+func (w *CollageGenerator) finishRound(ctx context.Context, g *group.Group, finish func(*session_round.SessionRound, time.Time) error) {
+	round, err := w.roundRepo.FindByGroupAndNumber(ctx, g.ID(), g.CurrentRound())
+	if err == nil {
+		if err = finish(round, time.Now()); err == nil {
+			err = w.roundRepo.Update(ctx, round)
+		}
+	}
+	if err != nil {
+		log.Printf("⚠️ Failed to finish round %d of group %s: %v", g.CurrentRound(), g.ID(), err)
+	}
+}
+
+// latestPhotosByFrame メンバーごとの最新の写真を、フレーム番号をキーにして返す
+// 同じフレームに複数のメンバーの写真がある場合は新しいものを優先する
+func (w *CollageGenerator) latestPhotosByFrame(ctx context.Context, groupID string, members []*group_member.GroupMember) (map[int]*upload_image.UploadImage, error) {
+	images, err := w.uploadImageRepo.FindLatestByGroupID(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	isMember := make(map[string]bool, len(members))
+	for _, m := range members {
+		isMember[m.UserID()] = true
+	}
+
+	// images は新しい順
+	photos := make(map[int]*upload_image.UploadImage, len(images))
+	for _, img := range images {
+		if !isMember[img.UserID().String()] {
+			continue
+		}
+		idx := img.FrameIndex()
+		if idx == nil {
+			log.Printf("⚠️ Photo %s in group %s has no frame index", img.ImageID(), groupID)
+			continue
+		}
+		if prev, ok := photos[*idx]; ok {
+			log.Printf("⚠️ Frame %d in group %s has photos from multiple members, using %s over %s",
+				*idx, groupID, prev.ImageID(), img.ImageID())
+			continue
+		}
+		photos[*idx] = img
 	}
 
+	return photos, nil
+}
+
+// generateCollage コラージュ画像を生成して保存し、記録するコラージュ結果と配置した写真を返す
+// allowMissing の場合は写真の無いフレームをプレースホルダーで埋める
+func (w *CollageGenerator) generateCollage(ctx context.Context, g *group.Group, memberCount int, photos map[int]*upload_image.UploadImage, allowMissing bool) (*collage_result.CollageResult, []collage_result.Placement, error) {
+	groupID := g.ID()
+	log.Printf("Generating collage for group %s from %d photos", groupID, len(photos))
+
 	templateID := g.TemplateID()
 	if templateID == nil || *templateID == "" {
-		return fmt.Errorf("template ID not found in group")
+		return nil, nil, fmt.Errorf("template ID not found in group")
 	}
 
 	log.Printf("Using template ID: %s", *templateID)
 
 	// テンプレート情報を読み込み
-	template, err := w.loadTemplate(*templateID)
+	template, err := w.loadTemplate(ctx, *templateID)
 	if err != nil {
-		return fmt.Errorf("failed to load template: %w", err)
+		return nil, nil, fmt.Errorf("failed to load template: %w", err)
 	}
 
 	log.Printf("Loaded template: %s (%dx%d)", template.Name, template.Width, template.Height)
 
-	// アップロードされた画像ファイルを取得
-	files, err := os.ReadDir(uploadDir)
-	if err != nil {
-		return fmt.Errorf("failed to read upload directory: %w", err)
-	}
-
-	// 画像ファイルのパスリストを作成
-	var imagePaths []string
-	for _, file := range files {
-		if !file.IsDir() {
-			ext := filepath.Ext(file.Name())
-			if ext == ".jpg" || ext == ".jpeg" || ext == ".png" {
-				imagePaths = append(imagePaths, filepath.Join(uploadDir, file.Name()))
+	// フレーム順に写真のキーを並べる（写真の無いフレームは空文字）
+	imagePaths := make([]string, len(template.Frames))
+	for i, frame := range template.Frames {
+		photo, ok := photos[i]
+		if !ok {
+			if allowMissing {
+				continue
 			}
+			return nil, nil, fmt.Errorf("no photo for frame %d (index %d)", frame.ID, i)
 		}
+		imagePaths[i] = blobstore.KeyFromFileURL(photo.FileURL())
 	}
 
-	if len(imagePaths) != template.PhotoCount {
-		return fmt.Errorf("image count mismatch: expected %d, got %d", template.PhotoCount, len(imagePaths))
+	if len(photos) > template.PhotoCount || (!allowMissing && len(photos) != template.PhotoCount) {
+		return nil, nil, fmt.Errorf("image count mismatch: expected %d, got %d", template.PhotoCount, len(photos))
 	}
 
 	// コラージュ画像を生成
-	resultImage, err := w.createCollageImage(template, imagePaths)
+	resultImage, frameBounds, err := composeFrames(ctx, w.store, w.resampleKernel, template, imagePaths)
 	if err != nil {
-		return fmt.Errorf("failed to create collage image: %w", err)
+		return nil, nil, fmt.Errorf("failed to create collage image: %w", err)
 	}
 
-	// 結果ディレクトリを作成
-	resultDir := "/uploads/collages"
-	if err := os.MkdirAll(resultDir, 0755); err != nil {
-		return fmt.Errorf("failed to create result directory: %w", err)
+	// コラージュ画像を保存（thumb / preview も一緒に作る）
+	resultPath := fmt.Sprintf("collages/%s_round%d_collage.jpg", groupID, g.CurrentRound())
+	if err := rendition.Render(ctx, w.store, resultPath, resultImage); err != nil {
+		return nil, nil, fmt.Errorf("failed to save collage image: %w", err)
 	}
 
-	// コラージュ画像を保存
-	resultPath := filepath.Join(resultDir, groupID+"_collage.jpg")
-	outFile, err := os.Create(resultPath)
+	log.Printf("Collage saved to %s", resultPath)
+
+	// コラージュ結果と、実際に配置できた写真の位置
+	result, err := collage_result.NewCollageResult(template.TemplateID, groupID, resultPath, memberCount)
 	if err != nil {
-		return fmt.Errorf("failed to create result file: %w", err)
+		return nil, nil, fmt.Errorf("failed to build collage result: %w", err)
 	}
-	defer outFile.Close()
-
-	if err := jpeg.Encode(outFile, resultImage, &jpeg.Options{Quality: 90}); err != nil {
-		return fmt.Errorf("failed to encode collage image: %w", err)
+	if err := result.AssignRound(g.CurrentRound()); err != nil {
+		return nil, nil, fmt.Errorf("failed to build collage result: %w", err)
 	}
 
-	log.Printf("Collage saved to %s", resultPath)
+	placements := make([]collage_result.Placement, 0, len(frameBounds))
+	for i, bounds := range frameBounds {
+		if bounds.Empty() {
+			continue
+		}
+		placements = append(placements, collage_result.Placement{
+			ImageID:   photos[i].ImageID(),
+			PositionX: bounds.Min.X,
+			PositionY: bounds.Min.Y,
+			Width:     bounds.Dx(),
+			Height:    bounds.Dy(),
+			SortOrder: i,
+		})
+	}
 
-	return nil
+	return result, placements, nil
 }
 
-// loadTemplate テンプレート情報を読み込み
-func (w *CollageGenerator) loadTemplate(templateID string) (*TemplateData, error) {
-	// templates.jsonファイルを読み込む
-	filePath := w.templatesPath
-	if !filepath.IsAbs(filePath) {
-		wd, err := os.Getwd()
-		if err != nil {
-			return nil, fmt.Errorf("failed to get working directory: %w", err)
-		}
-		filePath = filepath.Join(wd, filePath)
+// loadTemplate テンプレートとフレームを読み込み
+func (w *CollageGenerator) loadTemplate(ctx context.Context, templateID string) (*TemplateData, error) {
+	id, err := uuid.Parse(templateID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid template ID %q: %w", templateID, err)
 	}
+	return loadTemplateData(ctx, w.templateRepo, w.partRepo, id)
+}
 
-	data, err := os.ReadFile(filePath)
+// loadTemplateData テンプレートと、パーツ番号順のフレームを読み込み
+func loadTemplateData(ctx context.Context, templateRepo collage_template.Repository, partRepo template_part.Repository, id uuid.UUID) (*TemplateData, error) {
+	t, err := templateRepo.FindByID(ctx, id)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read templates file: %w", err)
+		return nil, err
 	}
-
-	var templates []TemplateData
-	if err := json.Unmarshal(data, &templates); err != nil {
-		return nil, fmt.Errorf("failed to parse templates data: %w", err)
+	parts, err := partRepo.FindByTemplateID(ctx, id)
+	if err != nil {
+		return nil, err
 	}
 
-	// テンプレートIDで検索（現在はnameで検索）
-	for _, t := range templates {
-		if t.Name == templateID {
-			return &t, nil
-		}
+	data := &TemplateData{
+		TemplateID: t.TemplateID(),
+		Name:       t.Name(),
+		PhotoCount: t.PhotoCount(),
+		ViewBox:    t.ViewBox(),
+		Width:      t.Width(),
+		Height:     t.Height(),
 	}
-
-	return nil, fmt.Errorf("template not found: %s", templateID)
+	for i, f := range t.Frames(parts) {
+		data.Frames = append(data.Frames, TemplateFrame{ID: f.Number, PartID: parts[i].PartID(), Path: f.Path})
+	}
+	return data, nil
 }
 
-// createCollageImage コラージュ画像を作成
-func (w *CollageGenerator) createCollageImage(template *TemplateData, imagePaths []string) (image.Image, error) {
+// composeFrames コラージュ画像を作成（撮影セッションと毎日のコラージュで共通）
+// 各フレームのSVGパスを出力解像度でラスタライズしたマスクで写真を切り抜いて合成する。
+// キーが空のフレームはプレースホルダーの色で塗る。
+// フレームごとに写真を配置した矩形も返す（配置できなかったフレームは空の矩形）
+func composeFrames(ctx context.Context, store blobstore.Store, kernel resample.Kernel, template *TemplateData, imagePaths []string) (image.Image, []image.Rectangle, error) {
 	// キャンバスを作成（デフォルトサイズ: 1000x1000）
 	width := template.Width
 	height := template.Height
@@ -283,7 +508,16 @@ func (w *CollageGenerator) createCollageImage(template *TemplateData, imagePaths
 		height = 1000
 	}
 
+	viewBox, err := svgpath.ParseViewBox(template.ViewBox)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid template viewBox: %w", err)
+	}
+
+	// アプリのプレビューと同じく背景は白
 	canvas := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.Draw(canvas, canvas.Bounds(), image.White, image.Point{}, draw.Src)
+
+	placed := make([]image.Rectangle, len(template.Frames))
 
 	// 各フレームに画像を配置
 	for i, frame := range template.Frames {
@@ -291,56 +525,82 @@ func (w *CollageGenerator) createCollageImage(template *TemplateData, imagePaths
 			break
 		}
 
-		// 画像を読み込み
-		imgFile, err := os.Open(imagePaths[i])
+		framePath, err := svgpath.Parse(frame.Path)
 		if err != nil {
-			log.Printf("Warning: failed to open image %s: %v", imagePaths[i], err)
+			return nil, nil, fmt.Errorf("invalid path for frame %d: %w", frame.ID, err)
+		}
+		pixelPath := viewBox.ToPixels(framePath, width, height)
+
+		mask := svgpath.Rasterize(pixelPath, width, height)
+		bounds := maskBounds(mask)
+		if bounds.Empty() {
+			log.Printf("Warning: frame %d has no visible area", frame.ID)
 			continue
 		}
 
-		img, _, err := image.Decode(imgFile)
-		imgFile.Close()
-		if err != nil {
-			log.Printf("Warning: failed to decode image %s: %v", imagePaths[i], err)
+		if imagePaths[i] == "" {
+			draw.DrawMask(canvas, bounds, image.NewUniform(placeholderColor), image.Point{}, mask, bounds.Min, draw.Over)
+			log.Printf("Filled frame %d with a placeholder", frame.ID)
 			continue
 		}
 
-		// フレームの位置とサイズ
-		x, y, fw, fh := frame.X, frame.Y, frame.W, frame.H
-		if fw == 0 || fh == 0 {
-			// サイズが指定されていない場合は画像のサイズを使用
-			fw = img.Bounds().Dx()
-			fh = img.Bounds().Dy()
+		// 画像を読み込み
+		img, err := decodeImage(ctx, store, imagePaths[i])
+		if err != nil {
+			log.Printf("Warning: failed to load image %s: %v", imagePaths[i], err)
+			continue
 		}
 
-		// 画像をリサイズして配置
-		resized := w.resizeImage(img, fw, fh)
-		dp := image.Point{X: x, Y: y}
-		dr := image.Rectangle{Min: dp, Max: dp.Add(resized.Bounds().Size())}
-		draw.Draw(canvas, dr, resized, image.Point{}, draw.Over)
+		// フレームの外接矩形を覆うように中央で切り出してリサイズし、マスクで切り抜いて配置
+		resized := resample.Fit(img, bounds.Dx(), bounds.Dy(), resample.Cover, kernel)
+		draw.DrawMask(canvas, bounds, resized, image.Point{}, mask, bounds.Min, draw.Over)
+		placed[i] = bounds
 
-		log.Printf("Placed image %d at (%d,%d) size (%dx%d)", i, x, y, fw, fh)
+		log.Printf("Placed image %d in frame %d at (%d,%d) size (%dx%d)",
+			i, frame.ID, bounds.Min.X, bounds.Min.Y, bounds.Dx(), bounds.Dy())
 	}
 
-	return canvas, nil
+	return canvas, placed, nil
 }
 
-// resizeImage 画像をリサイズ（簡易実装）
-func (w *CollageGenerator) resizeImage(img image.Image, width, height int) image.Image {
-	// 簡易的なニアレストネイバー法でリサイズ
-	bounds := img.Bounds()
-	srcW := bounds.Dx()
-	srcH := bounds.Dy()
+// decodeImage 保存先から写真を読み込んでデコードする
+func decodeImage(ctx context.Context, store blobstore.Store, key string) (image.Image, error) {
+	rc, _, err := store.Get(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
 
-	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	img, _, err := image.Decode(rc)
+	return img, err
+}
+
+// maskBounds マスクの不透明ピクセルを含む最小矩形を返す
+func maskBounds(mask *image.Alpha) image.Rectangle {
+	b := mask.Bounds()
+	minX, minY, maxX, maxY := b.Max.X, b.Max.Y, b.Min.X, b.Min.Y
 
-	for y := 0; y < height; y++ {
-		for x := 0; x < width; x++ {
-			srcX := x * srcW / width
-			srcY := y * srcH / height
-			dst.Set(x, y, img.At(bounds.Min.X+srcX, bounds.Min.Y+srcY))
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		row := mask.Pix[mask.PixOffset(b.Min.X, y):mask.PixOffset(b.Max.X, y)]
+		for x, a := range row {
+			if a == 0 {
+				continue
+			}
+			px := b.Min.X + x
+			if px < minX {
+				minX = px
+			}
+			if px >= maxX {
+				maxX = px + 1
+			}
+			if y < minY {
+				minY = y
+			}
+			if y >= maxY {
+				maxY = y + 1
+			}
 		}
 	}
 
-	return dst
+	return image.Rect(minX, minY, maxX, maxY)
 }
@@ -0,0 +1,239 @@
+package worker
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jphacks/os_2502/back/api/internal/blobstore"
+	"github.com/jphacks/os_2502/back/api/internal/domain/group"
+	"github.com/jphacks/os_2502/back/api/internal/domain/group_member"
+	"github.com/jphacks/os_2502/back/api/internal/domain/resumable_upload"
+	"github.com/jphacks/os_2502/back/api/internal/domain/session_round"
+	"github.com/jphacks/os_2502/back/api/internal/domain/upload_image"
+	"github.com/jphacks/os_2502/back/api/internal/realtime"
+	"github.com/jphacks/os_2502/back/api/internal/rendition"
+	"github.com/jphacks/os_2502/back/api/internal/resample"
+)
+
+// fakeGeneratorGroups 保存されているステータスを stored で持つ
+type fakeGeneratorGroups struct {
+	group.Repository
+	g      *group.Group
+	stored group.GroupStatus
+}
+
+func (f *fakeGeneratorGroups) FindByID(ctx context.Context, id string) (*group.Group, error) {
+	return f.g, nil
+}
+
+func (f *fakeGeneratorGroups) UpdateIfStatus(ctx context.Context, g *group.Group, expected group.GroupStatus) (bool, error) {
+	if f.stored != expected {
+		return false, nil
+	}
+	f.stored = g.Status()
+	return true, nil
+}
+
+type fakeGeneratorMembers struct{ group_member.Repository }
+
+func (fakeGeneratorMembers) FindByGroupID(ctx context.Context, groupID string) ([]*group_member.GroupMember, error) {
+	m, err := group_member.NewGroupMember(groupID, "member", false)
+	if err != nil {
+		return nil, err
+	}
+	return []*group_member.GroupMember{m}, nil
+}
+
+type fakeGeneratorImages struct{ upload_image.Repository }
+
+func (fakeGeneratorImages) FindLatestByGroupID(ctx context.Context, groupID string) ([]*upload_image.UploadImage, error) {
+	return nil, nil
+}
+
+type fakeGeneratorUploads struct {
+	resumable_upload.Repository
+	inProgress int
+}
+
+func (f fakeGeneratorUploads) CountInProgress(ctx context.Context, groupID string, startedBefore, now time.Time) (int, error) {
+	return f.inProgress, nil
+}
+
+type fakeGeneratorRounds struct {
+	session_round.Repository
+	finished int
+}
+
+func (f *fakeGeneratorRounds) FindByGroupAndNumber(ctx context.Context, groupID string, roundNumber int) (*session_round.SessionRound, error) {
+	f.finished++
+	return nil, errors.New("not found")
+}
+
+func TestGenerateAfterDeadline(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name         string
+		stored       group.GroupStatus
+		inProgress   int
+		wantErr      error
+		wantStored   group.GroupStatus
+		wantFinished int
+		wantEvents   int
+	}{
+		{"photo taking", group.GroupStatusPhotoTaking, 0, nil, group.GroupStatusFailed, 1, 1},
+		// 締め切り前に始まったアップロードが残っていれば完了を待つ
+		{"upload in progress", group.GroupStatusPhotoTaking, 1, errPhotosIncomplete, group.GroupStatusPhotoTaking, 0, 0},
+		// 他で期限切れになっていたら、失敗にせず通知もしない
+		{"lost ownership", group.GroupStatusExpired, 0, nil, group.GroupStatusExpired, 0, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := sessionGroup(t, "g", group.GroupStatusPhotoTaking, now.Add(-time.Minute), now.Add(-time.Second))
+			groups := &fakeGeneratorGroups{g: g, stored: tt.stored}
+			rounds := &fakeGeneratorRounds{}
+			publisher := &recordingPublisher{}
+
+			w := NewCollageGenerator(groups, fakeGeneratorMembers{}, fakeGeneratorImages{}, nil, nil, nil, rounds,
+				fakeGeneratorUploads{inProgress: tt.inProgress}, nil, publisher, nil, MissingPhotosFail, resample.Kernel{})
+			err := w.Generate(context.Background(), "g")
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Generate: got %v, want %v", err, tt.wantErr)
+			}
+			// 待つ場合は失敗にせず、猶予が終わるまでの間で後回しにする
+			var postponed *postponedError
+			if tt.wantErr != nil {
+				if !errors.As(err, &postponed) {
+					t.Fatalf("Generate: got %v, want a postponed error", err)
+				}
+				if graceEnd := g.CaptureDeadline().Add(group.UploadGracePeriod); postponed.until.After(graceEnd) {
+					t.Errorf("postponed until %v, want no later than %v", postponed.until, graceEnd)
+				}
+			}
+
+			if groups.stored != tt.wantStored {
+				t.Errorf("stored status = %s, want %s", groups.stored, tt.wantStored)
+			}
+			if rounds.finished != tt.wantFinished {
+				t.Errorf("finishRound called %d times, want %d", rounds.finished, tt.wantFinished)
+			}
+			if len(publisher.events) != tt.wantEvents {
+				t.Errorf("events = %+v, want %d", publisher.events, tt.wantEvents)
+			} else if tt.wantEvents > 0 && publisher.events[0].Type != realtime.EventSessionFailed {
+				t.Errorf("event = %s, want %s", publisher.events[0].Type, realtime.EventSessionFailed)
+			}
+		})
+	}
+}
+
+// セッションを失ったら保存したコラージュ画像を消すが、同じラウンドを他で完了していれば残す
+func TestDiscardCollage(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	tests := []struct {
+		name     string
+		current  *group.Group
+		wantKept bool
+	}{
+		{"failed", sessionGroup(t, "g", group.GroupStatusFailed, now, now), false},
+		{"completed same round", sessionGroup(t, "g", group.GroupStatusCompleted, now, now), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := blobstore.NewLocalStore(t.TempDir(), "", nil)
+			w := &CollageGenerator{groupRepo: &fakeGeneratorGroups{g: tt.current}, store: store}
+
+			key := "collages/g_round1_collage.jpg"
+			if err := rendition.Render(ctx, store, key, image.NewRGBA(image.Rect(0, 0, 8, 8))); err != nil {
+				t.Fatal(err)
+			}
+			// 他のラウンドの画像は対象外
+			if err := rendition.Render(ctx, store, "collages/g_round10_collage.jpg", image.NewRGBA(image.Rect(0, 0, 8, 8))); err != nil {
+				t.Fatal(err)
+			}
+
+			w.discardCollage(ctx, sessionGroup(t, "g", group.GroupStatusCompleted, now, now), key)
+
+			objects, err := store.List(ctx, "collages/")
+			if err != nil {
+				t.Fatal(err)
+			}
+			var kept, others int
+			for _, o := range objects {
+				if strings.HasPrefix(o.Key, "collages/g_round1_") {
+					kept++
+				} else {
+					others++
+				}
+			}
+			if (kept > 0) != tt.wantKept {
+				t.Errorf("%d objects of the round left, want kept %v", kept, tt.wantKept)
+			}
+			if others != len(rendition.Sizes) {
+				t.Errorf("%d objects of other rounds left, want %d", others, len(rendition.Sizes))
+			}
+		})
+	}
+}
+
+func TestComposeFrames(t *testing.T) {
+	ctx := context.Background()
+	store := blobstore.NewLocalStore(t.TempDir(), "", nil)
+
+	photo := image.NewRGBA(image.Rect(0, 0, 40, 40))
+	draw.Draw(photo, photo.Bounds(), image.NewUniform(color.RGBA{R: 0xff, A: 0xff}), image.Point{}, draw.Src)
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, photo); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Put(ctx, "photo.png", &buf, int64(buf.Len()), "image/png"); err != nil {
+		t.Fatal(err)
+	}
+
+	// 左半分の三角形のフレームに写真、右半分のフレームは写真なし
+	template := &TemplateData{
+		ViewBox: "0 0 1 1",
+		Width:   200,
+		Height:  100,
+		Frames: []TemplateFrame{
+			{ID: 1, Path: "M 0 0 L 0.5 0 L 0 1 Z"},
+			{ID: 2, Path: "M 0.5 0 L 1 0 L 1 1 L 0.5 1 Z"},
+		},
+	}
+	canvas, placed, err := composeFrames(ctx, store, resample.Bilinear, template, []string{"photo.png", ""})
+	if err != nil {
+		t.Fatalf("composeFrames: %v", err)
+	}
+
+	// キャンバスはテンプレートの大きさ
+	if want := image.Rect(0, 0, 200, 100); canvas.Bounds() != want {
+		t.Errorf("canvas = %v, want %v", canvas.Bounds(), want)
+	}
+	if want := image.Rect(0, 0, 100, 100); placed[0] != want {
+		t.Errorf("placed[0] = %v, want %v", placed[0], want)
+	}
+	if !placed[1].Empty() {
+		t.Errorf("placed[1] = %v, want empty", placed[1])
+	}
+
+	tests := []struct {
+		x, y int
+		want color.RGBA
+	}{
+		{5, 5, color.RGBA{R: 0xff, A: 0xff}},         // 三角形の内側
+		{90, 90, color.RGBA{0xff, 0xff, 0xff, 0xff}}, // 三角形の外側は背景のまま
+		{150, 50, placeholderColor},                  // 写真の無いフレーム
+	}
+	for _, tt := range tests {
+		if got := color.RGBAModel.Convert(canvas.At(tt.x, tt.y)).(color.RGBA); got != tt.want {
+			t.Errorf("pixel (%d,%d) = %v, want %v", tt.x, tt.y, got, tt.want)
+		}
+	}
+}
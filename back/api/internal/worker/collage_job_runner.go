@@ -0,0 +1,193 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jphacks/os_2502/back/api/internal/domain/collage_job"
+)
+
+// JobHandler グループのコラージュを生成する処理。エラーを返すとジョブは再試行される
+// postpone で作ったエラーを返した場合は、失敗にせず指定した時刻に再実行する
+type JobHandler func(ctx context.Context, groupID string) error
+
+// postponedError ジョブがまだ実行できる状態でないことを表す
+type postponedError struct {
+	reason error
+	until  time.Time
+}
+
+func (e *postponedError) Error() string {
+	return fmt.Sprintf("%v (postponed until %s)", e.reason, e.until.Format(time.RFC3339))
+}
+
+func (e *postponedError) Unwrap() error {
+	return e.reason
+}
+
+// postpone ジョブの実行回数を使わずに until に再実行させるエラーを作る
+func postpone(reason error, until time.Time) error {
+	return &postponedError{reason: reason, until: until}
+}
+
+// CollageJobRunnerConfig ジョブランナーの設定（ゼロ値の項目はデフォルトを使う）
+type CollageJobRunnerConfig struct {
+	// Workers 同時に実行するジョブ数
+	Workers int
+	// PollInterval キューを確認する間隔
+	PollInterval time.Duration
+	// Lease 1回の実行でジョブを確保しておく時間。これを過ぎると他のインスタンスが再実行できる
+	Lease time.Duration
+	// BackoffBase 1回目の失敗後に待つ時間（以降は倍々）
+	BackoffBase time.Duration
+	// BackoffMax 再試行までの待ち時間の上限
+	BackoffMax time.Duration
+}
+
+// CollageJobRunner collage_jobs からリースを取ってコラージュ生成を実行するワーカー
+// 複数のAPIインスタンスで動かしても、1つのジョブを実行するのは1インスタンスだけ
+type CollageJobRunner struct {
+	jobs     collage_job.Repository
+	handle   JobHandler
+	workerID string
+	cfg      CollageJobRunnerConfig
+	wg       sync.WaitGroup
+}
+
+// NewCollageJobRunner ジョブランナーを作成
+func NewCollageJobRunner(jobs collage_job.Repository, handle JobHandler, cfg CollageJobRunnerConfig) *CollageJobRunner {
+	if cfg.Workers <= 0 {
+		cfg.Workers = 2
+	}
+	if cfg.PollInterval <= 0 {
+		cfg.PollInterval = 2 * time.Second
+	}
+	if cfg.Lease <= 0 {
+		cfg.Lease = 2 * time.Minute
+	}
+	if cfg.BackoffBase <= 0 {
+		cfg.BackoffBase = 5 * time.Second
+	}
+	if cfg.BackoffMax <= 0 {
+		cfg.BackoffMax = 5 * time.Minute
+	}
+
+	return &CollageJobRunner{
+		jobs:     jobs,
+		handle:   handle,
+		workerID: newWorkerID(),
+		cfg:      cfg,
+	}
+}
+
+// newWorkerID リースの持ち主を識別するID（ホスト名 + PID + ランダム）
+func newWorkerID() string {
+	host, err := os.Hostname()
+	if err != nil {
+		host = "unknown"
+	}
+	return fmt.Sprintf("%s-%d-%s", host, os.Getpid(), uuid.NewString()[:8])
+}
+
+// Start ワーカーを開始（バックグラウンドで実行）
+// ctx がキャンセルされると新しいジョブの取得をやめ、実行中のジョブの終了を待って戻る
+func (r *CollageJobRunner) Start(ctx context.Context) {
+	log.Printf("🎨 Collage job runner started (worker %s, %d workers)", r.workerID, r.cfg.Workers)
+
+	slots := make(chan struct{}, r.cfg.Workers)
+	ticker := time.NewTicker(r.cfg.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			r.wg.Wait()
+			log.Println("🎨 Collage job runner stopped")
+			return
+		case <-ticker.C:
+			r.dispatch(ctx, slots)
+		}
+	}
+}
+
+// dispatch 空いているワーカーの数だけジョブを取得して実行する
+func (r *CollageJobRunner) dispatch(ctx context.Context, slots chan struct{}) {
+	free := cap(slots) - len(slots)
+	if free == 0 {
+		return
+	}
+
+	jobs, err := r.jobs.Claim(ctx, r.workerID, r.cfg.Lease, free)
+	if err != nil {
+		if ctx.Err() == nil {
+			log.Printf("❌ Failed to claim collage jobs: %v", err)
+		}
+		return
+	}
+
+	for _, job := range jobs {
+		slots <- struct{}{}
+		r.wg.Add(1)
+		go func(job *collage_job.CollageJob) {
+			defer func() {
+				<-slots
+				r.wg.Done()
+			}()
+			r.run(ctx, job)
+		}(job)
+	}
+}
+
+// run ジョブを1回実行して結果を記録する
+func (r *CollageJobRunner) run(ctx context.Context, job *collage_job.CollageJob) {
+	log.Printf("🎨 Running collage job %s for group %s (attempt %d/%d)",
+		job.JobID(), job.GroupID(), job.Attempts(), job.MaxAttempts())
+
+	// リースが切れる前に打ち切り、他のインスタンスと同時に実行しないようにする
+	jobCtx, cancel := context.WithTimeout(ctx, r.cfg.Lease)
+	err := r.execute(jobCtx, job.GroupID())
+	cancel()
+
+	now := time.Now()
+	var postponed *postponedError
+	if err == nil {
+		job.Succeed(now)
+		log.Printf("✅ Collage job %s for group %s succeeded", job.JobID(), job.GroupID())
+	} else if errors.As(err, &postponed) {
+		job.Postpone(postponed.until, now)
+		log.Printf("⏸️ Collage job %s for group %s postponed until %s: %v",
+			job.JobID(), job.GroupID(), postponed.until.Format(time.RFC3339), postponed.reason)
+	} else {
+		job.Fail(err, now, collage_job.Backoff(job.Attempts(), r.cfg.BackoffBase, r.cfg.BackoffMax))
+		if job.Status() == collage_job.StatusDead {
+			log.Printf("💀 Collage job %s for group %s failed %d times, giving up: %v",
+				job.JobID(), job.GroupID(), job.Attempts(), err)
+		} else {
+			log.Printf("🔁 Collage job %s for group %s failed, retrying at %s: %v",
+				job.JobID(), job.GroupID(), job.RunAt().Format(time.RFC3339), err)
+		}
+	}
+
+	// シャットダウン中でも結果は書き込む
+	releaseCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 10*time.Second)
+	defer cancel()
+	if err := r.jobs.Release(releaseCtx, job, r.workerID); err != nil {
+		log.Printf("⚠️ Failed to release collage job %s: %v", job.JobID(), err)
+	}
+}
+
+// execute ハンドラーを実行する。panic もジョブの失敗として扱う
+func (r *CollageJobRunner) execute(ctx context.Context, groupID string) (err error) {
+	defer func() {
+		if p := recover(); p != nil {
+			err = fmt.Errorf("panic: %v", p)
+		}
+	}()
+	return r.handle(ctx, groupID)
+}
@@ -0,0 +1,196 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/jphacks/os_2502/back/api/internal/domain/collage_job"
+)
+
+// fakeJobs メモリ上のジョブキュー
+type fakeJobs struct {
+	collage_job.Repository
+
+	mu       sync.Mutex
+	queue    []*collage_job.CollageJob
+	released []*collage_job.CollageJob
+}
+
+func (f *fakeJobs) add(groupID string, maxAttempts int) {
+	job, _ := collage_job.NewCollageJob(groupID, maxAttempts)
+	f.queue = append(f.queue, job)
+}
+
+func (f *fakeJobs) Claim(ctx context.Context, workerID string, lease time.Duration, limit int) ([]*collage_job.CollageJob, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var claimed []*collage_job.CollageJob
+	for len(f.queue) > 0 && len(claimed) < limit {
+		j := f.queue[0]
+		f.queue = f.queue[1:]
+		if j.RunAt().After(time.Now()) {
+			f.queue = append(f.queue, j)
+			break
+		}
+		until := time.Now().Add(lease)
+		j, _ = collage_job.Reconstruct(j.JobID(), j.GroupID(), collage_job.StatusRunning,
+			j.Attempts()+1, j.MaxAttempts(), j.RunAt(), &workerID, &until, j.LastError(), j.CreatedAt(), j.UpdatedAt())
+		claimed = append(claimed, j)
+	}
+	return claimed, nil
+}
+
+func (f *fakeJobs) Release(ctx context.Context, j *collage_job.CollageJob, workerID string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.released = append(f.released, j)
+	if !j.IsFinished() {
+		f.queue = append(f.queue, j)
+	}
+	return nil
+}
+
+func (f *fakeJobs) finished() []*collage_job.CollageJob {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var done []*collage_job.CollageJob
+	for _, j := range f.released {
+		if j.IsFinished() {
+			done = append(done, j)
+		}
+	}
+	return done
+}
+
+// runUntil 条件を満たすまでランナーを動かす
+func runUntil(t *testing.T, r *CollageJobRunner, cond func() bool) {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		r.Start(ctx)
+		close(done)
+	}()
+
+	deadline := time.After(5 * time.Second)
+	for !cond() {
+		select {
+		case <-deadline:
+			cancel()
+			<-done
+			t.Fatal("timed out")
+		case <-time.After(5 * time.Millisecond):
+		}
+	}
+	cancel()
+	<-done
+}
+
+func TestCollageJobRunnerRetriesThenDeadLetters(t *testing.T) {
+	jobs := &fakeJobs{}
+	jobs.add("flaky", 3)
+	jobs.add("broken", 2)
+
+	var flakyCalls int32
+	handle := func(ctx context.Context, groupID string) error {
+		switch groupID {
+		case "flaky":
+			if atomic.AddInt32(&flakyCalls, 1) < 2 {
+				return errors.New("temporary failure")
+			}
+			return nil
+		default:
+			panic("boom")
+		}
+	}
+
+	r := NewCollageJobRunner(jobs, handle, CollageJobRunnerConfig{
+		Workers:      2,
+		PollInterval: time.Millisecond,
+		BackoffBase:  time.Millisecond,
+		BackoffMax:   time.Millisecond,
+	})
+	runUntil(t, r, func() bool { return len(jobs.finished()) == 2 })
+
+	status := map[string]*collage_job.CollageJob{}
+	for _, j := range jobs.finished() {
+		status[j.GroupID()] = j
+	}
+	if j := status["flaky"]; j.Status() != collage_job.StatusSucceeded || j.Attempts() != 2 {
+		t.Errorf("flaky: status=%s attempts=%d, want succeeded after 2", j.Status(), j.Attempts())
+	}
+	if j := status["broken"]; j.Status() != collage_job.StatusDead || j.Attempts() != 2 {
+		t.Errorf("broken: status=%s attempts=%d, want dead after 2", j.Status(), j.Attempts())
+	}
+	if j := status["broken"]; j.LastError() == nil || *j.LastError() != "panic: boom" {
+		t.Errorf("broken: last error = %v", j.LastError())
+	}
+}
+
+func TestCollageJobRunnerBoundsConcurrency(t *testing.T) {
+	jobs := &fakeJobs{}
+	for i := 0; i < 6; i++ {
+		jobs.add("group", 1)
+	}
+
+	var running, peak int32
+	handle := func(ctx context.Context, groupID string) error {
+		n := atomic.AddInt32(&running, 1)
+		for {
+			p := atomic.LoadInt32(&peak)
+			if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		atomic.AddInt32(&running, -1)
+		return nil
+	}
+
+	r := NewCollageJobRunner(jobs, handle, CollageJobRunnerConfig{Workers: 2, PollInterval: time.Millisecond})
+	runUntil(t, r, func() bool { return len(jobs.finished()) == 6 })
+
+	if peak > 2 {
+		t.Errorf("peak concurrency = %d, want <= 2", peak)
+	}
+}
+
+func TestCollageJobRunnerPostponeDoesNotUseAttempts(t *testing.T) {
+	jobs := &fakeJobs{}
+	// 猶予の間に何度待っても、1回しか実行できないジョブがデッドレターにならない
+	jobs.add("waiting", 1)
+
+	var calls int32
+	handle := func(ctx context.Context, groupID string) error {
+		if atomic.AddInt32(&calls, 1) <= 3 {
+			return postpone(errPhotosIncomplete, time.Now())
+		}
+		return nil
+	}
+
+	r := NewCollageJobRunner(jobs, handle, CollageJobRunnerConfig{
+		Workers:      1,
+		PollInterval: time.Millisecond,
+		BackoffBase:  time.Millisecond,
+		BackoffMax:   time.Millisecond,
+	})
+	runUntil(t, r, func() bool { return len(jobs.finished()) == 1 })
+
+	job := jobs.finished()[0]
+	if job.Status() != collage_job.StatusSucceeded {
+		t.Errorf("status = %s, want %s", job.Status(), collage_job.StatusSucceeded)
+	}
+	if job.Attempts() != 1 {
+		t.Errorf("attempts = %d, want 1", job.Attempts())
+	}
+	if got := atomic.LoadInt32(&calls); got != 4 {
+		t.Errorf("handler called %d times, want 4", got)
+	}
+}
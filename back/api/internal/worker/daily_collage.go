@@ -0,0 +1,530 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math/rand"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jphacks/os_2502/back/api/internal/blobstore"
+	"github.com/jphacks/os_2502/back/api/internal/domain/collage_result"
+	"github.com/jphacks/os_2502/back/api/internal/domain/collage_template"
+	"github.com/jphacks/os_2502/back/api/internal/domain/daily_collage"
+	"github.com/jphacks/os_2502/back/api/internal/domain/group"
+	"github.com/jphacks/os_2502/back/api/internal/domain/group_member"
+	"github.com/jphacks/os_2502/back/api/internal/domain/group_part_assignment"
+	"github.com/jphacks/os_2502/back/api/internal/domain/template_part"
+	"github.com/jphacks/os_2502/back/api/internal/domain/upload_image"
+	"github.com/jphacks/os_2502/back/api/internal/notification"
+	"github.com/jphacks/os_2502/back/api/internal/realtime"
+	"github.com/jphacks/os_2502/back/api/internal/rendition"
+	"github.com/jphacks/os_2502/back/api/internal/resample"
+)
+
+// dailyBatchSize 1回の実行で扱うグループ・予定の数
+const dailyBatchSize = 100
+
+// errNoDailyPhotos 締め切りまでに1枚も写真が届かなかった
+var errNoDailyPhotos = errors.New("no photos were uploaded for the day")
+
+// DailyCollageConfig 永続グループの毎日のコラージュの設定（ゼロ値の項目はデフォルトを使う）
+type DailyCollageConfig struct {
+	// Location 撮影時刻と日付の区切りを決めるタイムゾーン
+	Location *time.Location
+	// StartHour / EndHour この時間帯 [StartHour, EndHour) の中からランダムに撮影時刻を選ぶ
+	StartHour int
+	EndHour   int
+	// PlanInterval 今日の撮影時刻とパーツの割り当てを決める間隔
+	PlanInterval time.Duration
+	// NotifyInterval 撮影時刻になった予定を確認してメンバーに知らせる間隔
+	NotifyInterval time.Duration
+	// RenderInterval 締め切りを過ぎた予定のコラージュを生成する間隔
+	RenderInterval time.Duration
+	// RenderLease 生成中のままこの時間を過ぎた予定は別のインスタンスが生成し直す
+	RenderLease time.Duration
+}
+
+// DailyCollageScheduler 永続グループの毎日のコラージュを進める
+// 撮影時刻とパーツを決め、撮影時刻に通知し、その日の終わりに集まった写真でコラージュを生成する
+type DailyCollageScheduler struct {
+	groupRepo      group.Repository
+	memberRepo     group_member.Repository
+	dailyRepo      daily_collage.Repository
+	assignmentRepo group_part_assignment.Repository
+	templateRepo   collage_template.Repository
+	partRepo       template_part.Repository
+	uploadRepo     upload_image.Repository
+	resultRepo     collage_result.Repository
+	store          blobstore.Store
+	publisher      realtime.Publisher
+	notifier       notification.Notifier
+	resampleKernel resample.Kernel
+	cfg            DailyCollageConfig
+	// randIntn [0, n) の乱数（テストで差し替える）
+	randIntn func(n int) int
+}
+
+// NewDailyCollageScheduler 毎日のコラージュのスケジューラーを作成
+func NewDailyCollageScheduler(
+	groupRepo group.Repository,
+	memberRepo group_member.Repository,
+	dailyRepo daily_collage.Repository,
+	assignmentRepo group_part_assignment.Repository,
+	templateRepo collage_template.Repository,
+	partRepo template_part.Repository,
+	uploadRepo upload_image.Repository,
+	resultRepo collage_result.Repository,
+	store blobstore.Store,
+	publisher realtime.Publisher,
+	notifier notification.Notifier,
+	resampleKernel resample.Kernel,
+	cfg DailyCollageConfig,
+) *DailyCollageScheduler {
+	if publisher == nil {
+		publisher = realtime.NopPublisher{}
+	}
+	if notifier == nil {
+		notifier = notification.NopNotifier{}
+	}
+	if resampleKernel.At == nil {
+		resampleKernel = resample.Bilinear
+	}
+	if cfg.Location == nil {
+		cfg.Location = time.Local
+	}
+	if cfg.StartHour < 0 || cfg.EndHour > 24 || cfg.StartHour >= cfg.EndHour {
+		cfg.StartHour, cfg.EndHour = 9, 21
+	}
+	if cfg.PlanInterval <= 0 {
+		cfg.PlanInterval = 10 * time.Minute
+	}
+	if cfg.NotifyInterval <= 0 {
+		cfg.NotifyInterval = 30 * time.Second
+	}
+	if cfg.RenderInterval <= 0 {
+		cfg.RenderInterval = time.Minute
+	}
+	if cfg.RenderLease <= 0 {
+		cfg.RenderLease = 10 * time.Minute
+	}
+
+	return &DailyCollageScheduler{
+		groupRepo:      groupRepo,
+		memberRepo:     memberRepo,
+		dailyRepo:      dailyRepo,
+		assignmentRepo: assignmentRepo,
+		templateRepo:   templateRepo,
+		partRepo:       partRepo,
+		uploadRepo:     uploadRepo,
+		resultRepo:     resultRepo,
+		store:          store,
+		publisher:      publisher,
+		notifier:       notifier,
+		resampleKernel: resampleKernel,
+		cfg:            cfg,
+		randIntn:       rand.Intn,
+	}
+}
+
+// Jobs スケジューラーに登録する定期ジョブを作成
+func (s *DailyCollageScheduler) Jobs() []ScheduledJob {
+	return []ScheduledJob{
+		{
+			Name:     "plan_daily_collages",
+			Interval: s.cfg.PlanInterval,
+			Run: func(ctx context.Context) (int, error) {
+				return s.PlanToday(ctx, time.Now())
+			},
+		},
+		{
+			Name:     "notify_daily_captures",
+			Interval: s.cfg.NotifyInterval,
+			Run: func(ctx context.Context) (int, error) {
+				return s.NotifyDue(ctx, time.Now())
+			},
+		},
+		{
+			Name:     "render_daily_collages",
+			Interval: s.cfg.RenderInterval,
+			Run: func(ctx context.Context) (int, error) {
+				return s.RenderClosed(ctx, time.Now())
+			},
+		},
+	}
+}
+
+// PlanToday 今日の予定がまだ無い永続グループに撮影時刻を決めてパーツを割り当てる
+// 今日の撮影の時間帯を過ぎてから作られたグループは翌日から
+func (s *DailyCollageScheduler) PlanToday(ctx context.Context, now time.Time) (int, error) {
+	day := daily_collage.DayOf(now, s.cfg.Location)
+	if _, ok := s.captureTime(day, now); !ok {
+		return 0, nil
+	}
+
+	planned := 0
+	for offset := 0; ; offset += dailyBatchSize {
+		groups, err := s.groupRepo.FindActiveByType(ctx, group.GroupTypePermanent, dailyBatchSize, offset)
+		if err != nil {
+			return planned, err
+		}
+		for _, g := range groups {
+			ok, err := s.plan(ctx, g, day, now)
+			if err != nil {
+				log.Printf("⚠️ Failed to plan daily collage for group %s: %v", g.ID(), err)
+				continue
+			}
+			if ok {
+				planned++
+			}
+		}
+		if len(groups) < dailyBatchSize {
+			return planned, nil
+		}
+	}
+}
+
+// plan グループの1日分の予定を作成し、作成したかどうかを返す
+func (s *DailyCollageScheduler) plan(ctx context.Context, g *group.Group, day, now time.Time) (bool, error) {
+	if _, err := s.dailyRepo.FindByGroupAndDay(ctx, g.ID(), day); err == nil {
+		return false, nil
+	} else if err != daily_collage.ErrDailyCollageNotFound {
+		return false, err
+	}
+
+	templateID, parts, err := s.templateParts(ctx, g)
+	if err != nil {
+		return false, err
+	}
+	if len(parts) == 0 {
+		// パーツの無いテンプレートでは毎日のコラージュを作れない
+		return false, nil
+	}
+
+	members, err := s.memberRepo.FindByGroupID(ctx, g.ID())
+	if err != nil {
+		return false, err
+	}
+	if len(members) == 0 {
+		return false, nil
+	}
+
+	captureAt, ok := s.captureTime(day, now)
+	if !ok {
+		return false, nil
+	}
+	closesAt := daily_collage.StartOf(day.AddDate(0, 0, 1), s.cfg.Location)
+
+	// 過去の割り当てから、前回と同じパーツにならないよう順番に回す
+	if err := s.assignParts(ctx, g.ID(), members, parts, day); err != nil {
+		return false, err
+	}
+
+	daily, err := daily_collage.NewDailyCollage(g.ID(), day, templateID, captureAt, closesAt)
+	if err != nil {
+		return false, err
+	}
+	if err := s.dailyRepo.Create(ctx, daily); err != nil {
+		if err == daily_collage.ErrAlreadyScheduled {
+			return false, nil
+		}
+		return false, err
+	}
+
+	log.Printf("📅 Planned daily collage for group %s on %s at %s", g.ID(), day.Format(time.DateOnly), captureAt.In(s.cfg.Location).Format(time.Kitchen))
+	return true, nil
+}
+
+// templateParts グループのテンプレートとそのパーツをパーツ番号順に返す
+// テンプレートを選んでいないグループは uuid.Nil を返す
+func (s *DailyCollageScheduler) templateParts(ctx context.Context, g *group.Group) (uuid.UUID, []*template_part.TemplatePart, error) {
+	ref := g.TemplateID()
+	if ref == nil || *ref == "" {
+		return uuid.Nil, nil, nil
+	}
+	id, err := uuid.Parse(*ref)
+	if err != nil {
+		return uuid.Nil, nil, nil
+	}
+
+	tmpl, err := s.templateRepo.FindByID(ctx, id)
+	if err != nil {
+		if err == collage_template.ErrTemplateNotFound {
+			return uuid.Nil, nil, nil
+		}
+		return uuid.Nil, nil, err
+	}
+
+	parts, err := s.partRepo.FindByTemplateID(ctx, tmpl.TemplateID())
+	if err != nil {
+		return uuid.Nil, nil, err
+	}
+	sort.Slice(parts, func(i, j int) bool { return parts[i].PartNumber() < parts[j].PartNumber() })
+	return tmpl.TemplateID(), parts, nil
+}
+
+// captureTime day の撮影の時間帯からランダムに撮影時刻を選ぶ
+// now が時間帯の途中なら残りの時間から選び、時間帯を過ぎていれば false
+func (s *DailyCollageScheduler) captureTime(day, now time.Time) (time.Time, bool) {
+	y, m, d := day.Date()
+	start := time.Date(y, m, d, s.cfg.StartHour, 0, 0, 0, s.cfg.Location)
+	end := time.Date(y, m, d, s.cfg.EndHour, 0, 0, 0, s.cfg.Location)
+	if now.After(start) {
+		start = now
+	}
+	span := int(end.Sub(start) / time.Second)
+	if span <= 0 {
+		return time.Time{}, false
+	}
+	return start.Add(time.Duration(s.randIntn(span)) * time.Second).Truncate(time.Second), true
+}
+
+// assignParts その日のパーツをメンバーに割り当てる（既に割り当て済みなら何もしない）
+func (s *DailyCollageScheduler) assignParts(ctx context.Context, groupID string, members []*group_member.GroupMember, parts []*template_part.TemplatePart, day time.Time) error {
+	existing, err := s.assignmentRepo.FindByGroupAndDay(ctx, groupID, day)
+	if err != nil {
+		return err
+	}
+	if len(existing) > 0 {
+		return nil
+	}
+
+	history, err := s.assignmentRepo.FindHistory(ctx, groupID, day, group_part_assignment.HistoryLimit)
+	if err != nil {
+		return err
+	}
+
+	memberIDs := make([]uuid.UUID, 0, len(members))
+	for _, m := range members {
+		if id, err := uuid.Parse(m.UserID()); err == nil {
+			memberIDs = append(memberIDs, id)
+		}
+	}
+	partIDs := make([]uuid.UUID, len(parts))
+	for i, p := range parts {
+		partIDs[i] = p.PartID()
+	}
+
+	plan, err := group_part_assignment.Assign(group_part_assignment.AssignInput{
+		Strategy: group_part_assignment.StrategyRotation,
+		Members:  memberIDs,
+		Parts:    partIDs,
+		History:  history,
+	})
+	if err != nil {
+		return err
+	}
+
+	assignments := make([]*group_part_assignment.GroupPartAssignment, 0, len(plan))
+	for _, userID := range memberIDs {
+		partID, ok := plan[userID]
+		if !ok {
+			continue
+		}
+		assignment, err := group_part_assignment.NewGroupPartAssignment(groupID, userID, partID, day)
+		if err != nil {
+			return err
+		}
+		assignments = append(assignments, assignment)
+	}
+
+	// 同時にAPIから割り当てられた場合はそちらを使う
+	if err := s.assignmentRepo.CreateBatch(ctx, assignments); err != nil && err != group_part_assignment.ErrDuplicatePartAssignment {
+		return err
+	}
+	return nil
+}
+
+// NotifyDue 撮影時刻になった予定のメンバーに撮影を知らせる
+// 同じ予定を複数のインスタンスが見つけても、通知済みにできた1つだけが知らせる
+func (s *DailyCollageScheduler) NotifyDue(ctx context.Context, now time.Time) (int, error) {
+	dailies, err := s.dailyRepo.FindDueForNotification(ctx, now, dailyBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	notified := 0
+	for _, d := range dailies {
+		claimed, err := s.dailyRepo.MarkNotified(ctx, d.DailyID(), now)
+		if err != nil {
+			return notified, err
+		}
+		if !claimed {
+			continue
+		}
+		if err := s.notifyCapture(ctx, d); err != nil {
+			log.Printf("⚠️ Failed to notify daily capture for group %s: %v", d.GroupID(), err)
+			continue
+		}
+		notified++
+	}
+	return notified, nil
+}
+
+// notifyCapture 撮影時刻になったことと、それぞれが撮影するパーツを知らせる
+func (s *DailyCollageScheduler) notifyCapture(ctx context.Context, d *daily_collage.DailyCollage) error {
+	g, err := s.groupRepo.FindByID(ctx, d.GroupID())
+	if err != nil {
+		return err
+	}
+	assignments, err := s.assignmentRepo.FindByGroupAndDay(ctx, d.GroupID(), d.CollageDay())
+	if err != nil {
+		return err
+	}
+
+	collageDay := d.CollageDay().Format(time.DateOnly)
+	payload := realtime.DailyCapturePayload{
+		CollageDay:  collageDay,
+		ClosesAt:    d.ClosesAt(),
+		Assignments: make([]realtime.DailyPartAssignment, 0, len(assignments)),
+	}
+	userIDs := make([]string, 0, len(assignments))
+	for _, a := range assignments {
+		partNumber := 0
+		if part, err := s.partRepo.FindByID(ctx, a.PartID()); err == nil {
+			partNumber = part.PartNumber()
+		}
+		payload.Assignments = append(payload.Assignments, realtime.DailyPartAssignment{
+			UserID:     a.UserID().String(),
+			PartID:     a.PartID().String(),
+			PartNumber: partNumber,
+		})
+		userIDs = append(userIDs, a.UserID().String())
+	}
+
+	s.publisher.Publish(realtime.NewEvent(realtime.EventDailyCapture, d.GroupID(), payload))
+	s.notifier.Notify(userIDs, notification.DailyCapture(d.GroupID(), g.Name(), collageDay, d.ClosesAt()))
+
+	log.Printf("📣 Notified %d members of group %s to capture the daily collage", len(userIDs), d.GroupID())
+	return nil
+}
+
+// RenderClosed 締め切りを過ぎた予定のコラージュを生成する
+// 写真が1枚も無い日や生成に失敗した日は失敗として記録する
+func (s *DailyCollageScheduler) RenderClosed(ctx context.Context, now time.Time) (int, error) {
+	dailies, err := s.dailyRepo.ClaimClosed(ctx, now, now.Add(-s.cfg.RenderLease), dailyBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	rendered := 0
+	for _, d := range dailies {
+		if err := s.render(ctx, d); err != nil {
+			log.Printf("💥 Daily collage for group %s on %s failed: %v", d.GroupID(), d.CollageDay().Format(time.DateOnly), err)
+			if err := d.Fail(err, time.Now()); err == nil {
+				err = s.dailyRepo.Update(ctx, d)
+			}
+			if err != nil {
+				log.Printf("⚠️ Failed to record daily collage failure for group %s: %v", d.GroupID(), err)
+			}
+			continue
+		}
+		rendered++
+	}
+	return rendered, nil
+}
+
+// render 1日分のコラージュを生成して記録し、メンバーに知らせる
+func (s *DailyCollageScheduler) render(ctx context.Context, d *daily_collage.DailyCollage) error {
+	groupID := d.GroupID()
+	collageDay := d.CollageDay().Format(time.DateOnly)
+
+	template, err := loadTemplateData(ctx, s.templateRepo, s.partRepo, d.TemplateID())
+	if err != nil {
+		return fmt.Errorf("failed to load template: %w", err)
+	}
+
+	// 撮り直しは新しい写真を使う（images は古い順）
+	images, err := s.uploadRepo.FindByGroupAndDate(ctx, groupID, d.CollageDay())
+	if err != nil {
+		return fmt.Errorf("failed to get uploaded photos: %w", err)
+	}
+	photos := make(map[uuid.UUID]*upload_image.UploadImage, len(template.Frames))
+	for _, img := range images {
+		if partID := img.PartID(); partID != nil {
+			photos[*partID] = img
+		}
+	}
+	if len(photos) == 0 {
+		return errNoDailyPhotos
+	}
+
+	// パーツのフレームの形で切り抜いて、テンプレートの大きさのキャンバスに配置する（写真の無いパーツはプレースホルダー）
+	imagePaths := make([]string, len(template.Frames))
+	for i, frame := range template.Frames {
+		if photo, ok := photos[frame.PartID]; ok {
+			imagePaths[i] = blobstore.KeyFromFileURL(photo.FileURL())
+		}
+	}
+	canvas, frameBounds, err := composeFrames(ctx, s.store, s.resampleKernel, template, imagePaths)
+	if err != nil {
+		return fmt.Errorf("failed to create collage image: %w", err)
+	}
+
+	placements := make([]collage_result.Placement, 0, len(photos))
+	for i, bounds := range frameBounds {
+		if bounds.Empty() {
+			continue
+		}
+		placements = append(placements, collage_result.Placement{
+			ImageID:   photos[template.Frames[i].PartID].ImageID(),
+			PositionX: bounds.Min.X,
+			PositionY: bounds.Min.Y,
+			Width:     bounds.Dx(),
+			Height:    bounds.Dy(),
+			SortOrder: i,
+		})
+	}
+
+	resultPath := fmt.Sprintf("collages/%s_%s_collage.jpg", groupID, collageDay)
+	if err := rendition.Render(ctx, s.store, resultPath, canvas); err != nil {
+		return fmt.Errorf("failed to save collage image: %w", err)
+	}
+
+	members, err := s.memberRepo.FindByGroupID(ctx, groupID)
+	if err != nil {
+		return fmt.Errorf("failed to get group members: %w", err)
+	}
+
+	result, err := collage_result.NewCollageResult(d.TemplateID(), groupID, resultPath, len(members))
+	if err != nil {
+		return fmt.Errorf("failed to build collage result: %w", err)
+	}
+	if err := s.resultRepo.CreateWithPlacements(ctx, result, placements); err != nil {
+		return fmt.Errorf("failed to record collage result: %w", err)
+	}
+
+	if err := d.Rendered(result.ResultID(), time.Now()); err != nil {
+		return err
+	}
+	if err := s.dailyRepo.Update(ctx, d); err != nil {
+		return fmt.Errorf("failed to mark daily collage as rendered: %w", err)
+	}
+
+	log.Printf("🎉 Daily collage generated for group %s on %s with %d/%d parts", groupID, collageDay, len(placements), len(template.Frames))
+
+	collageURL := fmt.Sprintf("/api/groups/%s/daily/collage?day=%s", groupID, collageDay)
+	s.publisher.Publish(realtime.NewEvent(realtime.EventCollageReady, groupID, realtime.CollageReadyPayload{
+		CollageURL: collageURL,
+		CollageDay: collageDay,
+	}))
+
+	groupName := ""
+	if g, err := s.groupRepo.FindByID(ctx, groupID); err == nil {
+		groupName = g.Name()
+	}
+	userIDs := make([]string, len(members))
+	for i, m := range members {
+		userIDs[i] = m.UserID()
+	}
+	s.notifier.Notify(userIDs, notification.CollageReady(groupID, groupName, collageURL))
+
+	result.MarkAsNotified()
+	if err := s.resultRepo.Update(ctx, result); err != nil {
+		log.Printf("⚠️ Failed to mark collage result %s as notified: %v", result.ResultID(), err)
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+package worker
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jphacks/os_2502/back/api/internal/domain/daily_collage"
+	"github.com/jphacks/os_2502/back/api/internal/resample"
+)
+
+func TestDailyCaptureTime(t *testing.T) {
+	tokyo := time.FixedZone("JST", 9*60*60)
+	s := NewDailyCollageScheduler(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, resample.Kernel{}, DailyCollageConfig{
+		Location:  tokyo,
+		StartHour: 9,
+		EndHour:   21,
+	})
+
+	// 00:30 JST は前日 15:30 UTC だが、日付は JST で決まる
+	now := time.Date(2025, 10, 17, 15, 30, 0, 0, time.UTC)
+	day := daily_collage.DayOf(now, tokyo)
+	if want := time.Date(2025, 10, 18, 0, 0, 0, 0, time.UTC); !day.Equal(want) {
+		t.Fatalf("day = %v, want %v", day, want)
+	}
+
+	s.randIntn = func(n int) int { return n - 1 }
+	captureAt, ok := s.captureTime(day, now)
+	if !ok {
+		t.Fatal("expected a capture time before the window")
+	}
+	if want := time.Date(2025, 10, 18, 20, 59, 59, 0, tokyo); !captureAt.Equal(want) {
+		t.Errorf("captureAt = %v, want %v", captureAt, want)
+	}
+
+	// 時間帯の途中なら残りの時間から選ぶ
+	s.randIntn = func(n int) int { return 0 }
+	midday := time.Date(2025, 10, 18, 13, 0, 0, 0, tokyo)
+	captureAt, ok = s.captureTime(day, midday)
+	if !ok || !captureAt.Equal(midday) {
+		t.Errorf("captureAt = %v (%v), want %v", captureAt, ok, midday)
+	}
+
+	if _, ok := s.captureTime(day, time.Date(2025, 10, 18, 21, 0, 0, 0, tokyo)); ok {
+		t.Error("expected no capture time after the window")
+	}
+}
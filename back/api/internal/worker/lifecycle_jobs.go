@@ -0,0 +1,121 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/jphacks/os_2502/back/api/internal/domain/device_token"
+	"github.com/jphacks/os_2502/back/api/internal/domain/friend"
+	"github.com/jphacks/os_2502/back/api/internal/domain/group"
+	"github.com/jphacks/os_2502/back/api/internal/realtime"
+)
+
+// 期限切れにしたグループに配信する理由
+const expiredReasonExpired = "expired"
+
+// 失敗したセッションに配信する理由
+const (
+	failedReasonDeadline       = "deadline"
+	failedReasonSessionAborted = "session_aborted"
+)
+
+// LifecycleConfig グループやトークンの後片付けをする定期ジョブの設定（ゼロ値の項目はデフォルトを使う）
+type LifecycleConfig struct {
+	// GroupExpiryInterval expires_at を過ぎたグループを期限切れにする間隔
+	GroupExpiryInterval time.Duration
+	// StuckSessionInterval 終わらないセッションを確認する間隔
+	StuckSessionInterval time.Duration
+	// StuckSessionTimeout カウントダウン開始からこの時間を過ぎても完了しないセッションは打ち切る
+	// グループは失敗になり、次のラウンドを始められる（永続グループも毎日の撮影を続けられる）
+	StuckSessionTimeout time.Duration
+	// FriendRequestInterval 古いフレンド申請を削除する間隔
+	FriendRequestInterval time.Duration
+	// DeviceTokenInterval 使われていないデバイストークンを無効化する間隔
+	DeviceTokenInterval time.Duration
+	// DeviceTokenIdleDays この日数使われていないデバイストークンを無効化する
+	DeviceTokenIdleDays int
+}
+
+// LifecycleJobs スケジューラーに登録する後片付けの定期ジョブを作成
+func LifecycleJobs(
+	groupRepo group.Repository,
+	friendRepo friend.Repository,
+	deviceTokenRepo device_token.Repository,
+	publisher realtime.Publisher,
+	cfg LifecycleConfig,
+) []ScheduledJob {
+	if publisher == nil {
+		publisher = realtime.NopPublisher{}
+	}
+	if cfg.GroupExpiryInterval <= 0 {
+		cfg.GroupExpiryInterval = time.Minute
+	}
+	if cfg.StuckSessionInterval <= 0 {
+		cfg.StuckSessionInterval = time.Minute
+	}
+	if cfg.StuckSessionTimeout <= 0 {
+		cfg.StuckSessionTimeout = 15 * time.Minute
+	}
+	if cfg.FriendRequestInterval <= 0 {
+		cfg.FriendRequestInterval = time.Hour
+	}
+	if cfg.DeviceTokenInterval <= 0 {
+		cfg.DeviceTokenInterval = 24 * time.Hour
+	}
+	if cfg.DeviceTokenIdleDays <= 0 {
+		cfg.DeviceTokenIdleDays = 90
+	}
+
+	// 期限切れにしたグループを購読中のクライアントに知らせる
+	notifyExpired := func(ids []string) {
+		for _, id := range ids {
+			publisher.Publish(realtime.NewEvent(realtime.EventGroupExpired, id, realtime.GroupExpiredPayload{
+				Reason: expiredReasonExpired,
+			}))
+		}
+	}
+
+	// 打ち切ったセッションを購読中のクライアントに知らせる
+	notifyAborted := func(groups []*group.Group) {
+		for _, g := range groups {
+			publisher.Publish(realtime.NewEvent(realtime.EventSessionFailed, g.ID(), realtime.SessionFailedPayload{
+				RoundNumber: g.CurrentRound(),
+				MemberCount: g.CurrentMemberCount(),
+				Reason:      failedReasonSessionAborted,
+			}))
+		}
+	}
+
+	return []ScheduledJob{
+		{
+			Name:     "expire_groups",
+			Interval: cfg.GroupExpiryInterval,
+			Run: func(ctx context.Context) (int, error) {
+				ids, err := groupRepo.ExpireOverdue(ctx, time.Now())
+				notifyExpired(ids)
+				return len(ids), err
+			},
+		},
+		{
+			Name:     "abort_stuck_sessions",
+			Interval: cfg.StuckSessionInterval,
+			Run: func(ctx context.Context) (int, error) {
+				groups, err := groupRepo.AbortStuckSessions(ctx, time.Now().Add(-cfg.StuckSessionTimeout))
+				notifyAborted(groups)
+				return len(groups), err
+			},
+		},
+		{
+			Name:     "purge_friend_requests",
+			Interval: cfg.FriendRequestInterval,
+			Run:      friendRepo.DeleteExpiredPendingRequests,
+		},
+		{
+			Name:     "deactivate_device_tokens",
+			Interval: cfg.DeviceTokenInterval,
+			Run: func(ctx context.Context) (int, error) {
+				return deviceTokenRepo.DeactivateOldTokens(ctx, cfg.DeviceTokenIdleDays)
+			},
+		},
+	}
+}
@@ -0,0 +1,43 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/jphacks/os_2502/back/api/internal/blobstore"
+	"github.com/jphacks/os_2502/back/api/internal/domain/resumable_upload"
+)
+
+// resumableUploadPurgeBatch 1回の実行で削除するアップロードの数
+const resumableUploadPurgeBatch = 100
+
+// ResumableUploadCleanupJob 有効期限を過ぎた再開可能なアップロードを削除する定期ジョブを作成
+// 完了しなかったアップロードの受信済みのパートもストレージから削除する
+func ResumableUploadCleanupJob(repo resumable_upload.Repository, store blobstore.Store, interval time.Duration) ScheduledJob {
+	if interval <= 0 {
+		interval = 10 * time.Minute
+	}
+
+	return ScheduledJob{
+		Name:     "purge_resumable_uploads",
+		Interval: interval,
+		Run: func(ctx context.Context) (int, error) {
+			uploads, err := repo.FindExpired(ctx, time.Now(), resumableUploadPurgeBatch)
+			if err != nil {
+				return 0, err
+			}
+
+			purged := 0
+			for _, upload := range uploads {
+				if err := blobstore.DeletePrefix(ctx, store, upload.PartPrefix()); err != nil {
+					return purged, err
+				}
+				if err := repo.Delete(ctx, upload.UploadID()); err != nil {
+					return purged, err
+				}
+				purged++
+			}
+			return purged, nil
+		},
+	}
+}
@@ -0,0 +1,134 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// ScheduledJob 一定間隔で実行する定期ジョブ
+type ScheduledJob struct {
+	Name     string
+	Interval time.Duration
+	// Run 1回分の処理。変更した件数を返す
+	Run func(ctx context.Context) (int, error)
+}
+
+// JobMetrics 定期ジョブごとの実行結果の集計
+type JobMetrics struct {
+	Runs         int64     `json:"runs"`
+	Failures     int64     `json:"failures"`
+	ChangedTotal int64     `json:"changed_total"`
+	LastChanged  int       `json:"last_changed"`
+	LastRunAt    time.Time `json:"last_run_at"`
+	LastDuration string    `json:"last_duration"`
+	LastError    string    `json:"last_error,omitempty"`
+}
+
+// Scheduler 定期ジョブをそれぞれの間隔で実行する
+// ジョブごとに1つのゴルーチンで動くので、同じジョブが重なって実行されることはない
+type Scheduler struct {
+	jobs    []ScheduledJob
+	logger  *slog.Logger
+	mu      sync.Mutex
+	metrics map[string]*JobMetrics
+}
+
+// NewScheduler スケジューラーを作成（logger が nil なら slog.Default）
+func NewScheduler(logger *slog.Logger, jobs ...ScheduledJob) *Scheduler {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	metrics := make(map[string]*JobMetrics, len(jobs))
+	for _, job := range jobs {
+		metrics[job.Name] = &JobMetrics{}
+	}
+	return &Scheduler{
+		jobs:    jobs,
+		logger:  logger.With("component", "scheduler"),
+		metrics: metrics,
+	}
+}
+
+// Start 全ての定期ジョブを開始（バックグラウンドで実行）
+// 起動直後に1回実行し、その後は間隔ごとに実行する。ctx がキャンセルされると実行中のジョブを待って戻る
+func (s *Scheduler) Start(ctx context.Context) {
+	var wg sync.WaitGroup
+	for _, job := range s.jobs {
+		if job.Interval <= 0 {
+			s.logger.Warn("scheduled job disabled", "job", job.Name)
+			continue
+		}
+		wg.Add(1)
+		go func(job ScheduledJob) {
+			defer wg.Done()
+			s.loop(ctx, job)
+		}(job)
+	}
+
+	s.logger.Info("scheduler started", "jobs", len(s.jobs))
+	wg.Wait()
+	s.logger.Info("scheduler stopped")
+}
+
+func (s *Scheduler) loop(ctx context.Context, job ScheduledJob) {
+	ticker := time.NewTicker(job.Interval)
+	defer ticker.Stop()
+
+	for {
+		s.runJob(ctx, job)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// runJob ジョブを1回実行してログとメトリクスを記録する
+func (s *Scheduler) runJob(ctx context.Context, job ScheduledJob) {
+	if ctx.Err() != nil {
+		return
+	}
+
+	started := time.Now()
+	changed, err := job.Run(ctx)
+	elapsed := time.Since(started)
+
+	s.mu.Lock()
+	m := s.metrics[job.Name]
+	m.Runs++
+	m.LastRunAt = started
+	m.LastDuration = elapsed.String()
+	m.LastChanged = changed
+	m.ChangedTotal += int64(changed)
+	m.LastError = ""
+	if err != nil {
+		m.Failures++
+		m.LastError = err.Error()
+	}
+	s.mu.Unlock()
+
+	if err != nil {
+		s.logger.Error("scheduled job failed", "job", job.Name, "duration", elapsed, "changed", changed, "error", err)
+		return
+	}
+	if changed > 0 {
+		s.logger.Info("scheduled job finished", "job", job.Name, "duration", elapsed, "changed", changed)
+	} else {
+		s.logger.Debug("scheduled job finished", "job", job.Name, "duration", elapsed, "changed", 0)
+	}
+}
+
+// Metrics ジョブごとのメトリクスのスナップショットを返す
+func (s *Scheduler) Metrics() map[string]JobMetrics {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	snapshot := make(map[string]JobMetrics, len(s.metrics))
+	for name, m := range s.metrics {
+		snapshot[name] = *m
+	}
+	return snapshot
+}
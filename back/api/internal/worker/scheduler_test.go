@@ -0,0 +1,173 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jphacks/os_2502/back/api/internal/domain/device_token"
+	"github.com/jphacks/os_2502/back/api/internal/domain/friend"
+	"github.com/jphacks/os_2502/back/api/internal/domain/group"
+	"github.com/jphacks/os_2502/back/api/internal/realtime"
+)
+
+func TestSchedulerRecordsMetrics(t *testing.T) {
+	calls := 0
+	s := NewScheduler(nil,
+		ScheduledJob{Name: "ok", Interval: time.Hour, Run: func(ctx context.Context) (int, error) {
+			calls++
+			return 3, nil
+		}},
+		ScheduledJob{Name: "failing", Interval: time.Hour, Run: func(ctx context.Context) (int, error) {
+			return 0, errors.New("db down")
+		}},
+	)
+
+	ctx := context.Background()
+	s.runJob(ctx, s.jobs[0])
+	s.runJob(ctx, s.jobs[0])
+	s.runJob(ctx, s.jobs[1])
+
+	m := s.Metrics()
+	if got := m["ok"]; got.Runs != 2 || got.ChangedTotal != 6 || got.LastChanged != 3 || got.Failures != 0 {
+		t.Errorf("ok metrics = %+v", got)
+	}
+	if got := m["failing"]; got.Runs != 1 || got.Failures != 1 || got.LastError != "db down" {
+		t.Errorf("failing metrics = %+v", got)
+	}
+	if calls != 2 {
+		t.Errorf("calls = %d, want 2", calls)
+	}
+}
+
+func TestSchedulerRunsJobsOnStart(t *testing.T) {
+	ran := make(chan struct{}, 1)
+	s := NewScheduler(nil, ScheduledJob{Name: "job", Interval: time.Hour, Run: func(ctx context.Context) (int, error) {
+		ran <- struct{}{}
+		return 0, nil
+	}})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		s.Start(ctx)
+		close(done)
+	}()
+
+	select {
+	case <-ran:
+	case <-time.After(time.Second):
+		t.Fatal("job did not run on start")
+	}
+	cancel()
+	<-done
+}
+
+type fakeLifecycleGroups struct {
+	group.Repository
+	overdue       []string
+	stuck         []*group.Group
+	startedBefore time.Time
+}
+
+func (f *fakeLifecycleGroups) ExpireOverdue(ctx context.Context, now time.Time) ([]string, error) {
+	return f.overdue, nil
+}
+
+func (f *fakeLifecycleGroups) AbortStuckSessions(ctx context.Context, startedBefore time.Time) ([]*group.Group, error) {
+	f.startedBefore = startedBefore
+	return f.stuck, nil
+}
+
+type fakeLifecycleFriends struct{ friend.Repository }
+
+func (fakeLifecycleFriends) DeleteExpiredPendingRequests(ctx context.Context) (int, error) {
+	return 4, nil
+}
+
+type fakeLifecycleTokens struct {
+	device_token.Repository
+	days int
+}
+
+func (f *fakeLifecycleTokens) DeactivateOldTokens(ctx context.Context, days int) (int, error) {
+	f.days = days
+	return 2, nil
+}
+
+type recordingPublisher struct {
+	mu     sync.Mutex
+	events []realtime.Event
+}
+
+func (p *recordingPublisher) Publish(e realtime.Event) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.events = append(p.events, e)
+}
+
+func TestLifecycleJobs(t *testing.T) {
+	stuck, err := group.Reconstruct("g3", "owner", "daily", group.GroupTypePermanent, group.GroupStatusFailed,
+		4, 3, "token", nil, nil, nil, nil, nil, nil, 10, false, 7, time.Now(), time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	groups := &fakeLifecycleGroups{overdue: []string{"g1", "g2"}, stuck: []*group.Group{stuck}}
+	tokens := &fakeLifecycleTokens{}
+	publisher := &recordingPublisher{}
+
+	jobs := LifecycleJobs(groups, fakeLifecycleFriends{}, tokens, publisher, LifecycleConfig{
+		StuckSessionTimeout: 10 * time.Minute,
+		DeviceTokenIdleDays: 30,
+	})
+
+	want := map[string]int{
+		"expire_groups":            2,
+		"abort_stuck_sessions":     1,
+		"purge_friend_requests":    4,
+		"deactivate_device_tokens": 2,
+	}
+	if len(jobs) != len(want) {
+		t.Fatalf("got %d jobs, want %d", len(jobs), len(want))
+	}
+	for _, job := range jobs {
+		if job.Interval <= 0 {
+			t.Errorf("%s: interval not defaulted", job.Name)
+		}
+		changed, err := job.Run(context.Background())
+		if err != nil || changed != want[job.Name] {
+			t.Errorf("%s: changed=%d err=%v, want %d", job.Name, changed, err, want[job.Name])
+		}
+	}
+
+	if d := time.Since(groups.startedBefore); d < 10*time.Minute || d > 11*time.Minute {
+		t.Errorf("stuck session cutoff is %v ago, want ~10m", d)
+	}
+	if tokens.days != 30 {
+		t.Errorf("device token idle days = %d, want 30", tokens.days)
+	}
+
+	reasons := map[string]string{}
+	for _, e := range publisher.events {
+		switch p := e.Payload.(type) {
+		case realtime.GroupExpiredPayload:
+			reasons[e.GroupID] = p.Reason
+		case realtime.SessionFailedPayload:
+			if p.RoundNumber != 7 || p.MemberCount != 3 {
+				t.Errorf("group %s: session failed payload = %+v", e.GroupID, p)
+			}
+			reasons[e.GroupID] = p.Reason
+		default:
+			t.Errorf("unexpected event %s", e.Type)
+		}
+	}
+	// 打ち切ったセッションはグループの期限切れではなくセッションの失敗として知らせる
+	wantReasons := map[string]string{"g1": "expired", "g2": "expired", "g3": "session_aborted"}
+	for id, reason := range wantReasons {
+		if reasons[id] != reason {
+			t.Errorf("group %s: reason = %q, want %q", id, reasons[id], reason)
+		}
+	}
+}
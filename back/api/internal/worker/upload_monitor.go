@@ -28,8 +28,8 @@ func NewUploadMonitor(uploadImageRepo upload_image.Repository) *UploadMonitor {
 
 // CheckUploadStatus アップロード状況を確認
 func (m *UploadMonitor) CheckUploadStatus(ctx context.Context, groupID string) (*UploadStatus, error) {
-	// グループのアップロード画像を取得
-	images, err := m.uploadImageRepo.FindByGroupID(ctx, groupID, 1000, 0)
+	// グループのアップロード画像を取得（撮り直しは各メンバーの最新の1枚として数える）
+	images, err := m.uploadImageRepo.FindLatestByGroupID(ctx, groupID)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,43 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/jphacks/os_2502/back/api/internal/blobstore"
+	"github.com/jphacks/os_2502/back/api/internal/domain/upload_slot"
+)
+
+// uploadSlotPurgeBatch 1回の実行で削除するアップロード枠の数
+const uploadSlotPurgeBatch = 100
+
+// UploadSlotCleanupJob 確定されずに有効期限が切れた直接アップロードの枠を削除する定期ジョブを作成
+// 端末がアップロードしたまま確定しなかったオブジェクトもストレージから削除する
+func UploadSlotCleanupJob(slotRepo upload_slot.Repository, store blobstore.Store, interval time.Duration) ScheduledJob {
+	if interval <= 0 {
+		interval = 10 * time.Minute
+	}
+
+	return ScheduledJob{
+		Name:     "purge_upload_slots",
+		Interval: interval,
+		Run: func(ctx context.Context) (int, error) {
+			slots, err := slotRepo.FindExpiredPending(ctx, time.Now(), uploadSlotPurgeBatch)
+			if err != nil {
+				return 0, err
+			}
+
+			purged := 0
+			for _, slot := range slots {
+				if err := store.Delete(ctx, slot.ObjectKey()); err != nil {
+					return purged, err
+				}
+				if err := slotRepo.Delete(ctx, slot.SlotID()); err != nil {
+					return purged, err
+				}
+				purged++
+			}
+			return purged, nil
+		},
+	}
+}
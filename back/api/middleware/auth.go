@@ -0,0 +1,116 @@
+package middleware
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/jphacks/os_2502/back/api/internal/auth"
+	"github.com/jphacks/os_2502/back/api/internal/domain/user"
+)
+
+// publicPaths 認証なしで呼べるパス（末尾が / のものは前方一致）
+var publicPaths = []string{
+	"/api/health",
+	"/api/time/sync",
+	"/api/template-data",
+	"/api/template-data/",
+	// 署名付きURL（署名を LocalStore が検証する）
+	"/api/blobs/",
+}
+
+// isPublic 認証が不要なリクエストか
+func isPublic(r *http.Request) bool {
+	// tus の対応している機能の問い合わせ
+	if r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") == "" && strings.HasPrefix(r.URL.Path, "/api/uploads") {
+		return true
+	}
+	for _, p := range publicPaths {
+		if r.URL.Path == p || (strings.HasSuffix(p, "/") && strings.HasPrefix(r.URL.Path, p)) {
+			return true
+		}
+	}
+	return false
+}
+
+// allowsUnregistered まだユーザー登録していない Firebase ユーザーでも呼べるリクエストか（登録と登録済みかの確認）
+func allowsUnregistered(r *http.Request) bool {
+	switch {
+	case r.URL.Path == "/api/users" && r.Method == http.MethodPost:
+		return true
+	case r.URL.Path == "/api/users/firebase" && r.Method == http.MethodGet:
+		return true
+	}
+	return false
+}
+
+// AuthMiddleware Authorization: Bearer の Firebase ID トークンを検証し、呼び出し元のユーザーをコンテキストに入れる
+func AuthMiddleware(verifier *auth.Verifier, userRepo user.Repository) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if isPublic(r) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			raw := bearerToken(r)
+			if raw == "" {
+				writeError(w, http.StatusUnauthorized, "認証が必要です")
+				return
+			}
+
+			token, err := verifier.Verify(r.Context(), raw)
+			if err != nil {
+				if errors.Is(err, auth.ErrTokenExpired) {
+					writeError(w, http.StatusUnauthorized, "認証トークンの有効期限が切れています")
+				} else {
+					writeError(w, http.StatusUnauthorized, "認証トークンが無効です")
+				}
+				return
+			}
+			ctx := auth.WithToken(r.Context(), token)
+
+			u, err := userRepo.FindByFirebaseUID(ctx, token.UID)
+			switch {
+			case err == nil:
+				ctx = auth.WithUser(ctx, u)
+			case err == user.ErrUserNotFound && allowsUnregistered(r):
+				// 登録前のユーザー
+			case err == user.ErrUserNotFound:
+				writeError(w, http.StatusForbidden, "ユーザー登録が完了していません")
+				return
+			default:
+				writeError(w, http.StatusInternalServerError, "ユーザーの取得に失敗しました")
+				return
+			}
+
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// bearerToken Authorization ヘッダーからトークンを取り出す。
+// WebSocket はクライアントによってヘッダーを付けられないため access_token クエリも受け付ける
+func bearerToken(r *http.Request) string {
+	if h := r.Header.Get("Authorization"); h != "" {
+		scheme, token, ok := strings.Cut(h, " ")
+		if ok && strings.EqualFold(scheme, "Bearer") {
+			return strings.TrimSpace(token)
+		}
+		return ""
+	}
+	if strings.EqualFold(r.Header.Get("Upgrade"), "websocket") {
+		return r.URL.Query().Get("access_token")
+	}
+	return ""
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(map[string]string{
+		"error":   http.StatusText(status),
+		"message": message,
+	})
+}
@@ -0,0 +1,112 @@
+package middleware
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jphacks/os_2502/back/api/internal/auth"
+	"github.com/jphacks/os_2502/back/api/internal/domain/user"
+)
+
+// fakeUsers Firebase UID で検索するだけのユーザーリポジトリ
+type fakeUsers struct {
+	user.Repository
+	byUID map[string]*user.User
+}
+
+func (f fakeUsers) FindByFirebaseUID(_ context.Context, uid string) (*user.User, error) {
+	if u, ok := f.byUID[uid]; ok {
+		return u, nil
+	}
+	return nil, user.ErrUserNotFound
+}
+
+func signToken(t *testing.T, key *rsa.PrivateKey, uid string) string {
+	t.Helper()
+	now := time.Now()
+	h, _ := json.Marshal(map[string]string{"alg": "RS256", "kid": "k1"})
+	b, _ := json.Marshal(map[string]interface{}{
+		"iss":       "https://securetoken.google.com/test-project",
+		"aud":       "test-project",
+		"sub":       uid,
+		"iat":       now.Unix(),
+		"exp":       now.Add(time.Hour).Unix(),
+		"auth_time": now.Unix(),
+	})
+	signing := base64.RawURLEncoding.EncodeToString(h) + "." + base64.RawURLEncoding.EncodeToString(b)
+	digest := sha256.Sum256([]byte(signing))
+	sig, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signing + "." + base64.RawURLEncoding.EncodeToString(sig)
+}
+
+func TestAuthMiddleware(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	registered, err := user.Reconstruct(uuid.New(), "uid-registered", "taro", nil, time.Now(), time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	verifier := auth.NewVerifier("test-project", auth.StaticKeys{"k1": &key.PublicKey})
+	users := fakeUsers{byUID: map[string]*user.User{"uid-registered": registered}}
+
+	var gotUser *user.User
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotUser, _ = auth.UserFromContext(r.Context())
+		w.WriteHeader(http.StatusOK)
+	})
+	h := AuthMiddleware(verifier, users)(next)
+
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		token      string
+		wantStatus int
+		wantUser   bool
+	}{
+		{name: "public path", method: http.MethodGet, path: "/api/health", wantStatus: http.StatusOK},
+		{name: "missing token", method: http.MethodGet, path: "/api/groups", wantStatus: http.StatusUnauthorized},
+		{name: "garbage token", method: http.MethodGet, path: "/api/groups", token: "abc", wantStatus: http.StatusUnauthorized},
+		{name: "registered user", method: http.MethodGet, path: "/api/groups", token: signToken(t, key, "uid-registered"), wantStatus: http.StatusOK, wantUser: true},
+		{name: "unregistered user", method: http.MethodGet, path: "/api/groups", token: signToken(t, key, "uid-new"), wantStatus: http.StatusForbidden},
+		{name: "unregistered user can sign up", method: http.MethodPost, path: "/api/users", token: signToken(t, key, "uid-new"), wantStatus: http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotUser = nil
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			if tt.token != "" {
+				req.Header.Set("Authorization", "Bearer "+tt.token)
+			}
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if tt.wantUser && (gotUser == nil || gotUser.ID() != registered.ID()) {
+				t.Errorf("user in context = %v, want %v", gotUser, registered.ID())
+			}
+			if !tt.wantUser && gotUser != nil {
+				t.Errorf("unexpected user in context: %v", gotUser.ID())
+			}
+		})
+	}
+}
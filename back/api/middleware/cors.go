@@ -2,17 +2,21 @@ package middleware
 
 import (
 	"net/http"
+	"strings"
 )
 
 func CORSMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 
 		w.Header().Set("Access-Control-Allow-Origin", "*")
-		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
+		w.Header().Set("Access-Control-Allow-Methods", "GET, HEAD, POST, PUT, PATCH, DELETE, OPTIONS")
+		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, Accept, If-None-Match, Range, "+
+			"Tus-Resumable, Upload-Length, Upload-Offset, Upload-Metadata, Upload-Defer-Length, X-HTTP-Method-Override")
+		w.Header().Set("Access-Control-Expose-Headers", "Location, ETag, Content-Range, Accept-Ranges, Content-Disposition, "+
+			"Tus-Resumable, Tus-Version, Tus-Extension, Tus-Max-Size, Upload-Length, Upload-Offset, Upload-Metadata, Upload-Expires")
 
-		// Handle preflight requests
-		if r.Method == "OPTIONS" {
+		// Handle preflight requests（tus の OPTIONS は Access-Control-Request-Method が無いのでハンドラーに渡す）
+		if r.Method == "OPTIONS" && (r.Header.Get("Access-Control-Request-Method") != "" || !strings.HasPrefix(r.URL.Path, "/api/uploads")) {
 			w.WriteHeader(http.StatusOK)
 			return
 		}
@@ -0,0 +1,8 @@
+-- Add frame_index column to upload_images table for placing photos into template frames
+
+ALTER TABLE `upload_images`
+ADD COLUMN `frame_index` INT NULL COMMENT 'テンプレートのフレーム番号（frames配列の0始まりのインデックス）' AFTER `part_id`;
+
+-- Add index for looking up the latest photo per frame
+ALTER TABLE `upload_images`
+ADD INDEX `idx_group_frame_index` (`group_id`, `frame_index`);
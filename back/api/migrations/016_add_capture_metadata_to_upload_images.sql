@@ -0,0 +1,6 @@
+-- Add capture metadata read from EXIF on ingest to upload_images table
+
+ALTER TABLE `upload_images`
+ADD COLUMN `captured_at` DATETIME NULL COMMENT '撮影日時（EXIFから取得）' AFTER `frame_index`,
+ADD COLUMN `original_width` INT NULL COMMENT '元画像の幅（向き補正後のピクセル数）' AFTER `captured_at`,
+ADD COLUMN `original_height` INT NULL COMMENT '元画像の高さ（向き補正後のピクセル数）' AFTER `original_width`;
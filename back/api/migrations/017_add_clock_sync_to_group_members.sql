@@ -0,0 +1,6 @@
+-- Add estimated device clock offset and round-trip time to group_members table
+
+ALTER TABLE `group_members`
+ADD COLUMN `clock_offset_ms` INT NULL COMMENT '端末時計のずれ（サーバー時刻 - 端末時刻、ミリ秒）' AFTER `ready_at`,
+ADD COLUMN `clock_rtt_ms` INT NULL COMMENT '時刻同期の往復遅延（ミリ秒）' AFTER `clock_offset_ms`,
+ADD COLUMN `clock_synced_at` TIMESTAMP NULL COMMENT '最後に時刻同期した時刻' AFTER `clock_rtt_ms`;
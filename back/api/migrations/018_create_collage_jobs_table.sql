@@ -0,0 +1,29 @@
+-- collage_jobsテーブルの作成
+-- コラージュ生成をジョブとして永続化し、複数のAPIインスタンスからリースを取って処理する
+
+CREATE TABLE IF NOT EXISTS collage_jobs (
+    job_id CHAR(36) PRIMARY KEY COMMENT 'ジョブID (UUID)',
+    group_id CHAR(36) NOT NULL COMMENT 'グループID',
+    status VARCHAR(20) NOT NULL DEFAULT 'pending' COMMENT 'ステータス (pending / running / succeeded / dead)',
+    active_key CHAR(36) NULL COMMENT '未完了の間だけグループIDが入る（同じグループのジョブの重複登録を防ぐ）',
+    attempts INT NOT NULL DEFAULT 0 COMMENT '実行回数',
+    max_attempts INT NOT NULL COMMENT '最大実行回数（超えたら dead）',
+    run_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '次に実行できる時刻',
+    locked_by VARCHAR(100) NULL COMMENT 'リースを持っているワーカー',
+    locked_until TIMESTAMP NULL COMMENT 'リースの期限',
+    last_error TEXT NULL COMMENT '最後の失敗理由',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '作成日時',
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新日時',
+
+    -- インデックス
+    UNIQUE INDEX uq_active_key (active_key),
+    INDEX idx_group_id (group_id),
+    INDEX idx_status_run_at (status, run_at),
+
+    -- 外部キー制約
+    CONSTRAINT fk_collage_jobs_group_id
+        FOREIGN KEY (group_id)
+        REFERENCES `groups`(id)
+        ON DELETE CASCADE
+        ON UPDATE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='コラージュ生成ジョブテーブル';
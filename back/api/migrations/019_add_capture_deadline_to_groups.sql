@@ -0,0 +1,6 @@
+-- Add capture deadline and failed status to groups table
+-- 撮影時刻から撮影受付の締め切りまでを photo_taking とし、締め切りで揃わなければ failed にできるようにする
+
+ALTER TABLE `groups`
+MODIFY COLUMN `status` ENUM('recruiting', 'ready_check', 'countdown', 'photo_taking', 'completed', 'failed', 'expired') NOT NULL DEFAULT 'recruiting' COMMENT 'グループステータス',
+ADD COLUMN `capture_deadline` TIMESTAMP NULL COMMENT '撮影した写真の受付締め切り' AFTER `scheduled_capture_time`;
@@ -0,0 +1,5 @@
+-- Add per-group photo session settings to groups table
+
+ALTER TABLE `groups`
+ADD COLUMN `countdown_seconds` INT NOT NULL DEFAULT 10 COMMENT 'カウントダウンの秒数' AFTER `expires_at`,
+ADD COLUMN `auto_start` BOOLEAN NOT NULL DEFAULT FALSE COMMENT '全員が準備完了したら自動でカウントダウンを開始する' AFTER `countdown_seconds`;
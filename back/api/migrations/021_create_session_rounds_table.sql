@@ -0,0 +1,55 @@
+-- session_roundsテーブルの作成
+-- 同じグループで何度も撮影できるように、カウントダウンを開始するごとに1ラウンドとして記録する
+
+CREATE TABLE IF NOT EXISTS session_rounds (
+    round_id CHAR(36) PRIMARY KEY COMMENT 'ラウンドID (UUID)',
+    group_id CHAR(36) NOT NULL COMMENT 'グループID',
+    round_number INT NOT NULL COMMENT 'グループ内のラウンド番号（1始まり）',
+    template_id CHAR(36) NOT NULL COMMENT 'このラウンドで使うテンプレートID',
+    scheduled_capture_time TIMESTAMP NOT NULL COMMENT '撮影予定時刻',
+    capture_deadline TIMESTAMP NOT NULL COMMENT '写真の受付締め切り',
+    status VARCHAR(20) NOT NULL DEFAULT 'in_progress' COMMENT 'ステータス (in_progress / completed / failed / aborted)',
+    finished_at TIMESTAMP NULL COMMENT '終了日時',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '作成日時（カウントダウン開始日時）',
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新日時',
+
+    -- インデックス
+    UNIQUE INDEX uq_group_round (group_id, round_number),
+    INDEX idx_status (status),
+
+    -- 外部キー制約
+    CONSTRAINT fk_session_rounds_group_id
+        FOREIGN KEY (group_id)
+        REFERENCES `groups`(id)
+        ON DELETE CASCADE
+        ON UPDATE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='撮影ラウンドテーブル';
+
+-- 現在（直近）のラウンド番号。まだ一度も撮影していないグループは 0
+ALTER TABLE `groups`
+ADD COLUMN `current_round` INT NOT NULL DEFAULT 0 COMMENT '現在のラウンド番号（未撮影は0）' AFTER `auto_start`;
+
+-- 写真とコラージュ結果をラウンドごとに分ける
+ALTER TABLE `upload_images`
+ADD COLUMN `round_number` INT NOT NULL DEFAULT 1 COMMENT 'ラウンド番号' AFTER `frame_index`,
+ADD INDEX `idx_group_round` (`group_id`, `round_number`);
+
+ALTER TABLE `collage_results`
+ADD COLUMN `round_number` INT NOT NULL DEFAULT 1 COMMENT 'ラウンド番号' AFTER `group_id`,
+ADD INDEX `idx_group_round` (`group_id`, `round_number`);
+
+-- 既存の撮影済みグループは1ラウンド目として記録する
+UPDATE `groups` SET `current_round` = 1 WHERE `countdown_started_at` IS NOT NULL;
+
+INSERT INTO session_rounds (round_id, group_id, round_number, template_id, scheduled_capture_time, capture_deadline, status, finished_at, created_at, updated_at)
+SELECT UUID(), id, 1, template_id, scheduled_capture_time, COALESCE(capture_deadline, scheduled_capture_time),
+    CASE status
+        WHEN 'completed' THEN 'completed'
+        WHEN 'failed' THEN 'failed'
+        WHEN 'expired' THEN 'aborted'
+        ELSE 'in_progress'
+    END,
+    CASE WHEN status IN ('completed', 'failed', 'expired') THEN updated_at ELSE NULL END,
+    countdown_started_at, updated_at
+FROM `groups`
+WHERE `countdown_started_at` IS NOT NULL AND `template_id` IS NOT NULL AND `scheduled_capture_time` IS NOT NULL;
@@ -0,0 +1,39 @@
+-- daily_collagesテーブルの作成
+-- 永続グループの「毎日のコラージュ」。日ごとにランダムな撮影時刻を決め、その日の終わりに生成する
+
+CREATE TABLE IF NOT EXISTS daily_collages (
+    daily_id CHAR(36) PRIMARY KEY COMMENT 'ID (UUID)',
+    group_id CHAR(36) NOT NULL COMMENT 'グループID',
+    collage_day DATE NOT NULL COMMENT 'コラージュ対象日（設定したタイムゾーンでの日付）',
+    template_id CHAR(36) NOT NULL COMMENT 'この日に使うテンプレートID',
+    capture_at TIMESTAMP NOT NULL COMMENT '撮影時刻（この時刻にメンバーへ通知し、写真の受付を開始）',
+    closes_at TIMESTAMP NOT NULL COMMENT '写真の受付締め切り（対象日の翌日0時）',
+    notified_at TIMESTAMP NULL COMMENT 'メンバーへ撮影時刻を通知した日時',
+    status VARCHAR(20) NOT NULL DEFAULT 'scheduled' COMMENT 'ステータス (scheduled / rendering / rendered / failed)',
+    result_id CHAR(36) NULL COMMENT '生成したコラージュ結果ID',
+    last_error TEXT NULL COMMENT '生成に失敗した理由',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '作成日時',
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新日時',
+
+    -- インデックス
+    UNIQUE INDEX uq_group_collage_day (group_id, collage_day),
+    INDEX idx_capture_at (capture_at),
+    INDEX idx_status_closes_at (status, closes_at),
+
+    -- 外部キー制約
+    CONSTRAINT fk_daily_collages_group_id
+        FOREIGN KEY (group_id)
+        REFERENCES `groups`(id)
+        ON DELETE CASCADE
+        ON UPDATE CASCADE,
+    CONSTRAINT fk_daily_collages_template_id
+        FOREIGN KEY (template_id)
+        REFERENCES collages_template(template_id)
+        ON DELETE CASCADE
+        ON UPDATE CASCADE,
+    CONSTRAINT fk_daily_collages_result_id
+        FOREIGN KEY (result_id)
+        REFERENCES collage_results(result_id)
+        ON DELETE SET NULL
+        ON UPDATE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='毎日のコラージュテーブル';
@@ -0,0 +1,13 @@
+-- 1人のメンバーに同じ日に複数のパーツを割り当てない
+-- 自動割り当てを同時に実行しても、メンバーごとに1つしか作られないようにする
+
+-- 既に重複している割り当ては最初のものだけ残す
+DELETE a FROM group_part_assignments a
+JOIN group_part_assignments b
+  ON a.group_id = b.group_id
+ AND a.collage_day = b.collage_day
+ AND a.user_id = b.user_id
+ AND (a.assigned_at > b.assigned_at OR (a.assigned_at = b.assigned_at AND a.assignment_id > b.assignment_id));
+
+ALTER TABLE group_part_assignments
+ADD UNIQUE KEY unique_group_day_user (group_id, collage_day, user_id);
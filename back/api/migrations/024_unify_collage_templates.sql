@@ -0,0 +1,73 @@
+-- テンプレートを collages_template / template_parts に一本化する
+-- これまで resources/templates.json にしか無かった viewBox やフレームのSVGパスもテーブルで持ち、
+-- groups / session_rounds の template_id にはテンプレート名ではなく collages_template のIDを保存する
+-- templates.json の内容は API の起動時に source_name で照合してインポートされる
+
+ALTER TABLE collages_template
+ADD COLUMN source_name VARCHAR(100) NULL COMMENT '組み込みテンプレートの templates.json での名前（ユーザーが作ったテンプレートは NULL）' AFTER file_path,
+ADD COLUMN photo_count INT NOT NULL DEFAULT 0 COMMENT '必要な写真の枚数' AFTER source_name,
+ADD COLUMN view_box VARCHAR(64) NOT NULL DEFAULT '0 0 1 1' COMMENT 'フレームのパスの座標系（SVGのviewBox）' AFTER photo_count,
+ADD COLUMN width INT NOT NULL DEFAULT 1000 COMMENT '生成するコラージュの幅（ピクセル）' AFTER view_box,
+ADD COLUMN height INT NOT NULL DEFAULT 1000 COMMENT '生成するコラージュの高さ（ピクセル）' AFTER width;
+
+ALTER TABLE template_parts
+ADD COLUMN path TEXT NULL COMMENT 'フレームの形（テンプレートのviewBox座標系のSVGパス。NULLなら矩形）' AFTER height;
+
+-- ワーカーが templates.json から作っていた行を組み込みテンプレートとして扱う
+UPDATE collages_template SET source_name = name WHERE file_path = 'resources/templates.json';
+
+-- グループやラウンドに保存されているテンプレート名のうち、まだ行が無いものを作っておく
+-- （フレームなどは起動時のインポートで埋まる）
+INSERT INTO collages_template (template_id, name, file_path, source_name)
+SELECT UUID(), n.template_id, 'resources/templates.json', n.template_id
+FROM (
+    SELECT template_id FROM `groups` WHERE template_id IS NOT NULL
+    UNION
+    SELECT template_id FROM session_rounds
+) n
+WHERE NOT EXISTS (SELECT 1 FROM collages_template t WHERE t.template_id = n.template_id OR t.source_name = n.template_id);
+
+-- 同じ名前の行が複数できていた場合は1つにまとめる
+UPDATE collage_results r
+JOIN collages_template t ON t.template_id = r.template_id
+JOIN (SELECT source_name, MIN(template_id) AS keep_id FROM collages_template WHERE source_name IS NOT NULL GROUP BY source_name) k ON k.source_name = t.source_name
+SET r.template_id = k.keep_id
+WHERE r.template_id <> k.keep_id;
+
+UPDATE daily_collages d
+JOIN collages_template t ON t.template_id = d.template_id
+JOIN (SELECT source_name, MIN(template_id) AS keep_id FROM collages_template WHERE source_name IS NOT NULL GROUP BY source_name) k ON k.source_name = t.source_name
+SET d.template_id = k.keep_id
+WHERE d.template_id <> k.keep_id;
+
+DELETE t FROM collages_template t
+JOIN (SELECT source_name, MIN(template_id) AS keep_id FROM collages_template WHERE source_name IS NOT NULL GROUP BY source_name) k ON k.source_name = t.source_name
+WHERE t.template_id <> k.keep_id;
+
+ALTER TABLE collages_template
+ADD UNIQUE KEY unique_source_name (source_name);
+
+-- テンプレート名をIDに置き換える
+UPDATE `groups` g
+JOIN collages_template t ON t.source_name = g.template_id
+SET g.template_id = t.template_id;
+
+UPDATE session_rounds r
+JOIN collages_template t ON t.source_name = r.template_id
+SET r.template_id = t.template_id;
+
+ALTER TABLE `groups`
+MODIFY COLUMN `template_id` CHAR(36) NULL COMMENT '選択されたテンプレートID（collages_template のID。グループ内で統一）',
+ADD CONSTRAINT fk_groups_template_id
+    FOREIGN KEY (template_id)
+    REFERENCES collages_template(template_id)
+    ON DELETE SET NULL
+    ON UPDATE CASCADE;
+
+ALTER TABLE session_rounds
+ADD INDEX idx_template_id (template_id),
+ADD CONSTRAINT fk_session_rounds_template_id
+    FOREIGN KEY (template_id)
+    REFERENCES collages_template(template_id)
+    ON DELETE CASCADE
+    ON UPDATE CASCADE;
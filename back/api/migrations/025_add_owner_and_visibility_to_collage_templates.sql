@@ -0,0 +1,20 @@
+-- ユーザーが作成したテンプレート
+-- 作成者と公開範囲（private: 自分だけ / friends: フレンドまで / public: 全員）を持ち、他のテンプレートからの複製元を記録する
+-- 組み込みテンプレートは作成者なしの public
+
+ALTER TABLE collages_template
+MODIFY COLUMN file_path VARCHAR(255) NULL COMMENT 'テンプレートファイルパス（ユーザーが作成したテンプレートは NULL）',
+ADD COLUMN owner_user_id CHAR(36) NULL COMMENT '作成者のユーザーID（組み込みテンプレートは NULL）' AFTER source_name,
+ADD COLUMN visibility VARCHAR(20) NOT NULL DEFAULT 'public' COMMENT '公開範囲 (private / friends / public)' AFTER owner_user_id,
+ADD COLUMN forked_from CHAR(36) NULL COMMENT '複製元のテンプレートID' AFTER visibility,
+ADD INDEX idx_owner_visibility (owner_user_id, visibility),
+ADD CONSTRAINT fk_collages_template_owner_user_id
+    FOREIGN KEY (owner_user_id)
+    REFERENCES users(id)
+    ON DELETE CASCADE
+    ON UPDATE CASCADE,
+ADD CONSTRAINT fk_collages_template_forked_from
+    FOREIGN KEY (forked_from)
+    REFERENCES collages_template(template_id)
+    ON DELETE SET NULL
+    ON UPDATE CASCADE;
@@ -0,0 +1,38 @@
+-- upload_slotsテーブルの作成
+-- 端末がストレージへ直接アップロードするための枠。署名付きURLを発行し、確定時にサイズと形式を確認して upload_images に記録する
+
+CREATE TABLE IF NOT EXISTS upload_slots (
+    slot_id CHAR(36) PRIMARY KEY COMMENT 'アップロード枠ID (UUID)',
+    group_id CHAR(36) NOT NULL COMMENT 'グループID',
+    user_id CHAR(36) NOT NULL COMMENT 'アップロードするユーザーID',
+    frame_index INT NOT NULL COMMENT 'フレーム番号',
+    object_key VARCHAR(255) NOT NULL COMMENT '端末がアップロードするストレージのキー',
+    content_type VARCHAR(50) NOT NULL COMMENT 'アップロードする画像の形式',
+    max_size BIGINT NOT NULL COMMENT '受け付ける最大サイズ（バイト）',
+    status VARCHAR(20) NOT NULL DEFAULT 'pending' COMMENT 'ステータス (pending / confirmed)',
+    image_id CHAR(36) NULL COMMENT '確定時に記録した画像ID',
+    expires_at TIMESTAMP NOT NULL COMMENT '署名付きURLの有効期限',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '作成日時',
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新日時',
+
+    -- インデックス
+    INDEX idx_group_user (group_id, user_id),
+    INDEX idx_status_expires_at (status, expires_at),
+
+    -- 外部キー制約
+    CONSTRAINT fk_upload_slots_group_id
+        FOREIGN KEY (group_id)
+        REFERENCES `groups`(id)
+        ON DELETE CASCADE
+        ON UPDATE CASCADE,
+    CONSTRAINT fk_upload_slots_user_id
+        FOREIGN KEY (user_id)
+        REFERENCES users(id)
+        ON DELETE CASCADE
+        ON UPDATE CASCADE,
+    CONSTRAINT fk_upload_slots_image_id
+        FOREIGN KEY (image_id)
+        REFERENCES upload_images(image_id)
+        ON DELETE SET NULL
+        ON UPDATE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='直接アップロード枠テーブル';
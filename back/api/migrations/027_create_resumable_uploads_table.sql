@@ -0,0 +1,38 @@
+-- resumable_uploadsテーブルの作成
+-- tus プロトコルで分割して送られる写真のアップロード。受信済みの位置を記録し、接続が切れても続きから再開できる
+
+CREATE TABLE IF NOT EXISTS resumable_uploads (
+    upload_id CHAR(36) PRIMARY KEY COMMENT 'アップロードID (UUID)',
+    group_id CHAR(36) NOT NULL COMMENT 'グループID',
+    user_id CHAR(36) NOT NULL COMMENT 'アップロードするユーザーID',
+    frame_index INT NOT NULL COMMENT 'フレーム番号',
+    upload_length BIGINT NOT NULL COMMENT '全体のサイズ（バイト）',
+    upload_offset BIGINT NOT NULL DEFAULT 0 COMMENT '受信済みのサイズ（バイト）',
+    metadata VARCHAR(1000) NULL COMMENT '作成時の Upload-Metadata ヘッダー',
+    status VARCHAR(20) NOT NULL DEFAULT 'uploading' COMMENT 'ステータス (uploading / completed)',
+    image_id CHAR(36) NULL COMMENT '完了時に記録した画像ID',
+    expires_at TIMESTAMP NOT NULL COMMENT 'アップロードの有効期限',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '作成日時',
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新日時',
+
+    -- インデックス
+    INDEX idx_group_user (group_id, user_id),
+    INDEX idx_expires_at (expires_at),
+
+    -- 外部キー制約
+    CONSTRAINT fk_resumable_uploads_group_id
+        FOREIGN KEY (group_id)
+        REFERENCES `groups`(id)
+        ON DELETE CASCADE
+        ON UPDATE CASCADE,
+    CONSTRAINT fk_resumable_uploads_user_id
+        FOREIGN KEY (user_id)
+        REFERENCES users(id)
+        ON DELETE CASCADE
+        ON UPDATE CASCADE,
+    CONSTRAINT fk_resumable_uploads_image_id
+        FOREIGN KEY (image_id)
+        REFERENCES upload_images(image_id)
+        ON DELETE SET NULL
+        ON UPDATE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='再開可能なアップロードテーブル';
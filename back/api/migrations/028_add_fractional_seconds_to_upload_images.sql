@@ -0,0 +1,5 @@
+-- 撮り直しで同じ秒に複数の写真が記録されても、最新の1枚を作成日時で決められるようにする
+-- 作成日時はアプリケーションで設定するので、マイクロ秒まで保存する
+
+ALTER TABLE upload_images
+MODIFY COLUMN created_at TIMESTAMP(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) COMMENT 'アップロード日時';